@@ -11,6 +11,10 @@ import (
 	"github.com/fatih/color"
 	"github.com/kris-nova/logger"
 	lol "github.com/kris-nova/lolgopher"
+	"golang.org/x/term"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsValue bool) {
@@ -121,3 +125,34 @@ func dumpLogsToDisk(logBuffer *bytes.Buffer, errorString string) error {
 
 	return err
 }
+
+// initStackEventView switches to the compact view of stack events if requested and stdout is a terminal.
+// Log lines are then written through the view, so that they do not overwrite the lines it redraws.
+func initStackEventView(compact bool) {
+	if !compact || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return
+	}
+	view := manager.NewCompactStackEventView(os.Stdout, logger.Writer)
+	logger.Writer = view
+	manager.SetStackEventView(view)
+}
+
+// initProgressEvents returns the sink that emits progress events in the given format to stderr, or appends them to
+// output if set; there is no sink if no format is given.
+func initProgressEvents(format, output string) (tasks.EventSink, error) {
+	if format == "" {
+		if output != "" {
+			return nil, fmt.Errorf("--progress-output requires --progress-format")
+		}
+		return nil, nil
+	}
+	var w io.Writer = os.Stderr
+	if output != "" {
+		f, err := os.OpenFile(output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("opening progress output: %w", err)
+		}
+		w = f
+	}
+	return tasks.NewEventSink(format, w)
+}
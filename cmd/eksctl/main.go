@@ -2,13 +2,19 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/weaveworks/eksctl/pkg/actions/anywhere"
+	"github.com/weaveworks/eksctl/pkg/ctl/apply"
 	"github.com/weaveworks/eksctl/pkg/ctl/associate"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/completion"
@@ -28,6 +34,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/update"
 	"github.com/weaveworks/eksctl/pkg/ctl/upgrade"
 	"github.com/weaveworks/eksctl/pkg/ctl/utils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
@@ -52,6 +59,7 @@ func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
 	rootCmd.AddCommand(cmdutils.NewVerbCmd("anywhere", "EKS anywhere", ""))
 
 	misc.Command(flagGrouping, rootCmd)
+	apply.Command(flagGrouping, rootCmd)
 }
 
 func main() {
@@ -92,16 +100,37 @@ func main() {
 	colorValue := rootCmd.PersistentFlags().StringP("color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
 
 	dumpLogsValue := rootCmd.PersistentFlags().BoolP("dumpLogs", "d", false, "dump logs to disk on failure if set to true")
+	compactProgressValue := rootCmd.PersistentFlags().Bool("compact-progress", false, "show the progress of CloudFormation stacks as one redrawn line per stack when stdout is a terminal")
+	progressFormatValue := rootCmd.PersistentFlags().String("progress-format", "", "emit machine-readable progress events of tasks, stacks and AWS API retries (valid option: jsonl)")
+	progressOutputValue := rootCmd.PersistentFlags().String("progress-output", "", "file to append progress events to (default: stderr)")
+	taskRetriesValue := rootCmd.PersistentFlags().Int("task-retries", 0, "number of times each task is retried when it fails with a transient AWS error (default: set per task)")
+	taskTimeoutValue := rootCmd.PersistentFlags().Duration("task-timeout", 0, "maximum duration of each attempt of a task, e.g. 30m (default: no timeout)")
 
 	logBuffer := new(bytes.Buffer)
 
+	var runOptions tasks.RunOptions
 	cobra.OnInitialize(func() {
 		initLogger(*loggerLevel, *colorValue, logBuffer, *dumpLogsValue)
+		initStackEventView(*compactProgressValue)
+		var err error
+		if runOptions.EventSink, err = initProgressEvents(*progressFormatValue, *progressOutputValue); err != nil {
+			logger.Critical(err.Error())
+			os.Exit(1)
+		}
+		if runOptions.RetryOverrides, err = initTaskRetries(rootCmd.PersistentFlags(), *taskRetriesValue, *taskTimeoutValue); err != nil {
+			logger.Critical(err.Error())
+			os.Exit(1)
+		}
 	})
+	// the tasks of every command run with the options set by the flags above, which are parsed once the command
+	// is known
+	rootCmd.PersistentPreRun = func(c *cobra.Command, _ []string) {
+		c.SetContext(tasks.WithRunOptions(c.Context(), runOptions))
+	}
 
 	rootCmd.SetUsageFunc(flagGrouping.Usage)
 
-	if err := rootCmd.Execute(); err != nil {
+	if err := rootCmd.ExecuteContext(interruptContext()); err != nil {
 
 		if *dumpLogsValue {
 			if dumpErr := dumpLogsToDisk(logBuffer, err.Error()); dumpErr != nil {
@@ -113,6 +142,34 @@ func main() {
 	}
 }
 
+// interruptContext returns a context that is cancelled on the first SIGINT or SIGTERM, so that commands stop
+// starting tasks and can clean up; a second signal terminates eksctl straight away.
+func interruptContext() context.Context {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+		logger.Warning("interrupted, waiting for running tasks to stop; press Ctrl-C again to exit immediately")
+	}()
+	return ctx
+}
+
+// initTaskRetries returns the overrides of the retry policies of all tasks from `--task-retries` and
+// `--task-timeout`, if set.
+func initTaskRetries(flags *pflag.FlagSet, retries int, timeout time.Duration) (tasks.RetryOverrides, error) {
+	if retries < 0 {
+		return tasks.RetryOverrides{}, fmt.Errorf("--task-retries must not be negative")
+	}
+	if timeout < 0 {
+		return tasks.RetryOverrides{}, fmt.Errorf("--task-timeout must not be negative")
+	}
+	overrides := tasks.RetryOverrides{Timeout: timeout}
+	if flags.Changed("task-retries") {
+		overrides.Retries = &retries
+	}
+	return overrides, nil
+}
+
 func checkCommand(rootCmd *cobra.Command) {
 	for _, cmd := range rootCmd.Commands() {
 		// just a precaution as the verb command didn't have runE
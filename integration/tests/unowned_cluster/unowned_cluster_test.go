@@ -303,6 +303,7 @@ var _ = Describe("(Integration) [non-eksctl cluster & nodegroup support]", func(
 				"--kubernetes-version", upgradeVersion,
 				"--timeout", "1h30m",
 				"--wait",
+				"--approve",
 				"--verbose", "2",
 			)
 		Expect(cmd).To(RunSuccessfully())
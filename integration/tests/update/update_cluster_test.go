@@ -285,6 +285,7 @@ var _ = Describe("(Integration) Upgrading cluster", func() {
 				"--name", initNG,
 				"--kubernetes-version", nextEKSVersion,
 				"--timeout=60m", // wait for CF stacks to finish update
+				"--approve",
 			)
 			ExpectWithOffset(1, cmd).To(RunSuccessfullyWithOutputString(ContainSubstring("nodegroup successfully upgraded")))
 
@@ -305,6 +306,7 @@ var _ = Describe("(Integration) Upgrading cluster", func() {
 				"--name", botNG,
 				"--kubernetes-version", nextEKSVersion,
 				"--timeout=60m", // wait for CF stacks to finish update
+				"--approve",
 			)
 			ExpectWithOffset(1, cmd).To(RunSuccessfullyWithOutputString(ContainSubstring("nodegroup successfully upgraded")))
 
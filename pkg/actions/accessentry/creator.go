@@ -32,6 +32,7 @@ type Creator struct {
 // Create creates the specified access entries.
 func (m *Creator) Create(ctx context.Context, accessEntries []api.AccessEntry) error {
 	taskTree := m.CreateTasks(ctx, accessEntries)
+	taskTree.Context = ctx
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		var allErrs []string
 		for _, err := range errs {
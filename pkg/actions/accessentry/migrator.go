@@ -123,7 +123,7 @@ func (m *Migrator) MigrateToAccessEntry(ctx context.Context, options MigrationOp
 		}
 	}
 
-	return runAllTasks(&taskTree)
+	return runAllTasks(ctx, &taskTree)
 }
 
 func (m *Migrator) doUpdateAuthenticationMode(ctx context.Context, authMode ekstypes.AuthenticationMode, timeout time.Duration) error {
@@ -32,6 +32,7 @@ func (aer *Remover) Delete(ctx context.Context, accessEntries []api.AccessEntry)
 		return err
 	}
 
+	tasks.Context = ctx
 	logger.Info(tasks.Describe())
 	if errs := tasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "accessentry(ies)")
@@ -44,6 +44,8 @@ type Manager struct {
 	stackManager        StackManager
 	createClientSet     CreateClientSet
 	DisableAWSNodePatch bool
+	// ExportTemplates makes Create stop after the IAM stacks of an addon, which are expected to export their templates
+	ExportTemplates bool
 }
 
 func New(clusterConfig *api.ClusterConfig, eksAPI awsapi.EKS, stackManager StackManager, withOIDC bool, oidcManager *iamoidc.OpenIDConnectManager, createClientSet CreateClientSet) (*Manager, error) {
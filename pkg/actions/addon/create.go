@@ -246,6 +246,11 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, iamRoleCreator I
 		logger.Warning(IAMPermissionsNotRequiredWarning(addon.Name))
 	}
 
+	if a.ExportTemplates {
+		logger.Info("not creating addon %q, only the templates of its IAM stacks are exported", addon.Name)
+		return nil
+	}
+
 	if !a.DisableAWSNodePatch && addon.CanonicalName() == api.VPCCNIAddon {
 		logger.Debug("patching AWS node")
 		err := a.patchAWSNodeSA(ctx)
@@ -270,7 +275,7 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, iamRoleCreator I
 					logger.Warning("failed to cleanup IAM role stacks: %w; please remove any remaining stacks manually", err)
 					return
 				}
-				if err := runAllTasks(deleteAddonIAMTasks); err != nil {
+				if err := runAllTasks(ctx, deleteAddonIAMTasks); err != nil {
 					logger.Warning("failed to cleanup IAM role stacks: %w; please remove any remaining stacks manually", err)
 				}
 			}()
@@ -37,7 +37,7 @@ func (a *Manager) Delete(ctx context.Context, addon *api.Addon) error {
 	}
 	if deleteAddonIAMTasks.Len() > 0 {
 		logger.Info("deleting associated IAM stack(s)")
-		if err := runAllTasks(deleteAddonIAMTasks); err != nil {
+		if err := runAllTasks(ctx, deleteAddonIAMTasks); err != nil {
 			return err
 		}
 	} else if addonExists {
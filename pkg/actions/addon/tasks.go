@@ -119,8 +119,19 @@ type createAddonTask struct {
 
 func (t *createAddonTask) Describe() string { return t.info }
 
+// TaskRetryPolicy retries the creation of addons on transient AWS errors, e.g. when the EKS API is throttled or
+// the IAM role of an addon has not propagated yet; addons created by an earlier attempt are skipped.
+func (t *createAddonTask) TaskRetryPolicy() *tasks.RetryPolicy {
+	return tasks.RetryAWSErrors(3)
+}
+
 func (t *createAddonTask) Do(errorCh chan error) error {
-	addonManager, err := createAddonManager(t.ctx, t.clusterProvider, t.cfg)
+	return t.DoWithContext(t.ctx, errorCh)
+}
+
+// DoWithContext creates the addons under ctx, which is the context of the attempt when the task is retried.
+func (t *createAddonTask) DoWithContext(ctx context.Context, errorCh chan error) error {
+	addonManager, err := createAddonManager(ctx, t.clusterProvider, t.cfg)
 	if err != nil {
 		return err
 	}
@@ -138,7 +149,7 @@ func (t *createAddonTask) Do(errorCh chan error) error {
 		if !t.wait {
 			t.timeout = 0
 		}
-		err := addonManager.Create(t.ctx, a, t.iamRoleCreator, t.timeout)
+		err := addonManager.Create(ctx, a, t.iamRoleCreator, t.timeout)
 		if err != nil {
 			go func() {
 				errorCh <- err
@@ -157,7 +168,7 @@ func (t *createAddonTask) Do(errorCh chan error) error {
 		if !t.wait {
 			t.timeout = 0
 		}
-		err := addonManager.Create(t.ctx, a, t.iamRoleCreator, t.timeout)
+		err := addonManager.Create(ctx, a, t.iamRoleCreator, t.timeout)
 		if err != nil {
 			go func() {
 				errorCh <- err
@@ -221,7 +232,8 @@ func (t *deleteAddonIAMTask) Do(errorCh chan error) error {
 	return nil
 }
 
-func runAllTasks(taskTree *tasks.TaskTree) error {
+func runAllTasks(ctx context.Context, taskTree *tasks.TaskTree) error {
+	taskTree.Context = ctx
 	logger.Debug(taskTree.Describe())
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		var allErrs []string
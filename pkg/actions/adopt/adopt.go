@@ -0,0 +1,352 @@
+package adopt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+// Options selects the existing resources to adopt.
+type Options struct {
+	// NodeGroups are the names of managed nodegroups
+	NodeGroups []string
+	// IAMRoles are the names of IAM roles
+	IAMRoles []string
+	// SecurityGroups are the IDs of security groups
+	SecurityGroups []string
+	// OIDCProvider adopts the IAM OIDC provider of the cluster
+	OIDCProvider bool
+	// DeleteWithStacks deletes the adopted resources when their stacks are deleted, instead of retaining them
+	DeleteWithStacks bool
+}
+
+// An Adopter imports existing resources into new eksctl stacks, after which eksctl manages them
+// like the resources it created.
+type Adopter struct {
+	cfg          *api.ClusterConfig
+	stackManager manager.StackManager
+	cfnAPI       awsapi.CloudFormation
+	eksAPI       awsapi.EKS
+	iamAPI       awsapi.IAM
+	ec2API       awsapi.EC2
+	roleARN      string
+	waitTimeout  time.Duration
+}
+
+// adoption is an existing resource and the stack it is imported into.
+type adoption struct {
+	stackName   string
+	description string
+	tags        map[string]string
+	resourceSet *builder.AdoptedResourceSet
+}
+
+// New creates a new Adopter.
+func New(cfg *api.ClusterConfig, stackManager manager.StackManager, provider api.ClusterProvider) *Adopter {
+	return &Adopter{
+		cfg:          cfg,
+		stackManager: stackManager,
+		cfnAPI:       provider.CloudFormation(),
+		eksAPI:       provider.EKS(),
+		iamAPI:       provider.IAM(),
+		ec2API:       provider.EC2(),
+		roleARN:      provider.CloudFormationRoleARN(),
+		waitTimeout:  provider.WaitTimeout(),
+	}
+}
+
+// Adopt imports the selected resources into new stacks, one per resource. In plan mode, the resources
+// that would be imported are only listed.
+func (a *Adopter) Adopt(ctx context.Context, options Options, plan bool) error {
+	adoptions, err := a.describeResources(ctx, options)
+	if err != nil {
+		return err
+	}
+	if len(adoptions) == 0 {
+		return errors.New("no resources to adopt")
+	}
+
+	stacks, err := a.stackManager.ListStacks(ctx)
+	if err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	for _, s := range stacks {
+		if s.StackStatus != cfntypes.StackStatusDeleteComplete {
+			existing[*s.StackName] = true
+		}
+	}
+	for _, ad := range adoptions {
+		if existing[ad.stackName] {
+			return fmt.Errorf("cannot adopt %s: stack %q already exists", ad.description, ad.stackName)
+		}
+	}
+
+	for _, ad := range adoptions {
+		if plan {
+			resource := ad.resourceSet.ResourceToImport()
+			cmdutils.LogIntendedAction(ctx, plan, "import %s (%s %v) into stack %q", ad.description, *resource.ResourceType, resource.ResourceIdentifier, ad.stackName)
+			continue
+		}
+		if err := a.importResource(ctx, ad); err != nil {
+			return err
+		}
+		logger.Success("adopted %s into stack %q", ad.description, ad.stackName)
+	}
+	return nil
+}
+
+func (a *Adopter) describeResources(ctx context.Context, options Options) ([]adoption, error) {
+	clusterName := a.cfg.Metadata.Name
+	var adoptions []adoption
+
+	for _, ngName := range options.NodeGroups {
+		out, err := a.eksAPI.DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String(ngName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describing nodegroup %q: %w", ngName, err)
+		}
+		adoptions = append(adoptions, adoption{
+			stackName:   manager.MakeNodeGroupStackName(clusterName, ngName),
+			description: fmt.Sprintf("nodegroup %q", ngName),
+			tags: map[string]string{
+				api.NodeGroupNameTag: ngName,
+				api.NodeGroupTypeTag: string(api.NodeGroupTypeManaged),
+			},
+			resourceSet: builder.NewAdoptedNodeGroupResourceSet(out.Nodegroup, options.DeleteWithStacks),
+		})
+	}
+
+	for _, roleName := range options.IAMRoles {
+		rs, err := a.describeRole(ctx, roleName, options.DeleteWithStacks)
+		if err != nil {
+			return nil, err
+		}
+		adoptions = append(adoptions, adoption{
+			stackName:   manager.MakeAdoptedStackName(clusterName, "role", roleName),
+			description: fmt.Sprintf("IAM role %q", roleName),
+			resourceSet: rs,
+		})
+	}
+
+	if len(options.SecurityGroups) > 0 {
+		out, err := a.ec2API.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+			GroupIds: options.SecurityGroups,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describing security groups: %w", err)
+		}
+		for i := range out.SecurityGroups {
+			sg := &out.SecurityGroups[i]
+			adoptions = append(adoptions, adoption{
+				stackName:   manager.MakeAdoptedStackName(clusterName, "sg", strings.TrimPrefix(*sg.GroupId, "sg-")),
+				description: fmt.Sprintf("security group %q", *sg.GroupId),
+				resourceSet: builder.NewAdoptedSecurityGroupResourceSet(sg, options.DeleteWithStacks),
+			})
+		}
+	}
+
+	if options.OIDCProvider {
+		rs, err := a.describeOIDCProvider(ctx, options.DeleteWithStacks)
+		if err != nil {
+			return nil, err
+		}
+		adoptions = append(adoptions, adoption{
+			stackName:   manager.MakeAdoptedStackName(clusterName, "oidc", "provider"),
+			description: "IAM OIDC provider",
+			resourceSet: rs,
+		})
+	}
+	return adoptions, nil
+}
+
+func (a *Adopter) describeRole(ctx context.Context, roleName string, deleteWithStack bool) (*builder.AdoptedResourceSet, error) {
+	out, err := a.iamAPI.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		return nil, fmt.Errorf("getting IAM role %q: %w", roleName, err)
+	}
+	if strings.HasPrefix(aws.ToString(out.Role.Path), "/aws-service-role/") {
+		return nil, fmt.Errorf("cannot adopt service-linked role %q", roleName)
+	}
+
+	var managedPolicyARNs []string
+	attached := iam.NewListAttachedRolePoliciesPaginator(a.iamAPI, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)})
+	for attached.HasMorePages() {
+		page, err := attached.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing policies attached to IAM role %q: %w", roleName, err)
+		}
+		for _, p := range page.AttachedPolicies {
+			managedPolicyARNs = append(managedPolicyARNs, aws.ToString(p.PolicyArn))
+		}
+	}
+
+	inlinePolicies := map[string]string{}
+	inline := iam.NewListRolePoliciesPaginator(a.iamAPI, &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)})
+	for inline.HasMorePages() {
+		page, err := inline.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing inline policies of IAM role %q: %w", roleName, err)
+		}
+		for _, policyName := range page.PolicyNames {
+			policy, err := a.iamAPI.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(policyName),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("getting inline policy %q of IAM role %q: %w", policyName, roleName, err)
+			}
+			inlinePolicies[policyName] = aws.ToString(policy.PolicyDocument)
+		}
+	}
+
+	return builder.NewAdoptedRoleResourceSet(out.Role, managedPolicyARNs, inlinePolicies, deleteWithStack)
+}
+
+// describeOIDCProvider finds the IAM OIDC provider of the cluster by its issuer URL.
+func (a *Adopter) describeOIDCProvider(ctx context.Context, deleteWithStack bool) (*builder.AdoptedResourceSet, error) {
+	cluster, err := a.eksAPI.DescribeCluster(ctx, &awseks.DescribeClusterInput{Name: aws.String(a.cfg.Metadata.Name)})
+	if err != nil {
+		return nil, fmt.Errorf("describing cluster %q: %w", a.cfg.Metadata.Name, err)
+	}
+	issuer := oidcIssuer(cluster.Cluster)
+	if issuer == "" {
+		return nil, fmt.Errorf("cluster %q has no OIDC issuer", a.cfg.Metadata.Name)
+	}
+
+	providers, err := a.iamAPI.ListOpenIDConnectProviders(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("listing IAM OIDC providers: %w", err)
+	}
+	for _, p := range providers.OpenIDConnectProviderList {
+		providerARN := aws.ToString(p.Arn)
+		if !strings.HasSuffix(providerARN, "/"+strings.TrimPrefix(issuer, "https://")) {
+			continue
+		}
+		provider, err := a.iamAPI.GetOpenIDConnectProvider(ctx, &iam.GetOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getting IAM OIDC provider %q: %w", providerARN, err)
+		}
+		return builder.NewAdoptedOIDCProviderResourceSet(providerARN, provider, deleteWithStack), nil
+	}
+	return nil, fmt.Errorf("no IAM OIDC provider found for issuer %q of cluster %q", issuer, a.cfg.Metadata.Name)
+}
+
+func oidcIssuer(cluster *ekstypes.Cluster) string {
+	if cluster.Identity == nil || cluster.Identity.Oidc == nil {
+		return ""
+	}
+	return aws.ToString(cluster.Identity.Oidc.Issuer)
+}
+
+// importResource creates the stack of an adoption with an IMPORT change set and waits for the import
+// to complete.
+func (a *Adopter) importResource(ctx context.Context, ad adoption) error {
+	templateBody, err := ad.resourceSet.RenderJSON()
+	if err != nil {
+		return fmt.Errorf("rendering template for stack %q: %w", ad.stackName, err)
+	}
+
+	changeSetName := a.stackManager.MakeChangeSetName("adopt")
+	input := &cloudformation.CreateChangeSetInput{
+		StackName:         aws.String(ad.stackName),
+		ChangeSetName:     aws.String(changeSetName),
+		ChangeSetType:     cfntypes.ChangeSetTypeImport,
+		Description:       aws.String(fmt.Sprintf("import %s", ad.description)),
+		TemplateBody:      aws.String(string(templateBody)),
+		ResourcesToImport: []cfntypes.ResourceToImport{ad.resourceSet.ResourceToImport()},
+		Tags:              a.makeTags(ad.tags),
+	}
+	if ad.resourceSet.WithNamedIAM() {
+		input.Capabilities = []cfntypes.Capability{cfntypes.CapabilityCapabilityNamedIam}
+	} else if ad.resourceSet.WithIAM() {
+		input.Capabilities = []cfntypes.Capability{cfntypes.CapabilityCapabilityIam}
+	}
+	if a.roleARN != "" {
+		input.RoleARN = aws.String(a.roleARN)
+	}
+
+	logger.Info("creating changeSet %q to import %s into stack %q", changeSetName, ad.description, ad.stackName)
+	if _, err := a.cfnAPI.CreateChangeSet(ctx, input); err != nil {
+		return fmt.Errorf("creating import changeSet for stack %q: %w", ad.stackName, err)
+	}
+
+	describeInput := &cloudformation.DescribeChangeSetInput{
+		StackName:     aws.String(ad.stackName),
+		ChangeSetName: aws.String(changeSetName),
+	}
+	if err := cloudformation.NewChangeSetCreateCompleteWaiter(a.cfnAPI).Wait(ctx, describeInput, a.waitTimeout); err != nil {
+		return fmt.Errorf("waiting for import changeSet of stack %q to be created: %w", ad.stackName, err)
+	}
+	changeSet, err := a.cfnAPI.DescribeChangeSet(ctx, describeInput)
+	if err != nil {
+		return fmt.Errorf("describing import changeSet of stack %q: %w", ad.stackName, err)
+	}
+	summary := manager.SummarizeChangeSet(ad.stackName, changeSetName, changeSet)
+	tasks.RecordChangeSet(ctx, fmt.Sprintf("import %s", ad.description), summary)
+	manager.LogChangeSet(summary)
+
+	if _, err := a.cfnAPI.ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{
+		StackName:     aws.String(ad.stackName),
+		ChangeSetName: aws.String(changeSetName),
+	}); err != nil {
+		return fmt.Errorf("executing import changeSet of stack %q: %w", ad.stackName, err)
+	}
+
+	logger.Info("waiting for CloudFormation stack %q", ad.stackName)
+	if err := cloudformation.NewStackImportCompleteWaiter(a.cfnAPI).Wait(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(ad.stackName),
+	}, a.waitTimeout); err != nil {
+		return fmt.Errorf("waiting for import into stack %q to complete: %w", ad.stackName, err)
+	}
+	return nil
+}
+
+// makeTags returns the tags eksctl sets on the stacks of the cluster, along with the given tags.
+func (a *Adopter) makeTags(extra map[string]string) []cfntypes.Tag {
+	tags := map[string]string{
+		api.ClusterNameTag:    a.cfg.Metadata.Name,
+		api.OldClusterNameTag: a.cfg.Metadata.Name,
+		api.EksctlVersionTag:  version.GetVersion(),
+	}
+	for k, v := range a.cfg.Metadata.Tags {
+		tags[k] = v
+	}
+	for k, v := range extra {
+		tags[k] = v
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	stackTags := make([]cfntypes.Tag, 0, len(keys))
+	for _, k := range keys {
+		stackTags = append(stackTags, cfntypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return stackTags
+}
@@ -0,0 +1,132 @@
+package adopt_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/adopt"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Adopt", func() {
+	const (
+		clusterName    = "test"
+		nodegroupStack = "eksctl-test-nodegroup-ng"
+	)
+
+	var (
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		p                *mockprovider.MockProvider
+		adopter          *adopt.Adopter
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.MakeChangeSetNameReturns("eksctl-adopt-1")
+		p = mockprovider.NewMockProvider()
+		adopter = adopt.New(cfg, fakeStackManager, p)
+
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String("ng"),
+		}).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &ekstypes.Nodegroup{
+				ClusterName:   aws.String(clusterName),
+				NodegroupName: aws.String("ng"),
+				NodeRole:      aws.String("arn:aws:iam::123456789012:role/node"),
+				Subnets:       []string{"subnet-1"},
+				AmiType:       ekstypes.AMITypesAl2023X8664Standard,
+				CapacityType:  ekstypes.CapacityTypesOnDemand,
+			},
+		}, nil)
+	})
+
+	It("imports a nodegroup into a nodegroup stack", func() {
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(&cloudformation.CreateChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeChangeSetOutput{
+			Status: cfntypes.ChangeSetStatusCreateComplete,
+			Changes: []cfntypes.Change{{
+				ResourceChange: &cfntypes.ResourceChange{
+					Action:            cfntypes.ChangeActionImport,
+					LogicalResourceId: aws.String("ManagedNodeGroup"),
+					ResourceType:      aws.String("AWS::EKS::Nodegroup"),
+				},
+			}},
+		}, nil)
+		p.MockCloudFormation().On("ExecuteChangeSet", mock.Anything, mock.Anything).Return(&cloudformation.ExecuteChangeSetOutput{}, nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeStacksOutput{
+			Stacks: []cfntypes.Stack{{StackName: aws.String(nodegroupStack), StackStatus: cfntypes.StackStatusImportComplete}},
+		}, nil)
+
+		Expect(adopter.Adopt(context.Background(), adopt.Options{NodeGroups: []string{"ng"}}, false)).To(Succeed())
+
+		input := p.MockCloudFormation().Calls[0].Arguments[1].(*cloudformation.CreateChangeSetInput)
+		Expect(*input.StackName).To(Equal(nodegroupStack))
+		Expect(*input.ChangeSetName).To(Equal("eksctl-adopt-1"))
+		Expect(input.ChangeSetType).To(Equal(cfntypes.ChangeSetTypeImport))
+		Expect(input.ResourcesToImport).To(ConsistOf(cfntypes.ResourceToImport{
+			LogicalResourceId:  aws.String("ManagedNodeGroup"),
+			ResourceType:       aws.String("AWS::EKS::Nodegroup"),
+			ResourceIdentifier: map[string]string{"Id": "test/ng"},
+		}))
+		Expect(input.Tags).To(ContainElements(
+			cfntypes.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)},
+			cfntypes.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng")},
+			cfntypes.Tag{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String("managed")},
+		))
+		p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ExecuteChangeSet", 1)
+	})
+
+	It("only lists the resources to import in plan mode", func() {
+		Expect(adopter.Adopt(context.Background(), adopt.Options{NodeGroups: []string{"ng"}}, true)).To(Succeed())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+	})
+
+	It("refuses to adopt a resource whose stack already exists", func() {
+		fakeStackManager.ListStacksReturns([]*manager.Stack{{StackName: aws.String(nodegroupStack), StackStatus: cfntypes.StackStatusCreateComplete}}, nil)
+
+		err := adopter.Adopt(context.Background(), adopt.Options{NodeGroups: []string{"ng"}}, false)
+		Expect(err).To(MatchError(`cannot adopt nodegroup "ng": stack "eksctl-test-nodegroup-ng" already exists`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+	})
+
+	It("finds the IAM OIDC provider of the cluster by its issuer", func() {
+		const providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/ABC"
+		p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&awseks.DescribeClusterOutput{
+			Cluster: &ekstypes.Cluster{
+				Identity: &ekstypes.Identity{Oidc: &ekstypes.OIDC{Issuer: aws.String("https://oidc.eks.us-west-2.amazonaws.com/id/ABC")}},
+			},
+		}, nil)
+		p.MockIAM().On("ListOpenIDConnectProviders", mock.Anything, mock.Anything).Return(&iam.ListOpenIDConnectProvidersOutput{
+			OpenIDConnectProviderList: []iamtypes.OpenIDConnectProviderListEntry{
+				{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/XYZ")},
+				{Arn: aws.String(providerARN)},
+			},
+		}, nil)
+		p.MockIAM().On("GetOpenIDConnectProvider", mock.Anything, &iam.GetOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+		}).Return(&iam.GetOpenIDConnectProviderOutput{
+			Url:          aws.String("oidc.eks.us-west-2.amazonaws.com/id/ABC"),
+			ClientIDList: []string{"sts.amazonaws.com"},
+		}, nil)
+
+		Expect(adopter.Adopt(context.Background(), adopt.Options{OIDCProvider: true}, true)).To(Succeed())
+		p.MockIAM().AssertNumberOfCalls(GinkgoT(), "GetOpenIDConnectProvider", 1)
+	})
+})
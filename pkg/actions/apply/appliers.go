@@ -0,0 +1,399 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ec2-instance-selector/v3/pkg/selector"
+	"github.com/kris-nova/logger"
+	"k8s.io/client-go/kubernetes"
+
+	accessentryactions "github.com/weaveworks/eksctl/pkg/actions/accessentry"
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	capabilityactions "github.com/weaveworks/eksctl/pkg/actions/capability"
+	fargateactions "github.com/weaveworks/eksctl/pkg/actions/fargate"
+	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/actions/podidentityassociation"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+)
+
+const (
+	defaultMaxGracePeriod        = 10 * time.Minute
+	defaultPodEvictionWaitPeriod = 10 * time.Second
+)
+
+// ApplierOptions holds the options shared by all appliers.
+type ApplierOptions struct {
+	ClusterConfig   *api.ClusterConfig
+	ClusterProvider *eks.ClusterProvider
+	StackManager    manager.StackManager
+	ClientSet       kubernetes.Interface
+	Cmd             *cmdutils.Cmd
+	WaitTimeout     time.Duration
+	// Drain controls whether nodes are drained before their nodegroup is deleted.
+	Drain bool
+}
+
+// NewAppliers returns the appliers for all resource types supported by `eksctl apply`.
+func NewAppliers(ctx context.Context, o ApplierOptions) (map[ResourceType]Applier, error) {
+	oidc, err := o.ClusterProvider.NewOpenIDConnectManager(ctx, o.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	oidcProviderExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addonManager, err := addon.New(o.ClusterConfig, o.ClusterProvider.AWSProvider.EKS(), o.StackManager, oidcProviderExists, oidc, func() (kubernetes.Interface, error) {
+		return o.ClientSet, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ng := &nodeGroupApplier{ApplierOptions: o}
+	return map[ResourceType]Applier{
+		ResourceTypeAccessEntry:            &accessEntryApplier{ApplierOptions: o},
+		ResourceTypeIdentityProvider:       &identityProviderApplier{ApplierOptions: o},
+		ResourceTypeFargateProfile:         &fargateProfileApplier{ApplierOptions: o},
+		ResourceTypeAddon:                  &addonApplier{ApplierOptions: o, addonManager: addonManager},
+		ResourceTypeNodeGroup:              ng,
+		ResourceTypeManagedNodeGroup:       ng,
+		ResourceTypePodIdentityAssociation: &podIdentityAssociationApplier{ApplierOptions: o},
+		ResourceTypeCapability:             &capabilityApplier{ApplierOptions: o},
+	}, nil
+}
+
+var errUpdateNotSupported = errors.New("updates are not supported for this resource type")
+
+type accessEntryApplier struct {
+	ApplierOptions
+}
+
+func (a *accessEntryApplier) Create(ctx context.Context, names []string) error {
+	creator := &accessentryactions.Creator{
+		ClusterName:  a.ClusterConfig.Metadata.Name,
+		StackCreator: a.StackManager,
+	}
+	return creator.Create(ctx, a.accessEntries(names))
+}
+
+func (a *accessEntryApplier) Update(_ context.Context, _ []string) error {
+	return errUpdateNotSupported
+}
+
+func (a *accessEntryApplier) Delete(ctx context.Context, names []string) error {
+	var accessEntries []api.AccessEntry
+	for _, name := range names {
+		var principalARN api.ARN
+		if err := principalARN.Set(name); err != nil {
+			return err
+		}
+		accessEntries = append(accessEntries, api.AccessEntry{PrincipalARN: principalARN})
+	}
+	remover := accessentryactions.NewRemover(a.ClusterConfig.Metadata.Name, a.StackManager, a.ClusterProvider.AWSProvider.EKS())
+	return remover.Delete(ctx, accessEntries)
+}
+
+func (a *accessEntryApplier) accessEntries(names []string) []api.AccessEntry {
+	var accessEntries []api.AccessEntry
+	for _, ae := range a.ClusterConfig.AccessConfig.AccessEntries {
+		if slices.Contains(names, ae.PrincipalARN.String()) {
+			accessEntries = append(accessEntries, ae)
+		}
+	}
+	return accessEntries
+}
+
+type identityProviderApplier struct {
+	ApplierOptions
+}
+
+func (i *identityProviderApplier) manager() identityproviders.Manager {
+	return identityproviders.NewManager(*i.ClusterConfig.Metadata, i.ClusterProvider.AWSProvider.EKS())
+}
+
+func (i *identityProviderApplier) Create(ctx context.Context, names []string) error {
+	var providers []api.IdentityProvider
+	for _, idp := range i.ClusterConfig.IdentityProviders {
+		if oidc, ok := idp.Inner.(*api.OIDCIdentityProvider); ok && slices.Contains(names, oidc.Name) {
+			providers = append(providers, idp)
+		}
+	}
+	m := i.manager()
+	return m.Associate(ctx, identityproviders.AssociateIdentityProvidersOptions{
+		Providers:   providers,
+		WaitTimeout: i.WaitTimeout,
+	})
+}
+
+func (i *identityProviderApplier) Update(_ context.Context, _ []string) error {
+	return errUpdateNotSupported
+}
+
+func (i *identityProviderApplier) Delete(ctx context.Context, names []string) error {
+	var providers []identityproviders.DisassociateIdentityProvider
+	for _, name := range names {
+		providers = append(providers, identityproviders.DisassociateIdentityProvider{
+			Name: name,
+			Type: api.OIDCIdentityProviderType,
+		})
+	}
+	m := i.manager()
+	return m.Disassociate(ctx, identityproviders.DisassociateIdentityProvidersOptions{
+		Providers:   providers,
+		WaitTimeout: i.WaitTimeout,
+	})
+}
+
+type fargateProfileApplier struct {
+	ApplierOptions
+}
+
+func (f *fargateProfileApplier) Create(ctx context.Context, names []string) error {
+	cfg := f.ClusterConfig.DeepCopy()
+	cfg.FargateProfiles = slices.DeleteFunc(cfg.FargateProfiles, func(fp *api.FargateProfile) bool {
+		return !slices.Contains(names, fp.Name)
+	})
+	return fargateactions.New(cfg, f.ClusterProvider, f.StackManager).Create(ctx)
+}
+
+func (f *fargateProfileApplier) Update(_ context.Context, _ []string) error {
+	return errUpdateNotSupported
+}
+
+func (f *fargateProfileApplier) Delete(ctx context.Context, names []string) error {
+	client := fargate.NewFromProvider(f.ClusterConfig.Metadata.Name, f.ClusterProvider.AWSProvider, f.StackManager)
+	for _, name := range names {
+		if err := client.DeleteProfile(ctx, name, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type addonApplier struct {
+	ApplierOptions
+	addonManager *addon.Manager
+}
+
+func (a *addonApplier) addons(names []string) []*api.Addon {
+	var podIdentityAgent, addons []*api.Addon
+	for _, ad := range a.ClusterConfig.Addons {
+		if !slices.Contains(names, ad.CanonicalName()) {
+			continue
+		}
+		// always install EKS Pod Identity Agent Addon first, if present,
+		// as other addons might require IAM permissions
+		if ad.CanonicalName() == api.PodIdentityAgentAddon {
+			podIdentityAgent = append(podIdentityAgent, ad)
+		} else {
+			addons = append(addons, ad)
+		}
+	}
+	return append(podIdentityAgent, addons...)
+}
+
+func (a *addonApplier) Create(ctx context.Context, names []string) error {
+	iamRoleCreator := &podidentityassociation.IAMRoleCreator{
+		ClusterName:  a.ClusterConfig.Metadata.Name,
+		StackCreator: a.StackManager,
+	}
+	for _, ad := range a.addons(names) {
+		if err := a.addonManager.Create(ctx, ad, iamRoleCreator, a.WaitTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *addonApplier) Update(ctx context.Context, names []string) error {
+	piaUpdater := &addon.PodIdentityAssociationUpdater{
+		ClusterName: a.ClusterConfig.Metadata.Name,
+		IAMRoleCreator: &podidentityassociation.IAMRoleCreator{
+			ClusterName:  a.ClusterConfig.Metadata.Name,
+			StackCreator: a.StackManager,
+		},
+		IAMRoleUpdater: &podidentityassociation.IAMRoleUpdater{
+			StackUpdater: a.StackManager,
+		},
+		EKSPodIdentityDescriber: a.ClusterProvider.AWSProvider.EKS(),
+		StackDeleter:            a.StackManager,
+	}
+	for _, ad := range a.addons(names) {
+		if err := a.addonManager.Update(ctx, ad, piaUpdater, a.WaitTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *addonApplier) Delete(ctx context.Context, names []string) error {
+	for _, name := range names {
+		if err := a.addonManager.Delete(ctx, &api.Addon{Name: name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeGroupApplier handles both managed and unmanaged nodegroups, as nodegroup creation and deletion
+// operate on both kinds at once.
+type nodeGroupApplier struct {
+	ApplierOptions
+}
+
+func (n *nodeGroupApplier) Create(ctx context.Context, names []string) error {
+	instanceSelector, err := selector.New(ctx, n.ClusterProvider.AWSProvider.AWSConfig())
+	if err != nil {
+		return err
+	}
+	cfg := n.ClusterConfig.DeepCopy()
+	ngFilter := filter.NewNodeGroupFilter()
+	ngFilter.AppendIncludeNames(names...)
+	return nodegroup.New(cfg, n.ClusterProvider, n.ClientSet, instanceSelector).Create(ctx, nodegroup.CreateOpts{
+		ConfigFileProvided: true,
+	}, ngFilter)
+}
+
+func (n *nodeGroupApplier) Update(_ context.Context, _ []string) error {
+	return errUpdateNotSupported
+}
+
+func (n *nodeGroupApplier) Delete(ctx context.Context, names []string) error {
+	stacks, err := n.StackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return err
+	}
+	var (
+		nodeGroups        []*api.NodeGroup
+		managedNodeGroups []*api.ManagedNodeGroup
+	)
+	for _, s := range stacks {
+		if !slices.Contains(names, s.NodeGroupName) {
+			continue
+		}
+		switch s.Type {
+		case api.NodeGroupTypeManaged:
+			managedNodeGroups = append(managedNodeGroups, &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: s.NodeGroupName}})
+		default:
+			ng := api.NewNodeGroup()
+			ng.Name = s.NodeGroupName
+			if err := n.ClusterProvider.GetNodeGroupIAM(ctx, n.StackManager, ng); err != nil {
+				logger.Warning("continuing with deletion, error getting instance role ARN for nodegroup %q: %v", ng.Name, err)
+			}
+			nodeGroups = append(nodeGroups, ng)
+		}
+	}
+
+	if n.Drain {
+		drainer := &nodegroup.Drainer{ClientSet: n.ClientSet}
+		if err := drainer.Drain(ctx, &nodegroup.DrainInput{
+			NodeGroups:            cmdutils.ToKubeNodeGroups(nodeGroups, managedNodeGroups),
+			MaxGracePeriod:        defaultMaxGracePeriod,
+			PodEvictionWaitPeriod: defaultPodEvictionWaitPeriod,
+			Parallel:              1,
+		}); err != nil {
+			return fmt.Errorf("draining nodegroups: %w", err)
+		}
+	}
+
+	deleter := &nodegroup.Deleter{
+		StackHelper:          n.StackManager,
+		NodeGroupDeleter:     n.ClusterProvider.AWSProvider.EKS(),
+		ClusterName:          n.ClusterConfig.Metadata.Name,
+		AuthConfigMapUpdater: &authConfigMapUpdater{clientSet: n.ClientSet},
+	}
+	return deleter.Delete(ctx, nodeGroups, managedNodeGroups, nodegroup.DeleteOptions{
+		Wait:                true,
+		UpdateAuthConfigMap: true,
+	})
+}
+
+type authConfigMapUpdater struct {
+	clientSet kubernetes.Interface
+}
+
+func (a *authConfigMapUpdater) RemoveNodeGroup(ng *api.NodeGroup) error {
+	return authconfigmap.RemoveNodeGroup(a.clientSet, ng)
+}
+
+type podIdentityAssociationApplier struct {
+	ApplierOptions
+}
+
+func (p *podIdentityAssociationApplier) Create(ctx context.Context, names []string) error {
+	var podIdentityAssociations []api.PodIdentityAssociation
+	for _, pia := range p.ClusterConfig.IAM.PodIdentityAssociations {
+		if slices.Contains(names, pia.NameString()) {
+			podIdentityAssociations = append(podIdentityAssociations, pia)
+		}
+	}
+	return podidentityassociation.NewCreator(p.ClusterConfig.Metadata.Name, p.StackManager, p.ClusterProvider.AWSProvider.EKS(), p.ClientSet).
+		CreatePodIdentityAssociations(ctx, podIdentityAssociations)
+}
+
+func (p *podIdentityAssociationApplier) Update(_ context.Context, _ []string) error {
+	return errUpdateNotSupported
+}
+
+func (p *podIdentityAssociationApplier) Delete(ctx context.Context, names []string) error {
+	var podIDs []podidentityassociation.Identifier
+	for _, name := range names {
+		namespace, serviceAccountName, found := strings.Cut(name, "/")
+		if !found {
+			namespace, serviceAccountName = "", name
+		}
+		podIDs = append(podIDs, podidentityassociation.Identifier{
+			Namespace:          namespace,
+			ServiceAccountName: serviceAccountName,
+		})
+	}
+	deleter := &podidentityassociation.Deleter{
+		ClusterName:  p.ClusterConfig.Metadata.Name,
+		StackDeleter: p.StackManager,
+		APIDeleter:   p.ClusterProvider.AWSProvider.EKS(),
+		ClientSet:    p.ClientSet,
+	}
+	return deleter.Delete(ctx, podIDs)
+}
+
+type capabilityApplier struct {
+	ApplierOptions
+}
+
+func (c *capabilityApplier) Create(ctx context.Context, names []string) error {
+	var capabilities []api.Capability
+	for _, capability := range c.ClusterConfig.Capabilities {
+		if slices.Contains(names, capability.Name) {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	creator := capabilityactions.NewCreator(c.ClusterConfig.Metadata.Name, c.StackManager, c.ClusterProvider.AWSProvider.EKS(), c.Cmd)
+	return creator.Create(ctx, capabilities)
+}
+
+func (c *capabilityApplier) Update(_ context.Context, _ []string) error {
+	return errUpdateNotSupported
+}
+
+func (c *capabilityApplier) Delete(ctx context.Context, names []string) error {
+	var capabilities []capabilityactions.Summary
+	for _, name := range names {
+		capabilities = append(capabilities, capabilityactions.Summary{
+			Capability: api.Capability{Name: name},
+		})
+	}
+	return capabilityactions.NewRemover(c.ClusterConfig.Metadata.Name, c.StackManager).Delete(ctx, capabilities)
+}
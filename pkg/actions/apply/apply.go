@@ -0,0 +1,232 @@
+package apply
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Action is the kind of change required to reconcile a resource.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	// ActionUnsupportedUpdate is reported for resources that exist in both the config and the cluster, but whose
+	// type cannot be updated by `eksctl apply`; differences between their config and the cluster are neither
+	// detected nor applied.
+	ActionUnsupportedUpdate Action = "unsupported-update"
+)
+
+// updatableResourceTypes are the resource types whose existing resources are updated to match the config
+var updatableResourceTypes = []ResourceType{ResourceTypeAddon}
+
+// ResourceType identifies a kind of resource managed by `eksctl apply`.
+type ResourceType string
+
+const (
+	ResourceTypeAccessEntry            ResourceType = "accessentry"
+	ResourceTypeIdentityProvider       ResourceType = "identityprovider"
+	ResourceTypeFargateProfile         ResourceType = "fargateprofile"
+	ResourceTypeAddon                  ResourceType = "addon"
+	ResourceTypeNodeGroup              ResourceType = "nodegroup"
+	ResourceTypeManagedNodeGroup       ResourceType = "managednodegroup"
+	ResourceTypePodIdentityAssociation ResourceType = "podidentityassociation"
+	ResourceTypeCapability             ResourceType = "capability"
+)
+
+// creationOrder is the order in which resources are created; deletions happen in reverse order
+// so that, e.g., pod identity associations are removed before the addons and nodegroups they may depend on.
+var creationOrder = []ResourceType{
+	ResourceTypeAccessEntry,
+	ResourceTypeIdentityProvider,
+	ResourceTypeFargateProfile,
+	ResourceTypeAddon,
+	ResourceTypeNodeGroup,
+	ResourceTypeManagedNodeGroup,
+	ResourceTypePodIdentityAssociation,
+	ResourceTypeCapability,
+}
+
+// Change describes a single change to a resource.
+type Change struct {
+	Action       Action
+	ResourceType ResourceType
+	Name         string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s %s %q", c.Action, c.ResourceType, c.Name)
+}
+
+// AddonState holds the live state of an addon that is relevant for detecting updates.
+type AddonState struct {
+	Version             string
+	ConfigurationValues string
+}
+
+// LiveState holds the names of the resources that currently exist for a cluster.
+type LiveState struct {
+	NodeGroups              []string
+	ManagedNodeGroups       []string
+	Addons                  map[string]AddonState
+	AccessEntries           []string
+	PodIdentityAssociations []string
+	FargateProfiles         []string
+	IdentityProviders       []string
+	Capabilities            []string
+}
+
+// ComputeChanges compares the desired state in clusterConfig against the live state and returns the changes
+// required to reconcile them, in the order in which they should be executed.
+// Resources that exist but are not present in clusterConfig are only deleted if prune is true, except for the
+// default addons, which EKS and eksctl install without them being listed in the config.
+func ComputeChanges(clusterConfig *api.ClusterConfig, live *LiveState, prune bool) []Change {
+	desired := desiredNames(clusterConfig)
+	current := map[ResourceType][]string{
+		ResourceTypeNodeGroup:              live.NodeGroups,
+		ResourceTypeManagedNodeGroup:       live.ManagedNodeGroups,
+		ResourceTypeAddon:                  addonNames(live.Addons),
+		ResourceTypeAccessEntry:            live.AccessEntries,
+		ResourceTypePodIdentityAssociation: live.PodIdentityAssociations,
+		ResourceTypeFargateProfile:         live.FargateProfiles,
+		ResourceTypeIdentityProvider:       live.IdentityProviders,
+		ResourceTypeCapability:             live.Capabilities,
+	}
+
+	var creates, updates, unsupportedUpdates, deletes []Change
+	for _, resourceType := range creationOrder {
+		for _, name := range desired[resourceType] {
+			if !slices.Contains(current[resourceType], name) {
+				creates = append(creates, Change{Action: ActionCreate, ResourceType: resourceType, Name: name})
+			}
+		}
+	}
+
+	for _, a := range clusterConfig.Addons {
+		name := a.CanonicalName()
+		state, ok := live.Addons[name]
+		if ok && addonNeedsUpdate(a, state) {
+			updates = append(updates, Change{Action: ActionUpdate, ResourceType: ResourceTypeAddon, Name: name})
+		}
+	}
+
+	for _, resourceType := range creationOrder {
+		if slices.Contains(updatableResourceTypes, resourceType) {
+			continue
+		}
+		for _, name := range desired[resourceType] {
+			if slices.Contains(current[resourceType], name) {
+				unsupportedUpdates = append(unsupportedUpdates, Change{Action: ActionUnsupportedUpdate, ResourceType: resourceType, Name: name})
+			}
+		}
+	}
+
+	if prune {
+		for i := len(creationOrder) - 1; i >= 0; i-- {
+			resourceType := creationOrder[i]
+			for _, name := range current[resourceType] {
+				if !slices.Contains(desired[resourceType], name) && !isDefaultAddon(resourceType, name) {
+					deletes = append(deletes, Change{Action: ActionDelete, ResourceType: resourceType, Name: name})
+				}
+			}
+		}
+	}
+
+	return slices.Concat(creates, updates, unsupportedUpdates, deletes)
+}
+
+// isDefaultAddon reports whether name is an addon installed by default, such as vpc-cni or coredns
+func isDefaultAddon(resourceType ResourceType, name string) bool {
+	return resourceType == ResourceTypeAddon && api.KnownAddons[name].IsDefault
+}
+
+// SplitUnsupportedUpdates splits changes into the changes that can be applied and the resources whose updates are
+// not supported.
+func SplitUnsupportedUpdates(changes []Change) (supported, unsupported []Change) {
+	for _, c := range changes {
+		if c.Action == ActionUnsupportedUpdate {
+			unsupported = append(unsupported, c)
+		} else {
+			supported = append(supported, c)
+		}
+	}
+	return supported, unsupported
+}
+
+func desiredNames(clusterConfig *api.ClusterConfig) map[ResourceType][]string {
+	desired := map[ResourceType][]string{}
+	for _, ng := range clusterConfig.NodeGroups {
+		desired[ResourceTypeNodeGroup] = append(desired[ResourceTypeNodeGroup], ng.Name)
+	}
+	for _, ng := range clusterConfig.ManagedNodeGroups {
+		desired[ResourceTypeManagedNodeGroup] = append(desired[ResourceTypeManagedNodeGroup], ng.Name)
+	}
+	for _, a := range clusterConfig.Addons {
+		desired[ResourceTypeAddon] = append(desired[ResourceTypeAddon], a.CanonicalName())
+	}
+	if clusterConfig.AccessConfig != nil {
+		for _, ae := range clusterConfig.AccessConfig.AccessEntries {
+			desired[ResourceTypeAccessEntry] = append(desired[ResourceTypeAccessEntry], ae.PrincipalARN.String())
+		}
+	}
+	if clusterConfig.IAM != nil {
+		for _, pia := range clusterConfig.IAM.PodIdentityAssociations {
+			desired[ResourceTypePodIdentityAssociation] = append(desired[ResourceTypePodIdentityAssociation], pia.NameString())
+		}
+	}
+	for _, fp := range clusterConfig.FargateProfiles {
+		desired[ResourceTypeFargateProfile] = append(desired[ResourceTypeFargateProfile], fp.Name)
+	}
+	for _, idp := range clusterConfig.IdentityProviders {
+		if oidc, ok := idp.Inner.(*api.OIDCIdentityProvider); ok {
+			desired[ResourceTypeIdentityProvider] = append(desired[ResourceTypeIdentityProvider], oidc.Name)
+		}
+	}
+	for _, c := range clusterConfig.Capabilities {
+		desired[ResourceTypeCapability] = append(desired[ResourceTypeCapability], c.Name)
+	}
+	return desired
+}
+
+func addonNames(addons map[string]AddonState) []string {
+	var names []string
+	for name := range addons {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// addonNeedsUpdate reports whether the addon's pinned version or configuration values differ from the live state.
+// Addons that track "latest" or leave the version unset are only updated when their configuration changes,
+// so that re-running apply against an unchanged config is a no-op.
+func addonNeedsUpdate(addon *api.Addon, state AddonState) bool {
+	if addon.Version != "" && addon.Version != "latest" && !addonVersionMatches(addon.Version, state.Version) {
+		return true
+	}
+	return addon.ConfigurationValues != "" && addon.ConfigurationValues != state.ConfigurationValues
+}
+
+// addonVersionMatches reports whether the installed version of an addon is the desired version. The eksbuild
+// suffix of the installed version, e.g. "-eksbuild.1", is only compared when the desired version has one.
+func addonVersionMatches(desired, installed string) bool {
+	desired, installed = strings.TrimPrefix(desired, "v"), strings.TrimPrefix(installed, "v")
+	desiredVersion, err := semver.Parse(desired)
+	if err != nil {
+		return desired == installed
+	}
+	installedVersion, err := semver.Parse(installed)
+	if err != nil {
+		return false
+	}
+	if len(desiredVersion.Pre) == 0 {
+		installedVersion.Pre = nil
+	}
+	return desiredVersion.Equals(installedVersion)
+}
@@ -0,0 +1,168 @@
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/apply"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("ComputeChanges", func() {
+	type changesEntry struct {
+		updateClusterConfig func(*api.ClusterConfig)
+		liveState           apply.LiveState
+		prune               bool
+
+		expectedChanges []apply.Change
+	}
+
+	DescribeTable("computes the changes required to reconcile the cluster", func(e changesEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "cluster"
+		if e.updateClusterConfig != nil {
+			e.updateClusterConfig(clusterConfig)
+		}
+		Expect(apply.ComputeChanges(clusterConfig, &e.liveState, e.prune)).To(Equal(e.expectedChanges))
+	},
+		Entry("no changes when the cluster is up-to-date", changesEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.Addons = []*api.Addon{{Name: "vpc-cni", Version: "v1.18.0"}}
+			},
+			liveState: apply.LiveState{
+				Addons: map[string]apply.AddonState{
+					"vpc-cni": {Version: "v1.18.0-eksbuild.1"},
+				},
+			},
+		}),
+
+		Entry("reports existing resources whose type cannot be updated", changesEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.ManagedNodeGroups = []*api.ManagedNodeGroup{api.NewManagedNodeGroup()}
+				c.ManagedNodeGroups[0].Name = "mng-1"
+				c.FargateProfiles = []*api.FargateProfile{{Name: "fp"}}
+				c.Addons = []*api.Addon{{Name: "vpc-cni", Version: "v1.18.0"}}
+			},
+			liveState: apply.LiveState{
+				ManagedNodeGroups: []string{"mng-1"},
+				FargateProfiles:   []string{"fp"},
+				Addons: map[string]apply.AddonState{
+					"vpc-cni": {Version: "v1.18.0-eksbuild.1"},
+				},
+			},
+			expectedChanges: []apply.Change{
+				{Action: apply.ActionUnsupportedUpdate, ResourceType: apply.ResourceTypeFargateProfile, Name: "fp"},
+				{Action: apply.ActionUnsupportedUpdate, ResourceType: apply.ResourceTypeManagedNodeGroup, Name: "mng-1"},
+			},
+		}),
+
+		Entry("creates missing resources in dependency order", changesEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				ng := api.NewNodeGroup()
+				ng.Name = "ng-1"
+				c.NodeGroups = []*api.NodeGroup{ng}
+				c.Addons = []*api.Addon{{Name: "coredns"}}
+				c.AccessConfig.AccessEntries = []api.AccessEntry{{PrincipalARN: api.MustParseARN("arn:aws:iam::111122223333:role/admin")}}
+				c.IAM.PodIdentityAssociations = []api.PodIdentityAssociation{{Namespace: "default", ServiceAccountName: "sa"}}
+				c.FargateProfiles = []*api.FargateProfile{{Name: "fp"}}
+				c.Capabilities = []api.Capability{{Name: "ack"}}
+			},
+			liveState: apply.LiveState{},
+			expectedChanges: []apply.Change{
+				{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeAccessEntry, Name: "arn:aws:iam::111122223333:role/admin"},
+				{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeFargateProfile, Name: "fp"},
+				{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeAddon, Name: "coredns"},
+				{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeNodeGroup, Name: "ng-1"},
+				{Action: apply.ActionCreate, ResourceType: apply.ResourceTypePodIdentityAssociation, Name: "default/sa"},
+				{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeCapability, Name: "ack"},
+			},
+		}),
+
+		Entry("updates addons whose version or configuration values differ", changesEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.Addons = []*api.Addon{
+					{Name: "vpc-cni", Version: "v1.19.0"},
+					{Name: "coredns", Version: "latest"},
+					{Name: "kube-proxy", ConfigurationValues: `{"mode":"ipvs"}`},
+				}
+			},
+			liveState: apply.LiveState{
+				Addons: map[string]apply.AddonState{
+					"vpc-cni":    {Version: "v1.18.0-eksbuild.1"},
+					"coredns":    {Version: "v1.11.1-eksbuild.4"},
+					"kube-proxy": {Version: "v1.29.0-eksbuild.1"},
+				},
+			},
+			expectedChanges: []apply.Change{
+				{Action: apply.ActionUpdate, ResourceType: apply.ResourceTypeAddon, Name: "vpc-cni"},
+				{Action: apply.ActionUpdate, ResourceType: apply.ResourceTypeAddon, Name: "kube-proxy"},
+			},
+		}),
+
+		Entry("does not delete resources missing from the config without prune", changesEntry{
+			liveState: apply.LiveState{
+				NodeGroups:      []string{"ng-1"},
+				FargateProfiles: []string{"fp"},
+			},
+		}),
+
+		Entry("deletes resources missing from the config in reverse order with prune", changesEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.ManagedNodeGroups = []*api.ManagedNodeGroup{api.NewManagedNodeGroup()}
+				c.ManagedNodeGroups[0].Name = "mng-2"
+			},
+			liveState: apply.LiveState{
+				ManagedNodeGroups:       []string{"mng-1"},
+				PodIdentityAssociations: []string{"default/sa"},
+				Addons: map[string]apply.AddonState{
+					"aws-ebs-csi-driver": {Version: "v1.30.0-eksbuild.1"},
+				},
+			},
+			prune: true,
+			expectedChanges: []apply.Change{
+				{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeManagedNodeGroup, Name: "mng-2"},
+				{Action: apply.ActionDelete, ResourceType: apply.ResourceTypePodIdentityAssociation, Name: "default/sa"},
+				{Action: apply.ActionDelete, ResourceType: apply.ResourceTypeManagedNodeGroup, Name: "mng-1"},
+				{Action: apply.ActionDelete, ResourceType: apply.ResourceTypeAddon, Name: "aws-ebs-csi-driver"},
+			},
+		}),
+
+		Entry("does not prune default addons missing from the config", changesEntry{
+			liveState: apply.LiveState{
+				Addons: map[string]apply.AddonState{
+					"vpc-cni":    {Version: "v1.19.2-eksbuild.1"},
+					"kube-proxy": {Version: "v1.31.3-eksbuild.2"},
+					"coredns":    {Version: "v1.11.1-eksbuild.4"},
+				},
+			},
+			prune: true,
+		}),
+	)
+
+	It("updates addons whose installed version differs from the desired version", func() {
+		clusterConfig := api.NewClusterConfig()
+		live := &apply.LiveState{Addons: map[string]apply.AddonState{"vpc-cni": {Version: "v1.19.10-eksbuild.1"}}}
+		update := []apply.Change{{Action: apply.ActionUpdate, ResourceType: apply.ResourceTypeAddon, Name: "vpc-cni"}}
+
+		for version, expectedChanges := range map[string][]apply.Change{
+			"v1.19.1":              update,
+			"v1.19.10":             nil,
+			"1.19.10":              nil,
+			"v1.19.10-eksbuild.1":  nil,
+			"v1.19.10-eksbuild.2":  update,
+			"v1.19":                update,
+			"not-a-semver-version": update,
+		} {
+			clusterConfig.Addons = []*api.Addon{{Name: "vpc-cni", Version: version}}
+			Expect(apply.ComputeChanges(clusterConfig, live, false)).To(Equal(expectedChanges), "desired version %s", version)
+		}
+	})
+
+	It("splits out the resources whose updates are not supported", func() {
+		create := apply.Change{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeAddon, Name: "coredns"}
+		unsupported := apply.Change{Action: apply.ActionUnsupportedUpdate, ResourceType: apply.ResourceTypeNodeGroup, Name: "ng-1"}
+		supported, unsupportedUpdates := apply.SplitUnsupportedUpdates([]apply.Change{create, unsupported})
+		Expect(supported).To(Equal([]apply.Change{create}))
+		Expect(unsupportedUpdates).To(Equal([]apply.Change{unsupported}))
+	})
+})
@@ -0,0 +1,68 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// An Applier applies changes to a single resource type.
+type Applier interface {
+	Create(ctx context.Context, names []string) error
+	Update(ctx context.Context, names []string) error
+	Delete(ctx context.Context, names []string) error
+}
+
+// A Reconciler turns a set of changes into a TaskTree and executes it.
+type Reconciler struct {
+	Appliers map[ResourceType]Applier
+}
+
+// PlanTasks returns a sequential TaskTree with one task per resource type and action, in the order of changes.
+func (r *Reconciler) PlanTasks(ctx context.Context, changes []Change, plan bool) (*tasks.TaskTree, error) {
+	type group struct {
+		action       Action
+		resourceType ResourceType
+		names        []string
+	}
+	var groups []*group
+	for _, c := range changes {
+		if len(groups) == 0 || groups[len(groups)-1].action != c.Action || groups[len(groups)-1].resourceType != c.ResourceType {
+			groups = append(groups, &group{action: c.Action, resourceType: c.ResourceType})
+		}
+		g := groups[len(groups)-1]
+		g.names = append(g.names, c.Name)
+	}
+
+	taskTree := &tasks.TaskTree{PlanMode: plan}
+	for _, g := range groups {
+		applier, ok := r.Appliers[g.resourceType]
+		if !ok {
+			return nil, fmt.Errorf("applying changes to resources of type %q is not supported", g.resourceType)
+		}
+		g := g
+		taskTree.Append(&tasks.GenericTask{
+			Description: fmt.Sprintf("%s %d %s(s): %s", g.action, len(g.names), g.resourceType, strings.Join(g.names, ", ")),
+			Metadata: tasks.Metadata{
+				Action:       string(g.action),
+				ResourceType: string(g.resourceType),
+				ResourceName: strings.Join(g.names, ","),
+			},
+			Doer: func() error {
+				switch g.action {
+				case ActionCreate:
+					return applier.Create(ctx, g.names)
+				case ActionUpdate:
+					return applier.Update(ctx, g.names)
+				case ActionDelete:
+					return applier.Delete(ctx, g.names)
+				default:
+					return fmt.Errorf("unknown action %q", g.action)
+				}
+			},
+		})
+	}
+	return taskTree, nil
+}
@@ -0,0 +1,97 @@
+package apply_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/apply"
+)
+
+type recordingApplier struct {
+	calls []string
+	err   error
+}
+
+func (r *recordingApplier) record(action string, names []string) error {
+	r.calls = append(r.calls, action+" "+strings.Join(names, ","))
+	return r.err
+}
+
+func (r *recordingApplier) Create(_ context.Context, names []string) error {
+	return r.record("create", names)
+}
+
+func (r *recordingApplier) Update(_ context.Context, names []string) error {
+	return r.record("update", names)
+}
+
+func (r *recordingApplier) Delete(_ context.Context, names []string) error {
+	return r.record("delete", names)
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		addonApplier     *recordingApplier
+		nodeGroupApplier *recordingApplier
+		reconciler       *apply.Reconciler
+		changes          []apply.Change
+	)
+
+	BeforeEach(func() {
+		addonApplier = &recordingApplier{}
+		nodeGroupApplier = &recordingApplier{}
+		reconciler = &apply.Reconciler{
+			Appliers: map[apply.ResourceType]apply.Applier{
+				apply.ResourceTypeAddon:            addonApplier,
+				apply.ResourceTypeManagedNodeGroup: nodeGroupApplier,
+			},
+		}
+		changes = []apply.Change{
+			{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeAddon, Name: "vpc-cni"},
+			{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeAddon, Name: "coredns"},
+			{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeManagedNodeGroup, Name: "mng-1"},
+			{Action: apply.ActionUpdate, ResourceType: apply.ResourceTypeAddon, Name: "kube-proxy"},
+			{Action: apply.ActionDelete, ResourceType: apply.ResourceTypeManagedNodeGroup, Name: "mng-0"},
+		}
+	})
+
+	It("groups consecutive changes by action and resource type", func() {
+		taskTree, err := reconciler.PlanTasks(context.Background(), changes, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taskTree.Parallel).To(BeFalse())
+		Expect(taskTree.Len()).To(Equal(4))
+		Expect(taskTree.Tasks[0].Describe()).To(Equal("create 2 addon(s): vpc-cni, coredns"))
+
+		Expect(taskTree.DoAllSync()).To(BeEmpty())
+		Expect(addonApplier.calls).To(Equal([]string{"create vpc-cni,coredns", "update kube-proxy"}))
+		Expect(nodeGroupApplier.calls).To(Equal([]string{"create mng-1", "delete mng-0"}))
+	})
+
+	It("does not apply changes in plan mode", func() {
+		taskTree, err := reconciler.PlanTasks(context.Background(), changes, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taskTree.Describe()).To(HavePrefix("(plan) "))
+		Expect(taskTree.DoAllSync()).To(BeEmpty())
+		Expect(addonApplier.calls).To(BeEmpty())
+		Expect(nodeGroupApplier.calls).To(BeEmpty())
+	})
+
+	It("stops at the first failing task", func() {
+		addonApplier.err = errors.New("addon error")
+		taskTree, err := reconciler.PlanTasks(context.Background(), changes, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taskTree.DoAllSync()).To(ConsistOf(MatchError("addon error")))
+		Expect(nodeGroupApplier.calls).To(BeEmpty())
+	})
+
+	It("returns an error for unsupported resource types", func() {
+		_, err := reconciler.PlanTasks(context.Background(), []apply.Change{
+			{Action: apply.ActionCreate, ResourceType: apply.ResourceTypeCapability, Name: "ack"},
+		}, false)
+		Expect(err).To(MatchError(`applying changes to resources of type "capability" is not supported`))
+	})
+})
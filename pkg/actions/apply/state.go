@@ -0,0 +1,171 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/actions/accessentry"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// StackLister lists the eksctl-managed stacks for a cluster.
+type StackLister interface {
+	ListNodeGroupStacksWithStatuses(ctx context.Context) ([]manager.NodeGroupStack, error)
+	ListAccessEntryStackNames(ctx context.Context, clusterName string) ([]string, error)
+}
+
+// A StateReader reads the live state of a cluster from CloudFormation and the EKS API.
+type StateReader struct {
+	ClusterName string
+	StackLister StackLister
+	EKSAPI      awsapi.EKS
+}
+
+// Read returns the live state of the cluster.
+func (r *StateReader) Read(ctx context.Context) (*LiveState, error) {
+	state := &LiveState{
+		Addons: map[string]AddonState{},
+	}
+
+	nodeGroupStacks, err := r.StackLister.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing nodegroup stacks: %w", err)
+	}
+	for _, s := range nodeGroupStacks {
+		switch s.Type {
+		case api.NodeGroupTypeManaged:
+			state.ManagedNodeGroups = append(state.ManagedNodeGroups, s.NodeGroupName)
+		case api.NodeGroupTypeUnmanaged:
+			state.NodeGroups = append(state.NodeGroups, s.NodeGroupName)
+		}
+	}
+
+	if err := r.readAddons(ctx, state); err != nil {
+		return nil, err
+	}
+	if err := r.readAccessEntries(ctx, state); err != nil {
+		return nil, err
+	}
+
+	podIDPaginator := eks.NewListPodIdentityAssociationsPaginator(r.EKSAPI, &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String(r.ClusterName),
+	})
+	for podIDPaginator.HasMorePages() {
+		out, err := podIDPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing pod identity associations: %w", err)
+		}
+		for _, a := range out.Associations {
+			// associations owned by an addon are reconciled through the addon itself
+			if a.OwnerArn != nil {
+				continue
+			}
+			pia := api.PodIdentityAssociation{
+				Namespace:          aws.ToString(a.Namespace),
+				ServiceAccountName: aws.ToString(a.ServiceAccount),
+			}
+			state.PodIdentityAssociations = append(state.PodIdentityAssociations, pia.NameString())
+		}
+	}
+
+	fargatePaginator := eks.NewListFargateProfilesPaginator(r.EKSAPI, &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(r.ClusterName),
+	})
+	for fargatePaginator.HasMorePages() {
+		out, err := fargatePaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing Fargate profiles: %w", err)
+		}
+		state.FargateProfiles = append(state.FargateProfiles, out.FargateProfileNames...)
+	}
+
+	idpPaginator := eks.NewListIdentityProviderConfigsPaginator(r.EKSAPI, &eks.ListIdentityProviderConfigsInput{
+		ClusterName: aws.String(r.ClusterName),
+	})
+	for idpPaginator.HasMorePages() {
+		out, err := idpPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing identity providers: %w", err)
+		}
+		for _, idp := range out.IdentityProviderConfigs {
+			state.IdentityProviders = append(state.IdentityProviders, aws.ToString(idp.Name))
+		}
+	}
+
+	capabilityPaginator := eks.NewListCapabilitiesPaginator(r.EKSAPI, &eks.ListCapabilitiesInput{
+		ClusterName: aws.String(r.ClusterName),
+	})
+	for capabilityPaginator.HasMorePages() {
+		out, err := capabilityPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing capabilities: %w", err)
+		}
+		for _, c := range out.Capabilities {
+			state.Capabilities = append(state.Capabilities, aws.ToString(c.CapabilityName))
+		}
+	}
+
+	return state, nil
+}
+
+func (r *StateReader) readAddons(ctx context.Context, state *LiveState) error {
+	paginator := eks.NewListAddonsPaginator(r.EKSAPI, &eks.ListAddonsInput{
+		ClusterName: aws.String(r.ClusterName),
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing addons: %w", err)
+		}
+		for _, name := range out.Addons {
+			addon, err := r.EKSAPI.DescribeAddon(ctx, &eks.DescribeAddonInput{
+				ClusterName: aws.String(r.ClusterName),
+				AddonName:   aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("describing addon %q: %w", name, err)
+			}
+			state.Addons[name] = AddonState{
+				Version:             aws.ToString(addon.Addon.AddonVersion),
+				ConfigurationValues: aws.ToString(addon.Addon.ConfigurationValues),
+			}
+		}
+	}
+	return nil
+}
+
+// readAccessEntries only reports access entries that were created by eksctl, so that entries created by EKS
+// for nodegroup roles or the cluster creator are never pruned.
+func (r *StateReader) readAccessEntries(ctx context.Context, state *LiveState) error {
+	stackNames, err := r.StackLister.ListAccessEntryStackNames(ctx, r.ClusterName)
+	if err != nil {
+		return fmt.Errorf("listing access entry stacks: %w", err)
+	}
+	existingStacks := sets.New[string](stackNames...)
+
+	paginator := eks.NewListAccessEntriesPaginator(r.EKSAPI, &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(r.ClusterName),
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing access entries: %w", err)
+		}
+		for _, principalARN := range out.AccessEntries {
+			var arn api.ARN
+			if err := arn.Set(principalARN); err != nil {
+				return err
+			}
+			if existingStacks.Has(accessentry.MakeStackName(r.ClusterName, api.AccessEntry{PrincipalARN: arn})) {
+				state.AccessEntries = append(state.AccessEntries, principalARN)
+			}
+		}
+	}
+	return nil
+}
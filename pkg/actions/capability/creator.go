@@ -48,6 +48,7 @@ func NewCreator(clusterName string, stackCreator StackCreator, eksAPI awsapi.EKS
 
 func (c *Creator) Create(ctx context.Context, capabilities []api.Capability) error {
 	taskTree := c.CreateTasks(ctx, capabilities)
+	taskTree.Context = ctx
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		var allErrs []string
 		for _, err := range errs {
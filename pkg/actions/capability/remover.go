@@ -45,6 +45,7 @@ func (r *Remover) Delete(ctx context.Context, capabilities []Summary) error {
 	}
 
 	// Execute capability deletions in parallel
+	capabilityTasks.Context = ctx
 	if errs := capabilityTasks.DoAllSync(); len(errs) > 0 {
 		var allErrs []string
 		for _, err := range errs {
@@ -36,6 +36,7 @@ func NewUpdater(clusterName string, eksAPI awsapi.EKS) *Updater {
 
 func (u *Updater) Update(ctx context.Context, capabilities []api.Capability) error {
 	taskTree := u.UpdateTasks(ctx, capabilities)
+	taskTree.Context = ctx
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		var allErrs []string
 		for _, err := range errs {
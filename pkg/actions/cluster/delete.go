@@ -143,6 +143,7 @@ func deleteDeprecatedStacks(ctx context.Context, stackManager manager.StackManag
 		return true, err
 	}
 	if count := tasks.Len(); count > 0 {
+		tasks.Context = ctx
 		logger.Info(tasks.Describe())
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
 			return true, handleErrors(errs, "deprecated stacks")
@@ -153,6 +154,55 @@ func deleteDeprecatedStacks(ctx context.Context, stackManager manager.StackManag
 	return false, nil
 }
 
+// listAdoptedStacks returns the stacks holding resources adopted by eksctl that have not been deleted
+func listAdoptedStacks(ctx context.Context, clusterName string, stackManager manager.StackManager) ([]*manager.Stack, error) {
+	stacks, err := stackManager.ListStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var adopted []*manager.Stack
+	for _, s := range stacks {
+		if s.StackStatus != types.StackStatusDeleteComplete && manager.IsAdoptedStack(clusterName, s) {
+			adopted = append(adopted, s)
+		}
+	}
+	return adopted, nil
+}
+
+// waitForAdoptedStacks returns whether the deletion of the cluster must be waited for, which it must
+// when the cluster has adopted stacks, as those can only be deleted once the cluster is gone
+func waitForAdoptedStacks(ctx context.Context, clusterName string, stackManager manager.StackManager, wait bool) (bool, error) {
+	if wait {
+		return true, nil
+	}
+	stacks, err := listAdoptedStacks(ctx, clusterName, stackManager)
+	if err != nil {
+		return false, err
+	}
+	if len(stacks) > 0 {
+		logger.Info("waiting for the cluster to be deleted before deleting its %d adopted stack(s)", len(stacks))
+		return true, nil
+	}
+	return false, nil
+}
+
+// deleteAdoptedStacks deletes the stacks holding resources adopted by eksctl, along with the resources
+// unless they are retained.
+// They are deleted last, once the cluster and its nodegroups, which may depend on them, are deleted.
+func deleteAdoptedStacks(ctx context.Context, clusterName string, stackManager manager.StackManager) error {
+	stacks, err := listAdoptedStacks(ctx, clusterName, stackManager)
+	if err != nil {
+		return err
+	}
+	for _, s := range stacks {
+		logger.Info("deleting adopted stack %q", *s.StackName)
+		if err := stackManager.DeleteStackSync(ctx, s); err != nil {
+			return fmt.Errorf("deleting adopted stack %q: %w", *s.StackName, err)
+		}
+	}
+	return nil
+}
+
 func checkForUndeletedStacks(ctx context.Context, stackManager manager.StackManager) error {
 	stacks, err := stackManager.ListStacks(ctx)
 	if err != nil {
@@ -3,6 +3,9 @@ package cluster_test
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 
@@ -136,3 +139,40 @@ var _ = Describe("DrainAllNodeGroups", func() {
 		})
 	})
 })
+
+var _ = Describe("DeleteAdoptedStacks", func() {
+	It("deletes only the adopted stacks of the cluster", func() {
+		fakeStackManager := new(fakes.FakeStackManager)
+		fakeStackManager.ListStacksReturns([]*manager.Stack{
+			{StackName: aws.String("eksctl-my-cluster-cluster")},
+			{StackName: aws.String("eksctl-my-cluster-adopted-role-node")},
+			{StackName: aws.String("eksctl-my-cluster-adopted-sg-123"), StackStatus: cfntypes.StackStatusDeleteComplete},
+		}, nil)
+
+		Expect(cluster.DeleteAdoptedStacks(context.Background(), "my-cluster", fakeStackManager)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(1))
+		_, stack := fakeStackManager.DeleteStackSyncArgsForCall(0)
+		Expect(*stack.StackName).To(Equal("eksctl-my-cluster-adopted-role-node"))
+	})
+})
+
+var _ = Describe("WaitForAdoptedStacks", func() {
+	It("waits for the cluster deletion only when the cluster has adopted stacks", func() {
+		fakeStackManager := new(fakes.FakeStackManager)
+		fakeStackManager.ListStacksReturns([]*manager.Stack{
+			{StackName: aws.String("eksctl-my-cluster-cluster")},
+			{StackName: aws.String("eksctl-my-cluster-adopted-sg-123"), StackStatus: cfntypes.StackStatusDeleteComplete},
+		}, nil)
+		wait, err := cluster.WaitForAdoptedStacks(context.Background(), "my-cluster", fakeStackManager, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+
+		fakeStackManager.ListStacksReturns([]*manager.Stack{
+			{StackName: aws.String("eksctl-my-cluster-cluster")},
+			{StackName: aws.String("eksctl-my-cluster-adopted-role-node")},
+		}, nil)
+		wait, err = cluster.WaitForAdoptedStacks(context.Background(), "my-cluster", fakeStackManager, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeTrue())
+	})
+})
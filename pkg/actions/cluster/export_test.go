@@ -6,7 +6,9 @@ import (
 )
 
 var (
-	DrainAllNodeGroups = drainAllNodeGroups
+	DrainAllNodeGroups   = drainAllNodeGroups
+	DeleteAdoptedStacks  = deleteAdoptedStacks
+	WaitForAdoptedStacks = waitForAdoptedStacks
 )
 
 var UpdateRemoteNetworkConfig = (*OwnedCluster).updateRemoteNetworkConfig
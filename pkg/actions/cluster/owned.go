@@ -105,7 +105,7 @@ func (c *OwnedCluster) updateRemoteNetworkConfig(ctx context.Context, dryRun boo
 		}
 	}
 
-	cmdutils.LogIntendedAction(dryRun, "update remote network config for cluster %q", c.cfg.Metadata.Name)
+	cmdutils.LogIntendedAction(ctx, dryRun, "update remote network config for cluster %q", c.cfg.Metadata.Name)
 	if !dryRun {
 		if err := c.ctl.UpdateClusterConfig(ctx, &awseks.UpdateClusterConfigInput{
 			Name:                aws.String(c.cfg.Metadata.Name),
@@ -134,6 +134,11 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		return err
 	}
 
+	// adopted stacks are deleted after the cluster, so its deletion must have completed by then
+	if wait, err = waitForAdoptedStacks(ctx, c.cfg.Metadata.Name, c.stackManager, wait); err != nil {
+		return err
+	}
+
 	var clientSet kubernetes.Interface
 	if clusterOperable {
 		var err error
@@ -218,6 +223,7 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		return nil
 	}
 
+	tasks.Context = ctx
 	logger.Info(tasks.Describe())
 	if errs := tasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "cluster with nodegroup(s)")
@@ -227,6 +233,10 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		return err
 	}
 
+	if err := deleteAdoptedStacks(ctx, c.cfg.Metadata.Name, c.stackManager); err != nil {
+		return err
+	}
+
 	if err := checkForUndeletedStacks(ctx, c.stackManager); err != nil {
 		return err
 	}
@@ -40,6 +40,14 @@ func (d *drainerMockOwned) Drain(_ context.Context, input *nodegroup.DrainInput)
 	return args.Error(0)
 }
 
+func newTaskGraph(ts ...tasks.Task) *tasks.Graph {
+	taskGraph := &tasks.Graph{}
+	for _, t := range ts {
+		taskGraph.Add(t)
+	}
+	return taskGraph
+}
+
 var _ = Describe("Delete", func() {
 	var (
 		clusterName              string
@@ -104,12 +112,10 @@ var _ = Describe("Delete", func() {
 
 			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
-				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
-					ranDeleteClusterTasks = true
-					return nil
-				}}},
-			}, nil)
+			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(newTaskGraph(&tasks.GenericTask{Doer: func() error {
+				ranDeleteClusterTasks = true
+				return nil
+			}}), nil)
 
 			karpenterStack := &manager.Stack{
 				StackName: aws.String("karpenter"),
@@ -186,9 +192,7 @@ var _ = Describe("Delete", func() {
 
 				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-				fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
-					Tasks: []tasks.Task{},
-				}, nil)
+				fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.Graph{}, nil)
 
 				c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager, autoModeDeleter)
 				fakeClientSet = fake.NewSimpleClientset()
@@ -256,9 +260,7 @@ var _ = Describe("Delete", func() {
 
 				p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-				fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
-					Tasks: []tasks.Task{},
-				}, nil)
+				fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.Graph{}, nil)
 
 				c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager, autoModeDeleter)
 				fakeClientSet = fake.NewSimpleClientset()
@@ -328,12 +330,10 @@ var _ = Describe("Delete", func() {
 
 			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
-				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
-					ranDeleteClusterTasks = true
-					return nil
-				}}},
-			}, nil)
+			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(newTaskGraph(&tasks.GenericTask{Doer: func() error {
+				ranDeleteClusterTasks = true
+				return nil
+			}}), nil)
 
 			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager, autoModeDeleter)
 
@@ -358,5 +358,42 @@ var _ = Describe("Delete", func() {
 			Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(1))
 			Expect(ranDeleteClusterTasks).To(BeTrue())
 		})
+
+		It("waits for the cluster to be deleted before deleting its adopted stacks", func() {
+			p.MockEKS().On("ListFargateProfiles", mock.Anything, &awseks.ListFargateProfilesInput{
+				ClusterName: strings.Pointer(clusterName),
+			}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: []string{}}, nil)
+
+			p.MockEC2().On("DescribeKeyPairs", mock.Anything, mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
+
+			p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+
+			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{}, nil)
+			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(newTaskGraph(&tasks.GenericTask{Doer: func() error {
+				ranDeleteClusterTasks = true
+				return nil
+			}}), nil)
+
+			adoptedStack := &manager.Stack{StackName: aws.String("eksctl-my-cluster-adopted-role-node")}
+			fakeStackManager.ListStacksStub = func(context.Context) ([]*manager.Stack, error) {
+				if fakeStackManager.DeleteStackSyncCallCount() > 0 {
+					return nil, nil
+				}
+				return []*manager.Stack{adoptedStack}, nil
+			}
+
+			ctl.Status.ClusterInfo.Cluster = testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusFailed)
+			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager, autoModeDeleter)
+
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ranDeleteClusterTasks).To(BeTrue())
+			Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(1))
+			_, _, _, _, _, _, _, _, _, _, wait, _, _ := fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsArgsForCall(0)
+			Expect(wait).To(BeTrue())
+			Expect(fakeStackManager.DeleteStackSyncCallCount()).To(Equal(1))
+			_, stack := fakeStackManager.DeleteStackSyncArgsForCall(0)
+			Expect(stack).To(Equal(adoptedStack))
+		})
 	})
 })
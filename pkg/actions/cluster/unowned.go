@@ -75,6 +75,11 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		return err
 	}
 
+	// adopted stacks are deleted after the cluster, so its deletion must have completed by then
+	if wait, err = waitForAdoptedStacks(ctx, clusterName, c.stackManager, wait); err != nil {
+		return err
+	}
+
 	var clientSet kubernetes.Interface
 	if clusterOperable {
 		clientSet, err = c.newClientSet()
@@ -124,6 +129,10 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		return err
 	}
 
+	if err := deleteAdoptedStacks(ctx, c.cfg.Metadata.Name, c.stackManager); err != nil {
+		return err
+	}
+
 	if err := checkForUndeletedStacks(ctx, c.stackManager); err != nil {
 		return err
 	}
@@ -198,6 +207,7 @@ func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, cluste
 		return nil
 	}
 
+	tasksTree.Context = ctx
 	logger.Info(tasksTree.Describe())
 	if errs := tasksTree.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "cluster IAM and OIDC")
@@ -276,6 +286,7 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(ctx context.Context,
 
 	// TODO what dis?
 	tasks.PlanMode = false
+	tasks.Context = ctx
 	logger.Info(tasks.Describe())
 	if errs := tasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "nodegroup(s)")
@@ -27,7 +27,7 @@ func upgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvid
 	}
 
 	printer := printers.NewJSONPrinter()
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return false, err
 	}
 
@@ -43,7 +43,7 @@ func upgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvid
 		}
 
 		msgNodeGroupsAndAddons := "you will need to follow the upgrade procedure for all of nodegroups and add-ons"
-		cmdutils.LogIntendedAction(dryRun, "%s cluster %q control plane from current version %q to %q", action, cfg.Metadata.Name, currentVersion, upgradeVersion)
+		cmdutils.LogIntendedAction(ctx, dryRun, "%s cluster %q control plane from current version %q to %q", action, cfg.Metadata.Name, currentVersion, upgradeVersion)
 		if !dryRun {
 			cfg.Metadata.Version = upgradeVersion
 			if err := ctl.UpdateClusterVersionBlocking(ctx, cfg); err != nil {
@@ -0,0 +1,240 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+)
+
+// StatusDetectionFailed is the status of stacks whose drift could not be detected.
+const StatusDetectionFailed = "DETECTION_FAILED"
+
+// StackDrift is the result of drift detection on a stack.
+type StackDrift struct {
+	StackName string `json:"stackName"`
+	// Status is the drift status of the stack, one of DRIFTED, IN_SYNC, UNKNOWN or NOT_CHECKED,
+	// or DETECTION_FAILED if drift detection failed for the stack
+	Status string `json:"status"`
+	// Reason explains why drift detection failed for the stack or for some of its resources
+	Reason    string          `json:"reason,omitempty"`
+	Resources []ResourceDrift `json:"resources,omitempty"`
+}
+
+// Drifted reports whether any resource in the stack has drifted.
+func (s StackDrift) Drifted() bool {
+	return s.Status == string(types.StackDriftStatusDrifted)
+}
+
+// Failed reports whether drift detection failed for the stack.
+func (s StackDrift) Failed() bool {
+	return s.Status == StatusDetectionFailed
+}
+
+// ResourceDrift describes a resource whose actual configuration differs from its template.
+type ResourceDrift struct {
+	LogicalResourceID  string `json:"logicalResourceId"`
+	PhysicalResourceID string `json:"physicalResourceId,omitempty"`
+	ResourceType       string `json:"resourceType"`
+	// Status is MODIFIED or DELETED
+	Status      string               `json:"status"`
+	Differences []PropertyDifference `json:"differences,omitempty"`
+}
+
+// PropertyDifference is a property whose actual value differs from the expected one.
+type PropertyDifference struct {
+	PropertyPath string `json:"propertyPath"`
+	// DifferenceType is ADD, REMOVE or NOT_EQUAL
+	DifferenceType string `json:"differenceType"`
+	ExpectedValue  string `json:"expectedValue,omitempty"`
+	ActualValue    string `json:"actualValue,omitempty"`
+}
+
+// A Detector runs CloudFormation drift detection on the stacks of a cluster.
+type Detector struct {
+	stackManager manager.StackManager
+	cfnAPI       awsapi.CloudFormation
+	waitTimeout  time.Duration
+	nextDelay    waiter.NextDelay
+}
+
+// NewDetector creates a new Detector.
+func NewDetector(stackManager manager.StackManager, cfnAPI awsapi.CloudFormation, waitTimeout time.Duration) *Detector {
+	return &Detector{
+		stackManager: stackManager,
+		cfnAPI:       cfnAPI,
+		waitTimeout:  waitTimeout,
+		nextDelay: func(_ int) time.Duration {
+			return 5 * time.Second
+		},
+	}
+}
+
+// Detect runs drift detection on every stack of the cluster and waits for the results; stacks for which
+// drift detection fails are reported with the DETECTION_FAILED status.
+func (d *Detector) Detect(ctx context.Context) ([]StackDrift, error) {
+	stacks, err := d.stackManager.ListStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.waitTimeout)
+	defer cancel()
+
+	drifts := make([]StackDrift, len(stacks))
+	detectionIDs := make([]string, len(stacks))
+	for i, s := range stacks {
+		drifts[i].StackName = *s.StackName
+		logger.Info("detecting drift for stack %q", *s.StackName)
+		out, err := d.cfnAPI.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{
+			StackName: s.StackName,
+		})
+		if err != nil {
+			drifts[i].failed(fmt.Errorf("detecting drift for stack %q: %w", *s.StackName, err))
+			continue
+		}
+		detectionIDs[i] = *out.StackDriftDetectionId
+	}
+
+	for i := range drifts {
+		if drifts[i].Failed() {
+			continue
+		}
+		drift, err := d.waitForDetection(ctx, drifts[i].StackName, detectionIDs[i])
+		if err != nil {
+			drifts[i].failed(err)
+			continue
+		}
+		if drift.Drifted() {
+			if drift.Resources, err = d.describeResourceDrifts(ctx, drift.StackName); err != nil {
+				drifts[i].failed(err)
+				continue
+			}
+		}
+		drifts[i] = drift
+	}
+	return drifts, nil
+}
+
+func (s *StackDrift) failed(err error) {
+	s.Status = StatusDetectionFailed
+	s.Reason = err.Error()
+	logger.Warning("%s", s.Reason)
+}
+
+func (d *Detector) waitForDetection(ctx context.Context, stackName, detectionID string) (StackDrift, error) {
+	var status *cloudformation.DescribeStackDriftDetectionStatusOutput
+	w := &waiter.Waiter{
+		NextDelay: d.nextDelay,
+		Operation: func() (bool, error) {
+			logger.Info("waiting for drift detection on stack %q", stackName)
+			var err error
+			status, err = d.cfnAPI.DescribeStackDriftDetectionStatus(ctx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+				StackDriftDetectionId: aws.String(detectionID),
+			})
+			if err != nil {
+				return false, fmt.Errorf("describing drift detection status for stack %q: %w", stackName, err)
+			}
+			return status.DetectionStatus != types.StackDriftDetectionStatusDetectionInProgress, nil
+		},
+	}
+	if err := w.Wait(ctx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return StackDrift{}, fmt.Errorf("timed out waiting for drift detection on stack %q", stackName)
+		}
+		return StackDrift{}, err
+	}
+
+	drift := StackDrift{
+		StackName: stackName,
+		Status:    string(status.StackDriftStatus),
+	}
+	if status.DetectionStatus == types.StackDriftDetectionStatusDetectionFailed {
+		// CloudFormation still reports the drift of the resources it was able to check
+		drift.Reason = aws.ToString(status.DetectionStatusReason)
+		logger.Warning("drift detection failed for some resources in stack %q: %s", stackName, drift.Reason)
+	}
+	return drift, nil
+}
+
+func (d *Detector) describeResourceDrifts(ctx context.Context, stackName string) ([]ResourceDrift, error) {
+	var resources []ResourceDrift
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(d.cfnAPI, &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stackName),
+		StackResourceDriftStatusFilters: []types.StackResourceDriftStatus{
+			types.StackResourceDriftStatusModified,
+			types.StackResourceDriftStatusDeleted,
+		},
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing resource drifts for stack %q: %w", stackName, err)
+		}
+		for _, r := range out.StackResourceDrifts {
+			resource := ResourceDrift{
+				LogicalResourceID:  aws.ToString(r.LogicalResourceId),
+				PhysicalResourceID: aws.ToString(r.PhysicalResourceId),
+				ResourceType:       aws.ToString(r.ResourceType),
+				Status:             string(r.StackResourceDriftStatus),
+			}
+			for _, p := range r.PropertyDifferences {
+				resource.Differences = append(resource.Differences, PropertyDifference{
+					PropertyPath:   aws.ToString(p.PropertyPath),
+					DifferenceType: string(p.DifferenceType),
+					ExpectedValue:  aws.ToString(p.ExpectedValue),
+					ActualValue:    aws.ToString(p.ActualValue),
+				})
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// Remediate reverts the drifted resources of every drifted stack to their template through a drift-aware change set
+// of the template the stack was last deployed with. In plan mode, the changes are only previewed.
+func (d *Detector) Remediate(ctx context.Context, drifts []StackDrift, plan bool) error {
+	for _, drift := range drifts {
+		if !drift.Drifted() {
+			continue
+		}
+		stack, err := d.stackManager.DescribeStack(ctx, &manager.Stack{StackName: aws.String(drift.StackName)})
+		if err != nil {
+			return err
+		}
+		template, err := d.stackManager.GetStackTemplate(ctx, drift.StackName)
+		if err != nil {
+			return fmt.Errorf("error getting template for stack %q: %w", drift.StackName, err)
+		}
+
+		err = d.stackManager.MustUpdateStack(ctx, manager.UpdateStackOptions{
+			Stack:                 stack,
+			ChangeSetName:         d.stackManager.MakeChangeSetName("remediate-drift"),
+			Description:           fmt.Sprintf("reverting drift in stack %q", drift.StackName),
+			TemplateData:          manager.TemplateBody(template),
+			UsePreviousParameters: true,
+			RevertDrift:           true,
+			Wait:                  true,
+			Plan:                  plan,
+		})
+		var noChangeErr *manager.NoChangeError
+		if errors.As(err, &noChangeErr) {
+			logger.Warning("CloudFormation found no drift to revert in stack %q; the drifted resources must be reverted manually", drift.StackName)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error remediating drift in stack %q: %w", drift.StackName, err)
+		}
+	}
+	return nil
+}
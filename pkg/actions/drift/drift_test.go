@@ -0,0 +1,226 @@
+package drift_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/drift"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Drift", func() {
+	const (
+		clusterStack   = "eksctl-test-cluster"
+		nodegroupStack = "eksctl-test-nodegroup-ng"
+	)
+
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		p                *mockprovider.MockProvider
+		detector         *drift.Detector
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.ListStacksReturns([]*manager.Stack{
+			{StackName: aws.String(clusterStack)},
+			{StackName: aws.String(nodegroupStack)},
+		}, nil)
+		p = mockprovider.NewMockProvider()
+		detector = drift.NewDetector(fakeStackManager, p.MockCloudFormation(), time.Minute)
+		detector.SetNextDelay(func(_ int) time.Duration {
+			return time.Millisecond
+		})
+
+		for _, stackName := range []string{clusterStack, nodegroupStack} {
+			p.MockCloudFormation().On("DetectStackDrift", mock.Anything, &cloudformation.DetectStackDriftInput{
+				StackName: aws.String(stackName),
+			}).Return(&cloudformation.DetectStackDriftOutput{
+				StackDriftDetectionId: aws.String(stackName + "-detection"),
+			}, nil)
+		}
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(clusterStack + "-detection"),
+		}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+			DetectionStatus:  types.StackDriftDetectionStatusDetectionInProgress,
+			StackDriftStatus: types.StackDriftStatusUnknown,
+		}, nil).Once()
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(clusterStack + "-detection"),
+		}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+			DetectionStatus:  types.StackDriftDetectionStatusDetectionComplete,
+			StackDriftStatus: types.StackDriftStatusInSync,
+		}, nil)
+	})
+
+	mockDriftedNodegroup := func() {
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(nodegroupStack + "-detection"),
+		}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+			DetectionStatus:  types.StackDriftDetectionStatusDetectionComplete,
+			StackDriftStatus: types.StackDriftStatusDrifted,
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackResourceDrifts", mock.Anything, mock.MatchedBy(func(input *cloudformation.DescribeStackResourceDriftsInput) bool {
+			return *input.StackName == nodegroupStack
+		}), mock.Anything).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+			StackResourceDrifts: []types.StackResourceDrift{
+				{
+					LogicalResourceId:        aws.String("SG"),
+					PhysicalResourceId:       aws.String("sg-123"),
+					ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+					StackResourceDriftStatus: types.StackResourceDriftStatusModified,
+					PropertyDifferences: []types.PropertyDifference{
+						{
+							PropertyPath:   aws.String("/SecurityGroupIngress/0/CidrIp"),
+							DifferenceType: types.DifferenceTypeNotEqual,
+							ExpectedValue:  aws.String("10.0.0.0/16"),
+							ActualValue:    aws.String("0.0.0.0/0"),
+						},
+					},
+				},
+			},
+		}, nil)
+	}
+
+	It("reports the drifted resources of every stack", func() {
+		mockDriftedNodegroup()
+
+		drifts, err := detector.Detect(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(Equal([]drift.StackDrift{
+			{
+				StackName: clusterStack,
+				Status:    "IN_SYNC",
+			},
+			{
+				StackName: nodegroupStack,
+				Status:    "DRIFTED",
+				Resources: []drift.ResourceDrift{
+					{
+						LogicalResourceID:  "SG",
+						PhysicalResourceID: "sg-123",
+						ResourceType:       "AWS::EC2::SecurityGroup",
+						Status:             "MODIFIED",
+						Differences: []drift.PropertyDifference{
+							{
+								PropertyPath:   "/SecurityGroupIngress/0/CidrIp",
+								DifferenceType: "NOT_EQUAL",
+								ExpectedValue:  "10.0.0.0/16",
+								ActualValue:    "0.0.0.0/0",
+							},
+						},
+					},
+				},
+			},
+		}))
+		Expect(drifts[0].Drifted()).To(BeFalse())
+		Expect(drifts[1].Drifted()).To(BeTrue())
+		p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStackResourceDrifts", 1)
+	})
+
+	It("keeps the results of a partially failed detection", func() {
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(nodegroupStack + "-detection"),
+		}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+			DetectionStatus:       types.StackDriftDetectionStatusDetectionFailed,
+			DetectionStatusReason: aws.String("Failed to detect drift on resource [NodeInstanceRole]"),
+			StackDriftStatus:      types.StackDriftStatusInSync,
+		}, nil)
+
+		drifts, err := detector.Detect(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts[1].Reason).To(Equal("Failed to detect drift on resource [NodeInstanceRole]"))
+		Expect(drifts[1].Drifted()).To(BeFalse())
+	})
+
+	It("records stacks for which drift detection cannot be started as failed and checks the others", func() {
+		fakeStackManager.ListStacksReturns([]*manager.Stack{
+			{StackName: aws.String("eksctl-test-addon-vpc-cni")},
+			{StackName: aws.String(clusterStack)},
+		}, nil)
+		p.MockCloudFormation().On("DetectStackDrift", mock.Anything, &cloudformation.DetectStackDriftInput{
+			StackName: aws.String("eksctl-test-addon-vpc-cni"),
+		}).Return(nil, errors.New("stack is in UPDATE_IN_PROGRESS state"))
+
+		drifts, err := detector.Detect(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(Equal([]drift.StackDrift{
+			{
+				StackName: "eksctl-test-addon-vpc-cni",
+				Status:    drift.StatusDetectionFailed,
+				Reason:    `detecting drift for stack "eksctl-test-addon-vpc-cni": stack is in UPDATE_IN_PROGRESS state`,
+			},
+			{
+				StackName: clusterStack,
+				Status:    "IN_SYNC",
+			},
+		}))
+		Expect(drifts[0].Failed()).To(BeTrue())
+		Expect(drifts[1].Failed()).To(BeFalse())
+	})
+
+	It("records stacks whose drift detection status cannot be described as failed", func() {
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(nodegroupStack + "-detection"),
+		}).Return(nil, errors.New("throttled"))
+
+		drifts, err := detector.Detect(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts[0].Status).To(Equal("IN_SYNC"))
+		Expect(drifts[1].StackName).To(Equal(nodegroupStack))
+		Expect(drifts[1].Failed()).To(BeTrue())
+		Expect(drifts[1].Reason).To(ContainSubstring("throttled"))
+	})
+
+	Context("Remediate", func() {
+		BeforeEach(func() {
+			mockDriftedNodegroup()
+			fakeStackManager.DescribeStackReturns(&manager.Stack{
+				StackName:  aws.String(nodegroupStack),
+				Parameters: []types.Parameter{{ParameterKey: aws.String("Secret"), ParameterValue: aws.String("****")}},
+			}, nil)
+			fakeStackManager.GetStackTemplateReturns("{}", nil)
+		})
+
+		It("reverts the drift of drifted stacks only with drift-aware change sets", func() {
+			drifts, err := detector.Detect(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(detector.Remediate(context.Background(), drifts, false)).To(Succeed())
+			Expect(fakeStackManager.MustUpdateStackCallCount()).To(Equal(1))
+			_, options := fakeStackManager.MustUpdateStackArgsForCall(0)
+			Expect(*options.Stack.StackName).To(Equal(nodegroupStack))
+			Expect(options.TemplateData).To(Equal(manager.TemplateBody("{}")))
+			Expect(options.RevertDrift).To(BeTrue())
+			Expect(options.UsePreviousParameters).To(BeTrue())
+			Expect(options.Parameters).To(BeEmpty())
+			Expect(options.Plan).To(BeFalse())
+		})
+
+		It("does not fail when CloudFormation finds no drift to revert", func() {
+			fakeStackManager.MustUpdateStackReturns(&manager.NoChangeError{Msg: "no changes"})
+			drifts, err := detector.Detect(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detector.Remediate(context.Background(), drifts, true)).To(Succeed())
+			_, options := fakeStackManager.MustUpdateStackArgsForCall(0)
+			Expect(options.Plan).To(BeTrue())
+		})
+
+		It("returns the errors of updating stacks", func() {
+			fakeStackManager.MustUpdateStackReturns(errors.New("update failed"))
+			drifts, err := detector.Detect(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detector.Remediate(context.Background(), drifts, false)).To(MatchError(ContainSubstring(`error remediating drift in stack "eksctl-test-nodegroup-ng": update failed`)))
+		})
+	})
+})
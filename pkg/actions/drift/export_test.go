@@ -0,0 +1,7 @@
+package drift
+
+import "github.com/weaveworks/eksctl/pkg/cfn/waiter"
+
+func (d *Detector) SetNextDelay(nextDelay waiter.NextDelay) {
+	d.nextDelay = nextDelay
+}
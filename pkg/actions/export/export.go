@@ -0,0 +1,318 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// managedTagPrefixes are the prefixes of tags that are set by eksctl, EKS or AWS, and are therefore
+// not exported.
+var managedTagPrefixes = []string{
+	"alpha.eksctl.io/",
+	"eksctl.io/",
+	"eksctl.cluster.k8s.io/",
+	"aws:",
+	"kubernetes.io/cluster/",
+	"k8s.io/cluster-autoscaler/",
+}
+
+// An Exporter reconstructs a ClusterConfig from the live state of a cluster, using the EKS API
+// and the CloudFormation stacks created by eksctl.
+type Exporter struct {
+	clusterName  string
+	provider     api.ClusterProvider
+	stackManager manager.StackManager
+}
+
+// NewExporter returns an Exporter for the cluster with the specified name.
+func NewExporter(clusterName string, provider api.ClusterProvider, stackManager manager.StackManager) *Exporter {
+	return &Exporter{
+		clusterName:  clusterName,
+		provider:     provider,
+		stackManager: stackManager,
+	}
+}
+
+// Export returns a ClusterConfig describing the cluster, its nodegroups, addons, access entries,
+// pod identity associations, IAM service accounts, Fargate profiles and identity providers.
+func (e *Exporter) Export(ctx context.Context) (*api.ClusterConfig, error) {
+	output, err := e.provider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
+		Name: aws.String(e.clusterName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to describe cluster %q: %w", e.clusterName, err)
+	}
+	cluster := output.Cluster
+	if cluster.ConnectorConfig != nil {
+		return nil, fmt.Errorf("cannot export non-EKS cluster %q", e.clusterName)
+	}
+	if cluster.Status != ekstypes.ClusterStatusActive {
+		return nil, fmt.Errorf("cannot export cluster %q in status %q", e.clusterName, cluster.Status)
+	}
+
+	cfg := &api.ClusterConfig{
+		TypeMeta: api.ClusterConfigTypeMeta(),
+		Metadata: &api.ClusterMeta{
+			Name:    e.clusterName,
+			Region:  e.provider.Region(),
+			Version: aws.ToString(cluster.Version),
+			Tags:    withoutManagedKeys(cluster.Tags),
+		},
+		IAM:    &api.ClusterIAM{},
+		Status: &api.ClusterStatus{},
+	}
+	if err := cfg.SetClusterState(cluster); err != nil {
+		return nil, err
+	}
+	exportControlPlane(cfg, cluster)
+
+	clusterStack, err := e.stackManager.DescribeClusterStackIfExists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("describing cluster stack: %w", err)
+	}
+	if clusterStack != nil {
+		if err := vpc.UseFromClusterStack(ctx, e.provider, clusterStack, cfg, true); err != nil {
+			return nil, fmt.Errorf("loading VPC configuration from cluster stack: %w", err)
+		}
+	} else {
+		cfg.IAM.ServiceRoleARN = cluster.RoleArn
+		if err := e.exportVPC(ctx, cfg, cluster.ResourcesVpcConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := e.exportNodeGroups(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if err := e.exportAddonsAndPodIdentityAssociations(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if err := e.exportAccessEntries(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if err := e.exportServiceAccounts(ctx, cfg, partition(cluster.Arn)); err != nil {
+		return nil, err
+	}
+
+	fargateClient := fargate.NewFromProvider(e.clusterName, e.provider, e.stackManager)
+	fargateProfiles, err := fargateClient.ReadProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading Fargate profiles: %w", err)
+	}
+	for _, profile := range fargateProfiles {
+		profile.Status = ""
+		profile.Tags = withoutManagedKeys(profile.Tags)
+	}
+	cfg.FargateProfiles = fargateProfiles
+
+	if err := e.exportIdentityProviders(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if cluster.Identity != nil && cluster.Identity.Oidc != nil {
+		hasProvider, err := e.hasOIDCProvider(ctx, aws.ToString(cluster.Identity.Oidc.Issuer))
+		if err != nil {
+			return nil, err
+		}
+		if hasProvider || len(cfg.IAM.ServiceAccounts) > 0 {
+			cfg.IAM.WithOIDC = aws.Bool(true)
+		}
+	}
+
+	cfg.Status = nil
+	return cfg, nil
+}
+
+// exportControlPlane sets the control plane configuration that is only available from the EKS API.
+func exportControlPlane(cfg *api.ClusterConfig, cluster *ekstypes.Cluster) {
+	if cluster.AccessConfig != nil {
+		cfg.AccessConfig = &api.AccessConfig{
+			AuthenticationMode: cluster.AccessConfig.AuthenticationMode,
+		}
+	}
+	if cluster.UpgradePolicy != nil && cluster.UpgradePolicy.SupportType != "" {
+		cfg.UpgradePolicy = &api.UpgradePolicy{
+			SupportType: string(cluster.UpgradePolicy.SupportType),
+		}
+	}
+	if cluster.Logging != nil {
+		var enableTypes []string
+		for _, setup := range cluster.Logging.ClusterLogging {
+			if aws.ToBool(setup.Enabled) {
+				for _, logType := range setup.Types {
+					enableTypes = append(enableTypes, string(logType))
+				}
+			}
+		}
+		if len(enableTypes) > 0 {
+			cfg.CloudWatch = &api.ClusterCloudWatch{
+				ClusterLogging: &api.ClusterCloudWatchLogging{
+					EnableTypes: enableTypes,
+				},
+			}
+		}
+	}
+	for _, encryptionConfig := range cluster.EncryptionConfig {
+		if encryptionConfig.Provider != nil && slices.Contains(encryptionConfig.Resources, "secrets") {
+			cfg.SecretsEncryption = &api.SecretsEncryption{
+				KeyARN: aws.ToString(encryptionConfig.Provider.KeyArn),
+			}
+		}
+	}
+}
+
+// exportVPC imports the VPC and subnets of a cluster that was not created by eksctl; subnets that
+// assign public IPs on launch are treated as public subnets.
+func (e *Exporter) exportVPC(ctx context.Context, cfg *api.ClusterConfig, vpcConfig *ekstypes.VpcConfigResponse) error {
+	if vpcConfig == nil {
+		return nil
+	}
+	cfg.VPC = &api.ClusterVPC{
+		Network: api.Network{
+			ID: aws.ToString(vpcConfig.VpcId),
+		},
+		Subnets: &api.ClusterSubnets{
+			Public:  api.NewAZSubnetMapping(),
+			Private: api.NewAZSubnetMapping(),
+		},
+		ControlPlaneSecurityGroupIDs: vpcConfig.SecurityGroupIds,
+		ClusterEndpoints: &api.ClusterEndpoints{
+			PublicAccess:  aws.Bool(vpcConfig.EndpointPublicAccess),
+			PrivateAccess: aws.Bool(vpcConfig.EndpointPrivateAccess),
+		},
+	}
+	if !slices.Equal(vpcConfig.PublicAccessCidrs, []string{"0.0.0.0/0"}) {
+		cfg.VPC.PublicAccessCIDRs = vpcConfig.PublicAccessCidrs
+	}
+	if len(vpcConfig.SubnetIds) == 0 {
+		return nil
+	}
+
+	output, err := e.provider.EC2().DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: vpcConfig.SubnetIds,
+	})
+	if err != nil {
+		return fmt.Errorf("describing cluster subnets: %w", err)
+	}
+	var publicSubnets, privateSubnets []ec2types.Subnet
+	for _, subnet := range output.Subnets {
+		if aws.ToBool(subnet.MapPublicIpOnLaunch) {
+			publicSubnets = append(publicSubnets, subnet)
+		} else {
+			privateSubnets = append(privateSubnets, subnet)
+		}
+	}
+	if err := vpc.ImportSubnets(ctx, e.provider.EC2(), cfg, cfg.VPC.Subnets.Public, publicSubnets, nil); err != nil {
+		return fmt.Errorf("importing public subnets: %w", err)
+	}
+	if err := vpc.ImportSubnets(ctx, e.provider.EC2(), cfg, cfg.VPC.Subnets.Private, privateSubnets, nil); err != nil {
+		return fmt.Errorf("importing private subnets: %w", err)
+	}
+	return nil
+}
+
+func (e *Exporter) exportIdentityProviders(ctx context.Context, cfg *api.ClusterConfig) error {
+	paginator := awseks.NewListIdentityProviderConfigsPaginator(e.provider.EKS(), &awseks.ListIdentityProviderConfigsInput{
+		ClusterName: aws.String(e.clusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing identity providers: %w", err)
+		}
+		for _, idp := range output.IdentityProviderConfigs {
+			if aws.ToString(idp.Type) != string(api.OIDCIdentityProviderType) {
+				logger.Warning("skipping identity provider %q of unsupported type %q", aws.ToString(idp.Name), aws.ToString(idp.Type))
+				continue
+			}
+			described, err := e.provider.EKS().DescribeIdentityProviderConfig(ctx, &awseks.DescribeIdentityProviderConfigInput{
+				ClusterName:            aws.String(e.clusterName),
+				IdentityProviderConfig: &idp,
+			})
+			if err != nil {
+				return fmt.Errorf("describing identity provider %q: %w", aws.ToString(idp.Name), err)
+			}
+			oidc := described.IdentityProviderConfig.Oidc
+			if oidc == nil {
+				continue
+			}
+			cfg.IdentityProviders = append(cfg.IdentityProviders, api.FromIdentityProvider(&api.OIDCIdentityProvider{
+				Name:           aws.ToString(oidc.IdentityProviderConfigName),
+				IssuerURL:      aws.ToString(oidc.IssuerUrl),
+				ClientID:       aws.ToString(oidc.ClientId),
+				UsernameClaim:  aws.ToString(oidc.UsernameClaim),
+				UsernamePrefix: aws.ToString(oidc.UsernamePrefix),
+				GroupsClaim:    aws.ToString(oidc.GroupsClaim),
+				GroupsPrefix:   aws.ToString(oidc.GroupsPrefix),
+				RequiredClaims: oidc.RequiredClaims,
+				Tags:           withoutManagedKeys(oidc.Tags),
+			}))
+		}
+	}
+	return nil
+}
+
+// hasOIDCProvider reports whether an IAM OIDC provider exists for issuerURL.
+func (e *Exporter) hasOIDCProvider(ctx context.Context, issuerURL string) (bool, error) {
+	if issuerURL == "" {
+		return false, nil
+	}
+	output, err := e.provider.IAM().ListOpenIDConnectProviders(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return false, fmt.Errorf("listing IAM OIDC providers: %w", err)
+	}
+	suffix := "/" + strings.TrimPrefix(issuerURL, "https://")
+	for _, provider := range output.OpenIDConnectProviderList {
+		if strings.HasSuffix(aws.ToString(provider.Arn), suffix) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// withoutManagedKeys returns tags or labels without the keys set by eksctl, EKS or AWS, or nil if no keys remain.
+func withoutManagedKeys(values map[string]string) map[string]string {
+	var filtered map[string]string
+	for key, value := range values {
+		if isManagedTag(key) {
+			continue
+		}
+		if filtered == nil {
+			filtered = map[string]string{}
+		}
+		filtered[key] = value
+	}
+	return filtered
+}
+
+func isManagedTag(key string) bool {
+	for _, prefix := range managedTagPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func partition(clusterARN *string) string {
+	parsed, err := arn.Parse(aws.ToString(clusterARN))
+	if err != nil {
+		return api.PartitionAWS
+	}
+	return parsed.Partition
+}
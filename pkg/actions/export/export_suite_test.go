@@ -0,0 +1,22 @@
+package export_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+func init() {
+	if err := api.Register(); err != nil {
+		panic(fmt.Errorf("unexpected error registering API scheme: %w", err))
+	}
+}
+
+func TestExport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Export Suite")
+}
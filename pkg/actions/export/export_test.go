@@ -0,0 +1,432 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/export"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
+)
+
+const (
+	clusterName  = "test-cluster"
+	issuerURL    = "https://oidc.eks.us-west-2.amazonaws.com/id/ABCDEF"
+	addonARN     = "arn:aws:eks:us-west-2:111122223333:addon/test-cluster/vpc-cni/abc"
+	userRoleARN  = "arn:aws:iam::111122223333:role/admin"
+	nodeRoleARN  = "arn:aws:iam::111122223333:role/node"
+	kmsKeyARN    = "arn:aws:kms:us-west-2:111122223333:key/abc"
+	adminPolicy  = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"
+	s3PolicyARN  = "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"
+	podRoleARN   = "arn:aws:iam::111122223333:role/pod"
+	cniRoleARN   = "arn:aws:iam::111122223333:role/cni"
+	fargateRole  = "arn:aws:iam::111122223333:role/fargate"
+	selfNGStack  = "eksctl-test-cluster-nodegroup-ng-1"
+	irsaStack    = "eksctl-test-cluster-addon-iamserviceaccount-kube-system-s3-reader"
+	nodeTemplate = `{
+  "Resources": {
+    "NodeGroup": {
+      "Type": "AWS::AutoScaling::AutoScalingGroup",
+      "Properties": {
+        "DesiredCapacity": "2",
+        "MinSize": "1",
+        "MaxSize": "4",
+        "VPCZoneIdentifier": ["subnet-private-1"],
+        "Tags": [
+          {"Key": "Name", "Value": "test-cluster-ng-1-Node", "PropagateAtLaunch": "true"},
+          {"Key": "kubernetes.io/cluster/test-cluster", "Value": "owned", "PropagateAtLaunch": "true"},
+          {"Key": "team", "Value": "platform", "PropagateAtLaunch": "true"}
+        ]
+      }
+    },
+    "NodeGroupLaunchTemplate": {
+      "Type": "AWS::EC2::LaunchTemplate",
+      "Properties": {
+        "LaunchTemplateData": {
+          "InstanceType": "m5.xlarge",
+          "MetadataOptions": {"HttpPutResponseHopLimit": 2, "HttpTokens": "required"},
+          "BlockDeviceMappings": [{"DeviceName": "/dev/xvda", "Ebs": {"VolumeSize": 100, "VolumeType": "gp3", "Iops": 3000, "Throughput": 125, "Encrypted": true}}]
+        }
+      }
+    },
+    "NodeInstanceRole": {"Type": "AWS::IAM::Role"}
+  }
+}`
+	irsaTemplate = `{
+  "Resources": {
+    "Role1": {
+      "Type": "AWS::IAM::Role",
+      "Properties": {
+        "ManagedPolicyArns": [
+          "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess",
+          {"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/CloudWatchAgentServerPolicy"}
+        ]
+      }
+    },
+    "Policy1": {
+      "Type": "AWS::IAM::Policy",
+      "Properties": {
+        "PolicyDocument": {
+          "Version": "2012-10-17",
+          "Statement": [{"Effect": "Allow", "Action": ["sqs:SendMessage"], "Resource": "*"}]
+        }
+      }
+    }
+  }
+}`
+)
+
+var _ = Describe("Exporter", func() {
+	var (
+		provider     *mockprovider.MockProvider
+		stackManager *fakes.FakeStackManager
+		cluster      *ekstypes.Cluster
+	)
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		stackManager = &fakes.FakeStackManager{}
+		cluster = &ekstypes.Cluster{
+			Name:                 aws.String(clusterName),
+			Arn:                  aws.String("arn:aws:eks:us-west-2:111122223333:cluster/test-cluster"),
+			Version:              aws.String("1.32"),
+			Status:               ekstypes.ClusterStatusActive,
+			Endpoint:             aws.String("https://endpoint.example.com"),
+			CertificateAuthority: &ekstypes.Certificate{Data: aws.String("dGVzdA==")},
+			RoleArn:              aws.String("arn:aws:iam::111122223333:role/cluster"),
+			KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigResponse{
+				ServiceIpv4Cidr: aws.String("10.100.0.0/16"),
+			},
+			ResourcesVpcConfig: &ekstypes.VpcConfigResponse{
+				VpcId:                 aws.String("vpc-1"),
+				SubnetIds:             []string{"subnet-public-1", "subnet-private-1"},
+				SecurityGroupIds:      []string{"sg-1"},
+				EndpointPublicAccess:  true,
+				EndpointPrivateAccess: true,
+				PublicAccessCidrs:     []string{"1.2.3.4/32"},
+			},
+			AccessConfig: &ekstypes.AccessConfigResponse{
+				AuthenticationMode: ekstypes.AuthenticationModeApi,
+			},
+			Logging: &ekstypes.Logging{
+				ClusterLogging: []ekstypes.LogSetup{
+					{Enabled: aws.Bool(true), Types: []ekstypes.LogType{ekstypes.LogTypeApi, ekstypes.LogTypeAudit}},
+					{Enabled: aws.Bool(false), Types: []ekstypes.LogType{ekstypes.LogTypeScheduler}},
+				},
+			},
+			EncryptionConfig: []ekstypes.EncryptionConfig{
+				{Provider: &ekstypes.Provider{KeyArn: aws.String(kmsKeyARN)}, Resources: []string{"secrets"}},
+			},
+			Identity: &ekstypes.Identity{
+				Oidc: &ekstypes.OIDC{Issuer: aws.String(issuerURL)},
+			},
+			Tags: map[string]string{
+				"team":               "platform",
+				api.ClusterNameTag:   clusterName,
+				api.EksctlVersionTag: "0.200.0",
+			},
+		}
+	})
+
+	mockCluster := func() {
+		provider.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+		provider.MockEC2().On("DescribeSubnets", mock.Anything, mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []ec2types.Subnet{
+				{SubnetId: aws.String("subnet-public-1"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2a"), CidrBlock: aws.String("192.168.0.0/19"), MapPublicIpOnLaunch: aws.Bool(true)},
+				{SubnetId: aws.String("subnet-private-1"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2a"), CidrBlock: aws.String("192.168.32.0/19"), MapPublicIpOnLaunch: aws.Bool(false)},
+			},
+		}, nil)
+		provider.MockEC2().On("DescribeVpcs", mock.Anything, mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []ec2types.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("192.168.0.0/16")}},
+		}, nil)
+	}
+
+	// mockEmptyResources mocks all resource listings that have not been mocked before to return no resources
+	mockEmptyResources := func() {
+		provider.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{}, nil)
+		provider.MockEKS().On("ListPodIdentityAssociations", mock.Anything, mock.Anything).Return(&awseks.ListPodIdentityAssociationsOutput{}, nil)
+		provider.MockEKS().On("ListAddons", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListAddonsOutput{}, nil)
+		provider.MockEKS().On("ListAccessEntries", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListAccessEntriesOutput{}, nil)
+		provider.MockEKS().On("ListFargateProfiles", mock.Anything, mock.Anything).Return(&awseks.ListFargateProfilesOutput{}, nil)
+		provider.MockEKS().On("ListIdentityProviderConfigs", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListIdentityProviderConfigsOutput{}, nil)
+		provider.MockIAM().On("ListOpenIDConnectProviders", mock.Anything, mock.Anything).Return(&iam.ListOpenIDConnectProvidersOutput{}, nil)
+	}
+
+	It("exports a cluster that was not created by eksctl", func() {
+		mockCluster()
+		provider.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{
+			Nodegroups: []string{"mng-1"},
+		}, nil)
+		provider.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &ekstypes.Nodegroup{
+				NodegroupName: aws.String("mng-1"),
+				AmiType:       ekstypes.AMITypesAl2023X8664Standard,
+				CapacityType:  ekstypes.CapacityTypesSpot,
+				InstanceTypes: []string{"m5.large", "m5a.large"},
+				Labels:        map[string]string{"role": "worker", api.NodeGroupNameLabel: "mng-1"},
+				Taints:        []ekstypes.Taint{{Key: aws.String("dedicated"), Value: aws.String("gpu"), Effect: ekstypes.TaintEffectNoSchedule}},
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(2), MinSize: aws.Int32(1), MaxSize: aws.Int32(3)},
+				Subnets:       []string{"subnet-private-1"},
+				NodeRole:      aws.String(nodeRoleARN),
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Id:      aws.String("lt-1"),
+					Version: aws.String("2"),
+				},
+			},
+		}, nil)
+		provider.MockEKS().On("ListPodIdentityAssociations", mock.Anything, mock.Anything).Return(&awseks.ListPodIdentityAssociationsOutput{
+			Associations: []ekstypes.PodIdentityAssociationSummary{
+				{AssociationId: aws.String("a-1")},
+				{AssociationId: aws.String("a-2"), OwnerArn: aws.String(addonARN)},
+			},
+		}, nil)
+		provider.MockEKS().On("DescribePodIdentityAssociation", mock.Anything, mock.Anything).Return(
+			func(_ context.Context, input *awseks.DescribePodIdentityAssociationInput, _ ...func(*awseks.Options)) (*awseks.DescribePodIdentityAssociationOutput, error) {
+				association := &ekstypes.PodIdentityAssociation{
+					AssociationArn: aws.String("arn:" + *input.AssociationId),
+					Namespace:      aws.String("default"),
+					ServiceAccount: aws.String("app"),
+					RoleArn:        aws.String(podRoleARN),
+				}
+				if *input.AssociationId == "a-2" {
+					association.Namespace = aws.String("kube-system")
+					association.ServiceAccount = aws.String("aws-node")
+					association.RoleArn = aws.String(cniRoleARN)
+					association.OwnerArn = aws.String(addonARN)
+				}
+				return &awseks.DescribePodIdentityAssociationOutput{Association: association}, nil
+			})
+		provider.MockEKS().On("ListAddons", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListAddonsOutput{
+			Addons: []string{"vpc-cni"},
+		}, nil)
+		provider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Return(&awseks.DescribeAddonOutput{
+			Addon: &ekstypes.Addon{
+				AddonName:           aws.String("vpc-cni"),
+				AddonArn:            aws.String(addonARN),
+				AddonVersion:        aws.String("v1.19.0-eksbuild.1"),
+				ConfigurationValues: aws.String(`{"env":{"ENABLE_PREFIX_DELEGATION":"true"}}`),
+			},
+		}, nil)
+		provider.MockEKS().On("ListAccessEntries", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListAccessEntriesOutput{
+			AccessEntries: []string{userRoleARN, nodeRoleARN},
+		}, nil)
+		provider.MockEKS().On("DescribeAccessEntry", mock.Anything, mock.Anything).Return(
+			func(_ context.Context, input *awseks.DescribeAccessEntryInput, _ ...func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+				entryType := "STANDARD"
+				if *input.PrincipalArn == nodeRoleARN {
+					entryType = "EC2_LINUX"
+				}
+				return &awseks.DescribeAccessEntryOutput{
+					AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn:     input.PrincipalArn,
+						Type:             aws.String(entryType),
+						KubernetesGroups: []string{"admins"},
+						Username:         aws.String("admin"),
+					},
+				}, nil
+			})
+		provider.MockEKS().On("ListAssociatedAccessPolicies", mock.Anything, mock.Anything).Return(&awseks.ListAssociatedAccessPoliciesOutput{
+			AssociatedAccessPolicies: []ekstypes.AssociatedAccessPolicy{
+				{PolicyArn: aws.String(adminPolicy), AccessScope: &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster}},
+			},
+		}, nil)
+		provider.MockEKS().On("ListFargateProfiles", mock.Anything, mock.Anything).Return(&awseks.ListFargateProfilesOutput{
+			FargateProfileNames: []string{"fp-default"},
+		}, nil)
+		provider.MockEKS().On("DescribeFargateProfile", mock.Anything, mock.Anything).Return(&awseks.DescribeFargateProfileOutput{
+			FargateProfile: &ekstypes.FargateProfile{
+				FargateProfileName:  aws.String("fp-default"),
+				PodExecutionRoleArn: aws.String(fargateRole),
+				Selectors:           []ekstypes.FargateProfileSelector{{Namespace: aws.String("serverless")}},
+				Subnets:             []string{"subnet-private-1"},
+				Status:              ekstypes.FargateProfileStatusActive,
+			},
+		}, nil)
+		provider.MockEKS().On("ListIdentityProviderConfigs", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.ListIdentityProviderConfigsOutput{
+			IdentityProviderConfigs: []ekstypes.IdentityProviderConfig{{Name: aws.String("cognito"), Type: aws.String("oidc")}},
+		}, nil)
+		provider.MockEKS().On("DescribeIdentityProviderConfig", mock.Anything, mock.Anything).Return(&awseks.DescribeIdentityProviderConfigOutput{
+			IdentityProviderConfig: &ekstypes.IdentityProviderConfigResponse{
+				Oidc: &ekstypes.OidcIdentityProviderConfig{
+					IdentityProviderConfigName: aws.String("cognito"),
+					IssuerUrl:                  aws.String("https://cognito.example.com"),
+					ClientId:                   aws.String("client"),
+				},
+			},
+		}, nil)
+		provider.MockIAM().On("ListOpenIDConnectProviders", mock.Anything, mock.Anything).Return(&iam.ListOpenIDConnectProvidersOutput{
+			OpenIDConnectProviderList: []iamtypes.OpenIDConnectProviderListEntry{
+				{Arn: aws.String("arn:aws:iam::111122223333:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/ABCDEF")},
+			},
+		}, nil)
+
+		cfg, err := export.NewExporter(clusterName, provider, stackManager).Export(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cfg.Metadata).To(Equal(&api.ClusterMeta{
+			Name:    clusterName,
+			Region:  api.DefaultRegion,
+			Version: "1.32",
+			Tags:    map[string]string{"team": "platform"},
+		}))
+		Expect(cfg.KubernetesNetworkConfig.ServiceIPv4CIDR).To(Equal("10.100.0.0/16"))
+		Expect(cfg.IAM.ServiceRoleARN).To(Equal(aws.String("arn:aws:iam::111122223333:role/cluster")))
+		Expect(cfg.IAM.WithOIDC).To(Equal(aws.Bool(true)))
+		Expect(cfg.CloudWatch.ClusterLogging.EnableTypes).To(ConsistOf("api", "audit"))
+		Expect(cfg.SecretsEncryption.KeyARN).To(Equal(kmsKeyARN))
+		Expect(cfg.AccessConfig.AuthenticationMode).To(Equal(ekstypes.AuthenticationModeApi))
+
+		Expect(cfg.VPC.ID).To(Equal("vpc-1"))
+		Expect(cfg.VPC.CIDR.String()).To(Equal("192.168.0.0/16"))
+		Expect(cfg.VPC.Subnets.Public).To(HaveKeyWithValue("us-west-2a", api.AZSubnetSpec{ID: "subnet-public-1", AZ: "us-west-2a", CIDR: mustParseCIDR("192.168.0.0/19")}))
+		Expect(cfg.VPC.Subnets.Private).To(HaveKeyWithValue("us-west-2a", api.AZSubnetSpec{ID: "subnet-private-1", AZ: "us-west-2a", CIDR: mustParseCIDR("192.168.32.0/19")}))
+		Expect(cfg.VPC.PublicAccessCIDRs).To(Equal([]string{"1.2.3.4/32"}))
+		Expect(cfg.VPC.ControlPlaneSecurityGroupIDs).To(Equal([]string{"sg-1"}))
+
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		ng := cfg.ManagedNodeGroups[0]
+		Expect(ng.Name).To(Equal("mng-1"))
+		Expect(ng.AMIFamily).To(Equal(api.NodeImageFamilyAmazonLinux2023))
+		Expect(ng.InstanceTypes).To(Equal([]string{"m5.large", "m5a.large"}))
+		Expect(ng.Spot).To(BeTrue())
+		Expect(ng.Labels).To(Equal(map[string]string{"role": "worker"}))
+		Expect(ng.Taints).To(Equal([]api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}))
+		Expect(*ng.DesiredCapacity).To(Equal(2))
+		Expect(ng.LaunchTemplate).To(Equal(&api.LaunchTemplate{ID: "lt-1", Version: aws.String("2")}))
+		Expect(ng.IAM.InstanceRoleARN).To(Equal(nodeRoleARN))
+
+		Expect(cfg.Addons).To(HaveLen(1))
+		Expect(cfg.Addons[0].Version).To(Equal("v1.19.0-eksbuild.1"))
+		Expect(cfg.Addons[0].ConfigurationValues).To(Equal(`{"env":{"ENABLE_PREFIX_DELEGATION":"true"}}`))
+		Expect(*cfg.Addons[0].PodIdentityAssociations).To(Equal([]api.PodIdentityAssociation{
+			{Namespace: "kube-system", ServiceAccountName: "aws-node", RoleARN: cniRoleARN},
+		}))
+		Expect(cfg.IAM.PodIdentityAssociations).To(Equal([]api.PodIdentityAssociation{
+			{Namespace: "default", ServiceAccountName: "app", RoleARN: podRoleARN},
+		}))
+
+		Expect(cfg.AccessConfig.AccessEntries).To(Equal([]api.AccessEntry{
+			{
+				PrincipalARN:       api.MustParseARN(userRoleARN),
+				KubernetesGroups:   []string{"admins"},
+				KubernetesUsername: "admin",
+				AccessPolicies: []api.AccessPolicy{
+					{PolicyARN: api.MustParseARN(adminPolicy), AccessScope: api.AccessScope{Type: ekstypes.AccessScopeTypeCluster}},
+				},
+			},
+		}))
+		Expect(cfg.FargateProfiles).To(Equal([]*api.FargateProfile{
+			{
+				Name:                "fp-default",
+				PodExecutionRoleARN: fargateRole,
+				Selectors:           []api.FargateProfileSelector{{Namespace: "serverless"}},
+				Subnets:             []string{"subnet-private-1"},
+			},
+		}))
+		Expect(cfg.IdentityProviders).To(HaveLen(1))
+		Expect(cfg.IdentityProviders[0].Inner).To(Equal(&api.OIDCIdentityProvider{
+			Name:      "cognito",
+			IssuerURL: "https://cognito.example.com",
+			ClientID:  "client",
+		}))
+
+		By("producing a config that can be loaded again")
+		var out bytes.Buffer
+		Expect(printers.NewYAMLPrinter().PrintObj(cfg, &out)).To(Succeed())
+		loaded, err := eks.ParseConfig(out.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.ManagedNodeGroups[0].Name).To(Equal("mng-1"))
+		Expect(loaded.AccessConfig.AccessEntries).To(HaveLen(1))
+	})
+
+	It("exports self-managed nodegroups and IAM service accounts from their stack templates", func() {
+		mockCluster()
+		mockEmptyResources()
+		stackManager.ListNodeGroupStacksWithStatusesReturns([]manager.NodeGroupStack{
+			{
+				NodeGroupName: "ng-1",
+				Type:          api.NodeGroupTypeUnmanaged,
+				Stack: &manager.Stack{
+					StackName: aws.String(selfNGStack),
+					Outputs: []cfntypes.Output{
+						{OutputKey: aws.String("InstanceRoleARN"), OutputValue: aws.String(nodeRoleARN)},
+					},
+				},
+			},
+		}, nil)
+		stackManager.DescribeIAMServiceAccountStacksReturns([]*manager.Stack{
+			{
+				StackName: aws.String(irsaStack),
+				Tags: []cfntypes.Tag{
+					{Key: aws.String(api.IAMServiceAccountNameTag), Value: aws.String("kube-system/s3-reader")},
+				},
+			},
+		}, nil)
+		stackManager.GetStackTemplateStub = func(_ context.Context, stackName string) (string, error) {
+			switch stackName {
+			case selfNGStack:
+				return nodeTemplate, nil
+			case irsaStack:
+				return irsaTemplate, nil
+			}
+			return "", fmt.Errorf("unexpected stack %q", stackName)
+		}
+
+		cfg, err := export.NewExporter(clusterName, provider, stackManager).Export(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cfg.NodeGroups).To(HaveLen(1))
+		ng := cfg.NodeGroups[0]
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.InstanceType).To(Equal("m5.xlarge"))
+		Expect(ng.ScalingConfig).To(Equal(&api.ScalingConfig{DesiredCapacity: aws.Int(2), MinSize: aws.Int(1), MaxSize: aws.Int(4)}))
+		Expect(ng.Subnets).To(Equal([]string{"subnet-private-1"}))
+		Expect(ng.Tags).To(Equal(map[string]string{"team": "platform"}))
+		Expect(ng.VolumeSize).To(Equal(aws.Int(100)))
+		Expect(ng.VolumeType).To(Equal(aws.String("gp3")))
+		Expect(ng.VolumeIOPS).To(Equal(aws.Int(3000)))
+		Expect(ng.VolumeEncrypted).To(Equal(aws.Bool(true)))
+		Expect(ng.DisableIMDSv1).To(Equal(aws.Bool(true)))
+		Expect(ng.IAM).To(BeNil())
+
+		Expect(cfg.IAM.ServiceAccounts).To(HaveLen(1))
+		serviceAccount := cfg.IAM.ServiceAccounts[0]
+		Expect(serviceAccount.NameString()).To(Equal("kube-system/s3-reader"))
+		Expect(serviceAccount.AttachPolicyARNs).To(Equal([]string{s3PolicyARN, "arn:aws:iam::aws:policy/CloudWatchAgentServerPolicy"}))
+		Expect(serviceAccount.AttachPolicy).To(HaveKeyWithValue("Statement", HaveLen(1)))
+		Expect(cfg.IAM.WithOIDC).To(Equal(aws.Bool(true)))
+	})
+
+	It("does not export clusters that are not active", func() {
+		cluster.Status = ekstypes.ClusterStatusCreating
+		mockCluster()
+
+		_, err := export.NewExporter(clusterName, provider, stackManager).Export(context.Background())
+		Expect(err).To(MatchError(`cannot export cluster "test-cluster" in status "CREATING"`))
+	})
+})
+
+func mustParseCIDR(cidr string) *ipnet.IPNet {
+	ipNet, err := ipnet.ParseCIDR(cidr)
+	Expect(err).NotTo(HaveOccurred())
+	return ipNet
+}
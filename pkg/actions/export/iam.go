@@ -0,0 +1,190 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/tidwall/gjson"
+
+	"github.com/weaveworks/eksctl/pkg/actions/podidentityassociation"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+)
+
+// exportAddonsAndPodIdentityAssociations exports addons along with the pod identity associations they own;
+// all other pod identity associations are exported under iam.podIdentityAssociations.
+func (e *Exporter) exportAddonsAndPodIdentityAssociations(ctx context.Context, cfg *api.ClusterConfig) error {
+	summaries, err := podidentityassociation.NewGetter(e.clusterName, e.provider.EKS()).GetPodIdentityAssociations(ctx, "", "")
+	if err != nil {
+		return err
+	}
+	addonAssociations := map[string][]api.PodIdentityAssociation{}
+	for _, s := range summaries {
+		pia := api.PodIdentityAssociation{
+			Namespace:          s.Namespace,
+			ServiceAccountName: s.ServiceAccountName,
+			RoleARN:            s.RoleARN,
+		}
+		if s.OwnerARN != "" {
+			addonAssociations[s.OwnerARN] = append(addonAssociations[s.OwnerARN], pia)
+			continue
+		}
+		cfg.IAM.PodIdentityAssociations = append(cfg.IAM.PodIdentityAssociations, pia)
+	}
+
+	paginator := awseks.NewListAddonsPaginator(e.provider.EKS(), &awseks.ListAddonsInput{
+		ClusterName: aws.String(e.clusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing addons: %w", err)
+		}
+		for _, name := range output.Addons {
+			described, err := e.provider.EKS().DescribeAddon(ctx, &awseks.DescribeAddonInput{
+				ClusterName: aws.String(e.clusterName),
+				AddonName:   aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("describing addon %q: %w", name, err)
+			}
+			addon := &api.Addon{
+				Name:                  name,
+				Version:               aws.ToString(described.Addon.AddonVersion),
+				ServiceAccountRoleARN: aws.ToString(described.Addon.ServiceAccountRoleArn),
+				ConfigurationValues:   aws.ToString(described.Addon.ConfigurationValues),
+				Tags:                  withoutManagedKeys(described.Addon.Tags),
+			}
+			if associations, ok := addonAssociations[aws.ToString(described.Addon.AddonArn)]; ok {
+				addon.PodIdentityAssociations = &associations
+			}
+			cfg.Addons = append(cfg.Addons, addon)
+		}
+	}
+	return nil
+}
+
+// exportAccessEntries exports standard access entries; entries for node roles are created by EKS and eksctl
+// along with their nodegroups and Fargate profiles.
+func (e *Exporter) exportAccessEntries(ctx context.Context, cfg *api.ClusterConfig) error {
+	paginator := awseks.NewListAccessEntriesPaginator(e.provider.EKS(), &awseks.ListAccessEntriesInput{
+		ClusterName: aws.String(e.clusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing access entries: %w", err)
+		}
+		for _, principalARN := range output.AccessEntries {
+			described, err := e.provider.EKS().DescribeAccessEntry(ctx, &awseks.DescribeAccessEntryInput{
+				ClusterName:  aws.String(e.clusterName),
+				PrincipalArn: aws.String(principalARN),
+			})
+			if err != nil {
+				return fmt.Errorf("describing access entry %q: %w", principalARN, err)
+			}
+			if entryType := aws.ToString(described.AccessEntry.Type); entryType != "" && entryType != string(api.AccessEntryTypeStandard) {
+				continue
+			}
+
+			accessEntry := api.AccessEntry{
+				PrincipalARN:       api.MustParseARN(principalARN),
+				KubernetesGroups:   described.AccessEntry.KubernetesGroups,
+				KubernetesUsername: aws.ToString(described.AccessEntry.Username),
+			}
+			policies, err := e.provider.EKS().ListAssociatedAccessPolicies(ctx, &awseks.ListAssociatedAccessPoliciesInput{
+				ClusterName:  aws.String(e.clusterName),
+				PrincipalArn: aws.String(principalARN),
+			})
+			if err != nil {
+				return fmt.Errorf("listing access policies for access entry %q: %w", principalARN, err)
+			}
+			for _, policy := range policies.AssociatedAccessPolicies {
+				accessEntry.AccessPolicies = append(accessEntry.AccessPolicies, exportAccessPolicy(policy))
+			}
+
+			if cfg.AccessConfig == nil {
+				cfg.AccessConfig = &api.AccessConfig{}
+			}
+			cfg.AccessConfig.AccessEntries = append(cfg.AccessConfig.AccessEntries, accessEntry)
+		}
+	}
+	return nil
+}
+
+func exportAccessPolicy(policy ekstypes.AssociatedAccessPolicy) api.AccessPolicy {
+	accessPolicy := api.AccessPolicy{
+		PolicyARN: api.MustParseARN(aws.ToString(policy.PolicyArn)),
+	}
+	if policy.AccessScope != nil {
+		accessPolicy.AccessScope = api.AccessScope{
+			Type:       policy.AccessScope.Type,
+			Namespaces: policy.AccessScope.Namespaces,
+		}
+	}
+	return accessPolicy
+}
+
+// exportServiceAccounts exports IAM service accounts from the role in their stack templates.
+func (e *Exporter) exportServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, partition string) error {
+	stacks, err := e.stackManager.DescribeIAMServiceAccountStacks(ctx)
+	if err != nil {
+		return fmt.Errorf("describing IAM service account stacks: %w", err)
+	}
+	for _, stack := range stacks {
+		meta, err := api.ClusterIAMServiceAccountNameStringToClusterIAMMeta(manager.GetIAMServiceAccountName(stack))
+		if err != nil {
+			return err
+		}
+		template, err := e.getStackTemplate(ctx, stack)
+		if err != nil {
+			return err
+		}
+		resources := template.Get("Resources")
+		role := resources.Get(outputs.IAMServiceAccountRoleName + ".Properties")
+
+		serviceAccount := &api.ClusterIAMServiceAccount{
+			ClusterIAMMeta:      *meta,
+			RoleName:            stringValue(role.Get("RoleName")),
+			PermissionsBoundary: stringValue(role.Get("PermissionsBoundary")),
+		}
+		for _, policyARN := range role.Get("ManagedPolicyArns").Array() {
+			if policyARN.Type != gjson.String {
+				policyARN = policyARN.Get("Fn::Sub")
+			}
+			if policyARN.Type == gjson.String {
+				serviceAccount.AttachPolicyARNs = append(serviceAccount.AttachPolicyARNs, strings.ReplaceAll(policyARN.String(), "${AWS::Partition}", partition))
+			}
+		}
+
+		var statements []interface{}
+		resources.ForEach(func(_, resource gjson.Result) bool {
+			if resource.Get("Type").String() == "AWS::IAM::Policy" {
+				for _, statement := range resource.Get("Properties.PolicyDocument.Statement").Array() {
+					statements = append(statements, statement.Value())
+				}
+			}
+			return true
+		})
+		if len(statements) > 0 {
+			serviceAccount.AttachPolicy = api.InlineDocument{
+				"Version":   "2012-10-17",
+				"Statement": statements,
+			}
+		}
+		cfg.IAM.ServiceAccounts = append(cfg.IAM.ServiceAccounts, serviceAccount)
+	}
+	return nil
+}
+
+func stringValue(v gjson.Result) string {
+	if v.Type != gjson.String {
+		return ""
+	}
+	return v.String()
+}
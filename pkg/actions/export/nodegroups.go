@@ -0,0 +1,316 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+)
+
+// amiFamilies maps AMI type prefixes to AMI families; more specific prefixes come first.
+var amiFamilies = []struct {
+	prefix, suffix string
+	family         string
+}{
+	{prefix: "AL2023_", family: api.NodeImageFamilyAmazonLinux2023},
+	{prefix: "AL2_", family: api.NodeImageFamilyAmazonLinux2},
+	{prefix: "BOTTLEROCKET_", suffix: "_FIPS", family: api.NodeImageFamilyBottlerocketFips},
+	{prefix: "BOTTLEROCKET_", family: api.NodeImageFamilyBottlerocket},
+	{prefix: "WINDOWS_CORE_2019_", family: api.NodeImageFamilyWindowsServer2019CoreContainer},
+	{prefix: "WINDOWS_FULL_2019_", family: api.NodeImageFamilyWindowsServer2019FullContainer},
+	{prefix: "WINDOWS_CORE_2022_", family: api.NodeImageFamilyWindowsServer2022CoreContainer},
+	{prefix: "WINDOWS_FULL_2022_", family: api.NodeImageFamilyWindowsServer2022FullContainer},
+	{prefix: "WINDOWS_CORE_2025_", family: api.NodeImageFamilyWindowsServer2025CoreContainer},
+	{prefix: "WINDOWS_FULL_2025_", family: api.NodeImageFamilyWindowsServer2025FullContainer},
+}
+
+var taintEffects = map[ekstypes.TaintEffect]corev1.TaintEffect{
+	ekstypes.TaintEffectNoSchedule:       corev1.TaintEffectNoSchedule,
+	ekstypes.TaintEffectNoExecute:        corev1.TaintEffectNoExecute,
+	ekstypes.TaintEffectPreferNoSchedule: corev1.TaintEffectPreferNoSchedule,
+}
+
+// exportNodeGroups exports self-managed nodegroups from their stack templates, and managed nodegroups
+// from the EKS API, using the stack template of those created by eksctl for their launch template.
+func (e *Exporter) exportNodeGroups(ctx context.Context, cfg *api.ClusterConfig) error {
+	stacks, err := e.stackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("listing nodegroup stacks: %w", err)
+	}
+	managedStacks := map[string]manager.NodeGroupStack{}
+	for _, stack := range stacks {
+		switch stack.Type {
+		case api.NodeGroupTypeManaged:
+			managedStacks[stack.NodeGroupName] = stack
+		case api.NodeGroupTypeUnmanaged:
+			ng, err := e.exportNodeGroup(ctx, stack)
+			if err != nil {
+				return err
+			}
+			cfg.NodeGroups = append(cfg.NodeGroups, ng)
+		}
+	}
+
+	paginator := awseks.NewListNodegroupsPaginator(e.provider.EKS(), &awseks.ListNodegroupsInput{
+		ClusterName: aws.String(e.clusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing managed nodegroups: %w", err)
+		}
+		for _, name := range output.Nodegroups {
+			described, err := e.provider.EKS().DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+				ClusterName:   aws.String(e.clusterName),
+				NodegroupName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("describing managed nodegroup %q: %w", name, err)
+			}
+			var template gjson.Result
+			if stack, ok := managedStacks[name]; ok {
+				if template, err = e.getStackTemplate(ctx, stack.Stack); err != nil {
+					return err
+				}
+			}
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, exportManagedNodeGroup(described.Nodegroup, template))
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) getStackTemplate(ctx context.Context, stack *manager.Stack) (gjson.Result, error) {
+	stackName := aws.ToString(stack.StackName)
+	template, err := e.stackManager.GetStackTemplate(ctx, stackName)
+	if err != nil {
+		return gjson.Result{}, fmt.Errorf("getting template for stack %q: %w", stackName, err)
+	}
+	return gjson.Parse(template), nil
+}
+
+// exportNodeGroup reconstructs a self-managed nodegroup from the auto scaling group and launch template in its stack template.
+// Labels, taints and bootstrap settings are part of the user data, and are not exported.
+func (e *Exporter) exportNodeGroup(ctx context.Context, stack manager.NodeGroupStack) (*api.NodeGroup, error) {
+	template, err := e.getStackTemplate(ctx, stack.Stack)
+	if err != nil {
+		return nil, err
+	}
+	resources := template.Get("Resources")
+	asg := resources.Get("NodeGroup.Properties")
+
+	ng := &api.NodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name: stack.NodeGroupName,
+			ScalingConfig: &api.ScalingConfig{
+				DesiredCapacity: intValue(asg.Get("DesiredCapacity")),
+				MinSize:         intValue(asg.Get("MinSize")),
+				MaxSize:         intValue(asg.Get("MaxSize")),
+			},
+			Subnets: stringValues(asg.Get("VPCZoneIdentifier")),
+		},
+		ClassicLoadBalancerNames: stringValues(asg.Get("LoadBalancerNames")),
+		TargetGroupARNs:          stringValues(asg.Get("TargetGroupARNs")),
+		MaxInstanceLifetime:      intValue(asg.Get("MaxInstanceLifetime")),
+	}
+	applyLaunchTemplateData(ng.NodeGroupBase, resources.Get("NodeGroupLaunchTemplate.Properties.LaunchTemplateData"))
+
+	asg.Get("Tags").ForEach(func(_, tag gjson.Result) bool {
+		key := tag.Get("Key").String()
+		if key == "Name" || isManagedTag(key) || tag.Get("Value").Type != gjson.String {
+			return true
+		}
+		if ng.Tags == nil {
+			ng.Tags = map[string]string{}
+		}
+		ng.Tags[key] = tag.Get("Value").String()
+		return true
+	})
+
+	if policy := asg.Get("MixedInstancesPolicy"); policy.Exists() {
+		distribution := policy.Get("InstancesDistribution")
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes:                       stringValues(policy.Get("LaunchTemplate.Overrides.#.InstanceType")),
+			OnDemandBaseCapacity:                intValue(distribution.Get("OnDemandBaseCapacity")),
+			OnDemandPercentageAboveBaseCapacity: intValue(distribution.Get("OnDemandPercentageAboveBaseCapacity")),
+			SpotInstancePools:                   intValue(distribution.Get("SpotInstancePools")),
+			CapacityRebalance:                   asg.Get("CapacityRebalance").Bool(),
+		}
+		if v := distribution.Get("SpotAllocationStrategy"); v.Type == gjson.String {
+			ng.InstancesDistribution.SpotAllocationStrategy = aws.String(v.String())
+		}
+		if v := distribution.Get("SpotMaxPrice"); v.Exists() {
+			ng.InstancesDistribution.MaxPrice = aws.Float64(v.Float())
+		}
+	}
+
+	// the instance role is only exported if it was not created by eksctl
+	if !resources.Get("NodeInstanceRole").Exists() {
+		for _, output := range stack.Stack.Outputs {
+			if aws.ToString(output.OutputKey) == outputs.NodeGroupInstanceRoleARN {
+				ng.IAM = &api.NodeGroupIAM{
+					InstanceRoleARN: aws.ToString(output.OutputValue),
+				}
+			}
+		}
+	}
+	return ng, nil
+}
+
+// exportManagedNodeGroup reconstructs a managed nodegroup; template is the stack template of nodegroups created by eksctl.
+func exportManagedNodeGroup(nodegroup *ekstypes.Nodegroup, template gjson.Result) *api.ManagedNodeGroup {
+	ng := &api.ManagedNodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name:      aws.ToString(nodegroup.NodegroupName),
+			AMIFamily: amiFamily(nodegroup.AmiType),
+			Labels:    withoutManagedKeys(nodegroup.Labels),
+			Tags:      withoutManagedKeys(nodegroup.Tags),
+			Subnets:   nodegroup.Subnets,
+		},
+		Spot: nodegroup.CapacityType == ekstypes.CapacityTypesSpot,
+	}
+	if len(nodegroup.InstanceTypes) == 1 {
+		ng.InstanceType = nodegroup.InstanceTypes[0]
+	} else {
+		ng.InstanceTypes = nodegroup.InstanceTypes
+	}
+	if scaling := nodegroup.ScalingConfig; scaling != nil {
+		ng.ScalingConfig = &api.ScalingConfig{
+			DesiredCapacity: int32Value(scaling.DesiredSize),
+			MinSize:         int32Value(scaling.MinSize),
+			MaxSize:         int32Value(scaling.MaxSize),
+		}
+	}
+	if nodegroup.DiskSize != nil {
+		ng.VolumeSize = int32Value(nodegroup.DiskSize)
+	}
+	if remoteAccess := nodegroup.RemoteAccess; remoteAccess != nil && remoteAccess.Ec2SshKey != nil {
+		ng.SSH = &api.NodeGroupSSH{
+			Allow:                  aws.Bool(true),
+			PublicKeyName:          remoteAccess.Ec2SshKey,
+			SourceSecurityGroupIDs: remoteAccess.SourceSecurityGroups,
+		}
+	}
+	for _, taint := range nodegroup.Taints {
+		ng.Taints = append(ng.Taints, api.NodeGroupTaint{
+			Key:    aws.ToString(taint.Key),
+			Value:  aws.ToString(taint.Value),
+			Effect: taintEffects[taint.Effect],
+		})
+	}
+	if updateConfig := nodegroup.UpdateConfig; updateConfig != nil {
+		ng.UpdateConfig = &api.NodeGroupUpdateConfig{
+			MaxUnavailable:           int32Value(updateConfig.MaxUnavailable),
+			MaxUnavailablePercentage: int32Value(updateConfig.MaxUnavailablePercentage),
+		}
+	}
+	if repairConfig := nodegroup.NodeRepairConfig; repairConfig != nil && repairConfig.Enabled != nil {
+		ng.NodeRepairConfig = &api.NodeGroupNodeRepairConfig{
+			Enabled: repairConfig.Enabled,
+		}
+	}
+
+	resources := template.Get("Resources")
+	// launch templates created by eksctl are exported as nodegroup fields, all others are referenced as is
+	if launchTemplateData := resources.Get("LaunchTemplate.Properties.LaunchTemplateData"); launchTemplateData.Exists() {
+		applyLaunchTemplateData(ng.NodeGroupBase, launchTemplateData)
+		if nodegroup.AmiType == ekstypes.AMITypesCustom {
+			if imageID := launchTemplateData.Get("ImageId"); imageID.Type == gjson.String {
+				ng.AMI = imageID.String()
+			}
+		}
+	} else if launchTemplate := nodegroup.LaunchTemplate; launchTemplate != nil {
+		ng.LaunchTemplate = &api.LaunchTemplate{
+			ID:      aws.ToString(launchTemplate.Id),
+			Version: launchTemplate.Version,
+		}
+	}
+	if !resources.Get("NodeInstanceRole").Exists() {
+		ng.IAM = &api.NodeGroupIAM{
+			InstanceRoleARN: aws.ToString(nodegroup.NodeRole),
+		}
+	}
+	return ng
+}
+
+// applyLaunchTemplateData sets the nodegroup fields that eksctl maps to launch template data.
+func applyLaunchTemplateData(ng *api.NodeGroupBase, data gjson.Result) {
+	if v := data.Get("InstanceType"); v.Type == gjson.String {
+		ng.InstanceType = v.String()
+	}
+	if v := data.Get("KeyName"); v.Type == gjson.String {
+		ng.SSH = &api.NodeGroupSSH{
+			Allow:         aws.Bool(true),
+			PublicKeyName: aws.String(v.String()),
+		}
+	}
+	if data.Get("MetadataOptions.HttpTokens").String() == "required" {
+		ng.DisableIMDSv1 = aws.Bool(true)
+	}
+	if v := data.Get("Monitoring.Enabled"); v.Exists() {
+		ng.EnableDetailedMonitoring = aws.Bool(v.Bool())
+	}
+	if v := data.Get("EbsOptimized"); v.Exists() {
+		ng.EBSOptimized = aws.Bool(v.Bool())
+	}
+
+	ebs := data.Get("BlockDeviceMappings.0.Ebs")
+	if !ebs.Exists() {
+		return
+	}
+	ng.VolumeSize = intValue(ebs.Get("VolumeSize"))
+	ng.VolumeIOPS = intValue(ebs.Get("Iops"))
+	ng.VolumeThroughput = intValue(ebs.Get("Throughput"))
+	if v := ebs.Get("VolumeType"); v.Type == gjson.String {
+		ng.VolumeType = aws.String(v.String())
+	}
+	if v := ebs.Get("Encrypted"); v.Exists() {
+		ng.VolumeEncrypted = aws.Bool(v.Bool())
+	}
+	if v := ebs.Get("KmsKeyId"); v.Type == gjson.String {
+		ng.VolumeKmsKeyID = aws.String(v.String())
+	}
+}
+
+func amiFamily(amiType ekstypes.AMITypes) string {
+	for _, f := range amiFamilies {
+		if strings.HasPrefix(string(amiType), f.prefix) && strings.HasSuffix(string(amiType), f.suffix) {
+			return f.family
+		}
+	}
+	return ""
+}
+
+// intValue returns the integer value of v, which CloudFormation templates may hold as a number or a string.
+func intValue(v gjson.Result) *int {
+	if v.Type != gjson.Number && v.Type != gjson.String {
+		return nil
+	}
+	return aws.Int(int(v.Int()))
+}
+
+func int32Value(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	return aws.Int(int(*v))
+}
+
+// stringValues returns the literal strings in the array v; intrinsic functions are skipped.
+func stringValues(v gjson.Result) []string {
+	var values []string
+	for _, item := range v.Array() {
+		if item.Type == gjson.String {
+			values = append(values, item.String())
+		}
+	}
+	return values
+}
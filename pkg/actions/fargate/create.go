@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kris-nova/logger"
+
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -50,7 +52,7 @@ func (m *Manager) Create(ctx context.Context) error {
 			}
 		}
 
-		if !api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
+		if !m.ExportTemplates && !api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
 			// Read back the default Fargate pod execution role ARN from CloudFormation:
 			if err := m.stackManager.RefreshFargatePodExecutionRoleARN(ctx); err != nil {
 				return fmt.Errorf("couldn't refresh role arn: %w", err)
@@ -58,6 +60,11 @@ func (m *Manager) Create(ctx context.Context) error {
 		}
 	}
 
+	if m.ExportTemplates {
+		logger.Info("not creating Fargate profiles, only the template of the Fargate stack is exported")
+		return nil
+	}
+
 	fargateClient := fargate.NewFromProvider(cfg.Metadata.Name, ctl.AWSProvider, m.stackManager)
 	if err := eks.DoCreateFargateProfiles(ctx, cfg, &fargateClient); err != nil {
 		return fmt.Errorf("could not create fargate profiles: %w", err)
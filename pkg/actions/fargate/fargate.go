@@ -13,6 +13,8 @@ type Manager struct {
 	cfg             *api.ClusterConfig
 	stackManager    manager.StackManager
 	newStdClientSet func() (kubernetes.Interface, error)
+	// ExportTemplates makes Create stop after the Fargate stack, which is expected to export its template
+	ExportTemplates bool
 }
 
 func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager) *Manager {
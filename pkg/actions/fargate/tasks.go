@@ -52,6 +52,7 @@ func ensureFargateRoleStackExists(ctx context.Context, cfg *api.ClusterConfig, p
 			stackManager: stackManager,
 		})
 
+		taskTree.Context = ctx
 		errs := taskTree.DoAllSync()
 		for _, e := range errs {
 			logger.Critical("%s\n", e.Error())
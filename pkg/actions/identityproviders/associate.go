@@ -57,6 +57,7 @@ func (m *Manager) Associate(ctx context.Context, options AssociateIdentityProvid
 		}
 	}
 
+	taskTree.Context = ctx
 	errs := taskTree.DoAllSync()
 	for _, err := range errs {
 		logger.Critical(err.Error())
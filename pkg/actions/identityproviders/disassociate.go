@@ -79,6 +79,7 @@ func (m *Manager) Disassociate(ctx context.Context, options DisassociateIdentity
 		})
 	}
 
+	taskTree.Context = ctx
 	errs := taskTree.DoAllSync()
 	for _, err := range errs {
 		logger.Critical(err.Error())
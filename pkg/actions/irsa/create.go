@@ -1,17 +1,29 @@
 package irsa
 
 import (
+	"context"
+
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
-func (m *Manager) CreateIAMServiceAccount(iamServiceAccounts []*api.ClusterIAMServiceAccount, plan bool) error {
-	taskTree := m.stackManager.NewTasksToCreateIAMServiceAccounts(iamServiceAccounts, m.oidcManager, kubernetes.NewCachedClientSet(m.clientSet))
+func (m *Manager) CreateIAMServiceAccount(ctx context.Context, iamServiceAccounts []*api.ClusterIAMServiceAccount, plan bool) error {
+	taskTree := m.stackManager.NewTasksToCreateIAMServiceAccounts(ctx, iamServiceAccounts, m.oidcManager, kubernetes.NewCachedClientSet(m.clientSet))
 	taskTree.PlanMode = plan
 
-	err := doTasks(taskTree, actionCreate)
+	err := doTasks(ctx, taskTree, actionCreate)
 
 	logPlanModeWarning(plan && len(iamServiceAccounts) > 0)
 
 	return err
 }
+
+// ExportIAMServiceAccountTemplates only runs the tasks that create the IAM role stacks of iamServiceAccounts,
+// for a stack manager that exports templates instead of creating stacks
+func (m *Manager) ExportIAMServiceAccountTemplates(ctx context.Context, iamServiceAccounts []*api.ClusterIAMServiceAccount) error {
+	taskTree := m.stackManager.NewTasksToCreateIAMServiceAccounts(ctx, iamServiceAccounts, m.oidcManager, kubernetes.NewCachedClientSet(m.clientSet))
+	return doTasks(ctx, taskTree.Select(func(md tasks.Metadata) bool {
+		return md.Action == "create" && md.StackName != ""
+	}), actionCreate)
+}
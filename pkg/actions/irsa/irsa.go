@@ -1,6 +1,7 @@
 package irsa
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -34,7 +35,8 @@ func New(clusterName string, stackManager manager.StackManager, oidcManager *iam
 	}
 }
 
-func doTasks(taskTree *tasks.TaskTree, action action) error {
+func doTasks(ctx context.Context, taskTree *tasks.TaskTree, action action) error {
+	taskTree.Context = ctx
 	logger.Info(taskTree.Describe())
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		logger.Info("%d error(s) occurred and IAM Role stacks haven't been %sd properly, you may wish to check CloudFormation console", len(errs), action)
@@ -13,7 +13,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
-func NewUpdateIAMServiceAccountTask(clusterName string, sa *api.ClusterIAMServiceAccount, stackManager manager.StackManager, oidcManager *iamoidc.OpenIDConnectManager) (*tasks.TaskTree, error) {
+func NewUpdateIAMServiceAccountTask(clusterName string, sa *api.ClusterIAMServiceAccount, stackManager manager.StackManager, oidcManager *iamoidc.OpenIDConnectManager, plan bool) (*tasks.TaskTree, error) {
 	rs := builder.NewIAMRoleResourceSetForServiceAccount(sa, oidcManager)
 	err := rs.AddAllResources()
 	if err != nil {
@@ -36,6 +36,7 @@ func NewUpdateIAMServiceAccountTask(clusterName string, sa *api.ClusterIAMServic
 			templateData: templateData,
 			sa:           sa,
 			clusterName:  clusterName,
+			plan:         plan,
 		},
 	)
 	return taskTree, nil
@@ -47,22 +48,29 @@ type updateIAMServiceAccountTask struct {
 	templateData manager.TemplateData
 	clusterName  string
 	info         string
+	plan         bool
 }
 
 func (t *updateIAMServiceAccountTask) Describe() string { return t.info }
 
 func (t *updateIAMServiceAccountTask) Do(errorCh chan error) error {
+	return t.DoWithContext(context.Background(), errorCh)
+}
+
+// DoWithContext updates the stack under ctx, whose run options decide whether a change set is previewed in plan mode.
+func (t *updateIAMServiceAccountTask) DoWithContext(ctx context.Context, errorCh chan error) error {
 	stackName := makeIAMServiceAccountStackName(t.clusterName, t.sa.Namespace, t.sa.Name)
 	go func() {
 		errorCh <- nil
 	}()
 
 	desc := fmt.Sprintf("updating policies for IAMServiceAccount %s/%s", t.sa.Namespace, t.sa.Name)
-	return t.stackManager.UpdateStack(context.TODO(), manager.UpdateStackOptions{
+	return t.stackManager.UpdateStack(ctx, manager.UpdateStackOptions{
 		StackName:     stackName,
 		ChangeSetName: fmt.Sprintf("updating-policy-%s", uuid.NewString()),
 		Description:   desc,
 		TemplateData:  t.templateData,
 		Wait:          true,
+		Plan:          t.plan,
 	})
 }
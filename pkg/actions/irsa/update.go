@@ -46,11 +46,11 @@ func (m *Manager) UpdateIAMServiceAccounts(ctx context.Context, iamServiceAccoun
 			iamServiceAccount.RoleName = roleName
 		}
 
-		taskTree, err := NewUpdateIAMServiceAccountTask(m.clusterName, iamServiceAccount, m.stackManager, m.oidcManager)
+		// in plan mode, the task only creates a change set, to list its changes, if change sets are previewed
+		taskTree, err := NewUpdateIAMServiceAccountTask(m.clusterName, iamServiceAccount, m.stackManager, m.oidcManager, plan)
 		if err != nil {
 			return err
 		}
-		taskTree.PlanMode = plan
 		updateTasks.Append(taskTree)
 	}
 	if len(nonExistingSAs) > 0 {
@@ -58,7 +58,7 @@ func (m *Manager) UpdateIAMServiceAccounts(ctx context.Context, iamServiceAccoun
 	}
 
 	defer logPlanModeWarning(plan && len(iamServiceAccounts) > 0)
-	return doTasks(updateTasks, actionUpdate)
+	return doTasks(ctx, updateTasks, actionUpdate)
 }
 
 // getRoleNameFromStackTemplate returns the role if the initial stack's template contained it.
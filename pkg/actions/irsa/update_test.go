@@ -63,13 +63,14 @@ var _ = Describe("Update", func() {
 			Expect(options.ChangeSetName).To(ContainSubstring("updating-policy"))
 			Expect(options.Description).To(Equal("updating policies for IAMServiceAccount default/test-sa"))
 			Expect(options.Wait).To(BeTrue())
+			Expect(options.Plan).To(BeFalse())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(string(options.TemplateData.(manager.TemplateBody))).To(ContainSubstring("arn-123"))
 			Expect(string(options.TemplateData.(manager.TemplateBody))).To(ContainSubstring(":sub\":\"system:serviceaccount:default:test-sa"))
 		})
 
 		When("in plan mode", func() {
-			It("does not trigger an update", func() {
+			It("previews the update without executing it", func() {
 				stacks := []*types.Stack{
 					{
 						StackName: aws.String("eksctl-my-cluster-addon-iamserviceaccount-default-test-sa"),
@@ -78,7 +79,10 @@ var _ = Describe("Update", func() {
 				err := irsaManager.UpdateIAMServiceAccounts(context.Background(), serviceAccount, stacks, true)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+				Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+				_, options := fakeStackManager.UpdateStackArgsForCall(0)
+				Expect(options.StackName).To(Equal("eksctl-my-cluster-addon-iamserviceaccount-default-test-sa"))
+				Expect(options.Plan).To(BeTrue())
 			})
 		})
 
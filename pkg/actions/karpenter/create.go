@@ -40,7 +40,7 @@ func (i *Installer) Create(ctx context.Context) error {
 
 	// Create IAM roles
 	taskTree := newTasksToInstallKarpenterIAMRoles(ctx, i.Config, i.StackManager, i.CTL.AWSProvider.EC2(), instanceProfileName)
-	if err := doTasks(taskTree); err != nil {
+	if err := doTasks(ctx, taskTree); err != nil {
 		return err
 	}
 
@@ -68,9 +68,9 @@ func (i *Installer) Create(ctx context.Context) error {
 	} else {
 		logger.Info("karpenter.createServiceAccount=false: eksctl will create both the IAM role and the %q service account in namespace %q", karpenter.DefaultServiceAccountName, karpenter.DefaultNamespace)
 	}
-	karpenterServiceAccountTaskTree := i.StackManager.NewTasksToCreateIAMServiceAccounts([]*api.ClusterIAMServiceAccount{iamServiceAccount}, i.OIDC, clientSetGetter)
+	karpenterServiceAccountTaskTree := i.StackManager.NewTasksToCreateIAMServiceAccounts(ctx, []*api.ClusterIAMServiceAccount{iamServiceAccount}, i.OIDC, clientSetGetter)
 	logger.Info(karpenterServiceAccountTaskTree.Describe())
-	if err := doTasks(karpenterServiceAccountTaskTree); err != nil {
+	if err := doTasks(ctx, karpenterServiceAccountTaskTree); err != nil {
 		return fmt.Errorf("failed to create/attach service account: %w", err)
 	}
 
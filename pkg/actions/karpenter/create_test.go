@@ -73,6 +73,7 @@ var _ = Describe("Create", func() {
 				Version: "0.4.3",
 			}
 			fakeStackManager = &managerfakes.FakeStackManager{}
+			fakeStackManager.NewTasksToCreateIAMServiceAccountsReturns(&tasks.TaskTree{})
 			fakeKarpenterInstaller = &karpenterfakes.FakeChartInstaller{}
 			ctl = &eks.ClusterProvider{
 				AWSProvider: p,
@@ -311,7 +312,7 @@ var _ = Describe("Create", func() {
 				}
 				Expect(install.Create(context.Background())).To(Succeed())
 				Expect(fakeKarpenterInstaller.InstallCallCount()).To(Equal(1))
-				accounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+				_, accounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
 				Expect(accounts).NotTo(BeEmpty())
 				Expect(api.IsEnabled(accounts[0].RoleOnly)).To(BeTrue())
 			})
@@ -331,7 +332,7 @@ var _ = Describe("Create", func() {
 				}
 				Expect(install.Create(context.Background())).To(Succeed())
 				Expect(fakeKarpenterInstaller.InstallCallCount()).To(Equal(1))
-				accounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+				_, accounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
 				Expect(accounts).NotTo(BeEmpty())
 				Expect(accounts[0].RoleOnly).To(BeNil())
 				policyARN := fmt.Sprintf("arn:aws:iam::123456789012:policy/eksctl-%s-%s", builder.KarpenterManagedPolicy, cfg.Metadata.Name)
@@ -73,7 +73,8 @@ func NewInstaller(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterP
 	}, nil
 }
 
-func doTasks(taskTree *tasks.TaskTree) error {
+func doTasks(ctx context.Context, taskTree *tasks.TaskTree) error {
+	taskTree.Context = ctx
 	logger.Info(taskTree.Describe())
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		logger.Info("%d error(s) occurred while installing Karpenter, you may wish to check your Cluster for further information", len(errs))
@@ -40,6 +40,8 @@ type CreateOpts struct {
 	SkipOutdatedAddonsCheck   bool
 	ConfigFileProvided        bool
 	Parallelism               int
+	// ExportTemplates only creates the nodegroup stacks, which are expected to export their templates
+	ExportTemplates bool
 }
 
 type DryRunSettings struct {
@@ -126,6 +128,7 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 	nodePools := nodes.ToNodePools(cfg)
 
 	nodeGroupService := eks.NewNodeGroupService(ctl.AWSProvider, m.instanceSelector, makeOutpostsService(cfg, ctl.AWSProvider))
+	nodeGroupService.SkipKeyImport = options.ExportTemplates
 	if err := nodeGroupService.ExpandInstanceSelectorOptions(nodePools, cfg.AvailabilityZones); err != nil {
 		return err
 	}
@@ -137,7 +140,7 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 	}
 
 	printer := printers.NewJSONPrinter()
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
@@ -183,9 +186,12 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 		return cmdutils.PrintNodeGroupDryRunConfig(clusterConfigCopy, options.DryRunSettings.OutStream)
 	}
 
-	if err := m.nodeCreationTasks(ctx, isOwnedCluster, skipEgressRules, options.UpdateAuthConfigMap, options.Parallelism); err != nil {
+	if err := m.nodeCreationTasks(ctx, isOwnedCluster, skipEgressRules, options.UpdateAuthConfigMap, options.Parallelism, options.ExportTemplates); err != nil {
 		return err
 	}
+	if options.ExportTemplates {
+		return nil
+	}
 
 	if err := m.postNodeCreationTasks(ctx, m.clientSet, options); err != nil {
 		return err
@@ -215,7 +221,7 @@ func makeOutpostsService(clusterConfig *api.ClusterConfig, provider api.ClusterP
 	}
 }
 
-func (m *Manager) nodeCreationTasks(ctx context.Context, isOwnedCluster, skipEgressRules bool, updateAuthConfigMap *bool, parallelism int) error {
+func (m *Manager) nodeCreationTasks(ctx context.Context, isOwnedCluster, skipEgressRules bool, updateAuthConfigMap *bool, parallelism int, exportTemplates bool) error {
 	cfg := m.cfg
 	meta := cfg.Metadata
 
@@ -280,11 +286,18 @@ func (m *Manager) nodeCreationTasks(ctx context.Context, isOwnedCluster, skipEgr
 	}
 
 	taskTree.Append(allNodeGroupTasks)
-	return eks.DoAllNodegroupStackTasks(taskTree, meta.Region, meta.Name)
+	if exportTemplates {
+		// only the nodegroup stacks are created, one after the other so that they are exported in a stable order
+		taskTree = taskTree.Select(func(m tasks.Metadata) bool {
+			return m.Action == "create" && m.StackName != ""
+		})
+	}
+	return eks.DoAllNodegroupStackTasks(ctx, taskTree, meta.Region, meta.Name)
 }
 
 func (m *Manager) postNodeCreationTasks(ctx context.Context, clientSet kubernetes.Interface, options CreateOpts) error {
 	tasks := m.ctl.ClusterTasksForNodeGroups(m.cfg, options.InstallNeuronDevicePlugin, options.InstallNvidiaDevicePlugin)
+	tasks.Context = ctx
 	logger.Info(tasks.Describe())
 	errs := tasks.DoAllSync()
 	if len(errs) > 0 {
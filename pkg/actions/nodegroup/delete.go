@@ -97,6 +97,7 @@ func (d *Deleter) Delete(ctx context.Context, nodeGroups []*api.NodeGroup, manag
 		taskTree.Append(deleteTasks)
 	}
 
+	taskTree.Context = ctx
 	logger.Info(taskTree.Describe())
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "nodegroup(s)")
@@ -120,8 +121,8 @@ func (d *Deleter) updateAuthConfigMapTask(nodeGroups []*api.NodeGroup, stacks []
 
 	return &tasks.GenericTask{
 		Description: "update auth ConfigMap",
-		Doer: func() error {
-			cmdutils.LogIntendedAction(options.Plan, "delete %d nodegroups from auth ConfigMap in cluster %q", len(nodeGroupsWithoutAccessEntry), d.ClusterName)
+		ContextDoer: func(ctx context.Context) error {
+			cmdutils.LogIntendedAction(ctx, options.Plan, "delete %d nodegroups from auth ConfigMap in cluster %q", len(nodeGroupsWithoutAccessEntry), d.ClusterName)
 			if options.Plan {
 				return nil
 			}
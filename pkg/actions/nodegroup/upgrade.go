@@ -28,6 +28,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/managed"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
@@ -49,6 +50,8 @@ type UpgradeOptions struct {
 	Wait bool
 	// Stack to upgrade
 	Stack *manager.NodeGroupStack
+	// Plan previews the upgrade without applying it
+	Plan bool
 }
 
 func (m *Manager) Upgrade(ctx context.Context, options UpgradeOptions) error {
@@ -136,6 +139,11 @@ func (m *Manager) upgradeUsingAPI(ctx context.Context, options UpgradeOptions, n
 		}
 	}
 
+	if options.Plan {
+		cmdutils.LogIntendedAction(ctx, true, "upgrade nodegroup %q to version %s", options.NodegroupName, aws.ToString(input.Version))
+		return nil
+	}
+
 	upgradeResponse, err := m.ctl.AWSProvider.EKS().UpdateNodegroupVersion(ctx, input)
 
 	if err != nil {
@@ -198,6 +206,10 @@ func (m *Manager) upgradeUsingStack(ctx context.Context, options UpgradeOptions,
 	}
 
 	updateStack := func(stack *cloudformation.Template, wait bool) error {
+		if options.Plan {
+			// all changes are previewed together once the template has been updated
+			return nil
+		}
 		bytes, err := stack.JSON()
 		if err != nil {
 			return err
@@ -289,6 +301,10 @@ func (m *Manager) upgradeUsingStack(ctx context.Context, options UpgradeOptions,
 
 	logger.Debug("nodegroup resources for upgrade: %+v", ngResource)
 
+	if options.Plan {
+		return m.previewStackUpgrade(ctx, options.NodegroupName, stack)
+	}
+
 	logger.Info("upgrading nodegroup version")
 	if err := updateStack(stack, options.Wait); err != nil {
 		return err
@@ -297,6 +313,29 @@ func (m *Manager) upgradeUsingStack(ctx context.Context, options UpgradeOptions,
 	return nil
 }
 
+// previewStackUpgrade prints the update of the nodegroup stack to the upgraded template without making it; the changes
+// of the update are listed if change sets are previewed
+func (m *Manager) previewStackUpgrade(ctx context.Context, nodeGroupName string, template *cloudformation.Template) error {
+	bytes, err := template.JSON()
+	if err != nil {
+		return err
+	}
+	stack, err := m.stackManager.DescribeNodeGroupStack(ctx, nodeGroupName)
+	if err != nil {
+		return err
+	}
+	if err := m.stackManager.UpdateStack(ctx, manager.UpdateStackOptions{
+		Stack:         stack,
+		ChangeSetName: m.stackManager.MakeChangeSetName("upgrade-nodegroup"),
+		Description:   fmt.Sprintf("previewing upgrade of nodegroup %q", nodeGroupName),
+		TemplateData:  manager.TemplateBody(bytes),
+		Plan:          true,
+	}); err != nil {
+		return fmt.Errorf("error previewing nodegroup stack update: %w", err)
+	}
+	return nil
+}
+
 func (m *Manager) updateReleaseVersion(latestReleaseVersion, launchTemplateVersion string, nodegroup *ekstypes.Nodegroup, ngResource *gfneks.Nodegroup) error {
 	latest, err := ParseReleaseVersion(latestReleaseVersion)
 	if err != nil {
@@ -249,6 +249,16 @@ var _ = Describe("Upgrade", func() {
 					Expect(template).To(Equal(al2FullyUpdatedTemplate))
 					Expect(wait).To(BeTrue())
 				})
+
+				It("previews the stack changes without updating the stack in plan mode", func() {
+					options.Plan = true
+					Expect(m.Upgrade(context.Background(), options)).To(Succeed())
+					Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(BeZero())
+					Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+					_, updateOptions := fakeStackManager.UpdateStackArgsForCall(0)
+					Expect(updateOptions.Plan).To(BeTrue())
+					Expect(string(updateOptions.TemplateData.(manager.TemplateBody))).To(Equal(al2FullyUpdatedTemplate))
+				})
 			})
 		})
 
@@ -92,7 +92,7 @@ func (d *Deleter) Delete(ctx context.Context, podIDs []Identifier) error {
 	if err != nil {
 		return err
 	}
-	return runAllTasks(tasks)
+	return runAllTasks(ctx, tasks)
 }
 
 func (d *Deleter) DeleteTasks(ctx context.Context, podIDs []Identifier) (*tasks.TaskTree, error) {
@@ -207,11 +207,11 @@ func (m *Migrator) MigrateToPodIdentity(ctx context.Context, options PodIdentity
 	}
 
 	// add suggestive logs
-	cmdutils.LogIntendedAction(taskTree.PlanMode, "migrate %d iamserviceaccount(s) and %d addon(s) to pod identity by executing the following tasks",
+	cmdutils.LogIntendedAction(ctx, taskTree.PlanMode, "migrate %d iamserviceaccount(s) and %d addon(s) to pod identity by executing the following tasks",
 		len(toBeCreated), addonMigrationTasks.Len())
 	defer cmdutils.LogPlanModeWarning(taskTree.PlanMode)
 
-	return runAllTasks(&taskTree)
+	return runAllTasks(ctx, &taskTree)
 }
 
 func IsPodIdentityAgentInstalled(ctx context.Context, eksAPI awsapi.EKS, clusterName string) (bool, error) {
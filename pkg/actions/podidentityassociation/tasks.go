@@ -248,7 +248,8 @@ func updateTrustStatements(
 	return trustStatements, nil
 }
 
-func runAllTasks(taskTree *tasks.TaskTree) error {
+func runAllTasks(ctx context.Context, taskTree *tasks.TaskTree) error {
+	taskTree.Context = ctx
 	logger.Info(taskTree.Describe())
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		var allErrs []string
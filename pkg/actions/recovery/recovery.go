@@ -0,0 +1,298 @@
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// LoadBalancerCleaner deletes the load balancers created by Kubernetes objects of the cluster.
+type LoadBalancerCleaner func(ctx context.Context) error
+
+// Options selects the stack to recover.
+type Options struct {
+	StackName string
+	// Resources are the logical IDs of the resources to skip or retain; by default, the resources
+	// that failed are used
+	Resources []string
+}
+
+// A Recoverer recovers stacks stuck in UPDATE_ROLLBACK_FAILED or DELETE_FAILED status.
+type Recoverer struct {
+	cfg                  *api.ClusterConfig
+	stackManager         manager.StackManager
+	cfnAPI               awsapi.CloudFormation
+	ec2API               awsapi.EC2
+	cleanupLoadBalancers LoadBalancerCleaner
+}
+
+// failedResource is a resource that failed to be rolled back or deleted.
+type failedResource struct {
+	logicalID    string
+	physicalID   string
+	resourceType string
+}
+
+// retainedResourceDeleters delete the resources retained by a stack deletion, in the order in which
+// they must be deleted; other resource types must be deleted manually.
+var retainedResourceDeleters = []struct {
+	resourceType string
+	delete       func(ctx context.Context, ec2API awsapi.EC2, id string) error
+}{
+	{
+		resourceType: "AWS::EC2::NetworkInterface",
+		delete: func(ctx context.Context, ec2API awsapi.EC2, id string) error {
+			_, err := ec2API.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{NetworkInterfaceId: aws.String(id)})
+			return err
+		},
+	},
+	{
+		resourceType: "AWS::EC2::SecurityGroup",
+		delete: func(ctx context.Context, ec2API awsapi.EC2, id string) error {
+			_, err := ec2API.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String(id)})
+			return err
+		},
+	},
+	{
+		resourceType: "AWS::EC2::Subnet",
+		delete: func(ctx context.Context, ec2API awsapi.EC2, id string) error {
+			_, err := ec2API.DeleteSubnet(ctx, &ec2.DeleteSubnetInput{SubnetId: aws.String(id)})
+			return err
+		},
+	},
+	{
+		resourceType: "AWS::EC2::VPC",
+		delete: func(ctx context.Context, ec2API awsapi.EC2, id string) error {
+			_, err := ec2API.DeleteVpc(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(id)})
+			return err
+		},
+	},
+}
+
+// New creates a new Recoverer. cleanupLoadBalancers is nil when the Kubernetes API of the cluster cannot be
+// reached, in which case load balancers are not cleaned up; dangling network interfaces are only cleaned up
+// when the VPC of cfg is set.
+func New(cfg *api.ClusterConfig, stackManager manager.StackManager, provider api.ClusterProvider, cleanupLoadBalancers LoadBalancerCleaner) *Recoverer {
+	return &Recoverer{
+		cfg:                  cfg,
+		stackManager:         stackManager,
+		cfnAPI:               provider.CloudFormation(),
+		ec2API:               provider.EC2(),
+		cleanupLoadBalancers: cleanupLoadBalancers,
+	}
+}
+
+// Recover inspects the failure of a stack and recovers it. A stack in UPDATE_ROLLBACK_FAILED status is rolled
+// back, skipping the resources that failed to be rolled back. The deletion of a stack in DELETE_FAILED status is
+// retried after cleaning up dangling network interfaces, and the load balancers created by Kubernetes objects if it
+// is the cluster stack, retaining the resources that failed to be deleted, which are then deleted directly. In plan
+// mode, the tasks are only described.
+func (r *Recoverer) Recover(ctx context.Context, options Options, plan bool) error {
+	stack, err := r.findStack(ctx, options.StackName)
+	if err != nil {
+		return err
+	}
+
+	var taskTree *tasks.TaskTree
+	switch stack.StackStatus {
+	case cfntypes.StackStatusUpdateRollbackFailed:
+		taskTree, err = r.rollbackTasks(ctx, stack, options.Resources)
+	case cfntypes.StackStatusDeleteFailed:
+		taskTree, err = r.deleteTasks(ctx, stack, options.Resources)
+	default:
+		return fmt.Errorf("stack %q is in status %s; only stacks in status %s or %s can be recovered", options.StackName,
+			stack.StackStatus, cfntypes.StackStatusUpdateRollbackFailed, cfntypes.StackStatusDeleteFailed)
+	}
+	if err != nil {
+		return err
+	}
+	taskTree.PlanMode = plan
+	taskTree.Context = ctx
+
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while recovering stack %q", len(errs), options.StackName)
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to recover stack %q", options.StackName)
+	}
+	if !plan {
+		logger.Success("recovered stack %q", options.StackName)
+	}
+	return nil
+}
+
+func (r *Recoverer) findStack(ctx context.Context, stackName string) (*manager.Stack, error) {
+	stacks, err := r.stackManager.ListStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		if aws.ToString(s.StackName) == stackName {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("no stack %q found for cluster %q", stackName, r.cfg.Metadata.Name)
+}
+
+func (r *Recoverer) rollbackTasks(ctx context.Context, stack *manager.Stack, resourcesToSkip []string) (*tasks.TaskTree, error) {
+	stackName := aws.ToString(stack.StackName)
+	if len(resourcesToSkip) == 0 {
+		failed, err := r.failedResources(ctx, stack, cfntypes.ResourceStatusUpdateFailed)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range failed {
+			resourcesToSkip = append(resourcesToSkip, f.logicalID)
+		}
+	}
+
+	description := fmt.Sprintf("continue rolling back stack %q", stackName)
+	if len(resourcesToSkip) > 0 {
+		description = fmt.Sprintf("%s, skipping %s", description, strings.Join(resourcesToSkip, ", "))
+	}
+	return &tasks.TaskTree{
+		Tasks: []tasks.Task{
+			&tasks.GenericTask{
+				Description: description,
+				Metadata:    tasks.Metadata{Action: "update", ResourceType: "stack", StackName: stackName},
+				ContextDoer: func(ctx context.Context) error {
+					return r.stackManager.ContinueUpdateRollbackSync(ctx, stack, resourcesToSkip)
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *Recoverer) deleteTasks(ctx context.Context, stack *manager.Stack, retainResources []string) (*tasks.TaskTree, error) {
+	stackName := aws.ToString(stack.StackName)
+	failed, err := r.failedResources(ctx, stack, cfntypes.ResourceStatusDeleteFailed)
+	if err != nil {
+		return nil, err
+	}
+	var retained []failedResource
+	if len(retainResources) == 0 {
+		retained = failed
+		for _, f := range failed {
+			retainResources = append(retainResources, f.logicalID)
+		}
+	} else {
+		for _, f := range failed {
+			if slices.Contains(retainResources, f.logicalID) {
+				retained = append(retained, f)
+			}
+		}
+	}
+
+	taskTree := &tasks.TaskTree{}
+	// load balancers created by Kubernetes objects only block the deletion of the cluster stack; they are
+	// in use by the cluster as long as it exists
+	if stackName == r.stackManager.MakeClusterStackName() {
+		if r.cleanupLoadBalancers != nil {
+			taskTree.Append(&tasks.GenericTask{
+				Description: fmt.Sprintf("clean up load balancers created by Kubernetes objects in cluster %q", r.cfg.Metadata.Name),
+				Metadata:    tasks.Metadata{Action: "delete", ResourceType: "load-balancers"},
+				ContextDoer: r.cleanupLoadBalancers,
+			})
+		} else {
+			logger.Warning("the Kubernetes API of cluster %q cannot be reached, load balancers created by Kubernetes objects will not be cleaned up", r.cfg.Metadata.Name)
+		}
+	}
+	if vpcID := r.cfg.VPC.ID; vpcID != "" {
+		taskTree.Append(&tasks.GenericTask{
+			Description: fmt.Sprintf("clean up dangling network interfaces in VPC %q", vpcID),
+			Metadata:    tasks.Metadata{Action: "delete", ResourceType: "network-interfaces", ResourceName: vpcID},
+			ContextDoer: func(ctx context.Context) error {
+				return vpc.CleanupNetworkInterfaces(ctx, r.ec2API, r.cfg)
+			},
+		})
+	}
+
+	description := fmt.Sprintf("delete stack %q", stackName)
+	if len(retainResources) > 0 {
+		description = fmt.Sprintf("%s, retaining %s", description, strings.Join(retainResources, ", "))
+	}
+	taskTree.Append(&tasks.GenericTask{
+		Description: description,
+		Metadata:    tasks.Metadata{Action: "delete", ResourceType: "stack", StackName: stackName},
+		ContextDoer: func(ctx context.Context) error {
+			return r.stackManager.DeleteStackRetainingResourcesSync(ctx, stack, retainResources)
+		},
+	})
+
+	for _, d := range retainedResourceDeleters {
+		for _, res := range retained {
+			if res.resourceType != d.resourceType || res.physicalID == "" {
+				continue
+			}
+			taskTree.Append(&tasks.GenericTask{
+				Description: fmt.Sprintf("delete retained %s %q", res.resourceType, res.physicalID),
+				Metadata:    tasks.Metadata{Action: "delete", ResourceType: res.resourceType, ResourceName: res.physicalID},
+				ContextDoer: func(ctx context.Context) error {
+					if err := d.delete(ctx, r.ec2API, res.physicalID); err != nil {
+						return fmt.Errorf("deleting retained %s %q: %w", res.resourceType, res.physicalID, err)
+					}
+					return nil
+				},
+			})
+		}
+	}
+	for _, res := range retained {
+		if !isDeletable(res) {
+			logger.Warning("%s %q (%s) will be retained and must be deleted manually", res.resourceType, res.logicalID, res.physicalID)
+		}
+	}
+	return taskTree, nil
+}
+
+func isDeletable(res failedResource) bool {
+	if res.physicalID == "" {
+		return false
+	}
+	for _, d := range retainedResourceDeleters {
+		if d.resourceType == res.resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// failedResources returns the resources of the stack in the given status
+func (r *Recoverer) failedResources(ctx context.Context, stack *manager.Stack, status cfntypes.ResourceStatus) ([]failedResource, error) {
+	var failed []failedResource
+	paginator := cloudformation.NewListStackResourcesPaginator(r.cfnAPI, &cloudformation.ListStackResourcesInput{
+		StackName: stack.StackName,
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing resources of stack %q: %w", aws.ToString(stack.StackName), err)
+		}
+		for _, res := range out.StackResourceSummaries {
+			if res.ResourceStatus != status {
+				continue
+			}
+			logger.Info("%s %q failed: %s", aws.ToString(res.ResourceType), aws.ToString(res.LogicalResourceId), aws.ToString(res.ResourceStatusReason))
+			failed = append(failed, failedResource{
+				logicalID:    aws.ToString(res.LogicalResourceId),
+				physicalID:   aws.ToString(res.PhysicalResourceId),
+				resourceType: aws.ToString(res.ResourceType),
+			})
+		}
+	}
+	return failed, nil
+}
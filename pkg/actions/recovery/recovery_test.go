@@ -0,0 +1,146 @@
+package recovery_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/recovery"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Recover", func() {
+	const (
+		clusterName = "test"
+		stackName   = "eksctl-test-cluster"
+	)
+
+	var (
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		p                *mockprovider.MockProvider
+	)
+
+	mockStack := func(status cfntypes.StackStatus) {
+		fakeStackManager.ListStacksReturns([]*manager.Stack{{
+			StackName:   aws.String(stackName),
+			StackStatus: status,
+		}}, nil)
+	}
+
+	mockStackResources := func(resources ...cfntypes.StackResourceSummary) {
+		p.MockCloudFormation().On("ListStackResources", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStackResourcesOutput{
+			StackResourceSummaries: resources,
+		}, nil)
+	}
+
+	resource := func(logicalID, physicalID, resourceType string, status cfntypes.ResourceStatus) cfntypes.StackResourceSummary {
+		return cfntypes.StackResourceSummary{
+			LogicalResourceId:  aws.String(logicalID),
+			PhysicalResourceId: aws.String(physicalID),
+			ResourceType:       aws.String(resourceType),
+			ResourceStatus:     status,
+		}
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.MakeClusterStackNameReturns(stackName)
+		p = mockprovider.NewMockProvider()
+	})
+
+	It("continues rolling back a stack, skipping the resources that failed to be rolled back", func() {
+		mockStack(cfntypes.StackStatusUpdateRollbackFailed)
+		mockStackResources(
+			resource("ControlPlane", "test", "AWS::EKS::Cluster", cfntypes.ResourceStatusUpdateFailed),
+			resource("VPC", "vpc-1", "AWS::EC2::VPC", cfntypes.ResourceStatusUpdateComplete),
+		)
+
+		recoverer := recovery.New(cfg, fakeStackManager, p, nil)
+		Expect(recoverer.Recover(context.Background(), recovery.Options{StackName: stackName}, false)).To(Succeed())
+
+		Expect(fakeStackManager.ContinueUpdateRollbackSyncCallCount()).To(Equal(1))
+		_, stack, resourcesToSkip := fakeStackManager.ContinueUpdateRollbackSyncArgsForCall(0)
+		Expect(*stack.StackName).To(Equal(stackName))
+		Expect(resourcesToSkip).To(Equal([]string{"ControlPlane"}))
+	})
+
+	It("retries the deletion of a stack after cleaning up blockers and deletes the retained resources", func() {
+		cfg.VPC.ID = "vpc-1"
+		mockStack(cfntypes.StackStatusDeleteFailed)
+		mockStackResources(
+			resource("VPC", "vpc-1", "AWS::EC2::VPC", cfntypes.ResourceStatusDeleteFailed),
+			resource("ControlPlaneSecurityGroup", "sg-1", "AWS::EC2::SecurityGroup", cfntypes.ResourceStatusDeleteFailed),
+			resource("ServiceRole", "role", "AWS::IAM::Role", cfntypes.ResourceStatusDeleteComplete),
+		)
+		p.MockEC2().On("DescribeNetworkInterfaces", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil)
+		p.MockEC2().On("DeleteSecurityGroup", mock.Anything, &ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-1")}).Return(&ec2.DeleteSecurityGroupOutput{}, nil).Once()
+		p.MockEC2().On("DeleteVpc", mock.Anything, &ec2.DeleteVpcInput{VpcId: aws.String("vpc-1")}).Return(&ec2.DeleteVpcOutput{}, nil).Once()
+
+		var cleanedUpLoadBalancers bool
+		recoverer := recovery.New(cfg, fakeStackManager, p, func(context.Context) error {
+			cleanedUpLoadBalancers = true
+			return nil
+		})
+		Expect(recoverer.Recover(context.Background(), recovery.Options{StackName: stackName}, false)).To(Succeed())
+
+		Expect(cleanedUpLoadBalancers).To(BeTrue())
+		Expect(fakeStackManager.DeleteStackRetainingResourcesSyncCallCount()).To(Equal(1))
+		_, _, retainResources := fakeStackManager.DeleteStackRetainingResourcesSyncArgsForCall(0)
+		Expect(retainResources).To(Equal([]string{"VPC", "ControlPlaneSecurityGroup"}))
+		p.MockEC2().AssertExpectations(GinkgoT())
+	})
+
+	It("does not clean up load balancers when recovering a stack other than the cluster stack", func() {
+		fakeStackManager.MakeClusterStackNameReturns("eksctl-test-cluster-other")
+		mockStack(cfntypes.StackStatusDeleteFailed)
+		mockStackResources(resource("NodeInstanceRole", "role", "AWS::IAM::Role", cfntypes.ResourceStatusDeleteFailed))
+
+		var cleanedUpLoadBalancers bool
+		recoverer := recovery.New(cfg, fakeStackManager, p, func(context.Context) error {
+			cleanedUpLoadBalancers = true
+			return nil
+		})
+		Expect(recoverer.Recover(context.Background(), recovery.Options{StackName: stackName}, false)).To(Succeed())
+
+		Expect(cleanedUpLoadBalancers).To(BeFalse())
+		Expect(fakeStackManager.DeleteStackRetainingResourcesSyncCallCount()).To(Equal(1))
+	})
+
+	It("does not change anything in plan mode", func() {
+		mockStack(cfntypes.StackStatusDeleteFailed)
+		mockStackResources(resource("VPC", "vpc-1", "AWS::EC2::VPC", cfntypes.ResourceStatusDeleteFailed))
+
+		recoverer := recovery.New(cfg, fakeStackManager, p, nil)
+		Expect(recoverer.Recover(context.Background(), recovery.Options{StackName: stackName}, true)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackRetainingResourcesSyncCallCount()).To(BeZero())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DeleteVpc", mock.Anything, mock.Anything)
+	})
+
+	It("refuses to recover stacks that are not stuck", func() {
+		mockStack(cfntypes.StackStatusUpdateComplete)
+
+		recoverer := recovery.New(cfg, fakeStackManager, p, nil)
+		err := recoverer.Recover(context.Background(), recovery.Options{StackName: stackName}, false)
+		Expect(err).To(MatchError(ContainSubstring("only stacks in status UPDATE_ROLLBACK_FAILED or DELETE_FAILED can be recovered")))
+	})
+
+	It("fails for stacks that do not belong to the cluster", func() {
+		fakeStackManager.ListStacksReturns(nil, nil)
+
+		recoverer := recovery.New(cfg, fakeStackManager, p, nil)
+		err := recoverer.Recover(context.Background(), recovery.Options{StackName: "other"}, false)
+		Expect(err).To(MatchError(`no stack "other" found for cluster "test"`))
+	})
+})
@@ -0,0 +1,577 @@
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// Resource types whose tags are reconciled.
+const (
+	ResourceTypeStack            = "stack"
+	ResourceTypeCluster          = "cluster"
+	ResourceTypeNodeGroup        = "nodegroup"
+	ResourceTypeAddon            = "addon"
+	ResourceTypeAutoScalingGroup = "auto-scaling-group"
+	// ResourceTypeStackProtection is the termination protection and stack policy of the cluster stack.
+	ResourceTypeStackProtection = "stack-protection"
+)
+
+// maxConcurrentUpdates is the number of resources whose tags are updated at the same time, which keeps the stack
+// updates and tagging calls of large clusters within the API rate limits.
+const maxConcurrentUpdates = 8
+
+// reservedTagKeyPrefixes prefix the keys of tags that are set by AWS, eksctl or Kubernetes, which are never removed.
+var reservedTagKeyPrefixes = []string{"aws:", "eks:", "alpha.eksctl.io/", "eksctl.io/", "eksctl.cluster.k8s.io/", "kubernetes.io/", "k8s.io/"}
+
+// A Change is a tag that is added to a resource, whose value is changed, or that is removed.
+type Change struct {
+	Key string
+	// CurrentValue is nil if the tag is added
+	CurrentValue *string
+	DesiredValue string
+	// Removed is set if the tag is removed, in which case DesiredValue is empty
+	Removed bool
+}
+
+// A ResourceDiff holds the changes to the tags of a resource.
+type ResourceDiff struct {
+	ResourceType string
+	ResourceName string
+	Changes      []Change
+
+	apply func(ctx context.Context) error
+}
+
+// A Reconciler reconciles the tags in a ClusterConfig onto the resources of an existing cluster. Tags that were
+// removed from metadata.tags are found by comparing it with the tags of the EKS cluster, and tags that were removed
+// from a nodegroup by comparing its tags with those of the nodegroup or its stack; tags with reserved prefixes, such
+// as `aws:` or `alpha.eksctl.io/`, are never removed.
+type Reconciler struct {
+	cfg          *api.ClusterConfig
+	stackManager manager.StackManager
+	cfnAPI       awsapi.CloudFormation
+	eksAPI       awsapi.EKS
+	asgAPI       awsapi.ASG
+
+	// removedClusterTags are the keys of the tags that were removed from metadata.tags
+	removedClusterTags []string
+}
+
+// New creates a new Reconciler.
+func New(cfg *api.ClusterConfig, stackManager manager.StackManager, provider api.ClusterProvider) *Reconciler {
+	return &Reconciler{
+		cfg:          cfg,
+		stackManager: stackManager,
+		cfnAPI:       provider.CloudFormation(),
+		eksAPI:       provider.EKS(),
+		asgAPI:       provider.ASG(),
+	}
+}
+
+// Diff returns the changes required to reconcile metadata.tags and the nodegroup tags onto the eksctl stacks, the EKS
+// cluster, nodegroups and addons and the ASGs of the cluster, as well as the changes to the protection of the cluster
+// stack. Resources whose tags are up-to-date are omitted.
+func (r *Reconciler) Diff(ctx context.Context) ([]*ResourceDiff, error) {
+	var diffs []*ResourceDiff
+	add := func(d *ResourceDiff) {
+		if d != nil && len(d.Changes) > 0 {
+			diffs = append(diffs, d)
+		}
+	}
+
+	// the tags of the cluster tell which tags were removed from metadata.tags, which are removed from all resources
+	clusterDiff, err := r.clusterDiff(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stacks, err := r.stackManager.ListStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		d, err := r.stackDiff(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		add(d)
+	}
+	add(clusterDiff)
+
+	for _, ng := range r.cfg.ManagedNodeGroups {
+		ngDiffs, err := r.managedNodeGroupDiffs(ctx, ng)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range ngDiffs {
+			add(d)
+		}
+	}
+
+	for _, ng := range r.cfg.NodeGroups {
+		d, err := r.unmanagedNodeGroupASGDiff(ctx, ng, stacks)
+		if err != nil {
+			return nil, err
+		}
+		add(d)
+	}
+
+	addonDiffs, err := r.addonDiffs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range addonDiffs {
+		add(d)
+	}
+
+	protectionDiff, err := r.stackProtectionDiff(ctx)
+	if err != nil {
+		return nil, err
+	}
+	add(protectionDiff)
+	return diffs, nil
+}
+
+// Reconcile applies diffs in parallel. In plan mode, the changes are only logged.
+func (r *Reconciler) Reconcile(ctx context.Context, diffs []*ResourceDiff, plan bool) error {
+	if len(diffs) == 0 {
+		logger.Success("all tags of cluster %q are up-to-date", r.cfg.Metadata.Name)
+		return nil
+	}
+	LogDiff(diffs)
+
+	taskTree := &tasks.TaskTree{Parallel: true, PlanMode: plan, Limit: maxConcurrentUpdates}
+	for _, d := range diffs {
+		taskTree.Append(&tasks.GenericTask{
+			Description: fmt.Sprintf("update %s of %s %q", changeNoun(d), d.ResourceType, d.ResourceName),
+			Metadata:    tasks.Metadata{Action: "update", ResourceType: d.ResourceType, ResourceName: d.ResourceName},
+			Doer: func() error {
+				return d.apply(ctx)
+			},
+		})
+	}
+	taskTree.Context = ctx
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while updating tags", len(errs))
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to update tags of cluster %q", r.cfg.Metadata.Name)
+	}
+	if !plan {
+		logger.Success("updated tags of %d resource(s) of cluster %q", len(diffs), r.cfg.Metadata.Name)
+	}
+	return nil
+}
+
+// LogDiff logs the changes in diffs.
+func LogDiff(diffs []*ResourceDiff) {
+	for _, d := range diffs {
+		logger.Info("%s of %s %q:", changeNoun(d), d.ResourceType, d.ResourceName)
+		for _, c := range d.Changes {
+			switch {
+			case c.Removed:
+				logger.Info("  - %s: %q", c.Key, *c.CurrentValue)
+			case c.CurrentValue == nil:
+				logger.Info("  + %s: %q", c.Key, c.DesiredValue)
+			default:
+				logger.Info("  ~ %s: %q -> %q", c.Key, *c.CurrentValue, c.DesiredValue)
+			}
+		}
+	}
+}
+
+func changeNoun(d *ResourceDiff) string {
+	if d.ResourceType == ResourceTypeStackProtection {
+		return "protection"
+	}
+	return "tags"
+}
+
+func (r *Reconciler) stackDiff(ctx context.Context, s *manager.Stack) (*ResourceDiff, error) {
+	stackName := aws.ToString(s.StackName)
+	desired := maps.Clone(r.cfg.Metadata.Tags)
+	current := stackTags(s)
+	removable := r.removedClusterTags
+	if ngName := r.stackManager.GetNodeGroupName(s); ngName != "" {
+		desired = withTags(desired, r.nodeGroupTags(ngName))
+		// nodegroup stacks are only tagged with metadata.tags and the tags of their nodegroup
+		removable = removedTags(current, desired)
+	}
+	changes := diffTags(current, desired, removable)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	if !r.stackManager.StackStatusIsNotTransitional(s) {
+		logger.Warning("skipping stack %q in status %s; re-run the command once the stack operation completes", stackName, s.StackStatus)
+		return nil, nil
+	}
+
+	return &ResourceDiff{
+		ResourceType: ResourceTypeStack,
+		ResourceName: stackName,
+		Changes:      changes,
+		apply: func(ctx context.Context) error {
+			template, err := r.stackManager.GetStackTemplate(ctx, stackName)
+			if err != nil {
+				return fmt.Errorf("error getting template for stack %q: %w", stackName, err)
+			}
+			err = r.stackManager.MustUpdateStack(ctx, manager.UpdateStackOptions{
+				Stack:                 s,
+				ChangeSetName:         r.stackManager.MakeChangeSetName("update-tags"),
+				Description:           fmt.Sprintf("updating tags of stack %q", stackName),
+				TemplateData:          manager.TemplateBody(template),
+				UsePreviousParameters: true,
+				Tags:                  desired,
+				RemoveTags:            removedTagKeys(changes),
+				Wait:                  true,
+			})
+			var noChangeErr *manager.NoChangeError
+			if errors.As(err, &noChangeErr) {
+				logger.Warning("CloudFormation found no taggable resources to update in stack %q", stackName)
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("error updating tags of stack %q: %w", stackName, err)
+			}
+			return nil
+		},
+	}, nil
+}
+
+func (r *Reconciler) clusterDiff(ctx context.Context) (*ResourceDiff, error) {
+	out, err := r.eksAPI.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(r.cfg.Metadata.Name)})
+	if err != nil {
+		return nil, fmt.Errorf("describing cluster %q: %w", r.cfg.Metadata.Name, err)
+	}
+	r.removedClusterTags = removedTags(out.Cluster.Tags, r.cfg.Metadata.Tags)
+	return r.eksResourceDiff(ResourceTypeCluster, r.cfg.Metadata.Name, aws.ToString(out.Cluster.Arn), out.Cluster.Tags, r.cfg.Metadata.Tags, r.removedClusterTags), nil
+}
+
+func (r *Reconciler) managedNodeGroupDiffs(ctx context.Context, ng *api.ManagedNodeGroup) ([]*ResourceDiff, error) {
+	out, err := r.eksAPI.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(r.cfg.Metadata.Name),
+		NodegroupName: aws.String(ng.Name),
+	})
+	if err != nil {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			logger.Warning("managed nodegroup %q does not exist, skipping", ng.Name)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("describing nodegroup %q: %w", ng.Name, err)
+	}
+	desired := withTags(maps.Clone(r.cfg.Metadata.Tags), ng.Tags)
+	// managed nodegroups are only tagged with metadata.tags and their own tags
+	removable := removedTags(out.Nodegroup.Tags, desired)
+	diffs := []*ResourceDiff{
+		r.eksResourceDiff(ResourceTypeNodeGroup, ng.Name, aws.ToString(out.Nodegroup.NodegroupArn), out.Nodegroup.Tags, desired, removable),
+	}
+
+	// the tags of managed nodegroups are only propagated to their ASGs when propagateASGTags is enabled
+	if !api.IsEnabled(ng.PropagateASGTags) || out.Nodegroup.Resources == nil {
+		return diffs, nil
+	}
+	for _, asg := range out.Nodegroup.Resources.AutoScalingGroups {
+		d, err := r.asgDiff(ctx, aws.ToString(asg.Name), desired, removable, false)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, d)
+	}
+	return diffs, nil
+}
+
+func (r *Reconciler) unmanagedNodeGroupASGDiff(ctx context.Context, ng *api.NodeGroup, stacks []*manager.Stack) (*ResourceDiff, error) {
+	var ngStack *manager.Stack
+	for _, s := range stacks {
+		if r.stackManager.GetNodeGroupName(s) == ng.Name {
+			ngStack = s
+			break
+		}
+	}
+	if ngStack == nil {
+		logger.Warning("nodegroup %q does not exist, skipping", ng.Name)
+		return nil, nil
+	}
+	asgName, err := r.stackManager.GetUnmanagedNodeGroupAutoScalingGroupName(ctx, ngStack)
+	if err != nil {
+		return nil, fmt.Errorf("getting the ASG of nodegroup %q: %w", ng.Name, err)
+	}
+	desired := withTags(maps.Clone(r.cfg.Metadata.Tags), ng.Tags)
+	return r.asgDiff(ctx, asgName, desired, removedTags(stackTags(ngStack), desired), true)
+}
+
+func (r *Reconciler) addonDiffs(ctx context.Context) ([]*ResourceDiff, error) {
+	var diffs []*ResourceDiff
+	paginator := eks.NewListAddonsPaginator(r.eksAPI, &eks.ListAddonsInput{ClusterName: aws.String(r.cfg.Metadata.Name)})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing addons of cluster %q: %w", r.cfg.Metadata.Name, err)
+		}
+		for _, name := range out.Addons {
+			addon, err := r.eksAPI.DescribeAddon(ctx, &eks.DescribeAddonInput{
+				ClusterName: aws.String(r.cfg.Metadata.Name),
+				AddonName:   aws.String(name),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("describing addon %q: %w", name, err)
+			}
+			desired := maps.Clone(r.cfg.Metadata.Tags)
+			for _, a := range r.cfg.Addons {
+				if a.Name == name {
+					desired = withTags(desired, a.Tags)
+				}
+			}
+			diffs = append(diffs, r.eksResourceDiff(ResourceTypeAddon, name, aws.ToString(addon.Addon.AddonArn), addon.Addon.Tags, desired, r.removedClusterTags))
+		}
+	}
+	return diffs, nil
+}
+
+func (r *Reconciler) eksResourceDiff(resourceType, name, arn string, current, desired map[string]string, removable []string) *ResourceDiff {
+	changes := diffTags(current, desired, removable)
+	return &ResourceDiff{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Changes:      changes,
+		apply: func(ctx context.Context) error {
+			if tags := changedTags(changes); len(tags) > 0 {
+				if _, err := r.eksAPI.TagResource(ctx, &eks.TagResourceInput{
+					ResourceArn: aws.String(arn),
+					Tags:        tags,
+				}); err != nil {
+					return fmt.Errorf("tagging %s %q: %w", resourceType, name, err)
+				}
+			}
+			if keys := removedTagKeys(changes); len(keys) > 0 {
+				if _, err := r.eksAPI.UntagResource(ctx, &eks.UntagResourceInput{
+					ResourceArn: aws.String(arn),
+					TagKeys:     keys,
+				}); err != nil {
+					return fmt.Errorf("removing tags of %s %q: %w", resourceType, name, err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func (r *Reconciler) asgDiff(ctx context.Context, asgName string, desired map[string]string, removable []string, propagateAtLaunch bool) (*ResourceDiff, error) {
+	out, err := r.asgAPI.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{asgName},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing ASG %q: %w", asgName, err)
+	}
+	current := map[string]string{}
+	for _, group := range out.AutoScalingGroups {
+		for _, t := range group.Tags {
+			current[aws.ToString(t.Key)] = aws.ToString(t.Value)
+		}
+	}
+	changes := diffTags(current, desired, slices.Concat(removable, r.removedClusterTags))
+	return &ResourceDiff{
+		ResourceType: ResourceTypeAutoScalingGroup,
+		ResourceName: asgName,
+		Changes:      changes,
+		apply: func(ctx context.Context) error {
+			var asgTags, removedASGTags []asgtypes.Tag
+			for _, c := range changes {
+				if c.Removed {
+					removedASGTags = append(removedASGTags, asgtypes.Tag{
+						ResourceId:   aws.String(asgName),
+						ResourceType: aws.String("auto-scaling-group"),
+						Key:          aws.String(c.Key),
+					})
+					continue
+				}
+				asgTags = append(asgTags, asgtypes.Tag{
+					ResourceId:        aws.String(asgName),
+					ResourceType:      aws.String("auto-scaling-group"),
+					Key:               aws.String(c.Key),
+					Value:             aws.String(c.DesiredValue),
+					PropagateAtLaunch: aws.Bool(propagateAtLaunch),
+				})
+			}
+			for chunk := range slices.Chunk(asgTags, builder.MaximumCreatedTagNumberPerCall) {
+				if _, err := r.asgAPI.CreateOrUpdateTags(ctx, &autoscaling.CreateOrUpdateTagsInput{Tags: chunk}); err != nil {
+					return fmt.Errorf("tagging ASG %q: %w", asgName, err)
+				}
+			}
+			for chunk := range slices.Chunk(removedASGTags, builder.MaximumCreatedTagNumberPerCall) {
+				if _, err := r.asgAPI.DeleteTags(ctx, &autoscaling.DeleteTagsInput{Tags: chunk}); err != nil {
+					return fmt.Errorf("removing tags of ASG %q: %w", asgName, err)
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+func (r *Reconciler) stackProtectionDiff(ctx context.Context) (*ResourceDiff, error) {
+	clusterStack := r.cfg.ClusterStack
+	if clusterStack == nil {
+		return nil, nil
+	}
+	stackName := r.stackManager.MakeClusterStackName()
+	stack, err := r.stackManager.DescribeStack(ctx, &manager.Stack{StackName: aws.String(stackName)})
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	if tp := clusterStack.TerminationProtection; tp != nil && *tp != aws.ToBool(stack.EnableTerminationProtection) {
+		changes = append(changes, Change{
+			Key:          "terminationProtection",
+			CurrentValue: aws.String(strconv.FormatBool(aws.ToBool(stack.EnableTerminationProtection))),
+			DesiredValue: strconv.FormatBool(*tp),
+		})
+	}
+	if len(clusterStack.StackPolicy) > 0 {
+		out, err := r.cfnAPI.GetStackPolicy(ctx, &cloudformation.GetStackPolicyInput{StackName: aws.String(stackName)})
+		if err != nil {
+			return nil, fmt.Errorf("getting stack policy of stack %q: %w", stackName, err)
+		}
+		desiredPolicy, err := json.Marshal(clusterStack.StackPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("serialising stack policy: %w", err)
+		}
+		change := Change{Key: "stackPolicy", DesiredValue: string(desiredPolicy)}
+		if out.StackPolicyBody != nil {
+			var currentPolicy api.InlineDocument
+			if err := json.Unmarshal([]byte(*out.StackPolicyBody), &currentPolicy); err != nil {
+				return nil, fmt.Errorf("parsing stack policy of stack %q: %w", stackName, err)
+			}
+			if reflect.DeepEqual(currentPolicy, clusterStack.StackPolicy) {
+				return r.newStackProtectionDiff(stackName, changes), nil
+			}
+			change.CurrentValue = out.StackPolicyBody
+		}
+		changes = append(changes, change)
+	}
+	return r.newStackProtectionDiff(stackName, changes), nil
+}
+
+func (r *Reconciler) newStackProtectionDiff(stackName string, changes []Change) *ResourceDiff {
+	return &ResourceDiff{
+		ResourceType: ResourceTypeStackProtection,
+		ResourceName: stackName,
+		Changes:      changes,
+		apply:        r.stackManager.UpdateClusterStackProtection,
+	}
+}
+
+func (r *Reconciler) nodeGroupTags(name string) map[string]string {
+	for _, ng := range r.cfg.NodeGroups {
+		if ng.Name == name {
+			return ng.Tags
+		}
+	}
+	for _, ng := range r.cfg.ManagedNodeGroups {
+		if ng.Name == name {
+			return ng.Tags
+		}
+	}
+	return nil
+}
+
+// diffTags returns the tags in desired that are missing from current or have a different value, followed by the
+// tags in removable that are set in current but not in desired, each sorted by key
+func diffTags(current, desired map[string]string, removable []string) []Change {
+	var changes []Change
+	for _, k := range slices.Sorted(maps.Keys(desired)) {
+		currentValue, ok := current[k]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Key: k, DesiredValue: desired[k]})
+		case currentValue != desired[k]:
+			changes = append(changes, Change{Key: k, CurrentValue: aws.String(currentValue), DesiredValue: desired[k]})
+		}
+	}
+	removable = slices.Clone(removable)
+	slices.Sort(removable)
+	for _, k := range slices.Compact(removable) {
+		currentValue, ok := current[k]
+		if _, isDesired := desired[k]; ok && !isDesired && !isReservedTagKey(k) {
+			changes = append(changes, Change{Key: k, CurrentValue: aws.String(currentValue), Removed: true})
+		}
+	}
+	return changes
+}
+
+// removedTags returns the keys of the tags in current that are neither in desired nor reserved
+func removedTags(current, desired map[string]string) []string {
+	var removed []string
+	for _, k := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := desired[k]; !ok && !isReservedTagKey(k) {
+			removed = append(removed, k)
+		}
+	}
+	return removed
+}
+
+func isReservedTagKey(key string) bool {
+	return slices.ContainsFunc(reservedTagKeyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+func changedTags(changes []Change) map[string]string {
+	tags := make(map[string]string, len(changes))
+	for _, c := range changes {
+		if !c.Removed {
+			tags[c.Key] = c.DesiredValue
+		}
+	}
+	return tags
+}
+
+func removedTagKeys(changes []Change) []string {
+	var keys []string
+	for _, c := range changes {
+		if c.Removed {
+			keys = append(keys, c.Key)
+		}
+	}
+	return keys
+}
+
+func stackTags(s *manager.Stack) map[string]string {
+	tags := map[string]string{}
+	for _, t := range s.Tags {
+		tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return tags
+}
+
+func withTags(tags, overrides map[string]string) map[string]string {
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	maps.Copy(tags, overrides)
+	return tags
+}
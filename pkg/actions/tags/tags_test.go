@@ -0,0 +1,258 @@
+package tags_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/tags"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Tags", func() {
+	const (
+		clusterName      = "test"
+		clusterStackName = "eksctl-test-cluster"
+		nodeGroupStack   = "eksctl-test-nodegroup-ng-1"
+		clusterARN       = "arn:aws:eks:us-west-2:123456789012:cluster/test"
+		asgName          = "eksctl-test-nodegroup-ng-1-NodeGroup"
+		nodeGroupName    = "ng-1"
+	)
+
+	var (
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		p                *mockprovider.MockProvider
+	)
+
+	stack := func(name string, tags map[string]string) *manager.Stack {
+		s := &manager.Stack{StackName: aws.String(name), StackStatus: cfntypes.StackStatusCreateComplete}
+		for k, v := range tags {
+			s.Tags = append(s.Tags, cfntypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		return s
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		cfg.Metadata.Tags = map[string]string{"team": "platform", "env": "prod"}
+		cfg.NodeGroups = []*api.NodeGroup{{NodeGroupBase: &api.NodeGroupBase{
+			Name: nodeGroupName,
+			Tags: map[string]string{"workload": "batch"},
+		}}}
+
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.ListStacksReturns([]*manager.Stack{
+			stack(clusterStackName, map[string]string{"team": "platform", "env": "dev"}),
+			stack(nodeGroupStack, map[string]string{"team": "platform", "env": "prod"}),
+		}, nil)
+		fakeStackManager.GetNodeGroupNameStub = func(s *manager.Stack) string {
+			if strings.HasSuffix(*s.StackName, "-nodegroup-"+nodeGroupName) {
+				return nodeGroupName
+			}
+			return ""
+		}
+		fakeStackManager.StackStatusIsNotTransitionalReturns(true)
+		fakeStackManager.GetUnmanagedNodeGroupAutoScalingGroupNameReturns(asgName, nil)
+		fakeStackManager.MakeClusterStackNameReturns(clusterStackName)
+
+		p = mockprovider.NewMockProvider()
+		p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&eks.DescribeClusterOutput{
+			Cluster: &ekstypes.Cluster{
+				Arn:  aws.String(clusterARN),
+				Tags: map[string]string{"team": "platform", "env": "prod"},
+			},
+		}, nil)
+		p.MockEKS().On("ListAddons", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListAddonsOutput{}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+				AutoScalingGroupName: aws.String(asgName),
+				Tags: []asgtypes.TagDescription{
+					{Key: aws.String("team"), Value: aws.String("platform")},
+				},
+			}},
+		}, nil)
+	})
+
+	It("computes the tags to add or change on every resource", func() {
+		diffs, err := tags.New(cfg, fakeStackManager, p).Diff(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diffs).To(HaveLen(3))
+		Expect(diffs[0].ResourceType).To(Equal(tags.ResourceTypeStack))
+		Expect(diffs[0].ResourceName).To(Equal(clusterStackName))
+		Expect(diffs[0].Changes).To(Equal([]tags.Change{{Key: "env", CurrentValue: aws.String("dev"), DesiredValue: "prod"}}))
+
+		Expect(diffs[1].ResourceName).To(Equal(nodeGroupStack))
+		Expect(diffs[1].Changes).To(Equal([]tags.Change{{Key: "workload", DesiredValue: "batch"}}))
+
+		Expect(diffs[2].ResourceType).To(Equal(tags.ResourceTypeAutoScalingGroup))
+		Expect(diffs[2].ResourceName).To(Equal(asgName))
+		Expect(diffs[2].Changes).To(Equal([]tags.Change{
+			{Key: "env", DesiredValue: "prod"},
+			{Key: "workload", DesiredValue: "batch"},
+		}))
+	})
+
+	It("updates the stacks and tags the ASGs", func() {
+		p.MockASG().On("CreateOrUpdateTags", mock.Anything, mock.Anything).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil).Once()
+		fakeStackManager.GetStackTemplateReturns("{}", nil)
+
+		reconciler := tags.New(cfg, fakeStackManager, p)
+		diffs, err := reconciler.Diff(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciler.Reconcile(context.Background(), diffs, false)).To(Succeed())
+
+		Expect(fakeStackManager.MustUpdateStackCallCount()).To(Equal(2))
+		updatedTags := map[string]map[string]string{}
+		for i := range 2 {
+			_, options := fakeStackManager.MustUpdateStackArgsForCall(i)
+			updatedTags[*options.Stack.StackName] = options.Tags
+			// the values of NoEcho parameters are masked when stacks are described
+			Expect(options.UsePreviousParameters).To(BeTrue())
+			Expect(options.Parameters).To(BeNil())
+			Expect(options.RemoveTags).To(BeEmpty())
+		}
+		Expect(updatedTags).To(Equal(map[string]map[string]string{
+			clusterStackName: {"team": "platform", "env": "prod"},
+			nodeGroupStack:   {"team": "platform", "env": "prod", "workload": "batch"},
+		}))
+
+		p.MockASG().AssertCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything, &autoscaling.CreateOrUpdateTagsInput{
+			Tags: []asgtypes.Tag{
+				{ResourceId: aws.String(asgName), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("env"), Value: aws.String("prod"), PropagateAtLaunch: aws.Bool(true)},
+				{ResourceId: aws.String(asgName), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("workload"), Value: aws.String("batch"), PropagateAtLaunch: aws.Bool(true)},
+			},
+		})
+	})
+
+	It("removes tags that were removed from the config", func() {
+		p.MockEKS().ExpectedCalls = nil
+		p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&eks.DescribeClusterOutput{
+			Cluster: &ekstypes.Cluster{
+				Arn:  aws.String(clusterARN),
+				Tags: map[string]string{"team": "platform", "env": "prod", "owner": "alice", api.ClusterNameTag: clusterName},
+			},
+		}, nil)
+		p.MockEKS().On("ListAddons", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListAddonsOutput{}, nil)
+		p.MockEKS().On("UntagResource", mock.Anything, &eks.UntagResourceInput{
+			ResourceArn: aws.String(clusterARN),
+			TagKeys:     []string{"owner"},
+		}).Return(&eks.UntagResourceOutput{}, nil).Once()
+		fakeStackManager.ListStacksReturns([]*manager.Stack{
+			stack(clusterStackName, map[string]string{"team": "platform", "env": "prod", "owner": "alice", api.ClusterNameTag: clusterName}),
+			stack(nodeGroupStack, map[string]string{"team": "platform", "env": "prod", "workload": "batch", "tier": "1", api.NodeGroupNameTag: nodeGroupName}),
+		}, nil)
+		p.MockASG().ExpectedCalls = nil
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{{
+				AutoScalingGroupName: aws.String(asgName),
+				Tags: []asgtypes.TagDescription{
+					{Key: aws.String("team"), Value: aws.String("platform")},
+					{Key: aws.String("env"), Value: aws.String("prod")},
+					{Key: aws.String("workload"), Value: aws.String("batch")},
+					{Key: aws.String("owner"), Value: aws.String("alice")},
+					{Key: aws.String("tier"), Value: aws.String("1")},
+					{Key: aws.String("Name"), Value: aws.String("test-ng-1-Node")},
+				},
+			}},
+		}, nil)
+		p.MockASG().On("DeleteTags", mock.Anything, mock.Anything).Return(&autoscaling.DeleteTagsOutput{}, nil).Once()
+		fakeStackManager.GetStackTemplateReturns("{}", nil)
+
+		reconciler := tags.New(cfg, fakeStackManager, p)
+		diffs, err := reconciler.Diff(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diffs).To(HaveLen(4))
+		Expect(diffs[0].ResourceName).To(Equal(clusterStackName))
+		Expect(diffs[0].Changes).To(Equal([]tags.Change{{Key: "owner", CurrentValue: aws.String("alice"), Removed: true}}))
+		Expect(diffs[1].ResourceName).To(Equal(nodeGroupStack))
+		Expect(diffs[1].Changes).To(Equal([]tags.Change{{Key: "tier", CurrentValue: aws.String("1"), Removed: true}}))
+		Expect(diffs[2].ResourceType).To(Equal(tags.ResourceTypeCluster))
+		Expect(diffs[2].Changes).To(Equal([]tags.Change{{Key: "owner", CurrentValue: aws.String("alice"), Removed: true}}))
+		Expect(diffs[3].ResourceType).To(Equal(tags.ResourceTypeAutoScalingGroup))
+		Expect(diffs[3].Changes).To(Equal([]tags.Change{
+			{Key: "owner", CurrentValue: aws.String("alice"), Removed: true},
+			{Key: "tier", CurrentValue: aws.String("1"), Removed: true},
+		}))
+
+		Expect(reconciler.Reconcile(context.Background(), diffs, false)).To(Succeed())
+		removedTags := map[string][]string{}
+		for i := range fakeStackManager.MustUpdateStackCallCount() {
+			_, options := fakeStackManager.MustUpdateStackArgsForCall(i)
+			removedTags[*options.Stack.StackName] = options.RemoveTags
+		}
+		Expect(removedTags).To(Equal(map[string][]string{
+			clusterStackName: {"owner"},
+			nodeGroupStack:   {"tier"},
+		}))
+		p.MockEKS().AssertExpectations(GinkgoT())
+		p.MockEKS().AssertNotCalled(GinkgoT(), "TagResource", mock.Anything, mock.Anything)
+		p.MockASG().AssertCalled(GinkgoT(), "DeleteTags", mock.Anything, &autoscaling.DeleteTagsInput{
+			Tags: []asgtypes.Tag{
+				{ResourceId: aws.String(asgName), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("owner")},
+				{ResourceId: aws.String(asgName), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("tier")},
+			},
+		})
+		p.MockASG().AssertNotCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything, mock.Anything)
+	})
+
+	It("does not change any resource in plan mode", func() {
+		reconciler := tags.New(cfg, fakeStackManager, p)
+		diffs, err := reconciler.Diff(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciler.Reconcile(context.Background(), diffs, true)).To(Succeed())
+
+		Expect(fakeStackManager.MustUpdateStackCallCount()).To(BeZero())
+		p.MockASG().AssertNotCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything, mock.Anything)
+	})
+
+	It("tags the EKS cluster and applies the protection of the cluster stack", func() {
+		cfg.Metadata.Tags["cost-center"] = "42"
+		cfg.NodeGroups = nil
+		cfg.ClusterStack = &api.ClusterStack{
+			TerminationProtection: api.Disabled(),
+			StackPolicy: api.InlineDocument{
+				"Statement": []interface{}{
+					map[string]interface{}{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"},
+				},
+			},
+		}
+		fakeStackManager.ListStacksReturns(nil, nil)
+		fakeStackManager.DescribeStackReturns(&manager.Stack{
+			StackName:                   aws.String(clusterStackName),
+			EnableTerminationProtection: aws.Bool(true),
+		}, nil)
+		p.MockCloudFormation().On("GetStackPolicy", mock.Anything, mock.Anything).Return(&cloudformation.GetStackPolicyOutput{}, nil)
+		p.MockEKS().On("TagResource", mock.Anything, &eks.TagResourceInput{
+			ResourceArn: aws.String(clusterARN),
+			Tags:        map[string]string{"cost-center": "42"},
+		}).Return(&eks.TagResourceOutput{}, nil).Once()
+
+		reconciler := tags.New(cfg, fakeStackManager, p)
+		diffs, err := reconciler.Diff(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diffs).To(HaveLen(2))
+		Expect(diffs[1].ResourceType).To(Equal(tags.ResourceTypeStackProtection))
+		Expect(diffs[1].Changes).To(HaveLen(2))
+		Expect(diffs[1].Changes[0]).To(Equal(tags.Change{Key: "terminationProtection", CurrentValue: aws.String("true"), DesiredValue: "false"}))
+
+		Expect(reconciler.Reconcile(context.Background(), diffs, false)).To(Succeed())
+		p.MockEKS().AssertExpectations(GinkgoT())
+		Expect(fakeStackManager.UpdateClusterStackProtectionCallCount()).To(Equal(1))
+	})
+})
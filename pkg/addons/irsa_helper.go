@@ -54,7 +54,7 @@ func (h *irsaHelper) CreateOrUpdate(ctx context.Context, sa *api.ClusterIAMServi
 		}
 	}
 	if stack == nil {
-		err = h.irsaManager.CreateIAMServiceAccount(serviceAccounts, false)
+		err = h.irsaManager.CreateIAMServiceAccount(ctx, serviceAccounts, false)
 	} else {
 		err = h.irsaManager.UpdateIAMServiceAccounts(ctx, serviceAccounts, []*manager.Stack{stack}, false)
 	}
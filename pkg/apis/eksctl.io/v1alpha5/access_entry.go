@@ -142,53 +142,55 @@ func validateAccessEntries(accessEntries []AccessEntry) error {
 	seen := make(map[ARN]struct{})
 	for i, ae := range accessEntries {
 		path := fmt.Sprintf("accessEntries[%d]", i)
+		fieldPath := "accessConfig." + path
 		if ae.PrincipalARN.IsZero() {
-			return fmt.Errorf("%s.principalARN must be set to a valid AWS ARN", path)
+			return fieldErrorf(fieldPath+".principalARN", "%s.principalARN must be set to a valid AWS ARN", path)
 		}
 
 		switch AccessEntryType(ae.Type) {
 		case "", AccessEntryTypeStandard, AccessEntryTypeEC2:
 		case AccessEntryTypeLinux, AccessEntryTypeWindows, AccessEntryTypeFargateLinux, AccessEntryTypeHybridLinux:
 			if len(ae.KubernetesGroups) > 0 || ae.KubernetesUsername != "" {
-				return fmt.Errorf("cannot specify %s.kubernetesGroups nor %s.kubernetesUsername when type is set to %s", path, path, ae.Type)
+				return fieldErrorf(fieldPath+".type", "cannot specify %s.kubernetesGroups nor %s.kubernetesUsername when type is set to %s", path, path, ae.Type)
 			}
 			if len(ae.AccessPolicies) > 0 {
-				return fmt.Errorf("cannot specify %s.accessPolicies when type is set to %s", path, ae.Type)
+				return fieldErrorf(fieldPath+".accessPolicies", "cannot specify %s.accessPolicies when type is set to %s", path, ae.Type)
 			}
 		default:
-			return fmt.Errorf("invalid access entry type %q for %s", ae.Type, path)
+			return fieldErrorf(fieldPath+".type", "invalid access entry type %q for %s", ae.Type, path)
 		}
 
-		for _, ap := range ae.AccessPolicies {
+		for j, ap := range ae.AccessPolicies {
+			policyPath := fmt.Sprintf("%s.accessPolicies[%d]", fieldPath, j)
 			if ap.PolicyARN.IsZero() {
-				return fmt.Errorf("%s.policyARN must be set to a valid AWS ARN", path)
+				return fieldErrorf(policyPath+".policyARN", "%s.policyARN must be set to a valid AWS ARN", path)
 			}
 
 			if parts := strings.Split(ap.PolicyARN.Resource, "/"); len(parts) > 1 {
 				if parts[0] != "cluster-access-policy" {
-					return fmt.Errorf("%s.policyARN must be a cluster-access-policy resource", path)
+					return fieldErrorf(policyPath+".policyARN", "%s.policyARN must be a cluster-access-policy resource", path)
 				}
 			} else {
-				return fmt.Errorf("invalid %s.policyARN", path)
+				return fieldErrorf(policyPath+".policyARN", "invalid %s.policyARN", path)
 			}
 
 			switch typ := ap.AccessScope.Type; typ {
 			case "":
-				return fmt.Errorf("%s.accessScope.type must be set to either %q or %q", path, ekstypes.AccessScopeTypeNamespace, ekstypes.AccessScopeTypeCluster)
+				return fieldErrorf(policyPath+".accessScope", "%s.accessScope.type must be set to either %q or %q", path, ekstypes.AccessScopeTypeNamespace, ekstypes.AccessScopeTypeCluster)
 			case ekstypes.AccessScopeTypeCluster:
 				if len(ap.AccessScope.Namespaces) > 0 {
-					return fmt.Errorf("cannot specify %s.accessScope.namespaces when accessScope is set to %s", path, typ)
+					return fieldErrorf(policyPath+".accessScope.namespaces", "cannot specify %s.accessScope.namespaces when accessScope is set to %s", path, typ)
 				}
 			case ekstypes.AccessScopeTypeNamespace:
 				if len(ap.AccessScope.Namespaces) == 0 {
-					return fmt.Errorf("at least one namespace must be specified when accessScope is set to %s: (%s)", typ, path)
+					return fieldErrorf(policyPath+".accessScope", "at least one namespace must be specified when accessScope is set to %s: (%s)", typ, path)
 				}
 			default:
-				return fmt.Errorf("invalid access scope type %q for %s", typ, path)
+				return fieldErrorf(policyPath+".accessScope.type", "invalid access scope type %q for %s", typ, path)
 			}
 		}
 		if _, exists := seen[ae.PrincipalARN]; exists {
-			return fmt.Errorf("duplicate access entry %s with principal ARN %q", path, ae.PrincipalARN.String())
+			return fieldErrorf(fieldPath+".principalARN", "duplicate access entry %s with principal ARN %q", path, ae.PrincipalARN.String())
 		}
 		seen[ae.PrincipalARN] = struct{}{}
 	}
@@ -0,0 +1,115 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// SSMReferencePrefix prefixes references to SSM parameters, e.g. `ssm:/path/param`.
+	SSMReferencePrefix = "ssm:"
+	// SecretsManagerReferencePrefix prefixes references to Secrets Manager secrets, e.g. `secretsmanager:name`,
+	// or `secretsmanager:name#key` for a key of a secret that holds a JSON object.
+	SecretsManagerReferencePrefix = "secretsmanager:"
+)
+
+// ResolvedReference records a field whose value was resolved from a reference.
+type ResolvedReference struct {
+	// Field is the path of the field, in which list items are identified by their name,
+	// e.g. `managedNodeGroups[ng-1].ssh.publicKey`
+	Field string
+	// Reference is the original value of the field
+	Reference string
+}
+
+// IsReference reports whether value is a reference to an SSM parameter or a Secrets Manager secret.
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, SSMReferencePrefix) || strings.HasPrefix(value, SecretsManagerReferencePrefix)
+}
+
+// HasReferences reports whether any field of the config holds a reference that has not been resolved.
+func (c *ClusterConfig) HasReferences() bool {
+	found := false
+	_ = c.ForEachReferenceField(func(_ string, value *string) error {
+		found = found || IsReference(*value)
+		return nil
+	})
+	return found
+}
+
+// ForEachReferenceField calls fn with the path and value of each set field that may hold a reference to an SSM
+// parameter or a Secrets Manager secret.
+func (c *ClusterConfig) ForEachReferenceField(fn func(field string, value *string) error) error {
+	if c.SecretsEncryption != nil {
+		if err := fn("secretsEncryption.keyARN", &c.SecretsEncryption.KeyARN); err != nil {
+			return err
+		}
+	}
+	for _, idp := range c.IdentityProviders {
+		if oidc, ok := idp.Inner.(*OIDCIdentityProvider); ok {
+			if err := fn(fmt.Sprintf("identityProviders[%s].clientID", oidc.Name), &oidc.ClientID); err != nil {
+				return err
+			}
+		}
+	}
+	for _, addon := range c.Addons {
+		if err := fn(fmt.Sprintf("addons[%s].configurationValues", addon.Name), &addon.ConfigurationValues); err != nil {
+			return err
+		}
+	}
+	for _, ng := range c.NodeGroups {
+		if err := forEachNodeGroupReferenceField(fmt.Sprintf("nodeGroups[%s]", ng.Name), ng.NodeGroupBase, fn); err != nil {
+			return err
+		}
+	}
+	for _, ng := range c.ManagedNodeGroups {
+		if err := forEachNodeGroupReferenceField(fmt.Sprintf("managedNodeGroups[%s]", ng.Name), ng.NodeGroupBase, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func forEachNodeGroupReferenceField(path string, ng *NodeGroupBase, fn func(field string, value *string) error) error {
+	if ng == nil {
+		return nil
+	}
+	visit := func(field string, value *string) error {
+		if value == nil {
+			return nil
+		}
+		return fn(path+"."+field, value)
+	}
+	if err := visit("volumeKmsKeyID", ng.VolumeKmsKeyID); err != nil {
+		return err
+	}
+	if ng.SSH != nil {
+		if err := visit("ssh.publicKey", ng.SSH.PublicKey); err != nil {
+			return err
+		}
+	}
+	if cr := ng.CapacityReservation; cr != nil && cr.CapacityReservationTarget != nil {
+		return visit("capacityReservation.capacityReservationTarget.capacityReservationID", cr.CapacityReservationTarget.CapacityReservationID)
+	}
+	return nil
+}
+
+// Redacted returns the config, or, if it holds values that were resolved from references, a copy of it
+// in which those values are replaced by their references.
+func (c *ClusterConfig) Redacted() *ClusterConfig {
+	if len(c.ResolvedReferences) == 0 {
+		return c
+	}
+	references := make(map[string]string, len(c.ResolvedReferences))
+	for _, r := range c.ResolvedReferences {
+		references[r.Field] = r.Reference
+	}
+	redacted := c.DeepCopy()
+	_ = redacted.ForEachReferenceField(func(field string, value *string) error {
+		if reference, ok := references[field]; ok {
+			*value = reference
+		}
+		return nil
+	})
+	return redacted
+}
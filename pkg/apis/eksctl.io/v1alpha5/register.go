@@ -11,8 +11,9 @@ import (
 
 // Conventional Kubernetes API constants
 const (
-	CurrentGroupVersion = "v1alpha5"
-	ClusterConfigKind   = "ClusterConfig"
+	CurrentGroupVersion   = "v1alpha5"
+	ClusterConfigKind     = "ClusterConfig"
+	ClusterConfigListKind = "ClusterConfigList"
 )
 
 // Conventional Kubernetes API variables
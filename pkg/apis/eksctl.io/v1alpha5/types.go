@@ -958,6 +958,7 @@ type ClusterProvider interface {
 	CloudFormation() awsapi.CloudFormation
 	CloudFormationRoleARN() string
 	CloudFormationDisableRollback() bool
+	CloudFormationTemplateBucket() string
 	ASG() awsapi.ASG
 	EKS() awsapi.EKS
 	SSM() awsapi.SSM
@@ -976,6 +977,8 @@ type ClusterProvider interface {
 	STSPresigner() STSPresigner
 	EC2() awsapi.EC2
 	Outposts() awsapi.Outposts
+	SecretsManager() awsapi.SecretsManager
+	S3() awsapi.S3
 }
 
 // STSPresigner defines the method to pre-sign GetCallerIdentity requests to add a proper header required by EKS for
@@ -991,6 +994,9 @@ type STSPresigner interface {
 type ProviderConfig struct {
 	CloudFormationRoleARN         string
 	CloudFormationDisableRollback bool
+	// CloudFormationTemplateBucket is the S3 bucket templates too large to be passed inline are uploaded to.
+	// If empty, eksctl manages a bucket per account and region
+	CloudFormationTemplateBucket string
 
 	Region      string
 	Profile     Profile
@@ -1118,6 +1124,14 @@ type ClusterConfig struct {
 	// Capabilities specifies the capabilities for the cluster.
 	// +optional
 	Capabilities []Capability `json:"capabilities,omitempty"`
+
+	// ClusterStack specifies the protection of the CloudFormation stack of the cluster.
+	// +optional
+	ClusterStack *ClusterStack `json:"clusterStack,omitempty"`
+
+	// ResolvedReferences records the fields whose values were resolved from references to SSM parameters or
+	// Secrets Manager secrets, so that their values can be redacted when the config is printed.
+	ResolvedReferences []ResolvedReference `json:"-"`
 }
 
 // Outpost holds the Outpost configuration.
@@ -1160,6 +1174,19 @@ type ZonalShiftConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+// ClusterStack holds the protection settings of the CloudFormation stack of the cluster.
+type ClusterStack struct {
+	// TerminationProtection prevents the cluster stack from being deleted outside of eksctl.
+	// Defaults to `true`
+	// +optional
+	TerminationProtection *bool `json:"terminationProtection,omitempty"`
+
+	// StackPolicy is the [stack policy](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
+	// of the cluster stack, which prevents its resources from being updated unintentionally.
+	// +optional
+	StackPolicy InlineDocument `json:"stackPolicy,omitempty"`
+}
+
 // KubeAPIServerConfig holds the kube-apiserver configuration.
 type KubeAPIServerConfig struct {
 	// EventTTL specifies how long Kubernetes events are retained, as a duration
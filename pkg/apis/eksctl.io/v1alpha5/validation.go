@@ -81,14 +81,14 @@ type nameSet map[string]struct{}
 
 func (s nameSet) checkUnique(path, name string) (bool, error) {
 	if _, notUnique := s[name]; notUnique {
-		return false, fmt.Errorf("%s %q is not unique", path, name)
+		return false, fieldErrorf(path, "%s %q is not unique", path, name)
 	}
 	s[name] = struct{}{}
 	return true, nil
 }
 
 func setNonEmpty(field string) error {
-	return fmt.Errorf("%s must be set and non-empty", field)
+	return fieldErrorf(field, "%s must be set and non-empty", field)
 }
 
 func (c *ClusterConfig) validateRemoteNetworkingConfig() error {
@@ -98,23 +98,23 @@ func (c *ClusterConfig) validateRemoteNetworkingConfig() error {
 	}
 
 	if c.IsControlPlaneOnOutposts() && c.Outpost.EtcdInstanceType == "" {
-		return fmt.Errorf("remoteNetworkConfig on Outpost clusters requires outpost.etcdInstanceType to be set")
+		return fieldErrorf("remoteNetworkConfig", "remoteNetworkConfig on Outpost clusters requires outpost.etcdInstanceType to be set")
 	}
 
 	if c.IPv6Enabled() {
-		return fmt.Errorf("remoteNetworkConfig is not supported on EKS cluster configured with IPv6 address family")
+		return fieldErrorf("remoteNetworkConfig", "remoteNetworkConfig is not supported on EKS cluster configured with IPv6 address family")
 	}
 
 	if c.AccessConfig.AuthenticationMode == ekstypes.AuthenticationModeConfigMap {
-		return fmt.Errorf("remoteNetworkConfig requires authenticationMode to be either %q or %q", ekstypes.AuthenticationModeApiAndConfigMap, ekstypes.AuthenticationModeApi)
+		return fieldErrorf("remoteNetworkConfig", "remoteNetworkConfig requires authenticationMode to be either %q or %q", ekstypes.AuthenticationModeApiAndConfigMap, ekstypes.AuthenticationModeApi)
 	}
 
 	if len(rnc.RemoteNodeNetworks) > 1 {
-		return fmt.Errorf("only one remoteNodeNetwork is allowed in remoteNetworkConfig.remoteNodeNetworks")
+		return fieldErrorf("remoteNetworkConfig.remoteNodeNetworks", "only one remoteNodeNetwork is allowed in remoteNetworkConfig.remoteNodeNetworks")
 	}
 
 	if len(rnc.RemotePodNetworks) > 1 {
-		return fmt.Errorf("only one remotePodNetwork is allowed in remoteNetworkConfig.remotePodNetworks")
+		return fieldErrorf("remoteNetworkConfig.remotePodNetworks", "only one remotePodNetwork is allowed in remoteNetworkConfig.remotePodNetworks")
 	}
 
 	if len(rnc.RemoteNodeNetworks) == 0 {
@@ -135,11 +135,11 @@ func (c *ClusterConfig) validateRemoteNetworkingConfig() error {
 
 	if rnc.VPCGatewayID.IsSet() {
 		if c.VPC.ID != "" {
-			return fmt.Errorf("remoteNetworkConfig.vpcGatewayID is not supported when using pre-existing VPC")
+			return fieldErrorf("remoteNetworkConfig.vpcGatewayID", "remoteNetworkConfig.vpcGatewayID is not supported when using pre-existing VPC")
 		}
 		// vpcGatewayId must be either a virtual private gateway or a transit gateway
 		if !rnc.VPCGatewayID.IsTransitGateway() && !rnc.VPCGatewayID.IsVirtualPrivateGateway() {
-			return fmt.Errorf("invalid value %q provided for remoteNetworkConfig.vpcGatewayID; "+
+			return fieldErrorf("remoteNetworkConfig.vpcGatewayID", "invalid value %q provided for remoteNetworkConfig.vpcGatewayID; "+
 				"only transit gateway (tgw-*) or virtual private gateway (vgw-*) IDs are supported", *rnc.VPCGatewayID)
 		}
 	}
@@ -147,17 +147,17 @@ func (c *ClusterConfig) validateRemoteNetworkingConfig() error {
 	// credentials provider must be either SSM or IAM Roles Anywhere
 	if !strings.EqualFold(*rnc.IAM.Provider, SSMProvider) &&
 		!strings.EqualFold(*rnc.IAM.Provider, IRAProvider) {
-		return fmt.Errorf("invalid value %q provided for remoteNetworkConfig.iam.provider; only %q and %q are supported",
+		return fieldErrorf("remoteNetworkConfig.iam.provider", "invalid value %q provided for remoteNetworkConfig.iam.provider; only %q and %q are supported",
 			*rnc.IAM.Provider, SSMProvider, IRAProvider)
 	}
 
 	// CABundleCert should only be set of credentials provider is IAM Roles Anywhere
 	if strings.EqualFold(*rnc.IAM.Provider, SSMProvider) && rnc.IAM.CABundleCert != nil {
-		return fmt.Errorf("remoteNetworkConfig.iam.caBundleCert is not supported when using SSM credentials provider")
+		return fieldErrorf("remoteNetworkConfig.iam.caBundleCert", "remoteNetworkConfig.iam.caBundleCert is not supported when using SSM credentials provider")
 	}
 
 	if strings.EqualFold(*rnc.IAM.Provider, IRAProvider) && rnc.IAM.CABundleCert == nil {
-		return fmt.Errorf("remoteNetworkConfig.iam.caBundleCert is required when using IAMRolesAnywhere credentials provider")
+		return fieldErrorf("remoteNetworkConfig.iam.caBundleCert", "remoteNetworkConfig.iam.caBundleCert is required when using IAMRolesAnywhere credentials provider")
 	}
 
 	if IsSetAndNonEmptyString(rnc.IAM.RoleARN) {
@@ -173,12 +173,12 @@ func validateSupportType(supportType string) error {
 	// Security: Validate characters to prevent injection attacks
 	for _, r := range supportType {
 		if r < 32 || r == 127 { // Control characters
-			return fmt.Errorf("upgradePolicy.supportType contains invalid control characters")
+			return fieldErrorf("upgradePolicy.supportType", "upgradePolicy.supportType contains invalid control characters")
 		}
 	}
 	// Validate against allowed values
 	if supportType != SupportTypeStandard && supportType != SupportTypeExtended {
-		return fmt.Errorf("upgradePolicy.supportType must be either %q or %q", SupportTypeStandard, SupportTypeExtended)
+		return fieldErrorf("upgradePolicy.supportType", "upgradePolicy.supportType must be either %q or %q", SupportTypeStandard, SupportTypeExtended)
 	}
 	return nil
 }
@@ -195,7 +195,7 @@ func validateRollbackConfig(rollbackConfig *RollbackConfig) error {
 		return nil
 	}
 	if timeout := *rollbackConfig.TimeoutMinutes; timeout < RollbackTimeoutMinutesMin || timeout > RollbackTimeoutMinutesMax {
-		return fmt.Errorf("metadata.rollbackConfig.timeoutMinutes must be between %d and %d, got %d", RollbackTimeoutMinutesMin, RollbackTimeoutMinutesMax, timeout)
+		return fieldErrorf("metadata.rollbackConfig.timeoutMinutes", "metadata.rollbackConfig.timeoutMinutes must be between %d and %d, got %d", RollbackTimeoutMinutesMin, RollbackTimeoutMinutesMax, timeout)
 	}
 	return nil
 }
@@ -217,26 +217,26 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 	}
 
 	if IsDisabled(cfg.IAM.WithOIDC) && len(cfg.IAM.ServiceAccounts) > 0 {
-		return fmt.Errorf("iam.withOIDC must be enabled explicitly for iam.serviceAccounts to be created")
+		return fieldErrorf("iam.withOIDC", "iam.withOIDC must be enabled explicitly for iam.serviceAccounts to be created")
 	}
 
 	saNames := nameSet{}
 	for i, sa := range cfg.IAM.ServiceAccounts {
 		path := fmt.Sprintf("iam.serviceAccounts[%d]", i)
 		if sa.Name == "" {
-			return fmt.Errorf("%s.name must be set", path)
+			return fieldErrorf(path+".name", "%s.name must be set", path)
 		}
 		if ok, err := saNames.checkUnique("<namespace>/<name> of "+path, sa.NameString()); !ok {
-			return err
+			return withFieldPath(path, err)
 		}
 		if !sa.WellKnownPolicies.HasPolicy() && len(sa.AttachPolicyARNs) == 0 && sa.AttachPolicy == nil && sa.AttachRoleARN == "" {
-			return fmt.Errorf("%[1]s.wellKnownPolicies, %[1]s.attachPolicyARNs,%[1]s.attachRoleARN  or %[1]s.attachPolicy must be set", path)
+			return fieldErrorf(path, "%[1]s.wellKnownPolicies, %[1]s.attachPolicyARNs,%[1]s.attachRoleARN  or %[1]s.attachPolicy must be set", path)
 		}
 	}
 
 	for i := range cfg.IAM.PodIdentityAssociations {
 		if err := validatePermissionPolicyName(&cfg.IAM.PodIdentityAssociations[i]); err != nil {
-			return fmt.Errorf("iam.podIdentityAssociations[%d]: %w", i, err)
+			return fieldErrorf(fmt.Sprintf("iam.podIdentityAssociations[%d]", i), "iam.podIdentityAssociations[%d]: %w", i, err)
 		}
 	}
 
@@ -252,13 +252,13 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 	ngNames := nameSet{}
 	validateNg := func(ng *NodeGroupBase, path string) error {
 		if ng.Name == "" {
-			return fmt.Errorf("%s.name must be set", path)
+			return fieldErrorf(path+".name", "%s.name must be set", path)
 		}
 		if _, err := ngNames.checkUnique(path+".name", ng.Name); err != nil {
 			return err
 		}
 		if cfg.PrivateCluster.Enabled && !ng.PrivateNetworking {
-			return fmt.Errorf("%s.privateNetworking must be enabled for a fully-private cluster", path)
+			return fieldErrorf(path+".privateNetworking", "%s.privateNetworking must be enabled for a fully-private cluster", path)
 		}
 		return nil
 	}
@@ -275,7 +275,7 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		}
 		if ng.OutpostARN != "" {
 			if ngOutpostARN != "" && ng.OutpostARN != ngOutpostARN {
-				return fmt.Errorf("cannot create nodegroups in two different Outposts; got Outpost ARN %q and %q", ngOutpostARN, ng.OutpostARN)
+				return fieldErrorf(path+".outpostARN", "cannot create nodegroups in two different Outposts; got Outpost ARN %q and %q", ngOutpostARN, ng.OutpostARN)
 			}
 			ngOutpostARN = ng.OutpostARN
 		}
@@ -293,73 +293,73 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 	}
 
 	if err := validateAvailabilityZones(cfg.AvailabilityZones); err != nil {
-		return err
+		return withFieldPath("availabilityZones", err)
 	}
 
 	if cfg.Outpost != nil {
 		if cfg.Outpost.ControlPlaneOutpostARN == "" {
-			return errors.New("outpost.controlPlaneOutpostARN is required for Outposts")
+			return fieldErrorf("outpost.controlPlaneOutpostARN", "outpost.controlPlaneOutpostARN is required for Outposts")
 		}
 		if err := validateOutpostARN(cfg.Outpost.ControlPlaneOutpostARN); err != nil {
-			return err
+			return withFieldPath("outpost.controlPlaneOutpostARN", err)
 		}
 
 		if IsDisabled(cfg.AccessConfig.BootstrapClusterCreatorAdminPermissions) {
-			return fmt.Errorf("accessConfig.BootstrapClusterCreatorAdminPermissions can't be set to false on Outposts")
+			return fieldErrorf("accessConfig.bootstrapClusterCreatorAdminPermissions", "accessConfig.BootstrapClusterCreatorAdminPermissions can't be set to false on Outposts")
 		}
 		if cfg.IPv6Enabled() {
-			return errors.New("IPv6 is not supported on Outposts")
+			return fieldErrorf("kubernetesNetworkConfig.ipFamily", "IPv6 is not supported on Outposts")
 		}
 		if len(cfg.Addons) > 0 {
-			return errors.New("Addons are not supported on Outposts")
+			return fieldErrorf("addons", "Addons are not supported on Outposts")
 		}
 		if len(cfg.IdentityProviders) > 0 {
-			return errors.New("Identity Providers are not supported on Outposts")
+			return fieldErrorf("identityProviders", "Identity Providers are not supported on Outposts")
 		}
 		if len(cfg.FargateProfiles) > 0 {
-			return errors.New("Fargate is not supported on Outposts")
+			return fieldErrorf("fargateProfiles", "Fargate is not supported on Outposts")
 		}
 		if cfg.Karpenter != nil {
-			return errors.New("Karpenter is not supported on Outposts")
+			return fieldErrorf("karpenter", "Karpenter is not supported on Outposts")
 		}
 		if cfg.SecretsEncryption != nil && cfg.SecretsEncryption.KeyARN != "" {
-			return errors.New("KMS encryption is not supported on Outposts")
+			return fieldErrorf("secretsEncryption.keyARN", "KMS encryption is not supported on Outposts")
 		}
 		const zonesErr = "cannot specify %s on Outposts; the AZ defaults to the Outpost AZ"
 		if len(cfg.AvailabilityZones) > 0 {
-			return fmt.Errorf(zonesErr, "availabilityZones")
+			return fieldErrorf("availabilityZones", zonesErr, "availabilityZones")
 		}
 		if len(cfg.LocalZones) > 0 {
-			return fmt.Errorf(zonesErr, "localZones")
+			return fieldErrorf("localZones", zonesErr, "localZones")
 		}
 		if cfg.GitOps != nil {
-			return errors.New("GitOps is not supported on Outposts")
+			return fieldErrorf("gitops", "GitOps is not supported on Outposts")
 		}
 		if cfg.IAM != nil && IsEnabled(cfg.IAM.WithOIDC) {
-			return errors.New("iam.withOIDC is not supported on Outposts")
+			return fieldErrorf("iam.withOIDC", "iam.withOIDC is not supported on Outposts")
 		}
 		if cfg.VPC != nil {
 			if IsEnabled(cfg.VPC.AutoAllocateIPv6) {
-				return errors.New("autoAllocateIPv6 is not supported on Outposts")
+				return fieldErrorf("vpc.autoAllocateIPv6", "autoAllocateIPv6 is not supported on Outposts")
 			}
 			if len(cfg.VPC.PublicAccessCIDRs) > 0 {
-				return errors.New("publicAccessCIDRs is not supported on Outposts")
+				return fieldErrorf("vpc.publicAccessCIDRs", "publicAccessCIDRs is not supported on Outposts")
 			}
 		}
 		if cfg.Outpost.EtcdPlacement != nil && cfg.Outpost.EtcdInstanceType == "" {
-			return errors.New("outpost.etcdInstanceType is required when outpost.etcdPlacement is specified")
+			return fieldErrorf("outpost.etcdPlacement", "outpost.etcdInstanceType is required when outpost.etcdPlacement is specified")
 		}
 		if cfg.Outpost.ControlPlanePlacement != nil && cfg.Outpost.ControlPlanePlacement.SpreadLevel != "" && cfg.Outpost.EtcdInstanceType == "" {
-			return errors.New("outpost.etcdInstanceType is required when outpost.controlPlanePlacement.spreadLevel is specified")
+			return fieldErrorf("outpost.controlPlanePlacement.spreadLevel", "outpost.etcdInstanceType is required when outpost.controlPlanePlacement.spreadLevel is specified")
 		}
 		if cfg.Outpost.ControlPlanePlacement != nil && cfg.Outpost.ControlPlanePlacement.GroupName != "" && cfg.Outpost.EtcdInstanceType != "" {
-			return errors.New("outpost.controlPlanePlacement.groupName is not supported when outpost.etcdInstanceType is specified")
+			return fieldErrorf("outpost.controlPlanePlacement.groupName", "outpost.controlPlanePlacement.groupName is not supported when outpost.etcdInstanceType is specified")
 		}
 		if cfg.Outpost.EtcdInstanceType != "" && cfg.AccessConfig.AuthenticationMode == ekstypes.AuthenticationModeConfigMap {
-			return errors.New("Outpost clusters with EC2 instance store require accessConfig.authenticationMode to be API_AND_CONFIG_MAP or API")
+			return fieldErrorf("accessConfig.authenticationMode", "Outpost clusters with EC2 instance store require accessConfig.authenticationMode to be API_AND_CONFIG_MAP or API")
 		}
 	} else if ngOutpostARN != "" && cfg.IsFullyPrivate() {
-		return errors.New("nodeGroup.outpostARN is not supported on a fully-private cluster (privateCluster.enabled)")
+		return fieldErrorf("privateCluster.enabled", "nodeGroup.outpostARN is not supported on a fully-private cluster (privateCluster.enabled)")
 	}
 
 	if err := cfg.ValidateVPCConfig(); err != nil {
@@ -383,7 +383,7 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 	if len(cfg.AccessConfig.AccessEntries) > 0 {
 		switch cfg.AccessConfig.AuthenticationMode {
 		case ekstypes.AuthenticationModeConfigMap:
-			return fmt.Errorf("accessConfig.authenticationMode must be set to either %s or %s to use access entries",
+			return fieldErrorf("accessConfig.authenticationMode", "accessConfig.authenticationMode must be set to either %s or %s to use access entries",
 				ekstypes.AuthenticationModeApiAndConfigMap, ekstypes.AuthenticationModeApi)
 		}
 		if err := validateAccessEntries(cfg.AccessConfig.AccessEntries); err != nil {
@@ -403,12 +403,12 @@ func validateKarpenterConfig(cfg *ClusterConfig) error {
 		return nil
 	}
 	if cfg.Karpenter.Version == "" {
-		return errors.New("version field is required if installing Karpenter is enabled")
+		return fieldErrorf("karpenter.version", "version field is required if installing Karpenter is enabled")
 	}
 
 	v, err := version.NewVersion(cfg.Karpenter.Version)
 	if err != nil {
-		return fmt.Errorf("failed to parse Karpenter version %q: %w", cfg.Karpenter.Version, err)
+		return fieldErrorf("karpenter.version", "failed to parse Karpenter version %q: %w", cfg.Karpenter.Version, err)
 	}
 
 	supportedVersion, err := version.NewVersion(supportedKarpenterVersion)
@@ -417,11 +417,11 @@ func validateKarpenterConfig(cfg *ClusterConfig) error {
 	}
 
 	if v.LessThan(supportedVersion) {
-		return fmt.Errorf("minimum supported version is %s", supportedKarpenterVersion)
+		return fieldErrorf("karpenter.version", "minimum supported version is %s", supportedKarpenterVersion)
 	}
 
 	if IsDisabled(cfg.IAM.WithOIDC) {
-		return errors.New("iam.withOIDC must be enabled with Karpenter")
+		return fieldErrorf("iam.withOIDC", "iam.withOIDC must be enabled with Karpenter")
 	}
 	return nil
 }
@@ -431,7 +431,7 @@ func validateCloudWatchLogging(clusterConfig *ClusterConfig) error {
 		if clusterConfig.CloudWatch != nil &&
 			clusterConfig.CloudWatch.ClusterLogging != nil &&
 			clusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays != 0 {
-			return errors.New("cannot set cloudWatch.clusterLogging.logRetentionInDays without enabling log types")
+			return fieldErrorf("cloudWatch.clusterLogging.logRetentionInDays", "cannot set cloudWatch.clusterLogging.logRetentionInDays without enabling log types")
 		}
 		return nil
 	}
@@ -444,7 +444,7 @@ func validateCloudWatchLogging(clusterConfig *ClusterConfig) error {
 			}
 		}
 		if isUnknown {
-			return fmt.Errorf("log type %q (cloudWatch.clusterLogging.enableTypes[%d]) is unknown", logType, i)
+			return fieldErrorf(fmt.Sprintf("cloudWatch.clusterLogging.enableTypes[%d]", i), "log type %q (cloudWatch.clusterLogging.enableTypes[%d]) is unknown", logType, i)
 		}
 	}
 	if logRetentionDays := clusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays; logRetentionDays != 0 {
@@ -453,7 +453,7 @@ func validateCloudWatchLogging(clusterConfig *ClusterConfig) error {
 				return nil
 			}
 		}
-		return fmt.Errorf("invalid value %d for logRetentionInDays; supported values are %v", logRetentionDays, LogRetentionInDaysValues)
+		return fieldErrorf("cloudWatch.clusterLogging.logRetentionInDays", "invalid value %d for logRetentionInDays; supported values are %v", logRetentionDays, LogRetentionInDaysValues)
 	}
 
 	return nil
@@ -476,30 +476,30 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 	if len(c.VPC.ExtraCIDRs) > 0 {
 		cidrs, err := validateCIDRs(c.VPC.ExtraCIDRs)
 		if err != nil {
-			return err
+			return withFieldPath("vpc.extraCIDRs", err)
 		}
 		c.VPC.ExtraCIDRs = cidrs
 	}
 	if len(c.VPC.PublicAccessCIDRs) > 0 {
 		cidrs, err := validateCIDRs(c.VPC.PublicAccessCIDRs)
 		if err != nil {
-			return err
+			return withFieldPath("vpc.publicAccessCIDRs", err)
 		}
 		c.VPC.PublicAccessCIDRs = cidrs
 	}
 	if len(c.VPC.ExtraIPv6CIDRs) > 0 {
 		if !c.IPv6Enabled() {
-			return fmt.Errorf("cannot specify vpc.extraIPv6CIDRs with an IPv4 cluster")
+			return fieldErrorf("vpc.extraIPv6CIDRs", "cannot specify vpc.extraIPv6CIDRs with an IPv4 cluster")
 		}
 		cidrs, err := validateCIDRs(c.VPC.ExtraIPv6CIDRs)
 		if err != nil {
-			return err
+			return withFieldPath("vpc.extraIPv6CIDRs", err)
 		}
 		c.VPC.ExtraIPv6CIDRs = cidrs
 	}
 
 	if c.VPC.SecurityGroup != "" && len(c.VPC.ControlPlaneSecurityGroupIDs) > 0 {
-		return errors.New("only one of vpc.securityGroup and vpc.controlPlaneSecurityGroupIDs can be specified")
+		return fieldErrorf("vpc.controlPlaneSecurityGroupIDs", "only one of vpc.securityGroup and vpc.controlPlaneSecurityGroupIDs can be specified")
 	}
 
 	if err := c.validateControlPlaneOnPrivateSubnets(); err != nil {
@@ -507,32 +507,32 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 	}
 
 	if (c.VPC.IPv6Cidr != "" || c.VPC.IPv6Pool != "") && !c.IPv6Enabled() {
-		return fmt.Errorf("Ipv6Cidr and Ipv6CidrPool are only supported when IPFamily is set to IPv6")
+		return fieldErrorf("vpc.ipv6Cidr", "Ipv6Cidr and Ipv6CidrPool are only supported when IPFamily is set to IPv6")
 	}
 
 	if c.IPv6Enabled() && c.Status == nil {
 		if IsEnabled(c.VPC.AutoAllocateIPv6) {
-			return fmt.Errorf("auto allocate ipv6 is not supported with IPv6")
+			return fieldErrorf("vpc.autoAllocateIPv6", "auto allocate ipv6 is not supported with IPv6")
 		}
 		if err := c.ipv6CidrsValid(); err != nil {
 			return err
 		}
 		if c.VPC.NAT != nil {
-			return fmt.Errorf("setting NAT is not supported with IPv6")
+			return fieldErrorf("vpc.nat", "setting NAT is not supported with IPv6")
 		}
 		if len(c.LocalZones) > 0 {
-			return errors.New("localZones are not supported with IPv6")
+			return fieldErrorf("localZones", "localZones are not supported with IPv6")
 		}
 	}
 
 	// manageSharedNodeSecurityGroupRules cannot be disabled if using eksctl managed security groups
 	if c.VPC.SharedNodeSecurityGroup == "" && IsDisabled(c.VPC.ManageSharedNodeSecurityGroupRules) {
-		return errors.New("vpc.manageSharedNodeSecurityGroupRules must be enabled when using eksctl-managed security groups")
+		return fieldErrorf("vpc.manageSharedNodeSecurityGroupRules", "vpc.manageSharedNodeSecurityGroupRules must be enabled when using eksctl-managed security groups")
 	}
 
 	if c.VPC.HostnameType != "" {
 		if c.HasAnySubnets() {
-			return errors.New("vpc.hostnameType is not supported with a pre-existing VPC")
+			return fieldErrorf("vpc.hostnameType", "vpc.hostnameType is not supported with a pre-existing VPC")
 		}
 		var hostnameType ec2types.HostnameType
 		found := false
@@ -543,16 +543,16 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 			}
 		}
 		if !found {
-			return fmt.Errorf("invalid value %q for vpc.hostnameType; supported values are %v", c.VPC.HostnameType, hostnameType.Values())
+			return fieldErrorf("vpc.hostnameType", "invalid value %q for vpc.hostnameType; supported values are %v", c.VPC.HostnameType, hostnameType.Values())
 		}
 	}
 
 	if len(c.LocalZones) > 0 {
 		if c.VPC.ID != "" {
-			return errors.New("localZones are not supported with a pre-existing VPC")
+			return fieldErrorf("localZones", "localZones are not supported with a pre-existing VPC")
 		}
 		if c.VPC.NAT != nil && c.VPC.NAT.Gateway != nil && *c.VPC.NAT.Gateway == ClusterHighlyAvailableNAT {
-			return fmt.Errorf("%s NAT gateway is not supported for localZones", ClusterHighlyAvailableNAT)
+			return fieldErrorf("vpc.nat.gateway", "%s NAT gateway is not supported for localZones", ClusterHighlyAvailableNAT)
 		}
 	}
 
@@ -567,7 +567,7 @@ func (c *ClusterConfig) validateControlPlaneOnPrivateSubnets() error {
 	}
 
 	if len(c.VPC.ControlPlaneSubnetIDs) > 0 {
-		return errors.New("only one of vpc.controlPlaneSubnetIDs and vpc.controlPlaneOnPrivateSubnets can be specified")
+		return fieldErrorf("vpc.controlPlaneOnPrivateSubnets", "only one of vpc.controlPlaneSubnetIDs and vpc.controlPlaneOnPrivateSubnets can be specified")
 	}
 
 	// The control plane is already restricted to private subnets on Outposts, where a
@@ -589,18 +589,18 @@ func (c *ClusterConfig) validateControlPlaneOnPrivateSubnets() error {
 	if c.VPC.Subnets == nil {
 		if len(c.AvailabilityZones) > 0 {
 			if azs := sets.New(c.AvailabilityZones...); azs.Len() < MinRequiredAvailabilityZones {
-				return fmt.Errorf("vpc.controlPlaneOnPrivateSubnets requires at least %d distinct availability zones, got %d (%v)", MinRequiredAvailabilityZones, azs.Len(), c.AvailabilityZones)
+				return fieldErrorf("vpc.controlPlaneOnPrivateSubnets", "vpc.controlPlaneOnPrivateSubnets requires at least %d distinct availability zones, got %d (%v)", MinRequiredAvailabilityZones, azs.Len(), c.AvailabilityZones)
 			}
 		}
 		return nil
 	}
 
 	if numPrivate := len(c.VPC.Subnets.Private); numPrivate < MinRequiredSubnets {
-		return fmt.Errorf("vpc.controlPlaneOnPrivateSubnets requires at least %d private subnets, got %d", MinRequiredSubnets, numPrivate)
+		return fieldErrorf("vpc.controlPlaneOnPrivateSubnets", "vpc.controlPlaneOnPrivateSubnets requires at least %d private subnets, got %d", MinRequiredSubnets, numPrivate)
 	}
 
 	if azs := distinctSubnetAZs(c.VPC.Subnets.Private); len(azs) < MinRequiredAvailabilityZones {
-		return fmt.Errorf("vpc.controlPlaneOnPrivateSubnets requires private subnets in at least %d availability zones, got %d (%v)", MinRequiredAvailabilityZones, len(azs), azs)
+		return fieldErrorf("vpc.controlPlaneOnPrivateSubnets", "vpc.controlPlaneOnPrivateSubnets requires private subnets in at least %d availability zones, got %d (%v)", MinRequiredAvailabilityZones, len(azs), azs)
 	}
 
 	return nil
@@ -675,11 +675,11 @@ func (c *ClusterConfig) ipv6CidrsValid() error {
 
 	if c.VPC.IPv6Cidr != "" && c.VPC.IPv6Pool != "" {
 		if c.VPC.ID != "" {
-			return fmt.Errorf("cannot provide VPC.IPv6Cidr when using a pre-existing VPC.ID")
+			return fieldErrorf("vpc.ipv6Cidr", "cannot provide VPC.IPv6Cidr when using a pre-existing VPC.ID")
 		}
 		return nil
 	}
-	return fmt.Errorf("Ipv6Cidr and Ipv6Pool must both be configured to use a custom IPv6 CIDR and address pool")
+	return fieldErrorf("vpc.ipv6Cidr", "Ipv6Cidr and Ipv6Pool must both be configured to use a custom IPv6 CIDR and address pool")
 }
 
 // addonContainsManagedAddons finds managed addons in the config and returns those it couldn't find.
@@ -713,12 +713,12 @@ func (c *ClusterConfig) getAddon(name string) *Addon {
 func (c *ClusterConfig) ValidateClusterEndpointConfig() error {
 	if c.VPC.ClusterEndpoints != nil {
 		if !c.HasClusterEndpointAccess() {
-			return ErrClusterEndpointNoAccess
+			return withFieldPath("vpc.clusterEndpoints", ErrClusterEndpointNoAccess)
 		}
 		endpts := c.VPC.ClusterEndpoints
 
 		if noAccess(endpts) {
-			return ErrClusterEndpointNoAccess
+			return withFieldPath("vpc.clusterEndpoints", ErrClusterEndpointNoAccess)
 		}
 	}
 	return nil
@@ -728,15 +728,15 @@ func (c *ClusterConfig) ValidateClusterEndpointConfig() error {
 func (c *ClusterConfig) ValidatePrivateCluster() error {
 	if c.PrivateCluster.Enabled {
 		if c.VPC != nil && c.VPC.ID != "" && len(c.VPC.Subnets.Private) == 0 {
-			return errors.New("vpc.subnets.private must be specified in a fully-private cluster when a pre-existing VPC is supplied")
+			return fieldErrorf("vpc.subnets.private", "vpc.subnets.private must be specified in a fully-private cluster when a pre-existing VPC is supplied")
 		}
 
 		if additionalEndpoints := c.PrivateCluster.AdditionalEndpointServices; len(additionalEndpoints) > 0 {
 			if c.PrivateCluster.SkipEndpointCreation {
-				return errors.New("privateCluster.additionalEndpointServices cannot be set when privateCluster.skipEndpointCreation is true")
+				return fieldErrorf("privateCluster.additionalEndpointServices", "privateCluster.additionalEndpointServices cannot be set when privateCluster.skipEndpointCreation is true")
 			}
 			if err := ValidateAdditionalEndpointServices(additionalEndpoints); err != nil {
-				return fmt.Errorf("invalid value in privateCluster.additionalEndpointServices: %w", err)
+				return fieldErrorf("privateCluster.additionalEndpointServices", "invalid value in privateCluster.additionalEndpointServices: %w", err)
 			}
 		}
 
@@ -744,7 +744,7 @@ func (c *ClusterConfig) ValidatePrivateCluster() error {
 			c.VPC.ClusterEndpoints = &ClusterEndpoints{}
 		}
 		if len(c.LocalZones) > 0 {
-			return errors.New("localZones cannot be used in a fully-private cluster")
+			return fieldErrorf("localZones", "localZones cannot be used in a fully-private cluster")
 		}
 		// public access is initially enabled to allow running operations that access the Kubernetes API
 		if !c.IsControlPlaneOnOutposts() {
@@ -766,11 +766,11 @@ func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 	}
 	if c.KubernetesNetworkConfig.ServiceIPv4CIDR != "" {
 		if c.IPv6Enabled() {
-			return errors.New("service IPv4 CIDR is not supported with IPv6")
+			return fieldErrorf("kubernetesNetworkConfig.serviceIPv4CIDR", "service IPv4 CIDR is not supported with IPv6")
 		}
 		serviceIP := c.KubernetesNetworkConfig.ServiceIPv4CIDR
 		if _, _, err := net.ParseCIDR(serviceIP); serviceIP != "" && err != nil {
-			return fmt.Errorf("invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR: %w", err)
+			return fieldErrorf("kubernetesNetworkConfig.serviceIPv4CIDR", "invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR: %w", err)
 		}
 	}
 
@@ -779,13 +779,13 @@ func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 	case strings.ToLower(IPV6Family):
 		if !c.IsAutoModeEnabled() {
 			if missing := c.addonContainsManagedAddons([]string{CoreDNSAddon}); len(missing) != 0 {
-				return fmt.Errorf("the default core addons must be defined for IPv6; missing addon(s): %s; either define them or use EKS Auto Mode", strings.Join(missing, ", "))
+				return fieldErrorf("addons", "the default core addons must be defined for IPv6; missing addon(s): %s; either define them or use EKS Auto Mode", strings.Join(missing, ", "))
 			}
 
 			if vpcCNIAddonEntry := c.getAddon(VPCCNIAddon); vpcCNIAddonEntry != nil {
 				// Check if at least one credential provider (Pod identity or IRSA) is configured
 				if len(c.addonContainsManagedAddons([]string{PodIdentityAgentAddon})) != 0 && (c.IAM == nil || c.IAM != nil && IsDisabled(c.IAM.WithOIDC)) {
-					return errors.New("either pod identity or oidc needs to be enabled if IPv6 is set; set either one or use EKS Auto Mode")
+					return fieldErrorf("kubernetesNetworkConfig.ipFamily", "either pod identity or oidc needs to be enabled if IPv6 is set; set either one or use EKS Auto Mode")
 				}
 
 				// If the pod identity addon is present, verify it is correctly configured for use by the VPC CNI addon
@@ -793,7 +793,7 @@ func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 				if len(c.addonContainsManagedAddons([]string{PodIdentityAgentAddon})) == 0 && !c.AddonsConfig.AutoApplyPodIdentityAssociations {
 					if !vpcCNIAddonEntry.UseDefaultPodIdentityAssociations &&
 						(vpcCNIAddonEntry.PodIdentityAssociations == nil || len(*vpcCNIAddonEntry.PodIdentityAssociations) == 0) {
-						return fmt.Errorf("Set one of: addonsConfig.autoApplyPodIdentityAssociations, useDefaultPodIdentityAssociations on the vpc-cni addon, apply a custom pod identity on the vpc-cni addon")
+						return fieldErrorf("addons", "Set one of: addonsConfig.autoApplyPodIdentityAssociations, useDefaultPodIdentityAssociations on the vpc-cni addon, apply a custom pod identity on the vpc-cni addon")
 					}
 				}
 			}
@@ -801,20 +801,20 @@ func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 
 		unsupportedVersion, err := c.unsupportedVPCCNIAddonVersion()
 		if err != nil {
-			return err
+			return withFieldPath("addons", err)
 		}
 
 		if unsupportedVersion {
-			return fmt.Errorf("%s version must be at least version %s for IPv6", VPCCNIAddon, minimumVPCCNIVersionForIPv6)
+			return fieldErrorf("addons", "%s version must be at least version %s for IPv6", VPCCNIAddon, minimumVPCCNIVersionForIPv6)
 		}
 
 		if version, err := utils.CompareVersions(c.Metadata.Version, Version1_21); err != nil {
-			return fmt.Errorf("failed to convert %s cluster version to semver: %w", c.Metadata.Version, err)
+			return fieldErrorf("metadata.version", "failed to convert %s cluster version to semver: %w", c.Metadata.Version, err)
 		} else if version == -1 {
-			return fmt.Errorf("cluster version must be >= %s", Version1_21)
+			return fieldErrorf("metadata.version", "cluster version must be >= %s", Version1_21)
 		}
 	default:
-		return fmt.Errorf("invalid value %q for ipFamily; allowed are %s and %s", c.KubernetesNetworkConfig.IPFamily, IPV4Family, IPV6Family)
+		return fieldErrorf("kubernetesNetworkConfig.ipFamily", "invalid value %q for ipFamily; allowed are %s and %s", c.KubernetesNetworkConfig.IPFamily, IPV4Family, IPV6Family)
 	}
 
 	return nil
@@ -834,7 +834,7 @@ func validateNodeGroupBase(np NodePool, path string, controlPlaneOnOutposts bool
 	ng := np.BaseNodeGroup()
 	if ng.VolumeSize == nil {
 		errCantSet := func(field string) error {
-			return fmt.Errorf("%s.%s cannot be set without %s.volumeSize", path, field, path)
+			return fieldErrorf(path+"."+field, "%s.%s cannot be set without %s.volumeSize", path, field, path)
 		}
 		if IsSetAndNonEmptyString(ng.VolumeName) {
 			return errCantSet("volumeName")
@@ -853,16 +853,16 @@ func validateNodeGroupBase(np NodePool, path string, controlPlaneOnOutposts bool
 
 	if ng.VolumeEncrypted == nil || IsDisabled(ng.VolumeEncrypted) {
 		if IsSetAndNonEmptyString(ng.VolumeKmsKeyID) {
-			return fmt.Errorf("%s.volumeKmsKeyID can not be set without %s.volumeEncrypted enabled explicitly", path, path)
+			return fieldErrorf(path+".volumeKmsKeyID", "%s.volumeKmsKeyID can not be set without %s.volumeEncrypted enabled explicitly", path, path)
 		}
 	}
 	if ng.MaxPodsPerNode < 0 {
-		return fmt.Errorf("%s.maxPodsPerNode cannot be negative", path)
+		return fieldErrorf(path+".maxPodsPerNode", "%s.maxPodsPerNode cannot be negative", path)
 	}
 
 	if IsEnabled(ng.DisablePodIMDS) && ng.IAM != nil {
 		fmtFieldConflictErr := func(_ string) error {
-			return fmt.Errorf("%s.disablePodIMDS and %s.iam.withAddonPolicies cannot be set at the same time", path, path)
+			return fieldErrorf(path+".disablePodIMDS", "%s.disablePodIMDS and %s.iam.withAddonPolicies cannot be set at the same time", path, path)
 		}
 		if err := validateNodeGroupIAMWithAddonPolicies(ng.IAM.WithAddonPolicies, fmtFieldConflictErr); err != nil {
 			return err
@@ -870,37 +870,37 @@ func validateNodeGroupBase(np NodePool, path string, controlPlaneOnOutposts bool
 	}
 
 	if len(ng.AvailabilityZones) > 0 && len(ng.Subnets) > 0 {
-		return fmt.Errorf("only one of %[1]s.subnets or %[1]s.availabilityZones should be set", path)
+		return fieldErrorf(path+".subnets", "only one of %[1]s.subnets or %[1]s.availabilityZones should be set", path)
 	}
 
 	if ng.Placement != nil {
 		if ng.Placement.GroupName == "" {
-			return fmt.Errorf("%s.placement.groupName must be set and non-empty", path)
+			return fieldErrorf(path+".placement.groupName", "%s.placement.groupName must be set and non-empty", path)
 		}
 	}
 
 	if IsEnabled(ng.EFAEnabled) {
 		if len(ng.AvailabilityZones) > 1 || len(ng.Subnets) > 1 {
-			return fmt.Errorf("%s.efaEnabled nodegroups must have only one subnet or one availability zone", path)
+			return fieldErrorf(path+".efaEnabled", "%s.efaEnabled nodegroups must have only one subnet or one availability zone", path)
 		}
 	}
 
 	if ng.AMIFamily != "" {
 		if !isSupportedAMIFamily(ng.AMIFamily) {
 			if ng.AMIFamily == NodeImageFamilyWindowsServer20H2CoreContainer || ng.AMIFamily == NodeImageFamilyWindowsServer2004CoreContainer {
-				return fmt.Errorf("AMI Family %s is deprecated. For more information, head to the Amazon documentation on Windows AMIs (https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-windows-ami.html)", ng.AMIFamily)
+				return fieldErrorf(path+".amiFamily", "AMI Family %s is deprecated. For more information, head to the Amazon documentation on Windows AMIs (https://docs.aws.amazon.com/eks/latest/userguide/eks-optimized-windows-ami.html)", ng.AMIFamily)
 			}
-			return fmt.Errorf("AMI Family %s is not supported - use one of: %s", ng.AMIFamily, strings.Join(SupportedAMIFamilies(), ", "))
+			return fieldErrorf(path+".amiFamily", "AMI Family %s is not supported - use one of: %s", ng.AMIFamily, strings.Join(SupportedAMIFamilies(), ", "))
 		}
 		if controlPlaneOnOutposts && (ng.AMIFamily != NodeImageFamilyAmazonLinux2 && ng.AMIFamily != NodeImageFamilyAmazonLinux2023) {
-			return fmt.Errorf("only %s and %s is supported on local clusters", NodeImageFamilyAmazonLinux2, NodeImageFamilyAmazonLinux2023)
+			return fieldErrorf(path+".amiFamily", "only %s and %s is supported on local clusters", NodeImageFamilyAmazonLinux2, NodeImageFamilyAmazonLinux2023)
 		}
 	}
 
 	if ng.SSH != nil {
 		if enableSSM := ng.SSH.EnableSSM; enableSSM != nil {
 			if !*enableSSM {
-				return errors.New("SSM agent is now built into EKS AMIs and cannot be disabled")
+				return fieldErrorf(path+".ssh.enableSSM", "SSM agent is now built into EKS AMIs and cannot be disabled")
 			}
 			logger.Warning("SSM is now enabled by default; `ssh.enableSSM` is deprecated and will be removed in a future release")
 		}
@@ -931,41 +931,41 @@ func validateNodeGroupBase(np NodePool, path string, controlPlaneOnOutposts bool
 		ng.AMIFamily != "" {
 		// Only AL2, AL2023 and Bottlerocket support Inferentia hosts.
 		if instanceutils.IsInferentiaInstanceType(instanceType) {
-			return ErrUnsupportedInstanceTypes("Inferentia", ng.AMIFamily, fmt.Sprintf("please use %s instead", NodeImageFamilyAmazonLinux2))
+			return withFieldPath(path+".amiFamily", ErrUnsupportedInstanceTypes("Inferentia", ng.AMIFamily, fmt.Sprintf("please use %s instead", NodeImageFamilyAmazonLinux2)))
 		}
 		// Only AL2, AL2023 and Bottlerocket support Trainium hosts.
 		if instanceutils.IsTrainiumInstanceType(instanceType) {
-			return ErrUnsupportedInstanceTypes("Trainium", ng.AMIFamily, fmt.Sprintf("please use %s instead", NodeImageFamilyAmazonLinux2))
+			return withFieldPath(path+".amiFamily", ErrUnsupportedInstanceTypes("Trainium", ng.AMIFamily, fmt.Sprintf("please use %s instead", NodeImageFamilyAmazonLinux2)))
 		}
 	}
 
 	if ng.CapacityReservation != nil {
 		if ng.CapacityReservation.CapacityReservationPreference != nil {
 			if ng.CapacityReservation.CapacityReservationTarget != nil {
-				return errors.New("only one of CapacityReservationPreference or CapacityReservationTarget may be specified at a time")
+				return fieldErrorf(path+".capacityReservation", "only one of CapacityReservationPreference or CapacityReservationTarget may be specified at a time")
 			}
 
 			if *ng.CapacityReservation.CapacityReservationPreference != OpenCapacityReservation && *ng.CapacityReservation.CapacityReservationPreference != NoneCapacityReservation {
-				return fmt.Errorf(`accepted values include "open" and "none"; got "%s"`, *ng.CapacityReservation.CapacityReservationPreference)
+				return fieldErrorf(path+".capacityReservation.capacityReservationPreference", `accepted values include "open" and "none"; got "%s"`, *ng.CapacityReservation.CapacityReservationPreference)
 			}
 		}
 
 		if ng.CapacityReservation.CapacityReservationTarget != nil {
 			if ng.CapacityReservation.CapacityReservationTarget.CapacityReservationID != nil && ng.CapacityReservation.CapacityReservationTarget.CapacityReservationResourceGroupARN != nil {
-				return errors.New("only one of CapacityReservationID or CapacityReservationResourceGroupARN may be specified at a time")
+				return fieldErrorf(path+".capacityReservation.capacityReservationTarget", "only one of CapacityReservationID or CapacityReservationResourceGroupARN may be specified at a time")
 			}
 		}
 
 		if ng.InstanceMarketOptions != nil {
 			if ng.InstanceMarketOptions.MarketType != nil {
 				if *ng.InstanceMarketOptions.MarketType != "capacity-block" {
-					return fmt.Errorf(`only accepted value is "capacity-block"; got "%s"`, *ng.InstanceMarketOptions.MarketType)
+					return fieldErrorf(path+".instanceMarketOptions.marketType", `only accepted value is "capacity-block"; got "%s"`, *ng.InstanceMarketOptions.MarketType)
 				}
 			}
 		}
 	} else {
 		if ng.InstanceMarketOptions != nil {
-			return errors.New("instanceMarketOptions cannot be set without capacityReservation")
+			return fieldErrorf(path+".instanceMarketOptions", "instanceMarketOptions cannot be set without capacityReservation")
 		}
 	}
 
@@ -976,54 +976,54 @@ func validateVolumeOpts(ng *NodeGroupBase, path string, controlPlaneOnOutposts b
 	if ng.VolumeType != nil {
 		volumeType := *ng.VolumeType
 		if ng.VolumeIOPS != nil && volumeType != NodeVolumeTypeIO1 && volumeType != NodeVolumeTypeIO2 && volumeType != NodeVolumeTypeGP3 {
-			return fmt.Errorf("%s.volumeIOPS is only supported for %s, %s and %s volume types", path, NodeVolumeTypeIO1, NodeVolumeTypeIO2, NodeVolumeTypeGP3)
+			return fieldErrorf(path+".volumeIOPS", "%s.volumeIOPS is only supported for %s, %s and %s volume types", path, NodeVolumeTypeIO1, NodeVolumeTypeIO2, NodeVolumeTypeGP3)
 		}
 
 		if volumeType == NodeVolumeTypeIO1 {
 			if ng.VolumeIOPS != nil && (*ng.VolumeIOPS < MinIO1Iops || *ng.VolumeIOPS > MaxIO1Iops) {
-				return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinIO1Iops, MaxIO1Iops)
+				return fieldErrorf(path+".volumeIOPS", "value for %s.volumeIOPS must be within range %d-%d", path, MinIO1Iops, MaxIO1Iops)
 			}
 		}
 
 		if volumeType == NodeVolumeTypeIO2 {
 			if ng.VolumeIOPS != nil && (*ng.VolumeIOPS < MinIO2Iops || *ng.VolumeIOPS > MaxIO2Iops) {
-				return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinIO2Iops, MaxIO2Iops)
+				return fieldErrorf(path+".volumeIOPS", "value for %s.volumeIOPS must be within range %d-%d", path, MinIO2Iops, MaxIO2Iops)
 			}
 		}
 
 		if ng.VolumeThroughput != nil && volumeType != NodeVolumeTypeGP3 {
-			return fmt.Errorf("%s.volumeThroughput is only supported for %s volume type", path, NodeVolumeTypeGP3)
+			return fieldErrorf(path+".volumeThroughput", "%s.volumeThroughput is only supported for %s volume type", path, NodeVolumeTypeGP3)
 		}
 
 		if controlPlaneOnOutposts && volumeType != NodeVolumeTypeGP2 {
-			return fmt.Errorf("cannot set %q for %s.volumeType; only %q volume types are supported on Outposts", volumeType, path, NodeVolumeTypeGP2)
+			return fieldErrorf(path+".volumeType", "cannot set %q for %s.volumeType; only %q volume types are supported on Outposts", volumeType, path, NodeVolumeTypeGP2)
 		}
 	}
 
 	if ng.VolumeType == nil || *ng.VolumeType == NodeVolumeTypeGP3 {
 		if ng.VolumeIOPS != nil && (*ng.VolumeIOPS < MinGP3Iops || *ng.VolumeIOPS > MaxGP3Iops) {
-			return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinGP3Iops, MaxGP3Iops)
+			return fieldErrorf(path+".volumeIOPS", "value for %s.volumeIOPS must be within range %d-%d", path, MinGP3Iops, MaxGP3Iops)
 		}
 
 		if ng.VolumeThroughput != nil && (*ng.VolumeThroughput < MinThroughput || *ng.VolumeThroughput > MaxThroughput) {
-			return fmt.Errorf("value for %s.volumeThroughput must be within range %d-%d", path, MinThroughput, MaxThroughput)
+			return fieldErrorf(path+".volumeThroughput", "value for %s.volumeThroughput must be within range %d-%d", path, MinThroughput, MaxThroughput)
 		}
 	}
 
 	return nil
 }
 
-func validateIdentityProvider(idP IdentityProvider) error {
+func validateIdentityProvider(idP IdentityProvider, path string) error {
 	switch idP := (idP.Inner).(type) {
 	case *OIDCIdentityProvider:
 		if idP.Name == "" {
-			return setNonEmpty("name")
+			return withFieldPath(path+".name", setNonEmpty("name"))
 		}
 		if idP.ClientID == "" {
-			return setNonEmpty("clientID")
+			return withFieldPath(path+".clientID", setNonEmpty("clientID"))
 		}
 		if idP.IssuerURL == "" {
-			return setNonEmpty("issuerURL")
+			return withFieldPath(path+".issuerURL", setNonEmpty("issuerURL"))
 		}
 	}
 	return nil
@@ -1031,13 +1031,40 @@ func validateIdentityProvider(idP IdentityProvider) error {
 
 func validateIdentityProviders(idPs []IdentityProvider) error {
 	for k, idP := range idPs {
-		if err := validateIdentityProvider(idP); err != nil {
+		if err := validateIdentityProvider(idP, fmt.Sprintf("identityProviders[%d]", k)); err != nil {
 			return fmt.Errorf("identityProviders[%d] is invalid: %w", k, err)
 		}
 	}
 	return nil
 }
 
+// A FieldError is an error about the field at Path, e.g. `managedNodeGroups[1].iam.instanceProfileARN`,
+// which allows pointing at the field in the config file the ClusterConfig was loaded from.
+// Path may name a field that is not set in the config file, in which case the closest parent that is set
+// is pointed at.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// fieldErrorf returns a FieldError about the field at path, formatting its error like fmt.Errorf
+func fieldErrorf(path, format string, args ...interface{}) error {
+	return &FieldError{Path: path, Err: fmt.Errorf(format, args...)}
+}
+
+// withFieldPath returns err as a FieldError about the field at path
+func withFieldPath(path string, err error) error {
+	return &FieldError{Path: path, Err: err}
+}
+
 type unsupportedFieldError struct {
 	ng    *NodeGroupBase
 	path  string
@@ -1076,7 +1103,7 @@ func ValidateNodeGroup(i int, ng *NodeGroup, cfg *ClusterConfig) error {
 	}
 
 	if err := validateNodeGroupName(ng.Name); err != nil {
-		return err
+		return withFieldPath(path+".name", err)
 	}
 
 	if ng.IAM != nil {
@@ -1089,22 +1116,22 @@ func ValidateNodeGroup(i int, ng *NodeGroup, cfg *ClusterConfig) error {
 		if attachPolicyARNs := ng.IAM.AttachPolicyARNs; len(attachPolicyARNs) > 0 {
 			for _, policyARN := range attachPolicyARNs {
 				if _, err := arn.Parse(policyARN); err != nil {
-					return fmt.Errorf("invalid ARN %q in %s.iam.attachPolicyARNs: %w", policyARN, path, err)
+					return fieldErrorf(path+".iam.attachPolicyARNs", "invalid ARN %q in %s.iam.attachPolicyARNs: %w", policyARN, path, err)
 				}
 
 			}
 		}
 		if err := validateDeprecatedIAMFields(ng.IAM); err != nil {
-			return err
+			return withFieldPath(path+".iam.withAddonPolicies", err)
 		}
 	}
 
 	if ng.AMI != "" && ng.AMIFamily == "" {
-		return errors.New("when using a custom AMI, amiFamily needs to be explicitly set via config file or via --node-ami-family flag")
+		return fieldErrorf(path+".ami", "when using a custom AMI, amiFamily needs to be explicitly set via config file or via --node-ami-family flag")
 	}
 
 	if ng.Bottlerocket != nil && !IsBottlerocketImage(ng.AMIFamily) {
-		return fmt.Errorf(`bottlerocket config can only be used with amiFamily "Bottlerocket" or "BottlerocketFips" but found "%s" (path=%s.bottlerocket)`,
+		return fieldErrorf(path+".bottlerocket", `bottlerocket config can only be used with amiFamily "Bottlerocket" or "BottlerocketFips" but found "%s" (path=%s.bottlerocket)`,
 			ng.AMIFamily, path)
 	}
 
@@ -1112,29 +1139,29 @@ func ValidateNodeGroup(i int, ng *NodeGroup, cfg *ClusterConfig) error {
 		ng.AMIFamily != NodeImageFamilyAmazonLinux2023 &&
 		!IsBottlerocketImage(ng.AMIFamily) &&
 		!IsWindowsImage(ng.AMIFamily) {
-		return fmt.Errorf("%[1]s.overrideBootstrapCommand is required when using a custom AMI based on %s (%[1]s.ami)", path, ng.AMIFamily)
+		return fieldErrorf(path+".ami", "%[1]s.overrideBootstrapCommand is required when using a custom AMI based on %s (%[1]s.ami)", path, ng.AMIFamily)
 	}
 
 	if err := validateTaints(ng.Taints); err != nil {
-		return err
+		return withFieldPath(path+".taints", err)
 	}
 
 	if err := validateLabels(ng.Labels); err != nil {
-		return err
+		return withFieldPath(path+".labels", err)
 	}
 
 	if ng.SSH != nil {
 		if err := validateNodeGroupSSH(ng.SSH); err != nil {
-			return err
+			return withFieldPath(path+".ssh", err)
 		}
 	}
 
 	fieldNotSupported := func(field string) error {
-		return &unsupportedFieldError{
+		return withFieldPath(path+"."+field, &unsupportedFieldError{
 			ng:    ng.NodeGroupBase,
 			path:  path,
 			field: field,
-		}
+		})
 	}
 
 	if IsWindowsImage(ng.AMIFamily) {
@@ -1157,31 +1184,31 @@ func ValidateNodeGroup(i int, ng *NodeGroup, cfg *ClusterConfig) error {
 			}
 		}
 	} else if err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig); err != nil {
-		return err
+		return withFieldPath(path+".kubeletExtraConfig", err)
 	}
 
 	if err := validateInstanceTypeSupport(ng); err != nil {
-		return err
+		return withFieldPath(path+".instanceType", err)
 	}
 
 	if err := validateInstancesDistribution(ng); err != nil {
-		return err
+		return withFieldPath(path+".instancesDistribution", err)
 	}
 
 	if err := validateCPUCredits(ng); err != nil {
-		return err
+		return withFieldPath(path+".cpuCredits", err)
 	}
 
 	if err := validateASGSuspendProcesses(ng); err != nil {
-		return err
+		return withFieldPath(path+".asgSuspendProcesses", err)
 	}
 
 	if ng.ContainerRuntime != nil {
 		if ng.AMIFamily == NodeImageFamilyAmazonLinux2023 && *ng.ContainerRuntime != ContainerRuntimeContainerD {
-			return fmt.Errorf("only %s is supported for container runtime on %s nodes", ContainerRuntimeContainerD, NodeImageFamilyAmazonLinux2023)
+			return fieldErrorf(path+".containerRuntime", "only %s is supported for container runtime on %s nodes", ContainerRuntimeContainerD, NodeImageFamilyAmazonLinux2023)
 		}
 		if *ng.ContainerRuntime != ContainerRuntimeDockerD && *ng.ContainerRuntime != ContainerRuntimeContainerD && *ng.ContainerRuntime != ContainerRuntimeDockerForWindows {
-			return fmt.Errorf("only %s, %s and %s are supported for container runtime", ContainerRuntimeContainerD, ContainerRuntimeDockerD, ContainerRuntimeDockerForWindows)
+			return fieldErrorf(path+".containerRuntime", "only %s, %s and %s are supported for container runtime", ContainerRuntimeContainerD, ContainerRuntimeDockerD, ContainerRuntimeDockerForWindows)
 		}
 		if clusterVersion := cfg.Metadata.Version; clusterVersion != "" {
 			isDockershimDeprecated, err := utils.IsMinVersion(DockershimDeprecationVersion, clusterVersion)
@@ -1189,43 +1216,43 @@ func ValidateNodeGroup(i int, ng *NodeGroup, cfg *ClusterConfig) error {
 				return err
 			}
 			if *ng.ContainerRuntime != ContainerRuntimeContainerD && isDockershimDeprecated {
-				return fmt.Errorf("only %s is supported for container runtime, starting with EKS version %s", ContainerRuntimeContainerD, DockershimDeprecationVersion)
+				return fieldErrorf(path+".containerRuntime", "only %s is supported for container runtime, starting with EKS version %s", ContainerRuntimeContainerD, DockershimDeprecationVersion)
 			}
 		}
 		if ng.OverrideBootstrapCommand != nil {
-			return fmt.Errorf("overrideBootstrapCommand overwrites container runtime setting; please use --container-runtime in the bootsrap script instead")
+			return fieldErrorf(path+".containerRuntime", "overrideBootstrapCommand overwrites container runtime setting; please use --container-runtime in the bootsrap script instead")
 		}
 	}
 
 	if ng.MaxInstanceLifetime != nil {
 		if *ng.MaxInstanceLifetime < OneDay {
-			return fmt.Errorf("maximum instance lifetime must have a minimum value of 86,400 seconds (one day), but was: %d", *ng.MaxInstanceLifetime)
+			return fieldErrorf(path+".maxInstanceLifetime", "maximum instance lifetime must have a minimum value of 86,400 seconds (one day), but was: %d", *ng.MaxInstanceLifetime)
 		}
 	}
 
 	if len(ng.LocalZones) > 0 && len(ng.AvailabilityZones) > 0 {
-		return errors.New("cannot specify both localZones and availabilityZones")
+		return fieldErrorf(path+".localZones", "cannot specify both localZones and availabilityZones")
 	}
 
 	if ng.OutpostARN != "" {
 		if err := validateOutpostARN(ng.OutpostARN); err != nil {
-			return err
+			return withFieldPath(path+".outpostARN", err)
 		}
 		if cfg.IsControlPlaneOnOutposts() && ng.OutpostARN != cfg.GetOutpost().ControlPlaneOutpostARN {
-			return fmt.Errorf("nodeGroup.outpostARN must either be empty or match the control plane's Outpost ARN (%q != %q)", ng.OutpostARN, cfg.GetOutpost().ControlPlaneOutpostARN)
+			return fieldErrorf(path+".outpostARN", "nodeGroup.outpostARN must either be empty or match the control plane's Outpost ARN (%q != %q)", ng.OutpostARN, cfg.GetOutpost().ControlPlaneOutpostARN)
 		}
 	}
 
 	if cfg.IsControlPlaneOnOutposts() || ng.OutpostARN != "" {
 		if ng.InstanceSelector != nil && !ng.InstanceSelector.IsZero() {
-			return errors.New("cannot specify instanceSelector for a nodegroup on Outposts")
+			return fieldErrorf(path+".instanceSelector", "cannot specify instanceSelector for a nodegroup on Outposts")
 		}
 		const msg = "%s cannot be specified for a nodegroup on Outposts; the AZ defaults to the Outpost AZ"
 		if len(ng.AvailabilityZones) > 0 {
-			return fmt.Errorf(msg, "availabilityZones")
+			return fieldErrorf(path+".availabilityZones", msg, "availabilityZones")
 		}
 		if len(ng.LocalZones) > 0 {
-			return fmt.Errorf(msg, "localZones")
+			return fieldErrorf(path+".localZones", msg, "localZones")
 		}
 	}
 
@@ -1368,7 +1395,7 @@ func validateDeprecatedIAMFields(iam *NodeGroupIAM) error {
 func validateNodeGroupIAM(iam *NodeGroupIAM, value, fieldName, path string) error {
 	if value != "" {
 		fmtFieldConflictErr := func(conflictingField string) error {
-			return fmt.Errorf("%s.iam.%s and %s.iam.%s cannot be set at the same time", path, fieldName, path, conflictingField)
+			return fieldErrorf(path+".iam."+fieldName, "%s.iam.%s and %s.iam.%s cannot be set at the same time", path, fieldName, path, conflictingField)
 		}
 
 		if iam.InstanceRoleName != "" {
@@ -1405,7 +1432,7 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 		}
 
 		errNotSupported := func(field string) error {
-			return fmt.Errorf("%s is not supported for Managed Nodes (%s.%s)", field, path, field)
+			return fieldErrorf(path+".iam."+field, "%s is not supported for Managed Nodes (%s.%s)", field, path, field)
 		}
 
 		if ng.IAM.InstanceProfileARN != "" {
@@ -1414,7 +1441,7 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 	}
 
 	if ng.OutpostARN != "" {
-		return errors.New("Outposts is not supported for managed nodegroups")
+		return fieldErrorf(path+".outpostARN", "Outposts is not supported for managed nodegroups")
 	}
 
 	// TODO fix error messages to not use CLI flags
@@ -1426,7 +1453,7 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 			ng.MinSize = ng.DesiredCapacity
 		}
 	} else if ng.DesiredCapacity != nil && *ng.DesiredCapacity < *ng.MinSize {
-		return fmt.Errorf("cannot use --nodes-min=%d and --nodes=%d at the same time", *ng.MinSize, *ng.DesiredCapacity)
+		return fieldErrorf(path+".minSize", "cannot use --nodes-min=%d and --nodes=%d at the same time", *ng.MinSize, *ng.DesiredCapacity)
 	}
 
 	// Ensure MaxSize is set, as it is required by the ASG CFN resource
@@ -1442,9 +1469,9 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 			ng.MaxSize = &defaultMaxSize
 		}
 	} else if ng.DesiredCapacity != nil && *ng.DesiredCapacity > *ng.MaxSize {
-		return fmt.Errorf("cannot use --nodes-max=%d and --nodes=%d at the same time", *ng.MaxSize, *ng.DesiredCapacity)
+		return fieldErrorf(path+".maxSize", "cannot use --nodes-max=%d and --nodes=%d at the same time", *ng.MaxSize, *ng.DesiredCapacity)
 	} else if *ng.MaxSize < *ng.MinSize {
-		return fmt.Errorf("cannot use --nodes-min=%d and --nodes-max=%d at the same time", *ng.MinSize, *ng.MaxSize)
+		return fieldErrorf(path+".maxSize", "cannot use --nodes-min=%d and --nodes-max=%d at the same time", *ng.MinSize, *ng.MaxSize)
 	}
 
 	if ng.DesiredCapacity == nil {
@@ -1453,36 +1480,36 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 
 	if ng.UpdateConfig != nil {
 		if ng.UpdateConfig.MaxUnavailable == nil && ng.UpdateConfig.MaxUnavailablePercentage == nil {
-			return fmt.Errorf("invalid UpdateConfig: maxUnavailable or maxUnavailablePercentage must be defined")
+			return fieldErrorf(path+".updateConfig", "invalid UpdateConfig: maxUnavailable or maxUnavailablePercentage must be defined")
 		}
 		if ng.UpdateConfig.MaxUnavailable != nil && ng.UpdateConfig.MaxUnavailablePercentage != nil {
-			return fmt.Errorf("cannot use maxUnavailable=%d and maxUnavailablePercentage=%d at the same time", *ng.UpdateConfig.MaxUnavailable, *ng.UpdateConfig.MaxUnavailablePercentage)
+			return fieldErrorf(path+".updateConfig", "cannot use maxUnavailable=%d and maxUnavailablePercentage=%d at the same time", *ng.UpdateConfig.MaxUnavailable, *ng.UpdateConfig.MaxUnavailablePercentage)
 		}
 		if aws.ToInt(ng.UpdateConfig.MaxUnavailable) > aws.ToInt(ng.MaxSize) {
-			return fmt.Errorf("maxUnavailable=%d cannot be greater than maxSize=%d", *ng.UpdateConfig.MaxUnavailable, *ng.MaxSize)
+			return fieldErrorf(path+".updateConfig.maxUnavailable", "maxUnavailable=%d cannot be greater than maxSize=%d", *ng.UpdateConfig.MaxUnavailable, *ng.MaxSize)
 		}
 	}
 
 	if IsEnabled(ng.SecurityGroups.WithLocal) || IsEnabled(ng.SecurityGroups.WithShared) {
-		return fmt.Errorf("securityGroups.withLocal and securityGroups.withShared are not supported for managed nodegroups (%s.securityGroups)", path)
+		return fieldErrorf(path+".securityGroups", "securityGroups.withLocal and securityGroups.withShared are not supported for managed nodegroups (%s.securityGroups)", path)
 	}
 
 	if ng.InstanceType != "" {
 		if len(ng.InstanceTypes) > 0 {
-			return fmt.Errorf("only one of instanceType or instanceTypes can be specified (%s)", path)
+			return fieldErrorf(path+".instanceTypes", "only one of instanceType or instanceTypes can be specified (%s)", path)
 		}
 		if !ng.InstanceSelector.IsZero() {
-			return fmt.Errorf("cannot set instanceType when instanceSelector is specified (%s)", path)
+			return fieldErrorf(path+".instanceType", "cannot set instanceType when instanceSelector is specified (%s)", path)
 		}
 	}
 
 	if IsBottlerocketImage(ng.AMIFamily) {
 		fieldNotSupported := func(field string) error {
-			return &unsupportedFieldError{
+			return withFieldPath(path+"."+field, &unsupportedFieldError{
 				ng:    ng.NodeGroupBase,
 				path:  path,
 				field: field,
-			}
+			})
 		}
 		if ng.PreBootstrapCommands != nil {
 			return fieldNotSupported("preBootstrapCommands")
@@ -1495,11 +1522,11 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 	// Windows doesn't use overrideBootstrapCommand, as it always uses bootstrapping script that comes with Windows AMIs
 	if IsWindowsImage(ng.AMIFamily) {
 		fieldNotSupported := func(field string) error {
-			return &unsupportedFieldError{
+			return withFieldPath(path+"."+field, &unsupportedFieldError{
 				ng:    ng.NodeGroupBase,
 				path:  path,
 				field: field,
-			}
+			})
 		}
 		if ng.OverrideBootstrapCommand != nil {
 			return fieldNotSupported("overrideBootstrapCommand")
@@ -1507,27 +1534,27 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 	}
 
 	if err := validateTaints(ng.Taints); err != nil {
-		return err
+		return withFieldPath(path+".taints", err)
 	}
 
 	if err := validateLabels(ng.Labels); err != nil {
-		return err
+		return withFieldPath(path+".labels", err)
 	}
 
 	switch {
 	case ng.LaunchTemplate != nil:
 		if ng.LaunchTemplate.ID == "" {
-			return fmt.Errorf("launchTemplate.id is required if launchTemplate is set (%s.%s)", path, "launchTemplate")
+			return fieldErrorf(path+".launchTemplate", "launchTemplate.id is required if launchTemplate is set (%s.%s)", path, "launchTemplate")
 		}
 
 		if ng.LaunchTemplate.Version != nil {
 			// TODO support `latest` and `default`
 			versionNumber, err := strconv.ParseInt(*ng.LaunchTemplate.Version, 10, 64)
 			if err != nil {
-				return fmt.Errorf("invalid launch template version: %w", err)
+				return fieldErrorf(path+".launchTemplate.version", "invalid launch template version: %w", err)
 			}
 			if versionNumber < 1 {
-				return fmt.Errorf("launchTemplate.version must be >= 1 (%s.launchTemplate.version)", path)
+				return fieldErrorf(path+".launchTemplate.version", "launchTemplate.version must be >= 1 (%s.launchTemplate.version)", path)
 			}
 		}
 
@@ -1541,25 +1568,25 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 				"volumeSize", "instanceName", "instancePrefix", "maxPodsPerNode", "disableIMDSv1",
 				"disablePodIMDS", "preBootstrapCommands", "overrideBootstrapCommand", "placement",
 			}
-			return fmt.Errorf("cannot set %s in managedNodeGroup when a launch template is supplied", strings.Join(incompatibleFields, ", "))
+			return fieldErrorf(path+".launchTemplate", "cannot set %s in managedNodeGroup when a launch template is supplied", strings.Join(incompatibleFields, ", "))
 		}
 
 	case ng.AMI != "":
 		if !IsAMI(ng.AMI) {
-			return fmt.Errorf("invalid AMI %q (%s.%s)", ng.AMI, path, "ami")
+			return fieldErrorf(path+".ami", "invalid AMI %q (%s.%s)", ng.AMI, path, "ami")
 		}
 		if ng.AMIFamily == "" {
-			return errors.New("when using a custom AMI, amiFamily needs to be explicitly set via config file or via --node-ami-family flag")
+			return fieldErrorf(path+".ami", "when using a custom AMI, amiFamily needs to be explicitly set via config file or via --node-ami-family flag")
 		}
 		if !IsAmazonLinuxImage(ng.AMIFamily) && !IsBottlerocketImage(ng.AMIFamily) && !IsUbuntuImage(ng.AMIFamily) {
-			return fmt.Errorf("cannot set amiFamily to %s when using a custom AMI for managed nodes, only %s are supported", ng.AMIFamily,
+			return fieldErrorf(path+".amiFamily", "cannot set amiFamily to %s when using a custom AMI for managed nodes, only %s are supported", ng.AMIFamily,
 				strings.Join(slices.Concat(SupportedAmazonLinuxImages, SupportedBottlerocketImages, SupportedUbuntuImages), ", "))
 		}
 		if ng.OverrideBootstrapCommand == nil && ng.AMIFamily != NodeImageFamilyAmazonLinux2023 && !IsBottlerocketImage(ng.AMIFamily) {
-			return fmt.Errorf("%[1]s.overrideBootstrapCommand is required when using a custom AMI based on %s (%[1]s.ami)", path, ng.AMIFamily)
+			return fieldErrorf(path+".ami", "%[1]s.overrideBootstrapCommand is required when using a custom AMI based on %s (%[1]s.ami)", path, ng.AMIFamily)
 		}
 		notSupportedWithCustomAMIErr := func(field string) error {
-			return fmt.Errorf("%s.%s is not supported when using a custom AMI (%s.ami)", path, field, path)
+			return fieldErrorf(path+"."+field, "%s.%s is not supported when using a custom AMI (%s.ami)", path, field, path)
 		}
 		if ng.MaxPodsPerNode != 0 {
 			return notSupportedWithCustomAMIErr("maxPodsPerNode")
@@ -1572,7 +1599,7 @@ func ValidateManagedNodeGroup(index int, ng *ManagedNodeGroup) error {
 		}
 
 	case ng.OverrideBootstrapCommand != nil && ng.AMIFamily != NodeImageFamilyAmazonLinux2023:
-		return fmt.Errorf("%s.overrideBootstrapCommand can only be set when a custom AMI (%s.ami) is specified", path, path)
+		return fieldErrorf(path+".overrideBootstrapCommand", "%s.overrideBootstrapCommand can only be set when a custom AMI (%s.ami) is specified", path, path)
 	}
 
 	return nil
@@ -1881,7 +1908,7 @@ func (fps FargateProfileSelector) Validate() error {
 
 func checkBottlerocketSettings(ng *NodeGroup, path string) error {
 	overlapErr := func(kubernetesField, ngField string) error {
-		return fmt.Errorf("invalid Bottlerocket setting: use %[1]s.%[2]s instead (path=%[1]s.bottlerocket.settings.kubernetes.%[3]s)", path, ngField, kubernetesField)
+		return fieldErrorf(path+".bottlerocket.settings.kubernetes."+kubernetesField, "invalid Bottlerocket setting: use %[1]s.%[2]s instead (path=%[1]s.bottlerocket.settings.kubernetes.%[3]s)", path, ngField, kubernetesField)
 	}
 
 	// Dig into kubernetes settings if provided.
@@ -1892,7 +1919,7 @@ func checkBottlerocketSettings(ng *NodeGroup, path string) error {
 
 	kube, ok := kubeVal.(map[string]interface{})
 	if !ok {
-		return errors.New("invalid kubernetes settings provided: expected a map of settings")
+		return fieldErrorf(path+".bottlerocket.settings.kubernetes", "invalid kubernetes settings provided: expected a map of settings")
 	}
 
 	checkMapping := map[string]string{
@@ -1909,7 +1936,7 @@ func checkBottlerocketSettings(ng *NodeGroup, path string) error {
 	}
 
 	if _, ok := kube["cluster-dns-ip"]; ok && ng.ClusterDNS != "" {
-		return fmt.Errorf("only one of %[1]s.bottlerocket.settings.kubernetes.cluster-dns-ip or %[1]s.clusterDNS can be set", path)
+		return fieldErrorf(path+".bottlerocket.settings.kubernetes.cluster-dns-ip", "only one of %[1]s.bottlerocket.settings.kubernetes.cluster-dns-ip or %[1]s.clusterDNS can be set", path)
 	}
 
 	return nil
@@ -1937,11 +1964,11 @@ func ValidateSecretsEncryption(clusterConfig *ClusterConfig) error {
 	}
 
 	if clusterConfig.SecretsEncryption.KeyARN == "" {
-		return errors.New("field secretsEncryption.keyARN is required for enabling secrets encryption")
+		return fieldErrorf("secretsEncryption.keyARN", "field secretsEncryption.keyARN is required for enabling secrets encryption")
 	}
 
 	if _, err := arn.Parse(clusterConfig.SecretsEncryption.KeyARN); err != nil {
-		return fmt.Errorf("invalid ARN in secretsEncryption.keyARN: %q: %w", clusterConfig.SecretsEncryption.KeyARN, err)
+		return fieldErrorf("secretsEncryption.keyARN", "invalid ARN in secretsEncryption.keyARN: %q: %w", clusterConfig.SecretsEncryption.KeyARN, err)
 	}
 	return nil
 }
@@ -1961,9 +1988,9 @@ func ToPodIdentityAssociationID(piaARN string) (string, error) {
 }
 
 func validateIAMIdentityMappings(clusterConfig *ClusterConfig) error {
-	for _, mapping := range clusterConfig.IAMIdentityMappings {
+	for i, mapping := range clusterConfig.IAMIdentityMappings {
 		if err := mapping.Validate(); err != nil {
-			return err
+			return withFieldPath(fmt.Sprintf("iamIdentityMappings[%d]", i), err)
 		}
 	}
 	return nil
@@ -1990,9 +2017,9 @@ func validatePermissionPolicyName(pia *PodIdentityAssociation) error {
 }
 
 func validateAddonPodIdentityAssociations(addons []*Addon) error {
-	for _, addon := range addons {
+	for i, addon := range addons {
 		makeAddonErr := func(msg string) error {
-			return fmt.Errorf("%s (addon: %s)", msg, addon.Name)
+			return fieldErrorf(fmt.Sprintf("addons[%d]", i), "%s (addon: %s)", msg, addon.Name)
 		}
 		if addon.PodIdentityAssociations != nil {
 			for i := range *addon.PodIdentityAssociations {
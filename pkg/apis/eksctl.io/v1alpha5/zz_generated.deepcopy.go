@@ -739,6 +739,16 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClusterStack != nil {
+		in, out := &in.ClusterStack, &out.ClusterStack
+		*out = new(ClusterStack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResolvedReferences != nil {
+		in, out := &in.ResolvedReferences, &out.ResolvedReferences
+		*out = make([]ResolvedReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1053,6 +1063,28 @@ func (in *ClusterNAT) DeepCopy() *ClusterNAT {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStack) DeepCopyInto(out *ClusterStack) {
+	*out = *in
+	if in.TerminationProtection != nil {
+		in, out := &in.TerminationProtection, &out.TerminationProtection
+		*out = new(bool)
+		**out = **in
+	}
+	in.StackPolicy.DeepCopyInto(&out.StackPolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStack.
+func (in *ClusterStack) DeepCopy() *ClusterStack {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
@@ -2718,6 +2750,22 @@ func (in *RemoteNodesIAM) DeepCopy() *RemoteNodesIAM {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedReference) DeepCopyInto(out *ResolvedReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedReference.
+func (in *ResolvedReference) DeepCopy() *ResolvedReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RollbackConfig) DeepCopyInto(out *RollbackConfig) {
 	*out = *in
@@ -0,0 +1,11 @@
+package v1alpha6_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAPIs(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,293 @@
+package v1alpha6
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// addonPolicies maps the values of `NodeGroupIAM.AddonPolicies` to the v1alpha5 `withAddonPolicies` fields.
+var addonPolicies = []struct {
+	name  string
+	field func(*v1alpha5.NodeGroupIAMAddonPolicies) **bool
+}{
+	{AddonPolicyImageBuilder, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.ImageBuilder }},
+	{AddonPolicyAutoScaler, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.AutoScaler }},
+	{AddonPolicyExternalDNS, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.ExternalDNS }},
+	{AddonPolicyCertManager, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.CertManager }},
+	{AddonPolicyAppMesh, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.AppMesh }},
+	{AddonPolicyAppMeshPreview, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.AppMeshPreview }},
+	{AddonPolicyEBS, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.EBS }},
+	{AddonPolicyFSX, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.FSX }},
+	{AddonPolicyEFS, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.EFS }},
+	{AddonPolicyAWSLoadBalancerController, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.AWSLoadBalancerController }},
+	{AddonPolicyXRay, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.XRay }},
+	{AddonPolicyCloudWatch, func(p *v1alpha5.NodeGroupIAMAddonPolicies) **bool { return &p.CloudWatch }},
+}
+
+// ConvertFromV1alpha5 converts a v1alpha5 ClusterConfig to v1alpha6; it fails if the config uses deprecated
+// fields that have no v1alpha6 equivalent.
+func ConvertFromV1alpha5(in *v1alpha5.ClusterConfig, out *ClusterConfig) error {
+	if in.GitOps != nil && in.GitOps.Flux != nil {
+		return errors.New("gitops is not supported in v1alpha6, remove it and use `eksctl enable flux` instead")
+	}
+
+	*out = ClusterConfig{
+		Metadata:                    in.Metadata,
+		UpgradePolicy:               in.UpgradePolicy,
+		KubernetesNetworkConfig:     in.KubernetesNetworkConfig,
+		AutoModeConfig:              in.AutoModeConfig,
+		RemoteNetworkConfig:         in.RemoteNetworkConfig,
+		IAM:                         in.IAM,
+		IAMIdentityMappings:         in.IAMIdentityMappings,
+		IdentityProviders:           in.IdentityProviders,
+		AccessConfig:                in.AccessConfig,
+		VPC:                         in.VPC,
+		Addons:                      in.Addons,
+		AddonsConfig:                in.AddonsConfig,
+		PrivateCluster:              in.PrivateCluster,
+		FargateProfiles:             in.FargateProfiles,
+		AvailabilityZones:           in.AvailabilityZones,
+		LocalZones:                  in.LocalZones,
+		CloudWatch:                  in.CloudWatch,
+		SecretsEncryption:           in.SecretsEncryption,
+		Karpenter:                   in.Karpenter,
+		Outpost:                     in.Outpost,
+		ControlPlaneScalingConfig:   in.ControlPlaneScalingConfig,
+		ZonalShiftConfig:            in.ZonalShiftConfig,
+		KubeAPIServerConfig:         in.KubeAPIServerConfig,
+		KubeSchedulerConfig:         in.KubeSchedulerConfig,
+		KubeControllerManagerConfig: in.KubeControllerManagerConfig,
+		Capabilities:                in.Capabilities,
+		ClusterStack:                in.ClusterStack,
+	}
+	out.APIVersion = SchemeGroupVersion.String()
+	out.Kind = ClusterConfigKind
+
+	for i, ng := range in.NodeGroups {
+		if ng.ContainerRuntime != nil && *ng.ContainerRuntime != v1alpha5.ContainerRuntimeContainerD {
+			return fmt.Errorf("nodeGroups[%d].containerRuntime: only %s is supported in v1alpha6", i, v1alpha5.ContainerRuntimeContainerD)
+		}
+		base, iam := convertNodeGroupBaseFromV1alpha5(ng.NodeGroupBase)
+		out.NodeGroups = append(out.NodeGroups, &NodeGroup{
+			NodeGroupBase:            base,
+			Managed:                  v1alpha5.Disabled(),
+			IAM:                      iam,
+			Taints:                   ng.Taints,
+			UpdateConfig:             ng.UpdateConfig,
+			InstancesDistribution:    ng.InstancesDistribution,
+			ASGMetricsCollection:     ng.ASGMetricsCollection,
+			CPUCredits:               ng.CPUCredits,
+			ClassicLoadBalancerNames: ng.ClassicLoadBalancerNames,
+			TargetGroupARNs:          ng.TargetGroupARNs,
+			ClusterDNS:               ng.ClusterDNS,
+			KubeletExtraConfig:       ng.KubeletExtraConfig,
+			MaxInstanceLifetime:      ng.MaxInstanceLifetime,
+			LocalZones:               ng.LocalZones,
+			EnclaveEnabled:           ng.EnclaveEnabled,
+		})
+	}
+	for _, ng := range in.ManagedNodeGroups {
+		base, iam := convertNodeGroupBaseFromV1alpha5(ng.NodeGroupBase)
+		out.NodeGroups = append(out.NodeGroups, &NodeGroup{
+			NodeGroupBase:    base,
+			IAM:              iam,
+			Taints:           ng.Taints,
+			UpdateConfig:     ng.UpdateConfig,
+			InstanceTypes:    ng.InstanceTypes,
+			Spot:             ng.Spot,
+			LaunchTemplate:   ng.LaunchTemplate,
+			ReleaseVersion:   ng.ReleaseVersion,
+			NodeRepairConfig: ng.NodeRepairConfig,
+		})
+	}
+	return nil
+}
+
+// ConvertToV1alpha5 converts a v1alpha6 ClusterConfig to v1alpha5; it fails if a nodegroup sets fields that
+// are not supported by its kind of nodegroup.
+func ConvertToV1alpha5(in *ClusterConfig, out *v1alpha5.ClusterConfig) error {
+	*out = v1alpha5.ClusterConfig{
+		Metadata:                    in.Metadata,
+		UpgradePolicy:               in.UpgradePolicy,
+		KubernetesNetworkConfig:     in.KubernetesNetworkConfig,
+		AutoModeConfig:              in.AutoModeConfig,
+		RemoteNetworkConfig:         in.RemoteNetworkConfig,
+		IAM:                         in.IAM,
+		IAMIdentityMappings:         in.IAMIdentityMappings,
+		IdentityProviders:           in.IdentityProviders,
+		AccessConfig:                in.AccessConfig,
+		VPC:                         in.VPC,
+		Addons:                      in.Addons,
+		AddonsConfig:                in.AddonsConfig,
+		PrivateCluster:              in.PrivateCluster,
+		FargateProfiles:             in.FargateProfiles,
+		AvailabilityZones:           in.AvailabilityZones,
+		LocalZones:                  in.LocalZones,
+		CloudWatch:                  in.CloudWatch,
+		SecretsEncryption:           in.SecretsEncryption,
+		Karpenter:                   in.Karpenter,
+		Outpost:                     in.Outpost,
+		ControlPlaneScalingConfig:   in.ControlPlaneScalingConfig,
+		ZonalShiftConfig:            in.ZonalShiftConfig,
+		KubeAPIServerConfig:         in.KubeAPIServerConfig,
+		KubeSchedulerConfig:         in.KubeSchedulerConfig,
+		KubeControllerManagerConfig: in.KubeControllerManagerConfig,
+		Capabilities:                in.Capabilities,
+		ClusterStack:                in.ClusterStack,
+	}
+	out.TypeMeta = v1alpha5.ClusterConfigTypeMeta()
+
+	for i, ng := range in.NodeGroups {
+		path := fmt.Sprintf("nodeGroups[%d]", i)
+		base, err := convertNodeGroupBaseToV1alpha5(ng.NodeGroupBase, ng.IAM, path)
+		if err != nil {
+			return err
+		}
+		if ng.IsManaged() {
+			if field := firstSetField(selfManagedOnlyFields(ng)); field != "" {
+				return fmt.Errorf("%s.%s is not supported for managed nodegroups, set `managed: false` to create a self-managed nodegroup", path, field)
+			}
+			out.ManagedNodeGroups = append(out.ManagedNodeGroups, &v1alpha5.ManagedNodeGroup{
+				NodeGroupBase:    base,
+				InstanceTypes:    ng.InstanceTypes,
+				Spot:             ng.Spot,
+				Taints:           ng.Taints,
+				UpdateConfig:     ng.UpdateConfig,
+				LaunchTemplate:   ng.LaunchTemplate,
+				ReleaseVersion:   ng.ReleaseVersion,
+				NodeRepairConfig: ng.NodeRepairConfig,
+			})
+			continue
+		}
+		if field := firstSetField(managedOnlyFields(ng)); field != "" {
+			return fmt.Errorf("%s.%s is only supported for managed nodegroups", path, field)
+		}
+		out.NodeGroups = append(out.NodeGroups, &v1alpha5.NodeGroup{
+			NodeGroupBase:            base,
+			InstancesDistribution:    ng.InstancesDistribution,
+			ASGMetricsCollection:     ng.ASGMetricsCollection,
+			CPUCredits:               ng.CPUCredits,
+			ClassicLoadBalancerNames: ng.ClassicLoadBalancerNames,
+			TargetGroupARNs:          ng.TargetGroupARNs,
+			Taints:                   ng.Taints,
+			UpdateConfig:             ng.UpdateConfig,
+			ClusterDNS:               ng.ClusterDNS,
+			KubeletExtraConfig:       ng.KubeletExtraConfig,
+			MaxInstanceLifetime:      ng.MaxInstanceLifetime,
+			LocalZones:               ng.LocalZones,
+			EnclaveEnabled:           ng.EnclaveEnabled,
+		})
+	}
+	return nil
+}
+
+// convertNodeGroupBaseFromV1alpha5 returns a copy of base without its IAM config, which is returned
+// separately as v1alpha6 shadows it.
+func convertNodeGroupBaseFromV1alpha5(base *v1alpha5.NodeGroupBase) (*v1alpha5.NodeGroupBase, *NodeGroupIAM) {
+	if base == nil {
+		return nil, nil
+	}
+	out := *base
+	out.IAM = nil
+	in := base.IAM
+	if in == nil {
+		return &out, nil
+	}
+
+	iam := &NodeGroupIAM{
+		AttachPolicy:                    in.AttachPolicy,
+		AttachPolicyARNs:                in.AttachPolicyARNs,
+		InstanceProfileARN:              in.InstanceProfileARN,
+		InstanceRoleARN:                 in.InstanceRoleARN,
+		InstanceRoleName:                in.InstanceRoleName,
+		InstanceRolePermissionsBoundary: in.InstanceRolePermissionsBoundary,
+	}
+	policies := in.WithAddonPolicies
+	for _, p := range addonPolicies {
+		enabled := v1alpha5.IsEnabled(*p.field(&policies))
+		// albIngress is the deprecated name of awsLoadBalancerController
+		if p.name == AddonPolicyAWSLoadBalancerController {
+			enabled = enabled || v1alpha5.IsEnabled(policies.DeprecatedALBIngress)
+		}
+		if enabled {
+			iam.AddonPolicies = append(iam.AddonPolicies, p.name)
+		}
+	}
+	return &out, iam
+}
+
+// convertNodeGroupBaseToV1alpha5 returns a copy of base with the IAM config converted to v1alpha5.
+func convertNodeGroupBaseToV1alpha5(base *v1alpha5.NodeGroupBase, in *NodeGroupIAM, path string) (*v1alpha5.NodeGroupBase, error) {
+	if base == nil {
+		base = &v1alpha5.NodeGroupBase{}
+	}
+	out := *base
+	if in == nil {
+		out.IAM = nil
+		return &out, nil
+	}
+
+	out.IAM = &v1alpha5.NodeGroupIAM{
+		AttachPolicy:                    in.AttachPolicy,
+		AttachPolicyARNs:                in.AttachPolicyARNs,
+		InstanceProfileARN:              in.InstanceProfileARN,
+		InstanceRoleARN:                 in.InstanceRoleARN,
+		InstanceRoleName:                in.InstanceRoleName,
+		InstanceRolePermissionsBoundary: in.InstanceRolePermissionsBoundary,
+	}
+	for i, name := range in.AddonPolicies {
+		found := false
+		for _, p := range addonPolicies {
+			if p.name == name {
+				*p.field(&out.IAM.WithAddonPolicies) = v1alpha5.Enabled()
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%s.iam.addonPolicies[%d]: unknown addon policy %q", path, i, name)
+		}
+	}
+	return &out, nil
+}
+
+type namedField struct {
+	name  string
+	isSet bool
+}
+
+func firstSetField(fields []namedField) string {
+	for _, f := range fields {
+		if f.isSet {
+			return f.name
+		}
+	}
+	return ""
+}
+
+func managedOnlyFields(ng *NodeGroup) []namedField {
+	return []namedField{
+		{"instanceTypes", len(ng.InstanceTypes) > 0},
+		{"spot", ng.Spot},
+		{"launchTemplate", ng.LaunchTemplate != nil},
+		{"releaseVersion", ng.ReleaseVersion != ""},
+		{"nodeRepairConfig", ng.NodeRepairConfig != nil},
+	}
+}
+
+func selfManagedOnlyFields(ng *NodeGroup) []namedField {
+	return []namedField{
+		{"instancesDistribution", ng.InstancesDistribution != nil},
+		{"asgMetricsCollection", len(ng.ASGMetricsCollection) > 0},
+		{"cpuCredits", ng.CPUCredits != nil},
+		{"classicLoadBalancerNames", len(ng.ClassicLoadBalancerNames) > 0},
+		{"targetGroupARNs", len(ng.TargetGroupARNs) > 0},
+		{"clusterDNS", ng.ClusterDNS != ""},
+		{"kubeletExtraConfig", ng.KubeletExtraConfig != nil},
+		{"maxInstanceLifetime", ng.MaxInstanceLifetime != nil},
+		{"localZones", len(ng.LocalZones) > 0},
+		{"enclaveEnabled", ng.EnclaveEnabled != nil},
+	}
+}
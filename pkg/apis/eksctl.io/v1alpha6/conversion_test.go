@@ -0,0 +1,103 @@
+package v1alpha6_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+)
+
+var _ = Describe("ClusterConfig conversion", func() {
+	newV1alpha5Config := func() *v1alpha5.ClusterConfig {
+		cfg := v1alpha5.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.Metadata.Region = "us-west-2"
+
+		ng := v1alpha5.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.large"
+		ng.ContainerRuntime = aws.String(v1alpha5.ContainerRuntimeContainerD)
+		ng.ClusterDNS = "169.254.20.10"
+		ng.IAM.WithAddonPolicies.AutoScaler = v1alpha5.Enabled()
+		ng.IAM.WithAddonPolicies.DeprecatedALBIngress = v1alpha5.Enabled()
+		ng.IAM.WithAddonPolicies.EBS = v1alpha5.Disabled()
+		ng.Taints = []v1alpha5.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}
+		cfg.NodeGroups = append(cfg.NodeGroups, ng)
+
+		mng := v1alpha5.NewManagedNodeGroup()
+		mng.Name = "mng-1"
+		mng.InstanceTypes = []string{"m5.large"}
+		mng.Spot = true
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+		return cfg
+	}
+
+	It("unifies nodegroups and replaces withAddonPolicies", func() {
+		out := &v1alpha6.ClusterConfig{}
+		Expect(scheme.Scheme.Convert(newV1alpha5Config(), out, nil)).To(Succeed())
+
+		Expect(out.APIVersion).To(Equal("eksctl.io/v1alpha6"))
+		Expect(out.NodeGroups).To(HaveLen(2))
+		ng, mng := out.NodeGroups[0], out.NodeGroups[1]
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.IsManaged()).To(BeFalse())
+		Expect(ng.IAM.AddonPolicies).To(Equal([]string{v1alpha6.AddonPolicyAutoScaler, v1alpha6.AddonPolicyAWSLoadBalancerController}))
+		Expect(ng.NodeGroupBase.IAM).To(BeNil())
+		Expect(ng.Taints).To(HaveLen(1))
+		Expect(mng.Name).To(Equal("mng-1"))
+		Expect(mng.IsManaged()).To(BeTrue())
+		Expect(mng.Spot).To(BeTrue())
+	})
+
+	It("converts back to the same v1alpha5 config", func() {
+		in := newV1alpha5Config()
+		v6 := &v1alpha6.ClusterConfig{}
+		Expect(v1alpha6.ConvertFromV1alpha5(in.DeepCopy(), v6)).To(Succeed())
+		out := &v1alpha5.ClusterConfig{}
+		Expect(scheme.Scheme.Convert(v6, out, nil)).To(Succeed())
+
+		// the deprecated and explicitly disabled fields have no v1alpha6 equivalent
+		expected := in.DeepCopy()
+		expected.NodeGroups[0].ContainerRuntime = nil
+		expected.NodeGroups[0].IAM.WithAddonPolicies = v1alpha5.NodeGroupIAMAddonPolicies{
+			AutoScaler:                v1alpha5.Enabled(),
+			AWSLoadBalancerController: v1alpha5.Enabled(),
+		}
+		expected.ManagedNodeGroups[0].IAM.WithAddonPolicies = v1alpha5.NodeGroupIAMAddonPolicies{}
+		Expect(out).To(Equal(expected))
+	})
+
+	DescribeTable("rejects configs that cannot be converted to v1alpha6", func(update func(*v1alpha5.ClusterConfig), expectedErr string) {
+		in := newV1alpha5Config()
+		update(in)
+		Expect(v1alpha6.ConvertFromV1alpha5(in, &v1alpha6.ClusterConfig{})).To(MatchError(expectedErr))
+	},
+		Entry("gitops", func(c *v1alpha5.ClusterConfig) {
+			c.GitOps = &v1alpha5.GitOps{Flux: &v1alpha5.Flux{}}
+		}, "gitops is not supported in v1alpha6, remove it and use `eksctl enable flux` instead"),
+		Entry("container runtime", func(c *v1alpha5.ClusterConfig) {
+			c.NodeGroups[0].ContainerRuntime = aws.String(v1alpha5.ContainerRuntimeDockerD)
+		}, "nodeGroups[0].containerRuntime: only containerd is supported in v1alpha6"),
+	)
+
+	DescribeTable("rejects configs that cannot be converted to v1alpha5", func(ng *v1alpha6.NodeGroup, expectedErr string) {
+		ng.NodeGroupBase = &v1alpha5.NodeGroupBase{Name: "ng-1"}
+		in := &v1alpha6.ClusterConfig{NodeGroups: []*v1alpha6.NodeGroup{ng}}
+		Expect(v1alpha6.ConvertToV1alpha5(in, &v1alpha5.ClusterConfig{})).To(MatchError(expectedErr))
+	},
+		Entry("self-managed fields on managed nodegroups", &v1alpha6.NodeGroup{
+			MaxInstanceLifetime: aws.Int(86400),
+		}, "nodeGroups[0].maxInstanceLifetime is not supported for managed nodegroups, set `managed: false` to create a self-managed nodegroup"),
+		Entry("managed fields on self-managed nodegroups", &v1alpha6.NodeGroup{
+			Managed:        aws.Bool(false),
+			ReleaseVersion: "1.32.0-20250101",
+		}, "nodeGroups[0].releaseVersion is only supported for managed nodegroups"),
+		Entry("unknown addon policies", &v1alpha6.NodeGroup{
+			IAM: &v1alpha6.NodeGroupIAM{AddonPolicies: []string{"albIngress"}},
+		}, `nodeGroups[0].iam.addonPolicies[0]: unknown addon policy "albIngress"`),
+	)
+})
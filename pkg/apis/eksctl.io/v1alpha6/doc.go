@@ -0,0 +1,6 @@
+// +k8s:deepcopy-gen=package
+
+// Package v1alpha6 is the v1alpha6 version of the API. It drops the deprecated fields of v1alpha5 and
+// unifies self-managed and managed nodegroups; configs are converted to v1alpha5 when they are loaded.
+// +groupName=eksctl.io
+package v1alpha6
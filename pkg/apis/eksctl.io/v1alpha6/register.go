@@ -0,0 +1,59 @@
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Conventional Kubernetes API constants
+const (
+	CurrentGroupVersion   = "v1alpha6"
+	ClusterConfigKind     = "ClusterConfig"
+	ClusterConfigListKind = "ClusterConfigList"
+)
+
+// Conventional Kubernetes API variables
+var (
+	SchemeGroupVersion = schema.GroupVersion{Group: api.GroupName, Version: CurrentGroupVersion}
+	SchemeBuilder      = runtime.NewSchemeBuilder(v1alpha5.AddToScheme, addKnownTypes, addConversionFuncs)
+	AddToScheme        = SchemeBuilder.AddToScheme
+)
+
+// v1alpha6 is only ever decoded through the conversion to v1alpha5, so it is
+// registered once, rather than by every caller that parses a config
+func init() {
+	if err := Register(); err != nil {
+		panic(err)
+	}
+}
+
+// Register our API, and the v1alpha5 API it converts to and from, with the scheme
+func Register() error {
+	return AddToScheme(scheme.Scheme)
+}
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&ClusterConfig{},
+		&ClusterConfigList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+func addConversionFuncs(scheme *runtime.Scheme) error {
+	if err := scheme.AddConversionFunc((*ClusterConfig)(nil), (*v1alpha5.ClusterConfig)(nil), func(a, b interface{}, _ conversion.Scope) error {
+		return ConvertToV1alpha5(a.(*ClusterConfig), b.(*v1alpha5.ClusterConfig))
+	}); err != nil {
+		return err
+	}
+	return scheme.AddConversionFunc((*v1alpha5.ClusterConfig)(nil), (*ClusterConfig)(nil), func(a, b interface{}, _ conversion.Scope) error {
+		return ConvertFromV1alpha5(a.(*v1alpha5.ClusterConfig), b.(*ClusterConfig))
+	})
+}
@@ -0,0 +1,257 @@
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Values for `NodeGroupIAM.AddonPolicies`
+const (
+	AddonPolicyImageBuilder              = "imageBuilder"
+	AddonPolicyAutoScaler                = "autoScaler"
+	AddonPolicyExternalDNS               = "externalDNS"
+	AddonPolicyCertManager               = "certManager"
+	AddonPolicyAppMesh                   = "appMesh"
+	AddonPolicyAppMeshPreview            = "appMeshPreview"
+	AddonPolicyEBS                       = "ebs"
+	AddonPolicyFSX                       = "fsx"
+	AddonPolicyEFS                       = "efs"
+	AddonPolicyAWSLoadBalancerController = "awsLoadBalancerController"
+	AddonPolicyXRay                      = "xRay"
+	AddonPolicyCloudWatch                = "cloudWatch"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterConfig is a simple config, to be replaced with Cluster API
+type ClusterConfig struct {
+	metav1.TypeMeta
+
+	// +required
+	Metadata *v1alpha5.ClusterMeta `json:"metadata"`
+
+	// UpgradePolicy specifies the upgrade policy for the cluster
+	// +optional
+	UpgradePolicy *v1alpha5.UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// +optional
+	KubernetesNetworkConfig *v1alpha5.KubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
+
+	// AutoModeConfig holds the config for Auto Mode.
+	// +optional
+	AutoModeConfig *v1alpha5.AutoModeConfig `json:"autoModeConfig,omitempty"`
+
+	// +optional
+	RemoteNetworkConfig *v1alpha5.RemoteNetworkConfig `json:"remoteNetworkConfig,omitempty"`
+
+	// +optional
+	IAM *v1alpha5.ClusterIAM `json:"iam,omitempty"`
+
+	// +optional
+	IAMIdentityMappings []*v1alpha5.IAMIdentityMapping `json:"iamIdentityMappings,omitempty"`
+
+	// +optional
+	IdentityProviders []v1alpha5.IdentityProvider `json:"identityProviders,omitempty"`
+
+	// AccessConfig specifies the access config for a cluster.
+	// +optional
+	AccessConfig *v1alpha5.AccessConfig `json:"accessConfig,omitempty"`
+
+	// +optional
+	VPC *v1alpha5.ClusterVPC `json:"vpc,omitempty"`
+
+	// +optional
+	Addons []*v1alpha5.Addon `json:"addons,omitempty"`
+
+	// AddonsConfig specifies the configuration for addons.
+	// +optional
+	AddonsConfig v1alpha5.AddonsConfig `json:"addonsConfig,omitempty"`
+
+	// PrivateCluster allows configuring a fully-private cluster
+	// in which no node has outbound internet access, and private access
+	// to AWS services is enabled via VPC endpoints
+	// +optional
+	PrivateCluster *v1alpha5.PrivateCluster `json:"privateCluster,omitempty"`
+
+	// NodeGroups holds both managed and self-managed nodegroups, see NodeGroup.Managed
+	// +optional
+	NodeGroups []*NodeGroup `json:"nodeGroups,omitempty"`
+
+	// +optional
+	FargateProfiles []*v1alpha5.FargateProfile `json:"fargateProfiles,omitempty"`
+
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// LocalZones specifies a list of local zones where the subnets should be created.
+	// Only self-managed nodegroups can be launched in local zones. These subnets are not passed to EKS.
+	// +optional
+	LocalZones []string `json:"localZones,omitempty"`
+
+	// +optional
+	CloudWatch *v1alpha5.ClusterCloudWatch `json:"cloudWatch,omitempty"`
+
+	// +optional
+	SecretsEncryption *v1alpha5.SecretsEncryption `json:"secretsEncryption,omitempty"`
+
+	// Karpenter specific configuration options.
+	// +optional
+	Karpenter *v1alpha5.Karpenter `json:"karpenter,omitempty"`
+
+	// Outpost specifies the Outpost configuration.
+	// +optional
+	Outpost *v1alpha5.Outpost `json:"outpost,omitempty"`
+
+	// ControlPlaneScalingConfig specifies control plane scaling configuration.
+	ControlPlaneScalingConfig *v1alpha5.ControlPlaneScalingConfig `json:"controlPlaneScalingConfig,omitempty"`
+
+	// ZonalShiftConfig specifies the zonal shift configuration.
+	ZonalShiftConfig *v1alpha5.ZonalShiftConfig `json:"zonalShiftConfig,omitempty"`
+
+	// KubeAPIServerConfig specifies the kube-apiserver configuration.
+	// +optional
+	KubeAPIServerConfig *v1alpha5.KubeAPIServerConfig `json:"kubeAPIServerConfig,omitempty"`
+
+	// KubeSchedulerConfig specifies the kube-scheduler configuration.
+	// +optional
+	KubeSchedulerConfig *v1alpha5.KubeSchedulerConfig `json:"kubeSchedulerConfig,omitempty"`
+
+	// KubeControllerManagerConfig specifies the kube-controller-manager configuration.
+	// +optional
+	KubeControllerManagerConfig *v1alpha5.KubeControllerManagerConfig `json:"kubeControllerManagerConfig,omitempty"`
+
+	// Capabilities specifies the capabilities for the cluster.
+	// +optional
+	Capabilities []v1alpha5.Capability `json:"capabilities,omitempty"`
+
+	// ClusterStack specifies the protection of the CloudFormation stack of the cluster.
+	// +optional
+	ClusterStack *v1alpha5.ClusterStack `json:"clusterStack,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterConfigList is a list of ClusterConfigs
+type ClusterConfigList struct {
+	metav1.TypeMeta
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterConfig `json:"items"`
+}
+
+// NodeGroup holds the configuration of a managed or a self-managed nodegroup; fields that only apply
+// to one kind of nodegroup are rejected for the other
+type NodeGroup struct {
+	*v1alpha5.NodeGroupBase
+
+	// Managed creates an EKS managed nodegroup, set it to `false` for a self-managed nodegroup
+	// Defaults to `true`
+	// +optional
+	Managed *bool `json:"managed,omitempty"`
+
+	// +optional
+	IAM *NodeGroupIAM `json:"iam,omitempty"`
+
+	// Taints taints to apply to the nodegroup
+	// +optional
+	Taints []v1alpha5.NodeGroupTaint `json:"taints,omitempty"`
+
+	// UpdateConfig configures how to update NodeGroups.
+	// +optional
+	UpdateConfig *v1alpha5.NodeGroupUpdateConfig `json:"updateConfig,omitempty"`
+
+	// Managed nodegroups only
+
+	// InstanceTypes specifies a list of instance types
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
+	// Spot creates a spot nodegroup
+	// +optional
+	Spot bool `json:"spot,omitempty"`
+
+	// LaunchTemplate specifies an existing launch template to use
+	// for the nodegroup
+	// +optional
+	LaunchTemplate *v1alpha5.LaunchTemplate `json:"launchTemplate,omitempty"`
+
+	// ReleaseVersion the AMI version of the EKS optimized AMI to use
+	// +optional
+	ReleaseVersion string `json:"releaseVersion,omitempty"`
+
+	// NodeRepairConfig configures the auto repair feature of the nodegroup
+	// +optional
+	NodeRepairConfig *v1alpha5.NodeGroupNodeRepairConfig `json:"nodeRepairConfig,omitempty"`
+
+	// Self-managed nodegroups only
+
+	// +optional
+	InstancesDistribution *v1alpha5.NodeGroupInstancesDistribution `json:"instancesDistribution,omitempty"`
+
+	// +optional
+	ASGMetricsCollection []v1alpha5.MetricsCollection `json:"asgMetricsCollection,omitempty"`
+
+	// CPUCredits configures [T3 Unlimited](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/burstable-performance-instances-unlimited-mode.html), valid only for T-type instances
+	// +optional
+	CPUCredits *string `json:"cpuCredits,omitempty"`
+
+	// Associate load balancers with auto scaling group
+	// +optional
+	ClassicLoadBalancerNames []string `json:"classicLoadBalancerNames,omitempty"`
+
+	// Associate target group with auto scaling group
+	// +optional
+	TargetGroupARNs []string `json:"targetGroupARNs,omitempty"`
+
+	// [Custom
+	// address](/usage/vpc-networking/#custom-cluster-dns-address) used for DNS
+	// lookups
+	// +optional
+	ClusterDNS string `json:"clusterDNS,omitempty"`
+
+	// [Customize `kubelet` config](/usage/customizing-the-kubelet/)
+	// +optional
+	KubeletExtraConfig *v1alpha5.InlineDocument `json:"kubeletExtraConfig,omitempty"`
+
+	// MaxInstanceLifetime defines the maximum amount of time in seconds an instance stays alive.
+	// +optional
+	MaxInstanceLifetime *int `json:"maxInstanceLifetime,omitempty"`
+
+	// LocalZones specifies a list of local zones where the nodegroup should be launched.
+	// The cluster should have been created with all of the local zones specified in this field.
+	// +optional
+	LocalZones []string `json:"localZones,omitempty"`
+
+	// EnclaveEnabled determines if the EC2 instance will be Nitro enclave enabled
+	// +optional
+	EnclaveEnabled *bool `json:"enclaveEnabled,omitempty"`
+}
+
+// IsManaged reports whether the nodegroup is an EKS managed nodegroup.
+func (n *NodeGroup) IsManaged() bool {
+	return n.Managed == nil || *n.Managed
+}
+
+// NodeGroupIAM holds all IAM attributes of a NodeGroup
+type NodeGroupIAM struct {
+	// AttachPolicy holds a policy document to attach
+	// +optional
+	AttachPolicy v1alpha5.InlineDocument `json:"attachPolicy,omitempty"`
+	// list of ARNs of the IAM policies to attach
+	// +optional
+	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
+	// InstanceProfileARN holds the ARN of instance profile, not supported for Managed NodeGroups
+	// +optional
+	InstanceProfileARN string `json:"instanceProfileARN,omitempty"`
+	// +optional
+	InstanceRoleARN string `json:"instanceRoleARN,omitempty"`
+	// +optional
+	InstanceRoleName string `json:"instanceRoleName,omitempty"`
+	// +optional
+	InstanceRolePermissionsBoundary string `json:"instanceRolePermissionsBoundary,omitempty"`
+	// AddonPolicies lists the addon policies to attach to the instance role, e.g. `autoScaler` or `ebs`;
+	// it replaces `withAddonPolicies`
+	// +optional
+	AddonPolicies []string `json:"addonPolicies,omitempty"`
+}
@@ -0,0 +1,380 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2018 Weaveworks.
+Copyright 2023 The eksctl Authors.
+All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1alpha6
+
+import (
+	v1alpha5 "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(v1alpha5.ClusterMeta)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(v1alpha5.UpgradePolicy)
+		**out = **in
+	}
+	if in.KubernetesNetworkConfig != nil {
+		in, out := &in.KubernetesNetworkConfig, &out.KubernetesNetworkConfig
+		*out = new(v1alpha5.KubernetesNetworkConfig)
+		**out = **in
+	}
+	if in.AutoModeConfig != nil {
+		in, out := &in.AutoModeConfig, &out.AutoModeConfig
+		*out = new(v1alpha5.AutoModeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteNetworkConfig != nil {
+		in, out := &in.RemoteNetworkConfig, &out.RemoteNetworkConfig
+		*out = new(v1alpha5.RemoteNetworkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAM != nil {
+		in, out := &in.IAM, &out.IAM
+		*out = new(v1alpha5.ClusterIAM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAMIdentityMappings != nil {
+		in, out := &in.IAMIdentityMappings, &out.IAMIdentityMappings
+		*out = make([]*v1alpha5.IAMIdentityMapping, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha5.IAMIdentityMapping)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IdentityProviders != nil {
+		in, out := &in.IdentityProviders, &out.IdentityProviders
+		*out = make([]v1alpha5.IdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessConfig != nil {
+		in, out := &in.AccessConfig, &out.AccessConfig
+		*out = new(v1alpha5.AccessConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(v1alpha5.ClusterVPC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]*v1alpha5.Addon, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha5.Addon)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	out.AddonsConfig = in.AddonsConfig
+	if in.PrivateCluster != nil {
+		in, out := &in.PrivateCluster, &out.PrivateCluster
+		*out = new(v1alpha5.PrivateCluster)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeGroups != nil {
+		in, out := &in.NodeGroups, &out.NodeGroups
+		*out = make([]*NodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NodeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FargateProfiles != nil {
+		in, out := &in.FargateProfiles, &out.FargateProfiles
+		*out = make([]*v1alpha5.FargateProfile, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1alpha5.FargateProfile)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LocalZones != nil {
+		in, out := &in.LocalZones, &out.LocalZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CloudWatch != nil {
+		in, out := &in.CloudWatch, &out.CloudWatch
+		*out = new(v1alpha5.ClusterCloudWatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsEncryption != nil {
+		in, out := &in.SecretsEncryption, &out.SecretsEncryption
+		*out = new(v1alpha5.SecretsEncryption)
+		**out = **in
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(v1alpha5.Karpenter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outpost != nil {
+		in, out := &in.Outpost, &out.Outpost
+		*out = new(v1alpha5.Outpost)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneScalingConfig != nil {
+		in, out := &in.ControlPlaneScalingConfig, &out.ControlPlaneScalingConfig
+		*out = new(v1alpha5.ControlPlaneScalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZonalShiftConfig != nil {
+		in, out := &in.ZonalShiftConfig, &out.ZonalShiftConfig
+		*out = new(v1alpha5.ZonalShiftConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeAPIServerConfig != nil {
+		in, out := &in.KubeAPIServerConfig, &out.KubeAPIServerConfig
+		*out = new(v1alpha5.KubeAPIServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeSchedulerConfig != nil {
+		in, out := &in.KubeSchedulerConfig, &out.KubeSchedulerConfig
+		*out = new(v1alpha5.KubeSchedulerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeControllerManagerConfig != nil {
+		in, out := &in.KubeControllerManagerConfig, &out.KubeControllerManagerConfig
+		*out = new(v1alpha5.KubeControllerManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]v1alpha5.Capability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterStack != nil {
+		in, out := &in.ClusterStack, &out.ClusterStack
+		*out = new(v1alpha5.ClusterStack)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfig.
+func (in *ClusterConfig) DeepCopy() *ClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigList) DeepCopyInto(out *ClusterConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigList.
+func (in *ClusterConfigList) DeepCopy() *ClusterConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
+	*out = *in
+	if in.NodeGroupBase != nil {
+		in, out := &in.NodeGroupBase, &out.NodeGroupBase
+		*out = new(v1alpha5.NodeGroupBase)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IAM != nil {
+		in, out := &in.IAM, &out.IAM
+		*out = new(NodeGroupIAM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1alpha5.NodeGroupTaint, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdateConfig != nil {
+		in, out := &in.UpdateConfig, &out.UpdateConfig
+		*out = new(v1alpha5.NodeGroupUpdateConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LaunchTemplate != nil {
+		in, out := &in.LaunchTemplate, &out.LaunchTemplate
+		*out = new(v1alpha5.LaunchTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeRepairConfig != nil {
+		in, out := &in.NodeRepairConfig, &out.NodeRepairConfig
+		*out = new(v1alpha5.NodeGroupNodeRepairConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstancesDistribution != nil {
+		in, out := &in.InstancesDistribution, &out.InstancesDistribution
+		*out = new(v1alpha5.NodeGroupInstancesDistribution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ASGMetricsCollection != nil {
+		in, out := &in.ASGMetricsCollection, &out.ASGMetricsCollection
+		*out = make([]v1alpha5.MetricsCollection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CPUCredits != nil {
+		in, out := &in.CPUCredits, &out.CPUCredits
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClassicLoadBalancerNames != nil {
+		in, out := &in.ClassicLoadBalancerNames, &out.ClassicLoadBalancerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetGroupARNs != nil {
+		in, out := &in.TargetGroupARNs, &out.TargetGroupARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeletExtraConfig != nil {
+		in, out := &in.KubeletExtraConfig, &out.KubeletExtraConfig
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxInstanceLifetime != nil {
+		in, out := &in.MaxInstanceLifetime, &out.MaxInstanceLifetime
+		*out = new(int)
+		**out = **in
+	}
+	if in.LocalZones != nil {
+		in, out := &in.LocalZones, &out.LocalZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnclaveEnabled != nil {
+		in, out := &in.EnclaveEnabled, &out.EnclaveEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroup.
+func (in *NodeGroup) DeepCopy() *NodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupIAM) DeepCopyInto(out *NodeGroupIAM) {
+	*out = *in
+	in.AttachPolicy.DeepCopyInto(&out.AttachPolicy)
+	if in.AttachPolicyARNs != nil {
+		in, out := &in.AttachPolicyARNs, &out.AttachPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddonPolicies != nil {
+		in, out := &in.AddonPolicies, &out.AddonPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupIAM.
+func (in *NodeGroupIAM) DeepCopy() *NodeGroupIAM {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupIAM)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -11,3 +11,5 @@ package generate
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh iam IAM
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh eks EKS
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh outposts Outposts
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh secretsmanager SecretsManager
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh s3 S3
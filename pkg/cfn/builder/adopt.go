@@ -0,0 +1,402 @@
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	gfncfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfneks "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/eks"
+	gfniam "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/iam"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/policies"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+const (
+	adoptedTemplateDescription = "(adopted)"
+
+	adoptedRoleResourceName          = "Role"
+	adoptedSecurityGroupResourceName = "SecurityGroup"
+	adoptedOIDCProviderResourceName  = "OIDCProvider"
+)
+
+// AdoptedResourceSet describes an existing resource so that it can be imported into a new stack
+// with a CloudFormation IMPORT change set.
+// As eksctl did not create the resource, it is given a Retain policy, so that deleting the stack leaves
+// the resource in place, unless deleting the resource along with its stack is asked for.
+type AdoptedResourceSet struct {
+	rs       *resourceSet
+	resource types.ResourceToImport
+}
+
+// adoptedDeletionPolicy returns the deletion policy of an adopted resource
+func adoptedDeletionPolicy(deleteWithStack bool) policies.DeletionPolicy {
+	if deleteWithStack {
+		return policies.DeletionPolicy("Delete")
+	}
+	return policies.DeletionPolicy("Retain")
+}
+
+// IsAdoptedTemplateDescription reports whether description is the description of a template generated for an
+// adopted resource
+func IsAdoptedTemplateDescription(description string) bool {
+	return strings.Contains(description, adoptedTemplateDescription)
+}
+
+func newAdoptedResourceSet(kind string) *AdoptedResourceSet {
+	rs := newResourceSet()
+	rs.template.Description = fmt.Sprintf("EKS %s %s %s", kind, adoptedTemplateDescription, templateDescriptionSuffix)
+	return &AdoptedResourceSet{rs: rs}
+}
+
+// NewAdoptedNodeGroupResourceSet returns a resource set for importing an existing managed nodegroup.
+// The nodegroup resource uses the same logical ID as nodegroups created by eksctl, so that the
+// adopted stack can be upgraded and deleted like any other managed nodegroup stack.
+// deleteWithStack sets whether deleting the stack deletes the nodegroup.
+func NewAdoptedNodeGroupResourceSet(ng *ekstypes.Nodegroup, deleteWithStack bool) *AdoptedResourceSet {
+	a := newAdoptedResourceSet("managed nodes")
+
+	resource := &gfneks.Nodegroup{
+		ClusterName:                     gfnt.NewString(aws.ToString(ng.ClusterName)),
+		NodegroupName:                   gfnt.NewString(aws.ToString(ng.NodegroupName)),
+		NodeRole:                        gfnt.NewString(aws.ToString(ng.NodeRole)),
+		Subnets:                         gfnt.NewStringSlice(ng.Subnets...),
+		AmiType:                         gfnt.NewString(string(ng.AmiType)),
+		CapacityType:                    gfnt.NewString(string(ng.CapacityType)),
+		AWSCloudFormationDeletionPolicy: adoptedDeletionPolicy(deleteWithStack),
+	}
+	if ng.ScalingConfig != nil {
+		resource.ScalingConfig = &gfneks.Nodegroup_ScalingConfig{
+			MinSize:     gfnt.NewInteger(int(aws.ToInt32(ng.ScalingConfig.MinSize))),
+			MaxSize:     gfnt.NewInteger(int(aws.ToInt32(ng.ScalingConfig.MaxSize))),
+			DesiredSize: gfnt.NewInteger(int(aws.ToInt32(ng.ScalingConfig.DesiredSize))),
+		}
+	}
+	if len(ng.InstanceTypes) > 0 {
+		resource.InstanceTypes = gfnt.NewStringSlice(ng.InstanceTypes...)
+	}
+	if ng.DiskSize != nil {
+		resource.DiskSize = gfnt.NewInteger(int(*ng.DiskSize))
+	}
+	if ng.AmiType != ekstypes.AMITypesCustom {
+		// the release version of a nodegroup using a custom AMI is the AMI ID, which cannot be set
+		resource.Version = gfnt.NewString(aws.ToString(ng.Version))
+		resource.ReleaseVersion = gfnt.NewString(aws.ToString(ng.ReleaseVersion))
+	}
+	if len(ng.Labels) > 0 {
+		resource.Labels = makeValueMap(ng.Labels)
+	}
+	if len(ng.Tags) > 0 {
+		resource.Tags = makeValueMap(ng.Tags)
+	}
+	for _, taint := range ng.Taints {
+		resource.Taints = append(resource.Taints, gfneks.Nodegroup_Taint{
+			Key:    gfnt.NewString(aws.ToString(taint.Key)),
+			Value:  gfnt.NewString(aws.ToString(taint.Value)),
+			Effect: gfnt.NewString(string(taint.Effect)),
+		})
+	}
+	if lt := ng.LaunchTemplate; lt != nil {
+		resource.LaunchTemplate = &gfneks.Nodegroup_LaunchTemplateSpecification{
+			Id:      gfnt.NewString(aws.ToString(lt.Id)),
+			Version: gfnt.NewString(aws.ToString(lt.Version)),
+		}
+	}
+	if ra := ng.RemoteAccess; ra != nil {
+		resource.RemoteAccess = &gfneks.Nodegroup_RemoteAccess{
+			Ec2SshKey: gfnt.NewString(aws.ToString(ra.Ec2SshKey)),
+		}
+		if len(ra.SourceSecurityGroups) > 0 {
+			resource.RemoteAccess.SourceSecurityGroups = gfnt.NewStringSlice(ra.SourceSecurityGroups...)
+		}
+	}
+	if uc := ng.UpdateConfig; uc != nil {
+		resource.UpdateConfig = &gfneks.Nodegroup_UpdateConfig{}
+		if uc.MaxUnavailable != nil {
+			resource.UpdateConfig.MaxUnavailable = gfnt.NewInteger(int(*uc.MaxUnavailable))
+		}
+		if uc.MaxUnavailablePercentage != nil {
+			resource.UpdateConfig.MaxUnavailablePercentage = gfnt.NewInteger(int(*uc.MaxUnavailablePercentage))
+		}
+	}
+
+	a.addResource(ManagedNodeGroupResourceName, resource, "AWS::EKS::Nodegroup", map[string]string{
+		// the primary identifier of a nodegroup is <cluster name>/<nodegroup name>
+		"Id": fmt.Sprintf("%s/%s", aws.ToString(ng.ClusterName), aws.ToString(ng.NodegroupName)),
+	})
+	return a
+}
+
+// NewAdoptedRoleResourceSet returns a resource set for importing an existing IAM role, along with the
+// managed policies attached to it and its inline policies, keyed by name.
+func NewAdoptedRoleResourceSet(role *iamtypes.Role, managedPolicyARNs []string, inlinePolicies map[string]string, deleteWithStack bool) (*AdoptedResourceSet, error) {
+	a := newAdoptedResourceSet("IAM role")
+	a.rs.withIAM = true
+	a.rs.withNamedIAM = true
+
+	roleName := aws.ToString(role.RoleName)
+	assumeRolePolicyDocument, err := decodePolicyDocument(aws.ToString(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return nil, fmt.Errorf("decoding assume role policy document of role %q: %w", roleName, err)
+	}
+
+	resource := &gfniam.Role{
+		RoleName:                        gfnt.NewString(roleName),
+		Path:                            gfnt.NewString(aws.ToString(role.Path)),
+		AssumeRolePolicyDocument:        assumeRolePolicyDocument,
+		AWSCloudFormationDeletionPolicy: adoptedDeletionPolicy(deleteWithStack),
+	}
+	if role.Description != nil {
+		resource.Description = gfnt.NewString(*role.Description)
+	}
+	if role.MaxSessionDuration != nil {
+		resource.MaxSessionDuration = gfnt.NewInteger(int(*role.MaxSessionDuration))
+	}
+	if role.PermissionsBoundary != nil {
+		resource.PermissionsBoundary = gfnt.NewString(aws.ToString(role.PermissionsBoundary.PermissionsBoundaryArn))
+	}
+	if len(managedPolicyARNs) > 0 {
+		resource.ManagedPolicyArns = gfnt.NewStringSlice(managedPolicyARNs...)
+	}
+	for _, name := range sortedKeys(inlinePolicies) {
+		document, err := decodePolicyDocument(inlinePolicies[name])
+		if err != nil {
+			return nil, fmt.Errorf("decoding inline policy %q of role %q: %w", name, roleName, err)
+		}
+		resource.Policies = append(resource.Policies, gfniam.Role_Policy{
+			PolicyName:     gfnt.NewString(name),
+			PolicyDocument: document,
+		})
+	}
+	for _, tag := range role.Tags {
+		resource.Tags = append(resource.Tags, makeTag(aws.ToString(tag.Key), aws.ToString(tag.Value)))
+	}
+
+	a.addResource(adoptedRoleResourceName, resource, "AWS::IAM::Role", map[string]string{
+		"RoleName": roleName,
+	})
+	return a, nil
+}
+
+// NewAdoptedSecurityGroupResourceSet returns a resource set for importing an existing security group
+// along with its ingress and egress rules.
+func NewAdoptedSecurityGroupResourceSet(sg *ec2types.SecurityGroup, deleteWithStack bool) *AdoptedResourceSet {
+	a := newAdoptedResourceSet("security group")
+
+	resource := &gfnec2.SecurityGroup{
+		GroupName:                       gfnt.NewString(aws.ToString(sg.GroupName)),
+		GroupDescription:                gfnt.NewString(aws.ToString(sg.Description)),
+		VpcId:                           gfnt.NewString(aws.ToString(sg.VpcId)),
+		AWSCloudFormationDeletionPolicy: adoptedDeletionPolicy(deleteWithStack),
+	}
+	for _, p := range sg.IpPermissions {
+		resource.SecurityGroupIngress = append(resource.SecurityGroupIngress, makeIngressRules(p)...)
+	}
+	for _, p := range sg.IpPermissionsEgress {
+		resource.SecurityGroupEgress = append(resource.SecurityGroupEgress, makeEgressRules(p)...)
+	}
+	for _, tag := range sg.Tags {
+		resource.Tags = append(resource.Tags, makeTag(aws.ToString(tag.Key), aws.ToString(tag.Value)))
+	}
+
+	a.addResource(adoptedSecurityGroupResourceName, resource, "AWS::EC2::SecurityGroup", map[string]string{
+		"Id": aws.ToString(sg.GroupId),
+	})
+	return a
+}
+
+// NewAdoptedOIDCProviderResourceSet returns a resource set for importing the existing IAM OIDC provider
+// identified by providerARN.
+func NewAdoptedOIDCProviderResourceSet(providerARN string, provider *iam.GetOpenIDConnectProviderOutput, deleteWithStack bool) *AdoptedResourceSet {
+	a := newAdoptedResourceSet("IAM OIDC provider")
+	a.rs.withIAM = true
+
+	providerURL := aws.ToString(provider.Url)
+	if !strings.HasPrefix(providerURL, "https://") {
+		// IAM returns the URL without its scheme, which CloudFormation requires
+		providerURL = "https://" + providerURL
+	}
+	resource := &gfniam.OIDCProvider{
+		Url:                             gfnt.NewString(providerURL),
+		ClientIdList:                    gfnt.NewStringSlice(provider.ClientIDList...),
+		ThumbprintList:                  gfnt.NewStringSlice(provider.ThumbprintList...),
+		AWSCloudFormationDeletionPolicy: adoptedDeletionPolicy(deleteWithStack),
+	}
+	for _, tag := range provider.Tags {
+		resource.Tags = append(resource.Tags, makeTag(aws.ToString(tag.Key), aws.ToString(tag.Value)))
+	}
+
+	a.addResource(adoptedOIDCProviderResourceName, resource, "AWS::IAM::OIDCProvider", map[string]string{
+		"Arn": providerARN,
+	})
+	return a
+}
+
+// addResource adds the resource to the template without the Name tag set by newResource, as
+// the template must describe the resource as it is.
+func (a *AdoptedResourceSet) addResource(logicalID string, resource interface{ AWSCloudFormationType() string }, resourceType string, identifier map[string]string) {
+	a.rs.template.Resources[logicalID] = resource
+	a.resource = types.ResourceToImport{
+		LogicalResourceId:  aws.String(logicalID),
+		ResourceType:       aws.String(resourceType),
+		ResourceIdentifier: identifier,
+	}
+}
+
+// ResourceToImport returns the resource to import and the properties identifying it.
+func (a *AdoptedResourceSet) ResourceToImport() types.ResourceToImport {
+	return a.resource
+}
+
+// RenderJSON returns the rendered JSON
+func (a *AdoptedResourceSet) RenderJSON() ([]byte, error) {
+	return a.rs.renderJSON()
+}
+
+// WithIAM states, if IAM roles will be created or not
+func (a *AdoptedResourceSet) WithIAM() bool {
+	return a.rs.withIAM
+}
+
+// WithNamedIAM states, if specifically named IAM roles will be created or not
+func (a *AdoptedResourceSet) WithNamedIAM() bool {
+	return a.rs.withNamedIAM
+}
+
+// GetAllOutputs collects all outputs of the stack
+func (a *AdoptedResourceSet) GetAllOutputs(stack types.Stack) error {
+	return a.rs.GetAllOutputs(stack)
+}
+
+func makeIngressRules(p ec2types.IpPermission) []gfnec2.SecurityGroup_Ingress {
+	newRule := func() gfnec2.SecurityGroup_Ingress {
+		rule := gfnec2.SecurityGroup_Ingress{IpProtocol: gfnt.NewString(aws.ToString(p.IpProtocol))}
+		if p.FromPort != nil {
+			rule.FromPort = gfnt.NewInteger(int(*p.FromPort))
+		}
+		if p.ToPort != nil {
+			rule.ToPort = gfnt.NewInteger(int(*p.ToPort))
+		}
+		return rule
+	}
+	var rules []gfnec2.SecurityGroup_Ingress
+	for _, r := range p.IpRanges {
+		rule := newRule()
+		rule.CidrIp = gfnt.NewString(aws.ToString(r.CidrIp))
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	for _, r := range p.Ipv6Ranges {
+		rule := newRule()
+		rule.CidrIpv6 = gfnt.NewString(aws.ToString(r.CidrIpv6))
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	for _, r := range p.PrefixListIds {
+		rule := newRule()
+		rule.SourcePrefixListId = gfnt.NewString(aws.ToString(r.PrefixListId))
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	for _, r := range p.UserIdGroupPairs {
+		rule := newRule()
+		rule.SourceSecurityGroupId = gfnt.NewString(aws.ToString(r.GroupId))
+		rule.SourceSecurityGroupOwnerId = makeOptionalString(r.UserId)
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func makeEgressRules(p ec2types.IpPermission) []gfnec2.SecurityGroup_Egress {
+	newRule := func() gfnec2.SecurityGroup_Egress {
+		rule := gfnec2.SecurityGroup_Egress{IpProtocol: gfnt.NewString(aws.ToString(p.IpProtocol))}
+		if p.FromPort != nil {
+			rule.FromPort = gfnt.NewInteger(int(*p.FromPort))
+		}
+		if p.ToPort != nil {
+			rule.ToPort = gfnt.NewInteger(int(*p.ToPort))
+		}
+		return rule
+	}
+	var rules []gfnec2.SecurityGroup_Egress
+	for _, r := range p.IpRanges {
+		rule := newRule()
+		rule.CidrIp = gfnt.NewString(aws.ToString(r.CidrIp))
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	for _, r := range p.Ipv6Ranges {
+		rule := newRule()
+		rule.CidrIpv6 = gfnt.NewString(aws.ToString(r.CidrIpv6))
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	for _, r := range p.PrefixListIds {
+		rule := newRule()
+		rule.DestinationPrefixListId = gfnt.NewString(aws.ToString(r.PrefixListId))
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	for _, r := range p.UserIdGroupPairs {
+		rule := newRule()
+		rule.DestinationSecurityGroupId = gfnt.NewString(aws.ToString(r.GroupId))
+		rule.Description = makeOptionalString(r.Description)
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// decodePolicyDocument decodes a URL-encoded policy document returned by IAM.
+func decodePolicyDocument(encoded string) (map[string]interface{}, error) {
+	document, err := url.PathUnescape(encoded)
+	if err != nil {
+		return nil, err
+	}
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func makeValueMap(m map[string]string) map[string]*gfnt.Value {
+	values := make(map[string]*gfnt.Value, len(m))
+	for k, v := range m {
+		values[k] = gfnt.NewString(v)
+	}
+	return values
+}
+
+func makeOptionalString(s *string) *gfnt.Value {
+	if s == nil {
+		return nil
+	}
+	return gfnt.NewString(*s)
+}
+
+func makeTag(key, value string) gfncfn.Tag {
+	return gfncfn.Tag{
+		Key:   gfnt.NewString(key),
+		Value: gfnt.NewString(value),
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
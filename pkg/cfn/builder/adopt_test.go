@@ -0,0 +1,155 @@
+package builder_test
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/goformation"
+)
+
+var _ = Describe("Adopted resources", func() {
+	renderResources := func(rs *builder.AdoptedResourceSet) map[string]interface{} {
+		data, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		var template struct {
+			Resources map[string]interface{}
+		}
+		Expect(json.Unmarshal(data, &template)).To(Succeed())
+		return template.Resources
+	}
+
+	It("describes a managed nodegroup with the logical ID used by eksctl", func() {
+		rs := builder.NewAdoptedNodeGroupResourceSet(&ekstypes.Nodegroup{
+			ClusterName:    aws.String("test"),
+			NodegroupName:  aws.String("ng"),
+			NodeRole:       aws.String("arn:aws:iam::123456789012:role/node"),
+			Subnets:        []string{"subnet-1", "subnet-2"},
+			AmiType:        ekstypes.AMITypesAl2023X8664Standard,
+			CapacityType:   ekstypes.CapacityTypesOnDemand,
+			InstanceTypes:  []string{"m5.large"},
+			Version:        aws.String("1.32"),
+			ReleaseVersion: aws.String("1.32.0-20250101"),
+			ScalingConfig: &ekstypes.NodegroupScalingConfig{
+				MinSize:     aws.Int32(1),
+				MaxSize:     aws.Int32(3),
+				DesiredSize: aws.Int32(2),
+			},
+			Labels: map[string]string{"role": "worker"},
+			Taints: []ekstypes.Taint{{Key: aws.String("dedicated"), Value: aws.String("gpu"), Effect: ekstypes.TaintEffectNoSchedule}},
+		}, false)
+		Expect(rs.ResourceToImport()).To(Equal(cfntypes.ResourceToImport{
+			LogicalResourceId:  aws.String(builder.ManagedNodeGroupResourceName),
+			ResourceType:       aws.String("AWS::EKS::Nodegroup"),
+			ResourceIdentifier: map[string]string{"Id": "test/ng"},
+		}))
+
+		data, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		template, err := goformation.ParseJSON(data)
+		Expect(err).NotTo(HaveOccurred())
+		ng, ok := template.GetAllEKSNodegroupResources()[builder.ManagedNodeGroupResourceName]
+		Expect(ok).To(BeTrue())
+		Expect(ng.NodegroupName.String()).To(Equal("ng"))
+		Expect(ng.ReleaseVersion.String()).To(Equal("1.32.0-20250101"))
+		Expect(ng.ScalingConfig.DesiredSize.String()).To(Equal("2"))
+		Expect(ng.Labels).To(HaveKey("role"))
+		Expect(ng.Taints).To(HaveLen(1))
+		Expect(string(ng.AWSCloudFormationDeletionPolicy)).To(Equal("Retain"))
+		Expect(renderResources(rs)[builder.ManagedNodeGroupResourceName]).NotTo(HaveKeyWithValue("Properties", HaveKey("Tags")))
+	})
+
+	It("does not set the release version of a nodegroup using a custom AMI", func() {
+		rs := builder.NewAdoptedNodeGroupResourceSet(&ekstypes.Nodegroup{
+			ClusterName:    aws.String("test"),
+			NodegroupName:  aws.String("custom"),
+			AmiType:        ekstypes.AMITypesCustom,
+			ReleaseVersion: aws.String("ami-123"),
+			LaunchTemplate: &ekstypes.LaunchTemplateSpecification{Id: aws.String("lt-123"), Version: aws.String("2")},
+		}, false)
+		properties := renderResources(rs)[builder.ManagedNodeGroupResourceName].(map[string]interface{})["Properties"]
+		Expect(properties).NotTo(HaveKey("ReleaseVersion"))
+		Expect(properties).NotTo(HaveKey("Version"))
+		Expect(properties).To(HaveKeyWithValue("LaunchTemplate", map[string]interface{}{"Id": "lt-123", "Version": "2"}))
+	})
+
+	It("describes an IAM role with its policies, to be deleted with its stack if asked for", func() {
+		rs, err := builder.NewAdoptedRoleResourceSet(&iamtypes.Role{
+			RoleName:                 aws.String("node-role"),
+			Path:                     aws.String("/"),
+			AssumeRolePolicyDocument: aws.String(url.PathEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`)),
+		}, []string{"arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"}, map[string]string{
+			"s3": url.PathEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+		}, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rs.WithNamedIAM()).To(BeTrue())
+		Expect(rs.ResourceToImport().ResourceIdentifier).To(Equal(map[string]string{"RoleName": "node-role"}))
+
+		data, err := json.Marshal(renderResources(rs)["Role"])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(MatchJSON(`{
+			"Type": "AWS::IAM::Role",
+			"DeletionPolicy": "Delete",
+			"Properties": {
+				"RoleName": "node-role",
+				"Path": "/",
+				"AssumeRolePolicyDocument": {"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"Service": "ec2.amazonaws.com"}, "Action": "sts:AssumeRole"}]},
+				"ManagedPolicyArns": ["arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"],
+				"Policies": [{"PolicyName": "s3", "PolicyDocument": {"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}}]
+			}
+		}`))
+	})
+
+	It("describes a security group with one rule per source", func() {
+		rs := builder.NewAdoptedSecurityGroupResourceSet(&ec2types.SecurityGroup{
+			GroupId:     aws.String("sg-123"),
+			GroupName:   aws.String("nodes"),
+			Description: aws.String("node security group"),
+			VpcId:       aws.String("vpc-123"),
+			IpPermissions: []ec2types.IpPermission{{
+				IpProtocol:       aws.String("tcp"),
+				FromPort:         aws.Int32(443),
+				ToPort:           aws.Int32(443),
+				IpRanges:         []ec2types.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+				UserIdGroupPairs: []ec2types.UserIdGroupPair{{GroupId: aws.String("sg-456"), Description: aws.String("control plane")}},
+			}},
+			IpPermissionsEgress: []ec2types.IpPermission{{
+				IpProtocol: aws.String("-1"),
+				IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+			}},
+		}, false)
+		Expect(rs.ResourceToImport().ResourceIdentifier).To(Equal(map[string]string{"Id": "sg-123"}))
+		Expect(renderResources(rs)["SecurityGroup"]).To(HaveKeyWithValue("DeletionPolicy", "Retain"))
+
+		properties := renderResources(rs)["SecurityGroup"].(map[string]interface{})["Properties"]
+		Expect(properties).To(HaveKeyWithValue("SecurityGroupIngress", ConsistOf(
+			map[string]interface{}{"IpProtocol": "tcp", "FromPort": float64(443), "ToPort": float64(443), "CidrIp": "10.0.0.0/16"},
+			map[string]interface{}{"IpProtocol": "tcp", "FromPort": float64(443), "ToPort": float64(443), "SourceSecurityGroupId": "sg-456", "Description": "control plane"},
+		)))
+		Expect(properties).To(HaveKeyWithValue("SecurityGroupEgress", ConsistOf(
+			map[string]interface{}{"IpProtocol": "-1", "CidrIp": "0.0.0.0/0"},
+		)))
+	})
+
+	It("describes an IAM OIDC provider with an https URL", func() {
+		const providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/ABC"
+		rs := builder.NewAdoptedOIDCProviderResourceSet(providerARN, &iam.GetOpenIDConnectProviderOutput{
+			Url:            aws.String("oidc.eks.us-west-2.amazonaws.com/id/ABC"),
+			ClientIDList:   []string{"sts.amazonaws.com"},
+			ThumbprintList: []string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"},
+		}, false)
+		Expect(rs.ResourceToImport().ResourceIdentifier).To(Equal(map[string]string{"Arn": providerARN}))
+
+		properties := renderResources(rs)["OIDCProvider"].(map[string]interface{})["Properties"]
+		Expect(properties).To(HaveKeyWithValue("Url", "https://oidc.eks.us-west-2.amazonaws.com/id/ABC"))
+	})
+})
@@ -0,0 +1,140 @@
+package builder
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// StackFailureCause identifies a common cause of CloudFormation resource failures
+type StackFailureCause string
+
+// Values for `StackFailureCause`
+const (
+	StackFailureCauseSCPDeny              StackFailureCause = "ServiceControlPolicyDeny"
+	StackFailureCausePermissionDenied     StackFailureCause = "PermissionDenied"
+	StackFailureCauseInsufficientCapacity StackFailureCause = "InsufficientCapacity"
+	StackFailureCauseServiceQuota         StackFailureCause = "ServiceQuotaExceeded"
+	StackFailureCauseSubnetOutOfIPs       StackFailureCause = "SubnetOutOfIPs"
+	StackFailureCauseInvalidAMI           StackFailureCause = "InvalidAMI"
+	StackFailureCauseVPCNotFound          StackFailureCause = "VPCNotFound"
+	StackFailureCauseDependencyViolation  StackFailureCause = "DependencyViolation"
+	StackFailureCauseInvalidParameter     StackFailureCause = "InvalidParameter"
+	StackFailureCauseUnknown              StackFailureCause = "Unknown"
+)
+
+// StackFailureRule classifies the failures of the resource types it applies to that match any of its error codes or
+// status reasons
+type StackFailureRule struct {
+	Cause StackFailureCause
+	// ResourceTypes are the CloudFormation resource types the rule applies to; a rule without resource types applies
+	// to all of them
+	ResourceTypes []string
+	// ErrorCodes are AWS error codes, matched exactly
+	ErrorCodes []string
+	// MessagePatterns are matched case-insensitively against the failure message; they should be specific enough
+	// not to match the messages of other causes
+	MessagePatterns []string
+	// Hint is a remediation hint for the cause
+	Hint string
+}
+
+// instanceResourceTypes are the resource types whose failures are caused by failing to launch EC2 instances
+var instanceResourceTypes = []string{"AWS::AutoScaling::AutoScalingGroup", "AWS::EKS::Nodegroup", "AWS::EC2::Instance", "AWS::EC2::EC2Fleet"}
+
+// StackFailureRules is the rule table used to classify stack failures; rules are evaluated in order, so
+// more specific rules must come first. Failures that match no rule are left unclassified. The VPC and
+// parameter rules carry the same guidance as SecurityGroupErrorHandler gives for these errors at template
+// generation time.
+var StackFailureRules = []StackFailureRule{
+	{
+		Cause:           StackFailureCauseSCPDeny,
+		MessagePatterns: []string{"with an explicit deny in a service control policy"},
+		Hint: "the action is denied by a service control policy of your AWS organization; " +
+			"ask your organization administrators to allow it for this account",
+	},
+	{
+		Cause: StackFailureCausePermissionDenied,
+		ErrorCodes: []string{"AccessDenied", "AccessDeniedException", "UnauthorizedOperation", "Client.UnauthorizedOperation",
+			"UnauthorizedAccess", "InvalidClientTokenId"},
+		MessagePatterns: []string{"not authorized to perform"},
+		Hint: "grant the missing permission to the identity running eksctl, or to the role passed with --cfn-role-arn; " +
+			"see https://eksctl.io/usage/minimum-iam-policies/",
+	},
+	{
+		Cause:         StackFailureCauseInsufficientCapacity,
+		ResourceTypes: instanceResourceTypes,
+		ErrorCodes: []string{"InsufficientInstanceCapacity", "Server.InsufficientInstanceCapacity", "InsufficientCapacity",
+			"InsufficientHostCapacity", "InsufficientReservedInstanceCapacity"},
+		MessagePatterns: []string{"we currently do not have sufficient", "there is no spot capacity available"},
+		Hint: "EC2 does not have enough capacity for the instance type in the availability zone; " +
+			"retry later, or use more instance types or other availability zones",
+	},
+	{
+		Cause: StackFailureCauseServiceQuota,
+		ErrorCodes: []string{"LimitExceeded", "LimitExceededException", "ServiceQuotaExceededException", "VcpuLimitExceeded",
+			"InstanceLimitExceeded", "AddressLimitExceeded", "VpcLimitExceeded", "NatGatewayLimitExceeded", "RulesPerSecurityGroupLimitExceeded",
+			"MaxSpotInstanceCountExceeded"},
+		MessagePatterns: []string{"than your current vcpu limit"},
+		Hint:            "a service quota of the account was reached; request an increase in the Service Quotas console or release unused resources",
+	},
+	{
+		Cause:           StackFailureCauseSubnetOutOfIPs,
+		ResourceTypes:   append([]string{"AWS::EC2::NetworkInterface", "AWS::EKS::Cluster"}, instanceResourceTypes...),
+		ErrorCodes:      []string{"InsufficientFreeAddressesInSubnet"},
+		MessagePatterns: []string{"not enough free addresses in subnet", "insufficient free ip addresses"},
+		Hint:            "the subnet has run out of IP addresses; use larger subnets, add subnets, or enable prefix delegation in the VPC CNI",
+	},
+	{
+		Cause:         StackFailureCauseInvalidAMI,
+		ResourceTypes: append([]string{"AWS::EC2::LaunchTemplate"}, instanceResourceTypes...),
+		ErrorCodes:    []string{"InvalidAMIID.NotFound", "InvalidAMIID.Malformed", "InvalidAMIID.Unavailable"},
+		Hint: "the AMI does not exist or is not available in this region; " +
+			"check the ami of the nodegroup, AMIs are regional, or use amiFamily to let eksctl resolve the AMI",
+	},
+	{
+		Cause:      StackFailureCauseVPCNotFound,
+		ErrorCodes: []string{"InvalidVpcId.NotFound", "InvalidVpcID.NotFound"},
+		Hint:       "ensure the VPC exists and you have the necessary permissions to access it",
+	},
+	{
+		Cause:           StackFailureCauseDependencyViolation,
+		ErrorCodes:      []string{"DependencyViolation"},
+		MessagePatterns: []string{"has a dependent object", "has dependencies and cannot be deleted"},
+		Hint: "the resource is still in use by resources created outside of the stack, such as network interfaces " +
+			"or security groups of load balancers; delete them and retry",
+	},
+	{
+		Cause:      StackFailureCauseInvalidParameter,
+		ErrorCodes: []string{"InvalidParameterValue", "InvalidParameterException", "InvalidParameterCombination"},
+		Hint:       "check your cluster configuration and ensure all required fields are properly set",
+	},
+}
+
+var errorCodePattern = regexp.MustCompile(`Error Code: ([A-Za-z0-9.]+)`)
+
+// ClassifyStackFailure returns the first rule of StackFailureRules that applies to the resource type and matches the
+// error code or message of a failure. If errorCode is empty, it is parsed from the message where CloudFormation
+// includes it. Rules with resource types never match failures whose resource type is unknown.
+func ClassifyStackFailure(resourceType, errorCode, message string) (StackFailureRule, bool) {
+	if errorCode == "" {
+		if m := errorCodePattern.FindStringSubmatch(message); m != nil {
+			errorCode = m[1]
+		}
+	}
+	message = strings.ToLower(message)
+	for _, rule := range StackFailureRules {
+		if len(rule.ResourceTypes) > 0 && !slices.Contains(rule.ResourceTypes, resourceType) {
+			continue
+		}
+		if errorCode != "" && slices.Contains(rule.ErrorCodes, errorCode) {
+			return rule, true
+		}
+		for _, pattern := range rule.MessagePatterns {
+			if strings.Contains(message, pattern) {
+				return rule, true
+			}
+		}
+	}
+	return StackFailureRule{Cause: StackFailureCauseUnknown}, false
+}
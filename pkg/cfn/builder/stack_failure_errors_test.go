@@ -0,0 +1,133 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyStackFailure(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceType  string
+		errorCode     string
+		message       string
+		expectedCause StackFailureCause
+		expectedMatch bool
+	}{
+		{
+			name:          "insufficient capacity from the resource status reason",
+			resourceType:  "AWS::AutoScaling::AutoScalingGroup",
+			message:       "We currently do not have sufficient m5.large capacity in the Availability Zone you requested (us-west-2d).",
+			expectedCause: StackFailureCauseInsufficientCapacity,
+			expectedMatch: true,
+		},
+		{
+			name:          "service quota from the error code in the reason",
+			message:       "Resource handler returned message: \"The maximum number of addresses has been reached. (Service: Ec2, Status Code: 400, Error Code: AddressLimitExceeded)\"",
+			expectedCause: StackFailureCauseServiceQuota,
+			expectedMatch: true,
+		},
+		{
+			name:          "permission denied from a CloudTrail error code",
+			errorCode:     "Client.UnauthorizedOperation",
+			message:       "You are not authorized to perform this operation.",
+			expectedCause: StackFailureCausePermissionDenied,
+			expectedMatch: true,
+		},
+		{
+			name:          "explicit deny in a service control policy takes precedence over permission denied",
+			errorCode:     "AccessDenied",
+			message:       "User: arn:aws:iam::123456789012:user/dev is not authorized to perform: ec2:CreateVpc with an explicit deny in a service control policy",
+			expectedCause: StackFailureCauseSCPDeny,
+			expectedMatch: true,
+		},
+		{
+			name:          "subnet out of IP addresses",
+			resourceType:  "AWS::EKS::Nodegroup",
+			message:       "There are not enough free addresses in subnet 'subnet-1234' to satisfy the requested number of instances.",
+			expectedCause: StackFailureCauseSubnetOutOfIPs,
+			expectedMatch: true,
+		},
+		{
+			name:          "invalid AMI",
+			resourceType:  "AWS::EC2::LaunchTemplate",
+			errorCode:     "InvalidAMIID.NotFound",
+			message:       "The image id '[ami-1234]' does not exist",
+			expectedCause: StackFailureCauseInvalidAMI,
+			expectedMatch: true,
+		},
+		{
+			name:          "VPC not found",
+			errorCode:     "InvalidVpcId.NotFound",
+			expectedCause: StackFailureCauseVPCNotFound,
+			expectedMatch: true,
+		},
+		{
+			name:          "insufficient capacity of another resource type",
+			resourceType:  "AWS::EC2::VPC",
+			errorCode:     "InsufficientInstanceCapacity",
+			expectedCause: StackFailureCauseUnknown,
+			expectedMatch: false,
+		},
+		{
+			name:          "invalid AMI of an unknown resource type",
+			errorCode:     "InvalidAMIID.NotFound",
+			expectedCause: StackFailureCauseUnknown,
+			expectedMatch: false,
+		},
+		{
+			name:          "messages mentioning quotas are not service quota failures",
+			resourceType:  "AWS::EKS::Addon",
+			message:       "Resource handler returned message: \"Addon quota-monitor is not supported\"",
+			expectedCause: StackFailureCauseUnknown,
+			expectedMatch: false,
+		},
+		{
+			name:          "throttling is not a service quota failure",
+			resourceType:  "AWS::EC2::SecurityGroup",
+			message:       "Resource handler returned message: \"Request limit exceeded. (Service: Ec2, Status Code: 503, Error Code: RequestLimitExceeded)\"",
+			expectedCause: StackFailureCauseUnknown,
+			expectedMatch: false,
+		},
+		{
+			name:          "messages mentioning access denied without an error code are not classified",
+			resourceType:  "AWS::CloudFormation::CustomResource",
+			message:       "Received response status [FAILED] from custom resource. Message returned: access denied while reading the config map",
+			expectedCause: StackFailureCauseUnknown,
+			expectedMatch: false,
+		},
+		{
+			name:          "generic validation errors are not classified as invalid parameters",
+			resourceType:  "AWS::EKS::Cluster",
+			errorCode:     "ValidationError",
+			message:       "Template format error: Unresolved resource dependencies [VPC] in the Resources block of the template",
+			expectedCause: StackFailureCauseUnknown,
+			expectedMatch: false,
+		},
+		{
+			name:          "invalid parameter",
+			resourceType:  "AWS::EKS::Cluster",
+			message:       "Resource handler returned message: \"unsupported Kubernetes version (Service: Eks, Status Code: 400, Error Code: InvalidParameterException)\"",
+			expectedCause: StackFailureCauseInvalidParameter,
+			expectedMatch: true,
+		},
+		{
+			name:          "unknown failure",
+			message:       "Resource creation cancelled",
+			expectedCause: StackFailureCauseUnknown,
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, ok := ClassifyStackFailure(tt.resourceType, tt.errorCode, tt.message)
+			assert.Equal(t, tt.expectedMatch, ok)
+			assert.Equal(t, tt.expectedCause, rule.Cause)
+			if ok {
+				assert.NotEmpty(t, rule.Hint)
+			}
+		})
+	}
+}
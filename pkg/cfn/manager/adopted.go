@@ -0,0 +1,99 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var invalidStackNameChars = regexp.MustCompile("[^a-zA-Z0-9-]+")
+
+// MakeAdoptedStackName returns the name of the stack that an existing resource adopted by eksctl is
+// imported into, e.g. eksctl-<cluster>-adopted-role-<role name>.
+func MakeAdoptedStackName(clusterName, kind, name string) string {
+	return fmt.Sprintf("eksctl-%s-adopted-%s-%s", clusterName, kind, invalidStackNameChars.ReplaceAllString(name, "-"))
+}
+
+// IsAdoptedStack reports whether s holds resources of cluster clusterName that were adopted by eksctl.
+func IsAdoptedStack(clusterName string, s *Stack) bool {
+	return strings.HasPrefix(*s.StackName, fmt.Sprintf("eksctl-%s-adopted-", clusterName))
+}
+
+// isAdoptedNodeGroupStack reports whether s is the stack of a managed nodegroup that was adopted by eksctl.
+// Adopted nodegroup stacks use the same name as the stacks of nodegroups created by eksctl, and are told
+// apart by their description.
+func isAdoptedNodeGroupStack(s *Stack) bool {
+	return s.Description != nil && builder.IsAdoptedTemplateDescription(*s.Description)
+}
+
+// retainsNodeGroup reports whether the adopted nodegroup stack s retains its nodegroup when it is deleted
+func (c *StackCollection) retainsNodeGroup(ctx context.Context, s *Stack) (bool, error) {
+	templateBody, err := c.GetStackTemplate(ctx, *s.StackName)
+	if err != nil {
+		return false, fmt.Errorf("getting template of stack %q: %w", *s.StackName, err)
+	}
+	var template struct {
+		Resources map[string]struct {
+			DeletionPolicy string
+		}
+	}
+	if err := json.Unmarshal([]byte(templateBody), &template); err != nil {
+		return false, fmt.Errorf("parsing template of stack %q: %w", *s.StackName, err)
+	}
+	return template.Resources[builder.ManagedNodeGroupResourceName].DeletionPolicy == "Retain", nil
+}
+
+// deleteAdoptedNodeGroup deletes the stack of an adopted managed nodegroup, and the nodegroup too when the stack
+// retains it, so that deleting an adopted nodegroup deletes it like any nodegroup created by eksctl.
+// The deletion of a retained nodegroup is only waited for if wait is set.
+func (c *StackCollection) deleteAdoptedNodeGroup(ctx context.Context, ngName string, s *Stack, wait bool) error {
+	retained, err := c.retainsNodeGroup(ctx, s)
+	if err != nil {
+		return err
+	}
+	if !retained {
+		if !wait {
+			_, err := c.DeleteStackBySpec(ctx, s)
+			return err
+		}
+		return c.DeleteStackSync(ctx, s)
+	}
+
+	// the stack only holds the retained nodegroup, so deleting it does not take long
+	if err := c.DeleteStackSync(ctx, s); err != nil {
+		return err
+	}
+	logger.Info("deleting nodegroup %q, which was retained by its adopted stack %q", ngName, *s.StackName)
+	input := &awseks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(ngName),
+	}
+	if _, err := c.eksAPI.DeleteNodegroup(ctx, &awseks.DeleteNodegroupInput{
+		ClusterName:   input.ClusterName,
+		NodegroupName: input.NodegroupName,
+	}); err != nil {
+		var notFound *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("deleting nodegroup %q: %w", ngName, err)
+	}
+	if !wait {
+		return nil
+	}
+	logger.Info("waiting for nodegroup %q to get deleted", ngName)
+	if err := awseks.NewNodegroupDeletedWaiter(c.eksAPI).Wait(ctx, input, c.waitTimeout); err != nil {
+		return fmt.Errorf("waiting for nodegroup %q to get deleted: %w", ngName, err)
+	}
+	return nil
+}
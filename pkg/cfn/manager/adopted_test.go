@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Deleting adopted nodegroups", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sm *StackCollection
+	)
+
+	nodeGroupStack := func(description string) NodeGroupStack {
+		return NodeGroupStack{
+			NodeGroupName: "ng-1",
+			Type:          api.NodeGroupTypeManaged,
+			Stack: &Stack{
+				StackName:   aws.String(stackName),
+				StackId:     aws.String("id"),
+				Description: aws.String(description),
+				Tags:        []cfntypes.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+			},
+		}
+	}
+
+	mockTemplate := func(deletionPolicy string) {
+		p.MockCloudFormation().On("GetTemplate", mock.Anything, mock.Anything).Return(&cloudformation.GetTemplateOutput{
+			TemplateBody: aws.String(`{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "DeletionPolicy": "` + deletionPolicy + `"}}}`),
+		}, nil)
+	}
+
+	deleteNodeGroups := func(stacks ...NodeGroupStack) {
+		taskTree, err := sm.NewTasksToDeleteNodeGroups(stacks, deleteAll, true, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taskTree.DoAllSync()).To(BeEmpty())
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sm = NewStackCollection(p, cfg).(*StackCollection)
+
+		p.MockCloudFormation().On("DeleteStack", mock.Anything, mock.Anything).Return(&cloudformation.DeleteStackOutput{}, nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeStacksOutput{
+			Stacks: []cfntypes.Stack{{StackName: aws.String(stackName), StackStatus: cfntypes.StackStatusDeleteComplete}},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeStackEventsOutput{}, nil)
+	})
+
+	It("deletes a nodegroup retained by its adopted stack", func() {
+		mockTemplate("Retain")
+		p.MockEKS().On("DeleteNodegroup", mock.Anything, mock.Anything).Return(&awseks.DeleteNodegroupOutput{}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything, mock.Anything).Return(nil, &ekstypes.ResourceNotFoundException{})
+
+		deleteNodeGroups(nodeGroupStack("EKS managed nodes (adopted) [created by eksctl]"))
+
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStack", mock.Anything, mock.Anything)
+		p.MockEKS().AssertCalled(GinkgoT(), "DeleteNodegroup", mock.Anything, &awseks.DeleteNodegroupInput{
+			ClusterName:   aws.String("test-cluster"),
+			NodegroupName: aws.String("ng-1"),
+		})
+		p.MockEKS().AssertCalled(GinkgoT(), "DescribeNodegroup", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	It("leaves the deletion of the nodegroup to an adopted stack that does not retain it", func() {
+		mockTemplate("Delete")
+
+		deleteNodeGroups(nodeGroupStack("EKS managed nodes (adopted) [created by eksctl]"))
+
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStack", mock.Anything, mock.Anything)
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DeleteNodegroup", mock.Anything, mock.Anything)
+	})
+
+	It("does not look up the template of nodegroup stacks created by eksctl", func() {
+		deleteNodeGroups(nodeGroupStack("EKS Managed Nodes (SSH access: false) [created by eksctl]"))
+
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStack", mock.Anything, mock.Anything)
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "GetTemplate", mock.Anything, mock.Anything)
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DeleteNodegroup", mock.Anything, mock.Anything)
+	})
+})
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
@@ -24,6 +27,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -32,7 +36,7 @@ const (
 	resourceTypeAutoScalingGroup = "auto-scaling-group"
 	outputsRootPath              = "Outputs"
 	mappingsRootPath             = "Mappings"
-	ourStackRegexFmt             = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|addon-.+|podidentityrole-.+|fargate|karpenter|capability-.+)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
+	ourStackRegexFmt             = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|addon-.+|podidentityrole-.+|fargate|karpenter|capability-.+|adopted-.+)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
 	clusterStackRegex            = "eksctl-.*-cluster"
 )
 
@@ -84,6 +88,90 @@ type StackCollection struct {
 	waitTimeout     time.Duration
 	sharedTags      []types.Tag
 	stsAPI          awsapi.STS
+
+	templateExporter *TemplateExporter
+	templateSource   *TemplateSource
+	templateStager   *templateStager
+
+	// createdStacks are the names of the stacks whose creation was requested by this StackCollection
+	createdStacks sync.Map
+}
+
+// mergeTags merges tagSets in order, with the values of later sets overriding those of earlier sets
+func mergeTags(tagSets ...[]types.Tag) []types.Tag {
+	var merged []types.Tag
+	index := map[string]int{}
+	for _, tags := range tagSets {
+		for _, tag := range tags {
+			key := aws.ToString(tag.Key)
+			if i, ok := index[key]; ok {
+				merged[i] = tag
+				continue
+			}
+			index[key] = len(merged)
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
+
+// withoutTags returns tags without the tags whose keys are in keys
+func withoutTags(tags []types.Tag, keys []string) []types.Tag {
+	if len(keys) == 0 {
+		return tags
+	}
+	return slices.DeleteFunc(tags, func(t types.Tag) bool {
+		return slices.Contains(keys, aws.ToString(t.Key))
+	})
+}
+
+// changeSetParameters returns the parameters a stack is updated with, along with the parameters that pass the values
+// resolved from references to it
+func changeSetParameters(options UpdateStackOptions, resolvedValueParameters []types.Parameter) []types.Parameter {
+	isResolvedValueParameter := func(key string) bool {
+		return slices.ContainsFunc(resolvedValueParameters, func(p types.Parameter) bool {
+			return aws.ToString(p.ParameterKey) == key
+		})
+	}
+	var parameters []types.Parameter
+	if options.UsePreviousParameters {
+		for _, p := range options.Stack.Parameters {
+			if isResolvedValueParameter(aws.ToString(p.ParameterKey)) {
+				continue
+			}
+			parameters = append(parameters, types.Parameter{
+				ParameterKey:     p.ParameterKey,
+				UsePreviousValue: aws.Bool(true),
+			})
+		}
+		return append(parameters, resolvedValueParameters...)
+	}
+	for _, k := range slices.Sorted(maps.Keys(options.Parameters)) {
+		if isResolvedValueParameter(k) {
+			continue
+		}
+		parameters = append(parameters, types.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(options.Parameters[k]),
+		})
+	}
+	return append(parameters, resolvedValueParameters...)
+}
+
+// deploymentMode returns the deployment mode of the change set a stack is updated with
+func deploymentMode(options UpdateStackOptions) types.DeploymentMode {
+	if options.RevertDrift {
+		return types.DeploymentModeRevertDrift
+	}
+	return ""
+}
+
+func tagsFromMap(tags map[string]string) []types.Tag {
+	var out []types.Tag
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		out = append(out, newTag(k, tags[k]))
+	}
+	return out
 }
 
 func newTag(key, value string) types.Tag {
@@ -92,6 +180,11 @@ func newTag(key, value string) types.Tag {
 
 // NewStackCollection creates a stack manager for a single cluster
 func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) StackManager {
+	return NewStackCollectionWithOptions(provider, spec)
+}
+
+// NewStackCollectionWithOptions creates a stack manager for a single cluster, configured with options
+func NewStackCollectionWithOptions(provider api.ClusterProvider, spec *api.ClusterConfig, options ...StackCollectionOption) StackManager {
 	tags := []types.Tag{
 		newTag(api.ClusterNameTag, spec.Metadata.Name),
 		newTag(api.OldClusterNameTag, spec.Metadata.Name),
@@ -100,7 +193,7 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) S
 	for key, value := range spec.Metadata.Tags {
 		tags = append(tags, newTag(key, value))
 	}
-	return &StackCollection{
+	c := &StackCollection{
 		spec:              spec,
 		sharedTags:        tags,
 		cloudformationAPI: provider.CloudFormation(),
@@ -114,7 +207,12 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) S
 		region:            provider.Region(),
 		waitTimeout:       provider.WaitTimeout(),
 		stsAPI:            provider.STS(),
+		templateStager:    newTemplateStager(provider, spec.Metadata.Name),
+	}
+	for _, o := range options {
+		o(c)
 	}
+	return c
 }
 
 // DoCreateStackRequest requests the creation of a CloudFormation stack
@@ -128,15 +226,17 @@ func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, te
 	for k, v := range tags {
 		input.Tags = append(input.Tags, newTag(k, v))
 	}
+	if c.spec != nil && *i.StackName == c.MakeClusterStackName() {
+		if err := c.applyClusterStackProtection(input); err != nil {
+			return err
+		}
+	}
 
-	switch data := templateData.(type) {
-	case TemplateBody:
-		input.TemplateBody = aws.String(string(data))
-	case TemplateURL:
-		input.TemplateURL = aws.String(string(data))
-	default:
-		return fmt.Errorf("unknown template data type: %T", templateData)
+	templateBody, templateURL, err := c.resolveTemplateData(ctx, *i.StackName, templateData)
+	if err != nil {
+		return err
 	}
+	input.TemplateBody, input.TemplateURL = templateBody, templateURL
 
 	if withIAM {
 		input.Capabilities = stackCapabilitiesIAM
@@ -163,6 +263,7 @@ func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, te
 		return fmt.Errorf("creating CloudFormation stack %q: %w", *i.StackName, err)
 	}
 	i.StackId = s.StackId
+	c.createdStacks.Store(*i.StackName, true)
 	return nil
 }
 
@@ -175,6 +276,10 @@ func (c *StackCollection) CreateStack(ctx context.Context, stackName string, res
 	if err != nil {
 		return err
 	}
+	if c.templateExporter != nil {
+		go exportedStackDone(errs)
+		return nil
+	}
 
 	go c.waitUntilStackIsCreated(ctx, stack, resourceSet, errs)
 	return nil
@@ -189,6 +294,10 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 	if err != nil {
 		return err
 	}
+	if c.templateExporter != nil {
+		go exportedStackDone(errCh)
+		return nil
+	}
 
 	go func() {
 		defer close(errCh)
@@ -204,18 +313,26 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 			c.TroubleshootStackFailureCause(ctx, stack, types.StackStatusCreateComplete)
 		}
 
-		ctx, cancelFunc := context.WithTimeout(context.Background(), c.waitTimeout)
+		// waiting stops when ctx is cancelled, e.g. because eksctl was interrupted
+		waitCtx, cancelFunc := context.WithTimeout(ctx, c.waitTimeout)
 		defer cancelFunc()
 
-		stack, err := waiter.WaitForStack(ctx, c.cloudformationAPI, *stack.StackId, *stack.StackName, waiter.ClusterCreationNextDelay)
+		events := c.newStackEventStreamer(stack)
+		defer events.done()
+		createdStack, err := waiter.WaitForStack(waitCtx, c.cloudformationAPI, *stack.StackId, *stack.StackName, waiter.ClusterCreationNextDelay, func(ctx context.Context, s *Stack) {
+			events.observe(ctx, s)
+			events.poll(ctx)
+		})
 
 		if err != nil {
-			troubleshoot()
-			errCh <- err
+			if ctx.Err() == nil {
+				troubleshoot()
+			}
+			errCh <- c.withFailureDiagnosis(ctx, stack, err)
 			return
 		}
 
-		if err := resourceSet.GetAllOutputs(*stack); err != nil {
+		if err := resourceSet.GetAllOutputs(*createdStack); err != nil {
 			errCh <- fmt.Errorf("getting stack %q outputs: %w", *stack.StackName, err)
 			return
 		}
@@ -233,15 +350,110 @@ func (c *StackCollection) createStackRequest(ctx context.Context, stackName stri
 	if err != nil {
 		return nil, fmt.Errorf("rendering template for %q stack: %w", *stack.StackName, err)
 	}
-
-	if err := c.DoCreateStackRequest(ctx, stack, TemplateBody(templateBody), tags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM()); err != nil {
+	templateBody, resolvedValues, err := c.parameterizeResolvedValues(stackName, templateBody)
+	if err != nil {
 		return nil, err
 	}
 
+	switch {
+	case c.templateExporter != nil:
+		parameters = withResolvedValueParameters(parameters, resolvedValues, true)
+		if err := c.exportStack(stackName, templateBody, tags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM()); err != nil {
+			return nil, err
+		}
+		return stack, nil
+	case c.templateSource != nil:
+		if err := c.createStackFromSource(ctx, stack, templateBody); err != nil {
+			return nil, err
+		}
+	default:
+		existing, err := c.stackCreatedByEarlierAttempt(ctx, stack)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			logger.Info("stack %q was created by an earlier attempt of the task, waiting for it instead of creating it", stackName)
+			return existing, nil
+		}
+		existing, err = c.stackCreatedInResumedRun(ctx, stack)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			logger.Info("stack %q was created by the resumed run, waiting for it instead of creating it", stackName)
+			return existing, nil
+		}
+		parameters = withResolvedValueParameters(parameters, resolvedValues, false)
+		if err := c.DoCreateStackRequest(ctx, stack, TemplateBody(templateBody), tags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM()); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Info("deploying stack %q", stackName)
 	return stack, nil
 }
 
+// stackCreatedByEarlierAttempt returns the stack if it is being or was created by an earlier attempt of a task that
+// is retried. A stack that failed to be created by an earlier attempt is deleted once it is rolled back, so that it
+// can be created again; a stack that cannot be deleted safely, e.g. because its rollback failed, is reported as an
+// error.
+func (c *StackCollection) stackCreatedByEarlierAttempt(ctx context.Context, i *Stack) (*Stack, error) {
+	if !tasks.IsRetry(ctx) {
+		return nil, nil
+	}
+	if _, ok := c.createdStacks.Load(*i.StackName); !ok {
+		return nil, nil
+	}
+	stack, err := c.DescribeStack(ctx, i)
+	if err != nil {
+		if IsStackDoesNotExistError(err) {
+			// the earlier attempt failed before the stack was created
+			return nil, nil
+		}
+		return nil, err
+	}
+	if stack.StackStatus == types.StackStatusRollbackInProgress {
+		logger.Info("waiting for the rollback of stack %q, which failed to be created by an earlier attempt of the task", *i.StackName)
+		if stack, err = c.doWaitUntilStackCreationIsRolledBack(ctx, stack); err != nil {
+			return nil, fmt.Errorf("waiting for the rollback of stack %q: %w", *i.StackName, err)
+		}
+	}
+	switch stack.StackStatus {
+	case types.StackStatusCreateInProgress, types.StackStatusCreateComplete:
+		return stack, nil
+	case types.StackStatusRollbackComplete:
+		logger.Info("deleting stack %q, which failed to be created by an earlier attempt of the task", *i.StackName)
+		if err := c.DeleteStackSync(ctx, stack); err != nil {
+			return nil, fmt.Errorf("deleting stack %q before creating it again: %w", *i.StackName, err)
+		}
+		c.createdStacks.Delete(*i.StackName)
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("stack %q failed to be created by an earlier attempt of the task and is in status %q, "+
+			"in which it cannot be deleted safely; delete it and run the command again", *i.StackName, stack.StackStatus)
+	}
+}
+
+// stackCreatedInResumedRun returns the stack if it is being or was created by a run that is being resumed, in which
+// case the task that creates it was interrupted before it completed; a stack that does not exist is not an error
+func (c *StackCollection) stackCreatedInResumedRun(ctx context.Context, i *Stack) (*Stack, error) {
+	if !tasks.IsResumingRun(ctx) {
+		return nil, nil
+	}
+	stack, err := c.DescribeStack(ctx, i)
+	if err != nil {
+		if IsStackDoesNotExistError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("checking whether stack %q was created by the resumed run: %w", *i.StackName, err)
+	}
+	switch stack.StackStatus {
+	case types.StackStatusCreateInProgress, types.StackStatusCreateComplete:
+		return stack, nil
+	}
+	return nil, nil
+}
+
 func (c *StackCollection) PropagateManagedNodeGroupTagsToASG(ngName string, ngTags map[string]string, asgNames []string, errCh chan error) error {
 	go func() {
 		defer close(errCh)
@@ -328,7 +540,9 @@ func (c *StackCollection) checkASGTagsNumber(ngName, asgName string, propagatedT
 	return nil
 }
 
-// UpdateStack will update a CloudFormation stack by creating and executing a ChangeSet
+// UpdateStack will update a CloudFormation stack by creating and executing a ChangeSet.
+// The changes are printed before the ChangeSet is executed. In plan mode, no ChangeSet is created,
+// unless change sets are previewed, in which case it is deleted instead of being executed
 func (c *StackCollection) UpdateStack(ctx context.Context, options UpdateStackOptions) error {
 	return c.updateStack(ctx, options, true)
 }
@@ -346,14 +560,28 @@ func (c *StackCollection) updateStack(ctx context.Context, options UpdateStackOp
 	} else {
 		options.StackName = *options.Stack.StackName
 	}
+	if options.Plan && !tasks.RunOptionsFrom(ctx).PreviewChangeSets {
+		logger.Info("(plan) not creating changeSet %q for stack %q; use --preview-change-sets to list the changes to its resources", options.ChangeSetName, options.StackName)
+		tasks.RecordAction(ctx, options.Description, tasks.Metadata{
+			Action:       "update",
+			ResourceType: "stack",
+			StackName:    options.StackName,
+		})
+		return nil
+	}
+	templateData, resolvedValueParameters, err := c.resolvedValueChangeSetParameters(options)
+	if err != nil {
+		return err
+	}
 	if err := c.doCreateChangeSetRequest(ctx,
 		options.StackName,
 		options.ChangeSetName,
 		options.Description,
-		options.TemplateData,
-		options.Parameters,
+		templateData,
+		changeSetParameters(options, resolvedValueParameters),
 		options.Stack.Capabilities,
-		options.Stack.Tags,
+		withoutTags(mergeTags(options.Stack.Tags, c.sharedTags, tagsFromMap(options.Tags)), options.RemoveTags),
+		deploymentMode(options),
 	); err != nil {
 		return err
 	}
@@ -372,6 +600,13 @@ func (c *StackCollection) updateStack(ctx context.Context, options UpdateStackOp
 		return err
 	}
 	logger.Debug("changes = %#v", changeSet.Changes)
+	summary := SummarizeChangeSet(options.StackName, options.ChangeSetName, changeSet)
+	tasks.RecordChangeSet(ctx, options.Description, summary)
+	LogChangeSet(summary)
+	if options.Plan {
+		logger.Info("(plan) not executing changeSet %q for stack %q", options.ChangeSetName, options.StackName)
+		return c.doDeleteChangeSet(ctx, options.StackName, options.ChangeSetName)
+	}
 	if err := c.doExecuteChangeSet(ctx, options.StackName, options.ChangeSetName); err != nil {
 		logger.Warning("error executing Cloudformation changeSet %s in stack %s. Check the Cloudformation console for further details", options.ChangeSetName, options.StackName)
 		return err
@@ -382,6 +617,28 @@ func (c *StackCollection) updateStack(ctx context.Context, options UpdateStackOp
 	return nil
 }
 
+// SummarizeChangeSet returns the resource changes of a described ChangeSet.
+func SummarizeChangeSet(stackName, changeSetName string, changeSet *ChangeSet) tasks.ChangeSet {
+	summary := tasks.ChangeSet{
+		Name:      changeSetName,
+		StackName: stackName,
+	}
+	for _, change := range changeSet.Changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		rc := change.ResourceChange
+		summary.Changes = append(summary.Changes, tasks.ResourceChange{
+			Action:             string(rc.Action),
+			LogicalResourceID:  aws.ToString(rc.LogicalResourceId),
+			PhysicalResourceID: aws.ToString(rc.PhysicalResourceId),
+			ResourceType:       aws.ToString(rc.ResourceType),
+			Replacement:        string(rc.Replacement),
+		})
+	}
+	return summary
+}
+
 // MustUpdateStack is like UpdateStack but returns a NoChangeError if there are no changes to execute.
 func (c *StackCollection) MustUpdateStack(ctx context.Context, options UpdateStackOptions) error {
 	return c.updateStack(ctx, options, false)
@@ -576,6 +833,10 @@ func defaultStackStatusFilter() []types.StackStatus {
 
 // DeleteStackBySpec sends a request to delete the stack
 func (c *StackCollection) DeleteStackBySpec(ctx context.Context, s *Stack) (*Stack, error) {
+	return c.deleteStack(ctx, s, nil)
+}
+
+func (c *StackCollection) deleteStack(ctx context.Context, s *Stack, retainResources []string) (*Stack, error) {
 	if !matchesCluster(c.spec.Metadata.Name, s.Tags) {
 		return nil, fmt.Errorf("cannot delete stack %q as it doesn't bear our %q, %q tags", *s.StackName,
 			fmt.Sprintf("%s:%s", api.OldClusterNameTag, c.spec.Metadata.Name),
@@ -593,7 +854,8 @@ func (c *StackCollection) DeleteStackBySpec(ctx context.Context, s *Stack) (*Sta
 	}
 
 	input := &cloudformation.DeleteStackInput{
-		StackName: s.StackId,
+		StackName:       s.StackId,
+		RetainResources: retainResources,
 	}
 
 	if cfnRole := c.roleARN; cfnRole != "" {
@@ -646,6 +908,42 @@ func (c *StackCollection) DeleteStackSync(ctx context.Context, s *Stack) error {
 	return c.doWaitUntilStackIsDeleted(ctx, s)
 }
 
+// DeleteStackRetainingResourcesSync retries the deletion of a stack in DELETE_FAILED status without deleting
+// retainResources, the logical IDs of the resources that failed to be deleted, and waits until status is DELETE_COMPLETE
+func (c *StackCollection) DeleteStackRetainingResourcesSync(ctx context.Context, s *Stack, retainResources []string) error {
+	i, err := c.deleteStack(ctx, s, retainResources)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("waiting for stack %q to get deleted", *i.StackName)
+	return c.doWaitUntilStackIsDeleted(ctx, s)
+}
+
+// ContinueUpdateRollbackSync continues rolling back a stack in UPDATE_ROLLBACK_FAILED status, skipping resourcesToSkip,
+// the logical IDs of the resources that failed to be rolled back, and waits until status is UPDATE_ROLLBACK_COMPLETE
+func (c *StackCollection) ContinueUpdateRollbackSync(ctx context.Context, s *Stack, resourcesToSkip []string) error {
+	if !matchesCluster(c.spec.Metadata.Name, s.Tags) {
+		return fmt.Errorf("cannot roll back stack %q as it doesn't bear our %q, %q tags", *s.StackName,
+			fmt.Sprintf("%s:%s", api.OldClusterNameTag, c.spec.Metadata.Name),
+			fmt.Sprintf("%s:%s", api.ClusterNameTag, c.spec.Metadata.Name))
+	}
+
+	input := &cloudformation.ContinueUpdateRollbackInput{
+		StackName:       s.StackId,
+		ResourcesToSkip: resourcesToSkip,
+	}
+	if cfnRole := c.roleARN; cfnRole != "" {
+		input.RoleARN = &cfnRole
+	}
+	if _, err := c.cloudformationAPI.ContinueUpdateRollback(ctx, input); err != nil {
+		return fmt.Errorf("not able to continue rolling back stack %q: %w", *s.StackName, err)
+	}
+
+	logger.Info("waiting for stack %q to get rolled back", *s.StackName)
+	return c.doWaitUntilStackRollbackIsComplete(ctx, s)
+}
+
 func fmtStacksRegexForCluster(name string) string {
 	return fmt.Sprintf(ourStackRegexFmt, name)
 }
@@ -735,36 +1033,28 @@ func (c *StackCollection) LookupCloudTrailEvents(ctx context.Context, i *Stack)
 }
 
 func (c *StackCollection) doCreateChangeSetRequest(ctx context.Context, stackName, changeSetName, description string, templateData TemplateData,
-	parameters map[string]string, capabilities []types.Capability, tags []types.Tag) error {
+	parameters []types.Parameter, capabilities []types.Capability, tags []types.Tag, deploymentMode types.DeploymentMode) error {
 	input := &cloudformation.CreateChangeSetInput{
-		StackName:     &stackName,
-		ChangeSetName: &changeSetName,
-		Description:   &description,
-		Tags:          append(tags, c.sharedTags...),
-		ChangeSetType: types.ChangeSetTypeUpdate,
+		StackName:      &stackName,
+		ChangeSetName:  &changeSetName,
+		Description:    &description,
+		Tags:           tags,
+		ChangeSetType:  types.ChangeSetTypeUpdate,
+		DeploymentMode: deploymentMode,
 	}
 
-	switch data := templateData.(type) {
-	case TemplateBody:
-		input.TemplateBody = aws.String(string(data))
-	case TemplateURL:
-		input.TemplateURL = aws.String(string(data))
-	default:
-		return fmt.Errorf("unknown template data type: %T", templateData)
+	templateBody, templateURL, err := c.resolveTemplateData(ctx, stackName, templateData)
+	if err != nil {
+		return err
 	}
+	input.TemplateBody, input.TemplateURL = templateBody, templateURL
 
 	input.Capabilities = capabilities
 	if cfnRole := c.roleARN; cfnRole != "" {
 		input.RoleARN = &cfnRole
 	}
 
-	for k, v := range parameters {
-		p := types.Parameter{
-			ParameterKey:   aws.String(k),
-			ParameterValue: aws.String(v),
-		}
-		input.Parameters = append(input.Parameters, p)
-	}
+	input.Parameters = parameters
 
 	logger.Debug("creating changeSet, input = %#v", input)
 	s, err := c.cloudformationAPI.CreateChangeSet(ctx, input)
@@ -789,6 +1079,20 @@ func (c *StackCollection) doExecuteChangeSet(ctx context.Context, stackName stri
 	return nil
 }
 
+func (c *StackCollection) doDeleteChangeSet(ctx context.Context, stackName string, changeSetName string) error {
+	input := &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: &changeSetName,
+		StackName:     &stackName,
+	}
+
+	logger.Debug("deleting changeSet, input = %#v", input)
+
+	if _, err := c.cloudformationAPI.DeleteChangeSet(ctx, input); err != nil {
+		return fmt.Errorf("deleting CloudFormation ChangeSet %q for stack %q: %w", changeSetName, stackName, err)
+	}
+	return nil
+}
+
 // DescribeStackChangeSet describes a ChangeSet by name
 func (c *StackCollection) DescribeStackChangeSet(ctx context.Context, i *Stack, changeSetName string) (*ChangeSet, error) {
 	input := &cloudformation.DescribeChangeSetInput{
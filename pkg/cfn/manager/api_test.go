@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	asTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
 
 	. "github.com/onsi/ginkgo/v2"
 
@@ -19,6 +23,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 var _ = Describe("StackCollection", func() {
@@ -198,6 +203,241 @@ var _ = Describe("StackCollection", func() {
 			})
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("does not create a change set in plan mode", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			recorder := &tasks.PlanRecorder{}
+			ctx := tasks.WithRunOptions(context.Background(), tasks.RunOptions{PlanRecorder: recorder})
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			Expect(sm.UpdateStack(ctx, UpdateStackOptions{
+				Stack:         &Stack{StackName: &stackName},
+				ChangeSetName: "eksctl-changeset",
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				Plan:          true,
+			})).To(Succeed())
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything, mock.Anything)
+
+			Expect(recorder.Plans()).To(ConsistOf(tasks.Plan{
+				Kind:        "action",
+				Description: "description",
+				Metadata:    tasks.Metadata{Action: "update", ResourceType: "stack", StackName: stackName},
+			}))
+		})
+
+		It("deletes the change set instead of executing it in plan mode when change sets are previewed", func() {
+			// Order of AWS SDK invocation
+			// 1) DescribeStacks
+			// 2) CreateChangeSet
+			// 3) DescribeChangeSet
+			// 4) DeleteChangeSet
+
+			stackName := "eksctl-stack"
+			changeSetName := "eksctl-changeset"
+			describeInput := &cfn.DescribeStacksInput{StackName: &stackName}
+			describeOutput := &cfn.DescribeStacksOutput{Stacks: []types.Stack{{
+				StackName:   &stackName,
+				StackStatus: types.StackStatusCreateComplete,
+			}}}
+			describeChangeSetOutput := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+				Status:        types.ChangeSetStatusCreateComplete,
+				Changes: []types.Change{
+					{
+						ResourceChange: &types.ResourceChange{
+							Action:             types.ChangeActionModify,
+							LogicalResourceId:  aws.String("NATGateway"),
+							PhysicalResourceId: aws.String("nat-123"),
+							ResourceType:       aws.String("AWS::EC2::NatGateway"),
+							Replacement:        types.ReplacementTrue,
+						},
+					},
+				},
+			}
+			deleteChangeSetInput := &cfn.DeleteChangeSetInput{
+				ChangeSetName: &changeSetName,
+				StackName:     &stackName,
+			}
+
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, describeInput).Return(describeOutput, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(describeChangeSetOutput, nil)
+			p.MockCloudFormation().On("DeleteChangeSet", mock.Anything, deleteChangeSetInput).Return(nil, nil)
+
+			recorder := &tasks.PlanRecorder{}
+			ctx := tasks.WithRunOptions(context.Background(), tasks.RunOptions{PlanRecorder: recorder, PreviewChangeSets: true})
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(ctx, UpdateStackOptions{
+				StackName:     stackName,
+				ChangeSetName: changeSetName,
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				Wait:          true,
+				Plan:          true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteChangeSet", mock.Anything, deleteChangeSetInput)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything, mock.Anything)
+
+			plans := recorder.Plans()
+			Expect(plans).To(HaveLen(1))
+			Expect(plans[0].ChangeSet.Changes).To(ConsistOf(tasks.ResourceChange{
+				Action:             "Modify",
+				LogicalResourceID:  "NATGateway",
+				PhysicalResourceID: "nat-123",
+				ResourceType:       "AWS::EC2::NatGateway",
+				Replacement:        "True",
+			}))
+		})
+
+		It("sets the tags in the options on the stack, overriding existing tags", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(context.Background(), UpdateStackOptions{
+				Stack: &Stack{
+					StackName: &stackName,
+					Tags: []types.Tag{
+						{Key: aws.String("team"), Value: aws.String("platform")},
+						{Key: aws.String("env"), Value: aws.String("dev")},
+					},
+				},
+				ChangeSetName: "eksctl-changeset",
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				Tags:          map[string]string{"env": "prod", "cost-center": "42"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			createChangeSetInput := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			tags := map[string]string{}
+			for _, t := range createChangeSetInput.Tags {
+				Expect(tags).NotTo(HaveKey(*t.Key))
+				tags[*t.Key] = *t.Value
+			}
+			Expect(tags).To(HaveKeyWithValue("team", "platform"))
+			Expect(tags).To(HaveKeyWithValue("env", "prod"))
+			Expect(tags).To(HaveKeyWithValue("cost-center", "42"))
+			Expect(tags).To(HaveKey(api.ClusterNameTag))
+		})
+
+		It("removes the tags in the options and keeps the previous values of the parameters", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(context.Background(), UpdateStackOptions{
+				Stack: &Stack{
+					StackName: &stackName,
+					Tags: []types.Tag{
+						{Key: aws.String("team"), Value: aws.String("platform")},
+						{Key: aws.String("owner"), Value: aws.String("alice")},
+					},
+					Parameters: []types.Parameter{
+						{ParameterKey: aws.String("Secret"), ParameterValue: aws.String("****")},
+					},
+				},
+				ChangeSetName:         "eksctl-changeset",
+				Description:           "description",
+				TemplateData:          TemplateBody(""),
+				UsePreviousParameters: true,
+				RemoveTags:            []string{"owner"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			createChangeSetInput := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			Expect(createChangeSetInput.Parameters).To(Equal([]types.Parameter{
+				{ParameterKey: aws.String("Secret"), UsePreviousValue: aws.Bool(true)},
+			}))
+			var keys []string
+			for _, t := range createChangeSetInput.Tags {
+				keys = append(keys, *t.Key)
+			}
+			Expect(keys).To(ContainElement("team"))
+			Expect(keys).NotTo(ContainElement("owner"))
+			Expect(createChangeSetInput.DeploymentMode).To(BeEmpty())
+		})
+
+		It("creates drift-aware change sets to revert drift", func() {
+			stackName := "eksctl-stack"
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			err := sm.UpdateStack(context.Background(), UpdateStackOptions{
+				Stack:         &Stack{StackName: &stackName},
+				ChangeSetName: "eksctl-changeset",
+				Description:   "description",
+				TemplateData:  TemplateBody("{}"),
+				RevertDrift:   true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			createChangeSetInput := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateChangeSetInput)
+			Expect(createChangeSetInput.DeploymentMode).To(Equal(types.DeploymentModeRevertDrift))
+		})
+	})
+
+	Context("FormatChangeSet", func() {
+		It("prints one aligned line per resource change", func() {
+			lines := FormatChangeSet(tasks.ChangeSet{
+				Name:      "eksctl-changeset",
+				StackName: "eksctl-stack",
+				Changes: []tasks.ResourceChange{
+					{
+						Action:            "Add",
+						LogicalResourceID: "SubnetPrivateUSWEST2A",
+						ResourceType:      "AWS::EC2::Subnet",
+					},
+					{
+						Action:             "Modify",
+						LogicalResourceID:  "NodeGroup",
+						PhysicalResourceID: "eksctl-asg",
+						ResourceType:       "AWS::AutoScaling::AutoScalingGroup",
+						Replacement:        "True",
+					},
+					{
+						Action:             "Modify",
+						LogicalResourceID:  "NodeGroupLaunchTemplate",
+						PhysicalResourceID: "lt-123",
+						ResourceType:       "AWS::EC2::LaunchTemplate",
+						Replacement:        "False",
+					},
+					{
+						Action:             "Remove",
+						LogicalResourceID:  "NATGateway",
+						PhysicalResourceID: "nat-123",
+						ResourceType:       "AWS::EC2::NatGateway",
+					},
+				},
+			})
+			Expect(lines).To(Equal([]string{
+				"+ Add     AWS::EC2::Subnet                    SubnetPrivateUSWEST2A",
+				"~ Modify  AWS::AutoScaling::AutoScalingGroup  NodeGroup (eksctl-asg)            requires replacement",
+				"~ Modify  AWS::EC2::LaunchTemplate            NodeGroupLaunchTemplate (lt-123)  in-place",
+				"- Remove  AWS::EC2::NatGateway                NATGateway (nat-123)",
+			}))
+		})
 	})
 
 	It("updates tags (existing + metadata + auto)", func() {
@@ -436,4 +676,291 @@ var _ = Describe("StackCollection", func() {
 			})
 		})
 	})
+
+	Context("recovering stuck stacks", func() {
+		const stackName = "eksctl-test-cluster"
+
+		var (
+			p     *mockprovider.MockProvider
+			sm    StackManager
+			stack *Stack
+		)
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test"
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, cfg)
+			stack = &Stack{
+				StackName: aws.String(stackName),
+				StackId:   aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/" + stackName + "/id"),
+				Tags:      []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test")}},
+			}
+			p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{}, nil)
+		})
+
+		It("continues rolling back a stack, skipping resources", func() {
+			p.MockCloudFormation().On("ContinueUpdateRollback", mock.Anything, &cfn.ContinueUpdateRollbackInput{
+				StackName:       stack.StackId,
+				ResourcesToSkip: []string{"ControlPlane"},
+			}).Return(&cfn.ContinueUpdateRollbackOutput{}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: types.StackStatusUpdateRollbackComplete}},
+			}, nil)
+
+			Expect(sm.ContinueUpdateRollbackSync(context.Background(), stack, []string{"ControlPlane"})).To(Succeed())
+		})
+
+		It("deletes a stack, retaining resources", func() {
+			p.MockCloudFormation().On("DeleteStack", mock.Anything, &cfn.DeleteStackInput{
+				StackName:       stack.StackId,
+				RetainResources: []string{"VPC"},
+			}).Return(&cfn.DeleteStackOutput{}, nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: types.StackStatusDeleteComplete}},
+			}, nil)
+
+			Expect(sm.DeleteStackRetainingResourcesSync(context.Background(), stack, []string{"VPC"})).To(Succeed())
+		})
+
+		It("refuses to roll back stacks of other clusters", func() {
+			stack.Tags = nil
+			err := sm.ContinueUpdateRollbackSync(context.Background(), stack, nil)
+			Expect(err).To(MatchError(ContainSubstring("doesn't bear our")))
+		})
+	})
+
+	Context("cluster stack protection", func() {
+		const stackName = "eksctl-test-cluster"
+
+		var (
+			p   *mockprovider.MockProvider
+			cfg *api.ClusterConfig
+		)
+
+		policy := api.InlineDocument{
+			"Statement": []interface{}{
+				map[string]interface{}{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"},
+			},
+		}
+		const policyBody = `{"Statement":[{"Action":"Update:Replace","Effect":"Deny","Principal":"*","Resource":"*"}]}`
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test"
+			cfg.ClusterStack = &api.ClusterStack{
+				TerminationProtection: api.Disabled(),
+				StackPolicy:           policy,
+			}
+			p = mockprovider.NewMockProvider()
+		})
+
+		It("sets the termination protection and stack policy when creating the cluster stack", func() {
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String(stackName)}, nil)
+
+			sm := NewStackCollection(p, cfg)
+			Expect(sm.DoCreateStackRequest(context.Background(), &Stack{StackName: aws.String(stackName)}, TemplateBody("{}"), nil, nil, false, false)).To(Succeed())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateStackInput)
+			Expect(input.EnableTerminationProtection).To(Equal(aws.Bool(false)))
+			Expect(input.StackPolicyBody).To(Equal(aws.String(policyBody)))
+		})
+
+		It("does not apply the protection of the cluster stack to other stacks", func() {
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String("other")}, nil)
+
+			sm := NewStackCollection(p, cfg)
+			Expect(sm.DoCreateStackRequest(context.Background(), &Stack{StackName: aws.String("eksctl-test-nodegroup-ng")}, TemplateBody("{}"), nil, nil, false, false)).To(Succeed())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(1).(*cfn.CreateStackInput)
+			Expect(input.EnableTerminationProtection).To(Equal(aws.Bool(true)))
+			Expect(input.StackPolicyBody).To(BeNil())
+		})
+
+		It("updates the protection of an existing cluster stack", func() {
+			p.MockCloudFormation().On("UpdateTerminationProtection", mock.Anything, &cfn.UpdateTerminationProtectionInput{
+				StackName:                   aws.String(stackName),
+				EnableTerminationProtection: aws.Bool(false),
+			}).Return(&cfn.UpdateTerminationProtectionOutput{}, nil).Once()
+			p.MockCloudFormation().On("SetStackPolicy", mock.Anything, &cfn.SetStackPolicyInput{
+				StackName:       aws.String(stackName),
+				StackPolicyBody: aws.String(policyBody),
+			}).Return(&cfn.SetStackPolicyOutput{}, nil).Once()
+
+			sm := NewStackCollection(p, cfg)
+			Expect(sm.UpdateClusterStackProtection(context.Background())).To(Succeed())
+			p.MockCloudFormation().AssertExpectations(GinkgoT())
+		})
+	})
+
+	Context("creating stacks in a resumed run", func() {
+		const stackName = "eksctl-test-cluster"
+
+		var (
+			p   *mockprovider.MockProvider
+			ctx context.Context
+		)
+
+		describeStack := func(status types.StackStatus) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackId: aws.String("id"), StackStatus: status}},
+			}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			dir := GinkgoT().TempDir()
+			const runID = "20261017T092145Z-3f9a1c"
+			Expect(os.WriteFile(filepath.Join(dir, runID+".json"), []byte(`{"runId":"`+runID+`","tasks":[]}`), 0o600)).To(Succeed())
+			journal, err := tasks.LoadJournal(dir, runID)
+			Expect(err).NotTo(HaveOccurred())
+			ctx = tasks.WithRunOptions(context.Background(), tasks.RunOptions{Journal: journal})
+		})
+
+		It("returns a stack the resumed run started creating", func() {
+			describeStack(types.StackStatusCreateInProgress)
+			sm := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			stack, err := sm.stackCreatedInResumedRun(ctx, &Stack{StackName: aws.String(stackName)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stack).NotTo(BeNil())
+			Expect(*stack.StackId).To(Equal("id"))
+		})
+
+		It("does not return a stack that failed to be created", func() {
+			describeStack(types.StackStatusRollbackComplete)
+			sm := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			Expect(sm.stackCreatedInResumedRun(ctx, &Stack{StackName: aws.String(stackName)})).To(BeNil())
+		})
+
+		It("does not return a stack that does not exist", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(nil, &smithy.OperationError{
+				ServiceID:     "CloudFormation",
+				OperationName: "DescribeStacks",
+				Err:           errors.New("api error ValidationError: Stack with id eksctl-test-cluster does not exist"),
+			})
+			sm := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			Expect(sm.stackCreatedInResumedRun(ctx, &Stack{StackName: aws.String(stackName)})).To(BeNil())
+		})
+
+		It("returns errors describing the stack", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(nil, errors.New("throttled"))
+			sm := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			_, err := sm.stackCreatedInResumedRun(ctx, &Stack{StackName: aws.String(stackName)})
+			Expect(err).To(MatchError(ContainSubstring(`checking whether stack "eksctl-test-cluster" was created by the resumed run: describing CloudFormation stack "eksctl-test-cluster": throttled`)))
+		})
+
+		It("does not look up stacks when no run is resumed", func() {
+			sm := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			Expect(sm.stackCreatedInResumedRun(context.Background(), &Stack{StackName: aws.String(stackName)})).To(BeNil())
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("creating stacks in a retried task", func() {
+		const stackName = "eksctl-test-nodegroup-ng"
+
+		var (
+			p  *mockprovider.MockProvider
+			sm *StackCollection
+		)
+
+		// retry runs a task that requests the creation of the stack and fails, then calls do with the context of the
+		// retry
+		retry := func(do func(ctx context.Context)) {
+			attempts := 0
+			task := &tasks.GenericTask{
+				Description: "create nodegroup",
+				ContextDoer: func(ctx context.Context) error {
+					attempts++
+					if attempts == 1 {
+						sm.createdStacks.Store(stackName, true)
+						return errors.New("failed")
+					}
+					do(ctx)
+					return nil
+				},
+				RetryPolicy: &tasks.RetryPolicy{Retries: 1, Delay: time.Millisecond},
+			}
+			Expect((&tasks.TaskTree{Tasks: []tasks.Task{task}}).DoAllSync()).To(BeEmpty())
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+		})
+
+		It("returns a stack that an earlier attempt started creating", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackId: aws.String("id"), StackStatus: types.StackStatusCreateInProgress}},
+			}, nil)
+			var (
+				stack *Stack
+				err   error
+			)
+			retry(func(ctx context.Context) {
+				stack, err = sm.stackCreatedByEarlierAttempt(ctx, &Stack{StackName: aws.String(stackName)})
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stack).NotTo(BeNil())
+			Expect(*stack.StackId).To(Equal("id"))
+		})
+
+		It("returns the error of looking up a stack that an earlier attempt started creating", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(nil, errors.New("throttled"))
+			var err error
+			retry(func(ctx context.Context) {
+				_, err = sm.stackCreatedByEarlierAttempt(ctx, &Stack{StackName: aws.String(stackName)})
+			})
+			Expect(err).To(MatchError(ContainSubstring("throttled")))
+		})
+
+		It("waits for the rollback of a stack that an earlier attempt failed to create", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackId: aws.String("id"), StackStatus: types.StackStatusRollbackInProgress}},
+			}, nil).Once()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackId: aws.String("id"), StackStatus: types.StackStatusRollbackFailed}},
+			}, nil)
+			var err error
+			retry(func(ctx context.Context) {
+				_, err = sm.stackCreatedByEarlierAttempt(ctx, &Stack{StackName: aws.String(stackName)})
+			})
+			Expect(err).To(MatchError(`stack "eksctl-test-nodegroup-ng" failed to be created by an earlier attempt of the task and is in status "ROLLBACK_FAILED", ` +
+				"in which it cannot be deleted safely; delete it and run the command again"))
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 2)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DeleteStack", mock.Anything, mock.Anything)
+		})
+
+		It("does not delete a stack that an earlier attempt failed to create without rolling it back", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []types.Stack{{StackName: aws.String(stackName), StackId: aws.String("id"), StackStatus: types.StackStatusCreateFailed}},
+			}, nil)
+			var err error
+			retry(func(ctx context.Context) {
+				_, err = sm.stackCreatedByEarlierAttempt(ctx, &Stack{StackName: aws.String(stackName)})
+			})
+			Expect(err).To(MatchError(ContainSubstring(`is in status "CREATE_FAILED"`)))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DeleteStack", mock.Anything, mock.Anything)
+		})
+
+		It("does not look up stacks created by another stack collection", func() {
+			other := NewStackCollection(p, api.NewClusterConfig()).(*StackCollection)
+			var (
+				stack *Stack
+				err   error
+			)
+			retry(func(ctx context.Context) {
+				stack, err = other.stackCreatedByEarlierAttempt(ctx, &Stack{StackName: aws.String(stackName)})
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stack).To(BeNil())
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, mock.Anything)
+		})
+
+		It("does not look up stacks outside of the retries of a task", func() {
+			sm.createdStacks.Store(stackName, true)
+			Expect(sm.stackCreatedByEarlierAttempt(context.Background(), &Stack{StackName: aws.String(stackName)})).To(BeNil())
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything, mock.Anything)
+		})
+	})
 })
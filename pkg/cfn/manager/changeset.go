@@ -0,0 +1,97 @@
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// FormatChangeSet returns a readable diff of the resources added, modified or removed by a ChangeSet,
+// one resource per line, including whether a modified resource needs to be replaced.
+func FormatChangeSet(changeSet tasks.ChangeSet) []string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, change := range changeSet.Changes {
+		resource := change.LogicalResourceID
+		if change.PhysicalResourceID != "" {
+			resource = fmt.Sprintf("%s (%s)", resource, change.PhysicalResourceID)
+		}
+		fmt.Fprintf(w, "%s %s\t%s\t%s\t%s\n", changeSymbol(change.Action), change.Action, change.ResourceType, resource, describeReplacement(change))
+	}
+	_ = w.Flush()
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		if line = strings.TrimRight(line, " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func changeSymbol(action string) string {
+	switch types.ChangeAction(action) {
+	case types.ChangeActionAdd:
+		return "+"
+	case types.ChangeActionRemove:
+		return "-"
+	case types.ChangeActionImport:
+		return "<"
+	default:
+		return "~"
+	}
+}
+
+func describeReplacement(change tasks.ResourceChange) string {
+	if types.ChangeAction(change.Action) != types.ChangeActionModify {
+		return ""
+	}
+	switch types.Replacement(change.Replacement) {
+	case types.ReplacementTrue:
+		return "requires replacement"
+	case types.ReplacementConditional:
+		return "may require replacement"
+	default:
+		return "in-place"
+	}
+}
+
+// needsReplacement reports whether a change will, or may, replace the resource.
+func needsReplacement(change tasks.ResourceChange) bool {
+	if types.ChangeAction(change.Action) != types.ChangeActionModify {
+		return false
+	}
+	switch types.Replacement(change.Replacement) {
+	case types.ReplacementTrue, types.ReplacementConditional:
+		return true
+	}
+	return false
+}
+
+// LogChangeSet prints the changes in a ChangeSet, warning about the resources that will be replaced.
+func LogChangeSet(changeSet tasks.ChangeSet) {
+	if len(changeSet.Changes) == 0 {
+		logger.Info("changeSet %q for stack %q contains no resource changes", changeSet.Name, changeSet.StackName)
+		return
+	}
+	logger.Info("changeSet %q for stack %q contains %d resource change(s):", changeSet.Name, changeSet.StackName, len(changeSet.Changes))
+	for _, line := range FormatChangeSet(changeSet) {
+		logger.Info("  %s", line)
+	}
+
+	var replaced []string
+	for _, change := range changeSet.Changes {
+		if needsReplacement(change) {
+			replaced = append(replaced, change.LogicalResourceID)
+		}
+	}
+	if len(replaced) > 0 {
+		logger.Warning("the following resources in stack %q will or may be replaced: %s", changeSet.StackName, strings.Join(replaced, ", "))
+	}
+}
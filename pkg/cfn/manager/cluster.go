@@ -2,12 +2,14 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/kris-nova/logger"
@@ -45,6 +47,79 @@ func (c *StackCollection) createClusterTask(ctx context.Context, errs chan error
 	return c.createClusterStack(ctx, name, stack, errs)
 }
 
+// loadClusterStackOutputs collects the outputs of the existing cluster stack, as createClusterTask does once
+// the stack is created
+func (c *StackCollection) loadClusterStackOutputs(ctx context.Context) error {
+	name := c.MakeClusterStackName()
+	stack, err := c.DescribeStack(ctx, &Stack{StackName: aws.String(name)})
+	if err != nil {
+		return err
+	}
+	resourceSet := builder.NewClusterResourceSet(c.ec2API, c.stsAPI, c.region, c.spec, nil, false)
+	if err := resourceSet.AddAllResources(ctx); err != nil {
+		return err
+	}
+	return resourceSet.GetAllOutputs(*stack)
+}
+
+// applyClusterStackProtection sets the termination protection and the stack policy of
+// clusterStack on the creation request of the cluster stack
+func (c *StackCollection) applyClusterStackProtection(input *cloudformation.CreateStackInput) error {
+	clusterStack := c.spec.ClusterStack
+	if clusterStack == nil {
+		return nil
+	}
+	if clusterStack.TerminationProtection != nil {
+		input.EnableTerminationProtection = clusterStack.TerminationProtection
+	}
+	policyBody, err := clusterStackPolicyBody(clusterStack)
+	if err != nil {
+		return err
+	}
+	input.StackPolicyBody = policyBody
+	return nil
+}
+
+// UpdateClusterStackProtection applies the termination protection and the stack policy
+// of clusterStack to the existing cluster stack
+func (c *StackCollection) UpdateClusterStackProtection(ctx context.Context) error {
+	clusterStack := c.spec.ClusterStack
+	if clusterStack == nil {
+		return nil
+	}
+	name := c.MakeClusterStackName()
+	if clusterStack.TerminationProtection != nil {
+		if _, err := c.cloudformationAPI.UpdateTerminationProtection(ctx, &cloudformation.UpdateTerminationProtectionInput{
+			StackName:                   aws.String(name),
+			EnableTerminationProtection: clusterStack.TerminationProtection,
+		}); err != nil {
+			return fmt.Errorf("updating termination protection of stack %q: %w", name, err)
+		}
+	}
+	policyBody, err := clusterStackPolicyBody(clusterStack)
+	if err != nil || policyBody == nil {
+		return err
+	}
+	if _, err := c.cloudformationAPI.SetStackPolicy(ctx, &cloudformation.SetStackPolicyInput{
+		StackName:       aws.String(name),
+		StackPolicyBody: policyBody,
+	}); err != nil {
+		return fmt.Errorf("setting stack policy of stack %q: %w", name, err)
+	}
+	return nil
+}
+
+func clusterStackPolicyBody(clusterStack *api.ClusterStack) (*string, error) {
+	if len(clusterStack.StackPolicy) == 0 {
+		return nil, nil
+	}
+	policy, err := json.Marshal(clusterStack.StackPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("serialising stack policy of the cluster stack: %w", err)
+	}
+	return aws.String(string(policy)), nil
+}
+
 // DescribeClusterStackIfExists calls ListStacks and filters out cluster stack.
 // If the stack does not exist, it returns nil.
 func (c *StackCollection) DescribeClusterStackIfExists(ctx context.Context) (*Stack, error) {
@@ -211,21 +286,20 @@ func (c *StackCollection) AppendNewClusterStackResource(ctx context.Context, ext
 	logger.Debug("currentTemplate = %s", currentTemplate)
 
 	describeUpdate := fmt.Sprintf("updating stack to add new resources %v and outputs %v", addResources, addOutputs)
-	if plan {
-		logger.Info("(plan) %s", describeUpdate)
-		return true, nil
-	}
-
 	err = c.UpdateStack(ctx, UpdateStackOptions{
 		StackName:     name,
 		ChangeSetName: c.MakeChangeSetName("update-cluster"),
 		Description:   describeUpdate,
 		TemplateData:  TemplateBody(currentTemplate),
 		Wait:          true,
+		Plan:          plan,
 	})
 	if err != nil {
 		return false, err
 	}
+	if plan {
+		return true, nil
+	}
 	stack, err := c.DescribeStack(ctx, &Stack{
 		StackName: aws.String(name),
 	})
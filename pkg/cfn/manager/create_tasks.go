@@ -22,54 +22,64 @@ import (
 
 // NewTasksToCreateCluster defines all tasks required to create a cluster along
 // with some nodegroups; see CreateAllNodeGroups for how onlyNodeGroupSubset works.
+// The tasks of postClusterCreationTasks are run once the control plane is created, and each nodegroup once
+// nodeGroupPrerequisites, which must be part of postClusterCreationTasks, have completed, without waiting for
+// the other post-creation tasks or nodegroups. postNodeGroupTasks, if set, are run as soon as any Linux nodegroup
+// whose nodes join the cluster by themselves has been created; they are left out of the graph if there is no such
+// nodegroup, e.g. when self-managed nodes are authorised through the aws-auth ConfigMap once the graph has run.
 func (c *StackCollection) NewTasksToCreateCluster(ctx context.Context, nodeGroups []*api.NodeGroup,
-	managedNodeGroups []*api.ManagedNodeGroup, accessConfig *api.AccessConfig, accessEntryCreator accessentry.CreatorInterface, nodeGroupParallelism int, postClusterCreationTasks ...tasks.Task) *tasks.TaskTree {
-	taskTree := tasks.TaskTree{Parallel: false}
+	managedNodeGroups []*api.ManagedNodeGroup, accessConfig *api.AccessConfig, accessEntryCreator accessentry.CreatorInterface, nodeGroupParallelism int,
+	postClusterCreationTasks *tasks.Graph, postNodeGroupTasks tasks.Task, nodeGroupPrerequisites ...tasks.Task) *tasks.Graph {
+	taskGraph := &tasks.Graph{}
 
-	taskTree.Append(&createClusterTask{
+	clusterTask := &createClusterTask{
 		info:                 fmt.Sprintf("create cluster control plane %q", c.spec.Metadata.Name),
 		stackCollection:      c,
 		supportsManagedNodes: true,
 		ctx:                  ctx,
-	})
+	}
+	taskGraph.Add(clusterTask)
 
 	if len(accessConfig.AccessEntries) > 0 {
-		taskTree.Append(accessEntryCreator.CreateTasks(ctx, accessConfig.AccessEntries))
+		if accessEntryTasks := accessEntryCreator.CreateTasks(ctx, accessConfig.AccessEntries); accessEntryTasks.Len() > 0 {
+			accessEntryTasks.IsSubTask = true
+			taskGraph.Add(accessEntryTasks, clusterTask)
+		}
 	}
 
-	appendNodeGroupTasksTo := func(taskTree *tasks.TaskTree) {
-		vpcImporter := vpc.NewStackConfigImporter(c.MakeClusterStackName())
-		nodeGroupTasks := &tasks.TaskTree{
-			Parallel:  true,
-			IsSubTask: true,
-		}
-		disableAccessEntryCreation := accessConfig.AuthenticationMode == ekstypes.AuthenticationModeConfigMap
-		if unmanagedNodeGroupTasks := c.NewUnmanagedNodeGroupTask(ctx, nodeGroups, false, false, disableAccessEntryCreation, vpcImporter, nodeGroupParallelism); unmanagedNodeGroupTasks.Len() > 0 {
-			unmanagedNodeGroupTasks.IsSubTask = true
-			nodeGroupTasks.Append(unmanagedNodeGroupTasks)
-		}
-		if managedNodeGroupTasks := c.NewManagedNodeGroupTask(ctx, managedNodeGroups, false, vpcImporter, nodeGroupParallelism); managedNodeGroupTasks.Len() > 0 {
-			managedNodeGroupTasks.IsSubTask = true
-			nodeGroupTasks.Append(managedNodeGroupTasks)
-		}
+	taskGraph.Include(postClusterCreationTasks, clusterTask)
 
-		if nodeGroupTasks.Len() > 0 {
-			taskTree.Append(nodeGroupTasks)
+	nodeGroupDependencies := nodeGroupPrerequisites
+	if len(nodeGroupDependencies) == 0 {
+		nodeGroupDependencies = []tasks.Task{clusterTask}
+	}
+	vpcImporter := vpc.NewStackConfigImporter(c.MakeClusterStackName())
+	disableAccessEntryCreation := accessConfig.AuthenticationMode == ekstypes.AuthenticationModeConfigMap
+
+	// every nodegroup is a task of its own, so that tasks that need nodes do not wait for all nodegroups
+	var nodeGroupTasks, joiningNodeGroupTasks []tasks.Task
+	unmanagedNodeGroupTasks := c.NewUnmanagedNodeGroupTask(ctx, nodeGroups, false, false, disableAccessEntryCreation, vpcImporter, 0)
+	for i, task := range unmanagedNodeGroupTasks.Tasks {
+		taskGraph.Add(task, nodeGroupDependencies...)
+		nodeGroupTasks = append(nodeGroupTasks, task)
+		if ng := nodeGroups[i]; !disableAccessEntryCreation && !api.IsWindowsImage(ng.AMIFamily) && ng.GetDesiredCapacity() > 0 {
+			joiningNodeGroupTasks = append(joiningNodeGroupTasks, task)
 		}
 	}
-
-	if len(postClusterCreationTasks) > 0 {
-		postClusterCreationTaskTree := &tasks.TaskTree{
-			Parallel:  false,
-			IsSubTask: true,
+	managedNodeGroupTasks := c.NewManagedNodeGroupTask(ctx, managedNodeGroups, false, vpcImporter, 0)
+	for i, task := range managedNodeGroupTasks.Tasks {
+		taskGraph.Add(task, nodeGroupDependencies...)
+		nodeGroupTasks = append(nodeGroupTasks, task)
+		if ng := managedNodeGroups[i]; !api.IsWindowsImage(ng.AMIFamily) && ng.GetDesiredCapacity() > 0 {
+			joiningNodeGroupTasks = append(joiningNodeGroupTasks, task)
 		}
-		postClusterCreationTaskTree.Append(postClusterCreationTasks...)
-		appendNodeGroupTasksTo(postClusterCreationTaskTree)
-		taskTree.Append(postClusterCreationTaskTree)
-	} else {
-		appendNodeGroupTasksTo(&taskTree)
 	}
-	return &taskTree
+	taskGraph.LimitTasks(nodeGroupParallelism, nodeGroupTasks...)
+
+	if postNodeGroupTasks != nil && len(joiningNodeGroupTasks) > 0 {
+		taskGraph.AddAfterAny(postNodeGroupTasks, joiningNodeGroupTasks...)
+	}
+	return taskGraph
 }
 
 // NewUnmanagedNodeGroupTask returns tasks for creating self-managed nodegroups.
@@ -127,7 +137,7 @@ func (c *StackCollection) NewManagedNodeGroupTask(ctx context.Context, nodeGroup
 }
 
 // NewTasksToCreateIAMServiceAccounts defines tasks required to create all of the IAM ServiceAccounts
-func (c *StackCollection) NewTasksToCreateIAMServiceAccounts(serviceAccounts []*api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *tasks.TaskTree {
+func (c *StackCollection) NewTasksToCreateIAMServiceAccounts(ctx context.Context, serviceAccounts []*api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *tasks.TaskTree {
 	taskTree := &tasks.TaskTree{Parallel: true}
 
 	for i := range serviceAccounts {
@@ -143,6 +153,7 @@ func (c *StackCollection) NewTasksToCreateIAMServiceAccounts(serviceAccounts []*
 				stackCollection: c,
 				serviceAccount:  sa,
 				oidc:            oidc,
+				ctx:             ctx,
 			})
 		} else {
 			logger.Debug("attachRoleARN was provided, skipping role creation")
@@ -49,59 +49,50 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(
 	cluster *ekstypes.Cluster,
 	clientSetGetter kubernetes.ClientSetGetter,
 	wait, force bool,
-	cleanup func(chan error, string) error) (*tasks.TaskTree, error) {
-	taskTree := &tasks.TaskTree{Parallel: false}
+	cleanup func(chan error, string) error) (*tasks.Graph, error) {
+	taskGraph := &tasks.Graph{}
+	// the resources of the cluster are deleted in parallel, and the control plane once they all are
+	var clusterDependencies []tasks.Task
+	addTasks := func(taskTree *tasks.TaskTree) {
+		if taskTree.Len() > 0 {
+			taskTree.IsSubTask = true
+			taskGraph.Add(taskTree)
+			clusterDependencies = append(clusterDependencies, taskTree)
+		}
+	}
 
 	nodeGroupTasks, err := c.NewTasksToDeleteNodeGroups(nodeGroupStacks, deleteAll, true, cleanup)
 
 	if err != nil {
 		return nil, err
 	}
-	if nodeGroupTasks.Len() > 0 {
-		nodeGroupTasks.IsSubTask = true
-		taskTree.Append(nodeGroupTasks)
-	}
+	addTasks(nodeGroupTasks)
 
 	if clusterOperable {
 		serviceAccountAndOIDCTasks, err := c.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx, newOIDCManager, cluster, clientSetGetter, force)
 		if err != nil {
 			return nil, err
 		}
-
-		if serviceAccountAndOIDCTasks.Len() > 0 {
-			serviceAccountAndOIDCTasks.IsSubTask = true
-			taskTree.Append(serviceAccountAndOIDCTasks)
-		}
+		addTasks(serviceAccountAndOIDCTasks)
 	}
 
 	deleteAddonIAMTasks, err := newTasksToDeleteAddonIAM(ctx, wait)
 	if err != nil {
 		return nil, err
 	}
-
-	if deleteAddonIAMTasks.Len() > 0 {
-		deleteAddonIAMTasks.IsSubTask = true
-		taskTree.Append(deleteAddonIAMTasks)
-	}
+	addTasks(deleteAddonIAMTasks)
 
 	deleteCapabilityIAMTasks, err := newTasksToDeleteCapabilityIAM()
 	if err != nil {
 		return nil, err
 	}
-
-	if deleteCapabilityIAMTasks.Len() > 0 {
-		deleteCapabilityIAMTasks.IsSubTask = true
-		taskTree.Append(deleteCapabilityIAMTasks)
-	}
+	addTasks(deleteCapabilityIAMTasks)
 
 	deletePodIdentityRoleTasks, err := newTasksToDeletePodIdentityRole()
 	if err != nil {
 		return nil, err
 	}
-	if deletePodIdentityRoleTasks.Len() > 0 {
-		deletePodIdentityRoleTasks.IsSubTask = true
-		taskTree.Append(deletePodIdentityRoleTasks)
-	}
+	addTasks(deletePodIdentityRoleTasks)
 
 	deleteAccessEntriesTasks, err := accessentry.
 		NewRemover(c.spec.Metadata.Name, c, c.eksAPI).
@@ -109,10 +100,7 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(
 	if err != nil {
 		return nil, err
 	}
-	if deleteAccessEntriesTasks.Len() > 0 {
-		deleteAccessEntriesTasks.IsSubTask = true
-		taskTree.Append(deleteAccessEntriesTasks)
-	}
+	addTasks(deleteAccessEntriesTasks)
 
 	if clusterStack == nil {
 		return nil, &StackNotFoundErr{ClusterName: c.spec.Metadata.Name}
@@ -120,20 +108,20 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(
 
 	info := fmt.Sprintf("delete cluster control plane %q", c.spec.Metadata.Name)
 	if wait {
-		taskTree.Append(&taskWithStackSpec{
+		taskGraph.Add(&taskWithStackSpec{
 			info:  info,
 			stack: clusterStack,
 			call:  c.DeleteStackBySpecSync,
-		})
+		}, clusterDependencies...)
 	} else {
-		taskTree.Append(&asyncTaskWithStackSpec{
+		taskGraph.Add(&asyncTaskWithStackSpec{
 			info:  info,
 			stack: clusterStack,
 			call:  c.DeleteStackBySpec,
-		})
+		}, clusterDependencies...)
 	}
 
-	return taskTree, nil
+	return taskGraph, nil
 }
 
 // NewTasksToDeleteNodeGroups defines tasks required to delete all nodegroups.
@@ -152,6 +140,16 @@ func (c *StackCollection) NewTasksToDeleteNodeGroups(nodeGroupStacks []NodeGroup
 			})
 		}
 		info := fmt.Sprintf("delete nodegroup %q", s.NodeGroupName)
+		if s.Type == api.NodeGroupTypeManaged && isAdoptedNodeGroupStack(s.Stack) {
+			taskTree.Append(&deleteAdoptedNodeGroupTask{
+				info:            info,
+				nodeGroupName:   s.NodeGroupName,
+				stack:           s.Stack,
+				stackCollection: c,
+				wait:            wait,
+			})
+			continue
+		}
 		if wait {
 			taskTree.Append(&taskWithStackSpec{
 				info:  info,
@@ -48,6 +48,19 @@ type FakeStackManager struct {
 		result1 bool
 		result2 error
 	}
+	ContinueUpdateRollbackSyncStub        func(context.Context, *manager.Stack, []string) error
+	continueUpdateRollbackSyncMutex       sync.RWMutex
+	continueUpdateRollbackSyncArgsForCall []struct {
+		arg1 context.Context
+		arg2 *manager.Stack
+		arg3 []string
+	}
+	continueUpdateRollbackSyncReturns struct {
+		result1 error
+	}
+	continueUpdateRollbackSyncReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateStackStub        func(context.Context, string, builder.ResourceSetReader, map[string]string, map[string]string, chan error) error
 	createStackMutex       sync.RWMutex
 	createStackArgsForCall []struct {
@@ -91,6 +104,19 @@ type FakeStackManager struct {
 	deleteStackBySpecSyncReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteStackRetainingResourcesSyncStub        func(context.Context, *manager.Stack, []string) error
+	deleteStackRetainingResourcesSyncMutex       sync.RWMutex
+	deleteStackRetainingResourcesSyncArgsForCall []struct {
+		arg1 context.Context
+		arg2 *manager.Stack
+		arg3 []string
+	}
+	deleteStackRetainingResourcesSyncReturns struct {
+		result1 error
+	}
+	deleteStackRetainingResourcesSyncReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStackSyncStub        func(context.Context, *manager.Stack) error
 	deleteStackSyncMutex       sync.RWMutex
 	deleteStackSyncArgsForCall []struct {
@@ -691,7 +717,7 @@ type FakeStackManager struct {
 	newTaskToDeleteUnownedNodeGroupReturnsOnCall map[int]struct {
 		result1 tasks.Task
 	}
-	NewTasksToCreateClusterStub        func(context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, *v1alpha5.AccessConfig, accessentry.CreatorInterface, int, ...tasks.Task) *tasks.TaskTree
+	NewTasksToCreateClusterStub        func(context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, *v1alpha5.AccessConfig, accessentry.CreatorInterface, int, *tasks.Graph, tasks.Task, ...tasks.Task) *tasks.Graph
 	newTasksToCreateClusterMutex       sync.RWMutex
 	newTasksToCreateClusterArgsForCall []struct {
 		arg1 context.Context
@@ -700,20 +726,23 @@ type FakeStackManager struct {
 		arg4 *v1alpha5.AccessConfig
 		arg5 accessentry.CreatorInterface
 		arg6 int
-		arg7 []tasks.Task
+		arg7 *tasks.Graph
+		arg8 tasks.Task
+		arg9 []tasks.Task
 	}
 	newTasksToCreateClusterReturns struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 	}
 	newTasksToCreateClusterReturnsOnCall map[int]struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 	}
-	NewTasksToCreateIAMServiceAccountsStub        func([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree
+	NewTasksToCreateIAMServiceAccountsStub        func(context.Context, []*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree
 	newTasksToCreateIAMServiceAccountsMutex       sync.RWMutex
 	newTasksToCreateIAMServiceAccountsArgsForCall []struct {
-		arg1 []*v1alpha5.ClusterIAMServiceAccount
-		arg2 *iamoidc.OpenIDConnectManager
-		arg3 kubernetes.ClientSetGetter
+		arg1 context.Context
+		arg2 []*v1alpha5.ClusterIAMServiceAccount
+		arg3 *iamoidc.OpenIDConnectManager
+		arg4 kubernetes.ClientSetGetter
 	}
 	newTasksToCreateIAMServiceAccountsReturns struct {
 		result1 *tasks.TaskTree
@@ -721,7 +750,7 @@ type FakeStackManager struct {
 	newTasksToCreateIAMServiceAccountsReturnsOnCall map[int]struct {
 		result1 *tasks.TaskTree
 	}
-	NewTasksToDeleteClusterWithNodeGroupsStub        func(context.Context, *manager.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, manager.NewTasksToDeleteAddonIAM, manager.NewTasksToDeleteCapabilityIAM, manager.NewTasksToDeletePodIdentityRole, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)
+	NewTasksToDeleteClusterWithNodeGroupsStub        func(context.Context, *manager.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, manager.NewTasksToDeleteAddonIAM, manager.NewTasksToDeleteCapabilityIAM, manager.NewTasksToDeletePodIdentityRole, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, func(chan error, string) error) (*tasks.Graph, error)
 	newTasksToDeleteClusterWithNodeGroupsMutex       sync.RWMutex
 	newTasksToDeleteClusterWithNodeGroupsArgsForCall []struct {
 		arg1  context.Context
@@ -739,11 +768,11 @@ type FakeStackManager struct {
 		arg13 func(chan error, string) error
 	}
 	newTasksToDeleteClusterWithNodeGroupsReturns struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 		result2 error
 	}
 	newTasksToDeleteClusterWithNodeGroupsReturnsOnCall map[int]struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 		result2 error
 	}
 	NewTasksToDeleteIAMServiceAccountsStub        func(context.Context, []string, kubernetes.ClientSetGetter, bool) (*tasks.TaskTree, error)
@@ -855,6 +884,17 @@ type FakeStackManager struct {
 		arg2 *types.Stack
 		arg3 types.StackStatus
 	}
+	UpdateClusterStackProtectionStub        func(context.Context) error
+	updateClusterStackProtectionMutex       sync.RWMutex
+	updateClusterStackProtectionArgsForCall []struct {
+		arg1 context.Context
+	}
+	updateClusterStackProtectionReturns struct {
+		result1 error
+	}
+	updateClusterStackProtectionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateNodeGroupStackStub        func(context.Context, string, string, bool) error
 	updateNodeGroupStackMutex       sync.RWMutex
 	updateNodeGroupStackArgsForCall []struct {
@@ -1015,6 +1055,74 @@ func (fake *FakeStackManager) ClusterHasDedicatedVPCReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) ContinueUpdateRollbackSync(arg1 context.Context, arg2 *manager.Stack, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.continueUpdateRollbackSyncMutex.Lock()
+	ret, specificReturn := fake.continueUpdateRollbackSyncReturnsOnCall[len(fake.continueUpdateRollbackSyncArgsForCall)]
+	fake.continueUpdateRollbackSyncArgsForCall = append(fake.continueUpdateRollbackSyncArgsForCall, struct {
+		arg1 context.Context
+		arg2 *manager.Stack
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.ContinueUpdateRollbackSyncStub
+	fakeReturns := fake.continueUpdateRollbackSyncReturns
+	fake.recordInvocation("ContinueUpdateRollbackSync", []interface{}{arg1, arg2, arg3Copy})
+	fake.continueUpdateRollbackSyncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackSyncCallCount() int {
+	fake.continueUpdateRollbackSyncMutex.RLock()
+	defer fake.continueUpdateRollbackSyncMutex.RUnlock()
+	return len(fake.continueUpdateRollbackSyncArgsForCall)
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackSyncCalls(stub func(context.Context, *manager.Stack, []string) error) {
+	fake.continueUpdateRollbackSyncMutex.Lock()
+	defer fake.continueUpdateRollbackSyncMutex.Unlock()
+	fake.ContinueUpdateRollbackSyncStub = stub
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackSyncArgsForCall(i int) (context.Context, *manager.Stack, []string) {
+	fake.continueUpdateRollbackSyncMutex.RLock()
+	defer fake.continueUpdateRollbackSyncMutex.RUnlock()
+	argsForCall := fake.continueUpdateRollbackSyncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackSyncReturns(result1 error) {
+	fake.continueUpdateRollbackSyncMutex.Lock()
+	defer fake.continueUpdateRollbackSyncMutex.Unlock()
+	fake.ContinueUpdateRollbackSyncStub = nil
+	fake.continueUpdateRollbackSyncReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackSyncReturnsOnCall(i int, result1 error) {
+	fake.continueUpdateRollbackSyncMutex.Lock()
+	defer fake.continueUpdateRollbackSyncMutex.Unlock()
+	fake.ContinueUpdateRollbackSyncStub = nil
+	if fake.continueUpdateRollbackSyncReturnsOnCall == nil {
+		fake.continueUpdateRollbackSyncReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.continueUpdateRollbackSyncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) CreateStack(arg1 context.Context, arg2 string, arg3 builder.ResourceSetReader, arg4 map[string]string, arg5 map[string]string, arg6 chan error) error {
 	fake.createStackMutex.Lock()
 	ret, specificReturn := fake.createStackReturnsOnCall[len(fake.createStackArgsForCall)]
@@ -1209,6 +1317,74 @@ func (fake *FakeStackManager) DeleteStackBySpecSyncReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeStackManager) DeleteStackRetainingResourcesSync(arg1 context.Context, arg2 *manager.Stack, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.deleteStackRetainingResourcesSyncMutex.Lock()
+	ret, specificReturn := fake.deleteStackRetainingResourcesSyncReturnsOnCall[len(fake.deleteStackRetainingResourcesSyncArgsForCall)]
+	fake.deleteStackRetainingResourcesSyncArgsForCall = append(fake.deleteStackRetainingResourcesSyncArgsForCall, struct {
+		arg1 context.Context
+		arg2 *manager.Stack
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.DeleteStackRetainingResourcesSyncStub
+	fakeReturns := fake.deleteStackRetainingResourcesSyncReturns
+	fake.recordInvocation("DeleteStackRetainingResourcesSync", []interface{}{arg1, arg2, arg3Copy})
+	fake.deleteStackRetainingResourcesSyncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) DeleteStackRetainingResourcesSyncCallCount() int {
+	fake.deleteStackRetainingResourcesSyncMutex.RLock()
+	defer fake.deleteStackRetainingResourcesSyncMutex.RUnlock()
+	return len(fake.deleteStackRetainingResourcesSyncArgsForCall)
+}
+
+func (fake *FakeStackManager) DeleteStackRetainingResourcesSyncCalls(stub func(context.Context, *manager.Stack, []string) error) {
+	fake.deleteStackRetainingResourcesSyncMutex.Lock()
+	defer fake.deleteStackRetainingResourcesSyncMutex.Unlock()
+	fake.DeleteStackRetainingResourcesSyncStub = stub
+}
+
+func (fake *FakeStackManager) DeleteStackRetainingResourcesSyncArgsForCall(i int) (context.Context, *manager.Stack, []string) {
+	fake.deleteStackRetainingResourcesSyncMutex.RLock()
+	defer fake.deleteStackRetainingResourcesSyncMutex.RUnlock()
+	argsForCall := fake.deleteStackRetainingResourcesSyncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) DeleteStackRetainingResourcesSyncReturns(result1 error) {
+	fake.deleteStackRetainingResourcesSyncMutex.Lock()
+	defer fake.deleteStackRetainingResourcesSyncMutex.Unlock()
+	fake.DeleteStackRetainingResourcesSyncStub = nil
+	fake.deleteStackRetainingResourcesSyncReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) DeleteStackRetainingResourcesSyncReturnsOnCall(i int, result1 error) {
+	fake.deleteStackRetainingResourcesSyncMutex.Lock()
+	defer fake.deleteStackRetainingResourcesSyncMutex.Unlock()
+	fake.DeleteStackRetainingResourcesSyncStub = nil
+	if fake.deleteStackRetainingResourcesSyncReturnsOnCall == nil {
+		fake.deleteStackRetainingResourcesSyncReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteStackRetainingResourcesSyncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) DeleteStackSync(arg1 context.Context, arg2 *manager.Stack) error {
 	fake.deleteStackSyncMutex.Lock()
 	ret, specificReturn := fake.deleteStackSyncReturnsOnCall[len(fake.deleteStackSyncArgsForCall)]
@@ -4096,7 +4272,7 @@ func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturnsOnCall(i int
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateCluster(arg1 context.Context, arg2 []*v1alpha5.NodeGroup, arg3 []*v1alpha5.ManagedNodeGroup, arg4 *v1alpha5.AccessConfig, arg5 accessentry.CreatorInterface, arg6 int, arg7 ...tasks.Task) *tasks.TaskTree {
+func (fake *FakeStackManager) NewTasksToCreateCluster(arg1 context.Context, arg2 []*v1alpha5.NodeGroup, arg3 []*v1alpha5.ManagedNodeGroup, arg4 *v1alpha5.AccessConfig, arg5 accessentry.CreatorInterface, arg6 int, arg7 *tasks.Graph, arg8 tasks.Task, arg9 ...tasks.Task) *tasks.Graph {
 	var arg2Copy []*v1alpha5.NodeGroup
 	if arg2 != nil {
 		arg2Copy = make([]*v1alpha5.NodeGroup, len(arg2))
@@ -4116,14 +4292,16 @@ func (fake *FakeStackManager) NewTasksToCreateCluster(arg1 context.Context, arg2
 		arg4 *v1alpha5.AccessConfig
 		arg5 accessentry.CreatorInterface
 		arg6 int
-		arg7 []tasks.Task
-	}{arg1, arg2Copy, arg3Copy, arg4, arg5, arg6, arg7})
+		arg7 *tasks.Graph
+		arg8 tasks.Task
+		arg9 []tasks.Task
+	}{arg1, arg2Copy, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9})
 	stub := fake.NewTasksToCreateClusterStub
 	fakeReturns := fake.newTasksToCreateClusterReturns
-	fake.recordInvocation("NewTasksToCreateCluster", []interface{}{arg1, arg2Copy, arg3Copy, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("NewTasksToCreateCluster", []interface{}{arg1, arg2Copy, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9})
 	fake.newTasksToCreateClusterMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7...)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9...)
 	}
 	if specificReturn {
 		return ret.result1
@@ -4137,61 +4315,62 @@ func (fake *FakeStackManager) NewTasksToCreateClusterCallCount() int {
 	return len(fake.newTasksToCreateClusterArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterCalls(stub func(context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, *v1alpha5.AccessConfig, accessentry.CreatorInterface, int, ...tasks.Task) *tasks.TaskTree) {
+func (fake *FakeStackManager) NewTasksToCreateClusterCalls(stub func(context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, *v1alpha5.AccessConfig, accessentry.CreatorInterface, int, *tasks.Graph, tasks.Task, ...tasks.Task) *tasks.Graph) {
 	fake.newTasksToCreateClusterMutex.Lock()
 	defer fake.newTasksToCreateClusterMutex.Unlock()
 	fake.NewTasksToCreateClusterStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterArgsForCall(i int) (context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, *v1alpha5.AccessConfig, accessentry.CreatorInterface, int, []tasks.Task) {
+func (fake *FakeStackManager) NewTasksToCreateClusterArgsForCall(i int) (context.Context, []*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, *v1alpha5.AccessConfig, accessentry.CreatorInterface, int, *tasks.Graph, tasks.Task, []tasks.Task) {
 	fake.newTasksToCreateClusterMutex.RLock()
 	defer fake.newTasksToCreateClusterMutex.RUnlock()
 	argsForCall := fake.newTasksToCreateClusterArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterReturns(result1 *tasks.TaskTree) {
+func (fake *FakeStackManager) NewTasksToCreateClusterReturns(result1 *tasks.Graph) {
 	fake.newTasksToCreateClusterMutex.Lock()
 	defer fake.newTasksToCreateClusterMutex.Unlock()
 	fake.NewTasksToCreateClusterStub = nil
 	fake.newTasksToCreateClusterReturns = struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterReturnsOnCall(i int, result1 *tasks.TaskTree) {
+func (fake *FakeStackManager) NewTasksToCreateClusterReturnsOnCall(i int, result1 *tasks.Graph) {
 	fake.newTasksToCreateClusterMutex.Lock()
 	defer fake.newTasksToCreateClusterMutex.Unlock()
 	fake.NewTasksToCreateClusterStub = nil
 	if fake.newTasksToCreateClusterReturnsOnCall == nil {
 		fake.newTasksToCreateClusterReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+			result1 *tasks.Graph
 		})
 	}
 	fake.newTasksToCreateClusterReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccounts(arg1 []*v1alpha5.ClusterIAMServiceAccount, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter) *tasks.TaskTree {
-	var arg1Copy []*v1alpha5.ClusterIAMServiceAccount
-	if arg1 != nil {
-		arg1Copy = make([]*v1alpha5.ClusterIAMServiceAccount, len(arg1))
-		copy(arg1Copy, arg1)
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccounts(arg1 context.Context, arg2 []*v1alpha5.ClusterIAMServiceAccount, arg3 *iamoidc.OpenIDConnectManager, arg4 kubernetes.ClientSetGetter) *tasks.TaskTree {
+	var arg2Copy []*v1alpha5.ClusterIAMServiceAccount
+	if arg2 != nil {
+		arg2Copy = make([]*v1alpha5.ClusterIAMServiceAccount, len(arg2))
+		copy(arg2Copy, arg2)
 	}
 	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
 	ret, specificReturn := fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)]
 	fake.newTasksToCreateIAMServiceAccountsArgsForCall = append(fake.newTasksToCreateIAMServiceAccountsArgsForCall, struct {
-		arg1 []*v1alpha5.ClusterIAMServiceAccount
-		arg2 *iamoidc.OpenIDConnectManager
-		arg3 kubernetes.ClientSetGetter
-	}{arg1Copy, arg2, arg3})
+		arg1 context.Context
+		arg2 []*v1alpha5.ClusterIAMServiceAccount
+		arg3 *iamoidc.OpenIDConnectManager
+		arg4 kubernetes.ClientSetGetter
+	}{arg1, arg2Copy, arg3, arg4})
 	stub := fake.NewTasksToCreateIAMServiceAccountsStub
 	fakeReturns := fake.newTasksToCreateIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToCreateIAMServiceAccounts", []interface{}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("NewTasksToCreateIAMServiceAccounts", []interface{}{arg1, arg2Copy, arg3, arg4})
 	fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -4205,17 +4384,17 @@ func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCallCount() int
 	return len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCalls(stub func([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree) {
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCalls(stub func(context.Context, []*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree) {
 	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
 	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
 	fake.NewTasksToCreateIAMServiceAccountsStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsArgsForCall(i int) ([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsArgsForCall(i int) (context.Context, []*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
 	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
 	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
 	argsForCall := fake.newTasksToCreateIAMServiceAccountsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturns(result1 *tasks.TaskTree) {
@@ -4241,7 +4420,7 @@ func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturnsOnCall(i
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context.Context, arg2 *manager.Stack, arg3 []manager.NodeGroupStack, arg4 bool, arg5 manager.NewOIDCManager, arg6 manager.NewTasksToDeleteAddonIAM, arg7 manager.NewTasksToDeleteCapabilityIAM, arg8 manager.NewTasksToDeletePodIdentityRole, arg9 *typesc.Cluster, arg10 kubernetes.ClientSetGetter, arg11 bool, arg12 bool, arg13 func(chan error, string) error) (*tasks.TaskTree, error) {
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context.Context, arg2 *manager.Stack, arg3 []manager.NodeGroupStack, arg4 bool, arg5 manager.NewOIDCManager, arg6 manager.NewTasksToDeleteAddonIAM, arg7 manager.NewTasksToDeleteCapabilityIAM, arg8 manager.NewTasksToDeletePodIdentityRole, arg9 *typesc.Cluster, arg10 kubernetes.ClientSetGetter, arg11 bool, arg12 bool, arg13 func(chan error, string) error) (*tasks.Graph, error) {
 	var arg3Copy []manager.NodeGroupStack
 	if arg3 != nil {
 		arg3Copy = make([]manager.NodeGroupStack, len(arg3))
@@ -4283,7 +4462,7 @@ func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCallCount() i
 	return len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(context.Context, *manager.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, manager.NewTasksToDeleteAddonIAM, manager.NewTasksToDeleteCapabilityIAM, manager.NewTasksToDeletePodIdentityRole, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(context.Context, *manager.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, manager.NewTasksToDeleteAddonIAM, manager.NewTasksToDeleteCapabilityIAM, manager.NewTasksToDeletePodIdentityRole, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, func(chan error, string) error) (*tasks.Graph, error)) {
 	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
 	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
 	fake.NewTasksToDeleteClusterWithNodeGroupsStub = stub
@@ -4296,28 +4475,28 @@ func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsArgsForCall(i
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11, argsForCall.arg12, argsForCall.arg13
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturns(result1 *tasks.Graph, result2 error) {
 	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
 	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
 	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
 	fake.newTasksToDeleteClusterWithNodeGroupsReturns = struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.Graph, result2 error) {
 	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
 	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
 	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
 	if fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall == nil {
 		fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+			result1 *tasks.Graph
 			result2 error
 		})
 	}
 	fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+		result1 *tasks.Graph
 		result2 error
 	}{result1, result2}
 }
@@ -4831,6 +5010,67 @@ func (fake *FakeStackManager) TroubleshootStackFailureCauseArgsForCall(i int) (c
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeStackManager) UpdateClusterStackProtection(arg1 context.Context) error {
+	fake.updateClusterStackProtectionMutex.Lock()
+	ret, specificReturn := fake.updateClusterStackProtectionReturnsOnCall[len(fake.updateClusterStackProtectionArgsForCall)]
+	fake.updateClusterStackProtectionArgsForCall = append(fake.updateClusterStackProtectionArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.UpdateClusterStackProtectionStub
+	fakeReturns := fake.updateClusterStackProtectionReturns
+	fake.recordInvocation("UpdateClusterStackProtection", []interface{}{arg1})
+	fake.updateClusterStackProtectionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) UpdateClusterStackProtectionCallCount() int {
+	fake.updateClusterStackProtectionMutex.RLock()
+	defer fake.updateClusterStackProtectionMutex.RUnlock()
+	return len(fake.updateClusterStackProtectionArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateClusterStackProtectionCalls(stub func(context.Context) error) {
+	fake.updateClusterStackProtectionMutex.Lock()
+	defer fake.updateClusterStackProtectionMutex.Unlock()
+	fake.UpdateClusterStackProtectionStub = stub
+}
+
+func (fake *FakeStackManager) UpdateClusterStackProtectionArgsForCall(i int) context.Context {
+	fake.updateClusterStackProtectionMutex.RLock()
+	defer fake.updateClusterStackProtectionMutex.RUnlock()
+	argsForCall := fake.updateClusterStackProtectionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) UpdateClusterStackProtectionReturns(result1 error) {
+	fake.updateClusterStackProtectionMutex.Lock()
+	defer fake.updateClusterStackProtectionMutex.Unlock()
+	fake.UpdateClusterStackProtectionStub = nil
+	fake.updateClusterStackProtectionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateClusterStackProtectionReturnsOnCall(i int, result1 error) {
+	fake.updateClusterStackProtectionMutex.Lock()
+	defer fake.updateClusterStackProtectionMutex.Unlock()
+	fake.UpdateClusterStackProtectionStub = nil
+	if fake.updateClusterStackProtectionReturnsOnCall == nil {
+		fake.updateClusterStackProtectionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateClusterStackProtectionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) UpdateNodeGroupStack(arg1 context.Context, arg2 string, arg3 string, arg4 bool) error {
 	fake.updateNodeGroupStackMutex.Lock()
 	ret, specificReturn := fake.updateNodeGroupStackReturnsOnCall[len(fake.updateNodeGroupStackArgsForCall)]
@@ -25,7 +25,20 @@ type UpdateStackOptions struct {
 	Description   string
 	TemplateData  TemplateData
 	Parameters    map[string]string
-	Wait          bool
+	// UsePreviousParameters keeps the current values of all parameters of the stack instead of setting Parameters;
+	// the values of NoEcho parameters are masked when the stack is described, so they can only be kept this way
+	UsePreviousParameters bool
+	// Tags are set on the stack in addition to its existing tags, overriding the values of existing tags
+	Tags map[string]string
+	// RemoveTags are the keys of the tags that are removed from the stack
+	RemoveTags []string
+	// RevertDrift creates a drift-aware change set, which also reverts the resources of the stack that have drifted
+	// from the template to their template configuration
+	RevertDrift bool
+	Wait        bool
+	// Plan prints the update without making it; the change set is only created, to print the changes it would
+	// make, and deleted afterwards if the run options preview change sets
+	Plan bool
 }
 
 // GetNodegroupOption nodegroup options.
@@ -40,9 +53,11 @@ var _ StackManager = &StackCollection{}
 //counterfeiter:generate -o fakes/fake_stack_manager.go . StackManager
 type StackManager interface {
 	AppendNewClusterStackResource(ctx context.Context, extendForOutposts, plan bool) (bool, error)
+	ContinueUpdateRollbackSync(ctx context.Context, s *Stack, resourcesToSkip []string) error
 	CreateStack(ctx context.Context, name string, stack builder.ResourceSetReader, tags, parameters map[string]string, errs chan error) error
 	DeleteStackBySpec(ctx context.Context, s *Stack) (*Stack, error)
 	DeleteStackBySpecSync(ctx context.Context, s *Stack, errs chan error) error
+	DeleteStackRetainingResourcesSync(ctx context.Context, s *Stack, retainResources []string) error
 	DeleteStackSync(ctx context.Context, s *Stack) error
 	DeleteTasksForDeprecatedStacks(ctx context.Context) (*tasks.TaskTree, error)
 	DescribeClusterStackIfExists(ctx context.Context) (*Stack, error)
@@ -87,10 +102,10 @@ type StackManager interface {
 	MakeChangeSetName(action string) string
 	MakeClusterStackName() string
 	NewManagedNodeGroupTask(ctx context.Context, nodeGroups []*api.ManagedNodeGroup, forceAddCNIPolicy bool, importer vpc.Importer, nodeGroupParallelism int) *tasks.TaskTree
-	NewTasksToDeleteClusterWithNodeGroups(ctx context.Context, clusterStack *Stack, nodeGroupStacks []NodeGroupStack, clusterOperable bool, newOIDCManager NewOIDCManager, newTasksToDeleteAddonIAM NewTasksToDeleteAddonIAM, newTasksToDeleteCapabilityIAM NewTasksToDeleteCapabilityIAM, newTasksToDeletePodIdentityRole NewTasksToDeletePodIdentityRole, cluster *ekstypes.Cluster, clientSetGetter kubernetes.ClientSetGetter, wait, force bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error)
-	NewTasksToCreateIAMServiceAccounts(serviceAccounts []*api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *tasks.TaskTree
+	NewTasksToDeleteClusterWithNodeGroups(ctx context.Context, clusterStack *Stack, nodeGroupStacks []NodeGroupStack, clusterOperable bool, newOIDCManager NewOIDCManager, newTasksToDeleteAddonIAM NewTasksToDeleteAddonIAM, newTasksToDeleteCapabilityIAM NewTasksToDeleteCapabilityIAM, newTasksToDeletePodIdentityRole NewTasksToDeletePodIdentityRole, cluster *ekstypes.Cluster, clientSetGetter kubernetes.ClientSetGetter, wait, force bool, cleanup func(chan error, string) error) (*tasks.Graph, error)
+	NewTasksToCreateIAMServiceAccounts(ctx context.Context, serviceAccounts []*api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *tasks.TaskTree
 	NewTaskToDeleteUnownedNodeGroup(ctx context.Context, clusterName, nodegroup string, nodeGroupDeleter NodeGroupDeleter, waitCondition *DeleteWaitCondition) tasks.Task
-	NewTasksToCreateCluster(ctx context.Context, nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, accessConfig *api.AccessConfig, accessEntryCreator accessentry.CreatorInterface, nodeGroupParallelism int, postClusterCreationTasks ...tasks.Task) *tasks.TaskTree
+	NewTasksToCreateCluster(ctx context.Context, nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, accessConfig *api.AccessConfig, accessEntryCreator accessentry.CreatorInterface, nodeGroupParallelism int, postClusterCreationTasks *tasks.Graph, postNodeGroupTasks tasks.Task, nodeGroupPrerequisites ...tasks.Task) *tasks.Graph
 	NewTasksToDeleteIAMServiceAccounts(ctx context.Context, serviceAccounts []string, clientSetGetter kubernetes.ClientSetGetter, wait bool) (*tasks.TaskTree, error)
 	NewTasksToDeleteNodeGroups(stacks []NodeGroupStack, shouldDelete func(_ string) bool, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error)
 	NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx context.Context, newOIDCManager NewOIDCManager, cluster *ekstypes.Cluster, clientSetGetter kubernetes.ClientSetGetter, force bool) (*tasks.TaskTree, error)
@@ -99,6 +114,7 @@ type StackManager interface {
 	RefreshFargatePodExecutionRoleARN(ctx context.Context) error
 	StackStatusIsNotTransitional(s *Stack) bool
 	TroubleshootStackFailureCause(ctx context.Context, s *cfntypes.Stack, desiredStatus cfntypes.StackStatus)
+	UpdateClusterStackProtection(ctx context.Context) error
 	UpdateNodeGroupStack(ctx context.Context, nodeGroupName, template string, wait bool) error
 	UpdateStack(ctx context.Context, options UpdateStackOptions) error
 	MustUpdateStack(ctx context.Context, options UpdateStackOptions) error
@@ -8,6 +8,8 @@ import (
 	builder "github.com/weaveworks/eksctl/pkg/cfn/builder"
 
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 )
 
 // NodeGroupStackManager is an autogenerated mock type for the NodeGroupStackManager type
@@ -33,6 +35,36 @@ func (_m *NodeGroupStackManager) CreateStack(ctx context.Context, stackName stri
 	return r0
 }
 
+// DescribeStack provides a mock function with given fields: ctx, i
+func (_m *NodeGroupStackManager) DescribeStack(ctx context.Context, i *types.Stack) (*types.Stack, error) {
+	ret := _m.Called(ctx, i)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeStack")
+	}
+
+	var r0 *types.Stack
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Stack) (*types.Stack, error)); ok {
+		return rf(ctx, i)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Stack) *types.Stack); ok {
+		r0 = rf(ctx, i)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Stack)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.Stack) error); ok {
+		r1 = rf(ctx, i)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewNodeGroupStackManager creates a new instance of NodeGroupStackManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNodeGroupStackManager(t interface {
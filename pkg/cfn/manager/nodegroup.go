@@ -40,6 +40,11 @@ func makeNodeGroupStackName(clusterName, ngName string) string {
 	return fmt.Sprintf("eksctl-%s-nodegroup-%s", clusterName, ngName)
 }
 
+// MakeNodeGroupStackName returns the name of the stack of nodegroup ngName in cluster clusterName.
+func MakeNodeGroupStackName(clusterName, ngName string) string {
+	return makeNodeGroupStackName(clusterName, ngName)
+}
+
 // CreateNodeGroupOptions holds options for creating nodegroup tasks.
 type CreateNodeGroupOptions struct {
 	ForceAddCNIPolicy          bool
@@ -53,6 +58,8 @@ type CreateNodeGroupOptions struct {
 type NodeGroupStackManager interface {
 	// CreateStack creates a CloudFormation stack.
 	CreateStack(ctx context.Context, stackName string, resourceSet builder.ResourceSetReader, tags, parameters map[string]string, errs chan error) error
+	// DescribeStack describes a CloudFormation stack.
+	DescribeStack(ctx context.Context, i *Stack) (*Stack, error)
 }
 
 // A NodeGroupResourceSet creates resources for a nodegroup.
@@ -89,9 +96,19 @@ func (t *UnmanagedNodeGroupTask) Create(ctx context.Context, options CreateNodeG
 		createAccessEntryInStack := ng.IAM.InstanceRoleARN == ""
 		createNodeGroupTask := &tasks.GenericTask{
 			Description: fmt.Sprintf("create nodegroup %q", ng.NameString()),
-			Doer: func() error {
+			Metadata: tasks.Metadata{
+				Action:       "create",
+				ResourceType: "nodeGroup",
+				ResourceName: ng.Name,
+				StackName:    makeNodeGroupStackName(t.ClusterConfig.Metadata.Name, ng.Name),
+			},
+			ContextDoer: func(ctx context.Context) error {
 				return t.createNodeGroup(ctx, ng, options, createAccessEntryInStack)
 			},
+			Resumer: func() error {
+				return t.loadNodeGroupOutputs(ctx, ng, options, createAccessEntryInStack)
+			},
+			RetryPolicy: newNodeGroupRetryPolicy(),
 		}
 
 		if options.DisableAccessEntryCreation || createAccessEntryInStack {
@@ -116,22 +133,8 @@ func (t *UnmanagedNodeGroupTask) createNodeGroup(ctx context.Context, ng *api.No
 	name := makeNodeGroupStackName(t.ClusterConfig.Metadata.Name, ng.Name)
 
 	logger.Info("building nodegroup stack %q", name)
-	bootstrapper, err := t.NewBootstrapper(t.ClusterConfig, ng)
+	resourceSet, err := t.buildNodeGroupResourceSet(ctx, ng, options, createAccessEntryInStack)
 	if err != nil {
-		return fmt.Errorf("error creating bootstrapper: %w", err)
-	}
-
-	resourceSet := t.CreateNodeGroupResourceSet(builder.NodeGroupOptions{
-		ClusterConfig:              t.ClusterConfig,
-		NodeGroup:                  ng,
-		Bootstrapper:               bootstrapper,
-		ForceAddCNIPolicy:          options.ForceAddCNIPolicy,
-		VPCImporter:                options.VPCImporter,
-		SkipEgressRules:            options.SkipEgressRules,
-		DisableAccessEntry:         options.DisableAccessEntryCreation,
-		DisableAccessEntryResource: !createAccessEntryInStack,
-	})
-	if err := resourceSet.AddAllResources(ctx); err != nil {
 		return err
 	}
 
@@ -149,6 +152,42 @@ func (t *UnmanagedNodeGroupTask) createNodeGroup(ctx context.Context, ng *api.No
 	return <-errCh
 }
 
+// loadNodeGroupOutputs collects the outputs of the existing stack of a nodegroup that was created in a resumed run
+func (t *UnmanagedNodeGroupTask) loadNodeGroupOutputs(ctx context.Context, ng *api.NodeGroup, options CreateNodeGroupOptions, createAccessEntryInStack bool) error {
+	name := makeNodeGroupStackName(t.ClusterConfig.Metadata.Name, ng.Name)
+	resourceSet, err := t.buildNodeGroupResourceSet(ctx, ng, options, createAccessEntryInStack)
+	if err != nil {
+		return err
+	}
+	stack, err := t.StackManager.DescribeStack(ctx, &Stack{StackName: aws.String(name)})
+	if err != nil {
+		return err
+	}
+	return resourceSet.GetAllOutputs(*stack)
+}
+
+func (t *UnmanagedNodeGroupTask) buildNodeGroupResourceSet(ctx context.Context, ng *api.NodeGroup, options CreateNodeGroupOptions, createAccessEntryInStack bool) (NodeGroupResourceSet, error) {
+	bootstrapper, err := t.NewBootstrapper(t.ClusterConfig, ng)
+	if err != nil {
+		return nil, fmt.Errorf("error creating bootstrapper: %w", err)
+	}
+
+	resourceSet := t.CreateNodeGroupResourceSet(builder.NodeGroupOptions{
+		ClusterConfig:              t.ClusterConfig,
+		NodeGroup:                  ng,
+		Bootstrapper:               bootstrapper,
+		ForceAddCNIPolicy:          options.ForceAddCNIPolicy,
+		VPCImporter:                options.VPCImporter,
+		SkipEgressRules:            options.SkipEgressRules,
+		DisableAccessEntry:         options.DisableAccessEntryCreation,
+		DisableAccessEntryResource: !createAccessEntryInStack,
+	})
+	if err := resourceSet.AddAllResources(ctx); err != nil {
+		return nil, err
+	}
+	return resourceSet, nil
+}
+
 func (t *UnmanagedNodeGroupTask) maybeCreateAccessEntry(ctx context.Context, ng *api.NodeGroup) error {
 	roleARN := ng.IAM.InstanceRoleARN
 	_, err := t.EKSAPI.CreateAccessEntry(ctx, &eks.CreateAccessEntryInput{
@@ -182,7 +221,7 @@ func (c *StackCollection) createManagedNodeGroupTask(ctx context.Context, errorC
 	if err != nil {
 		return err
 	}
-	if cluster == nil && c.spec.IPv6Enabled() {
+	if cluster == nil && c.spec.IPv6Enabled() && !c.templateExporter.exported(c.MakeClusterStackName()) {
 		return errors.New("managed nodegroups cannot be created on IPv6 unowned clusters")
 	}
 	logger.Info("building managed nodegroup stack %q", name)
@@ -0,0 +1,235 @@
+package manager
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// resolvedReferenceParameterPrefix prefixes the names of the NoEcho parameters that pass the values resolved from
+// references in the ClusterConfig to stacks, so that they are not written to template bodies.
+const resolvedReferenceParameterPrefix = "ResolvedReference"
+
+// A resolvedValue is the value of a ClusterConfig field that was resolved from a reference.
+type resolvedValue struct {
+	parameter string
+	reference string
+	value     string
+}
+
+func resolvedReferenceParameterName(reference string) string {
+	sum := sha256.Sum256([]byte(reference))
+	return resolvedReferenceParameterPrefix + hex.EncodeToString(sum[:])[:16]
+}
+
+// resolvedValues returns the values of the ClusterConfig fields that were resolved from references.
+func (c *StackCollection) resolvedValues() []resolvedValue {
+	if c.spec == nil || len(c.spec.ResolvedReferences) == 0 {
+		return nil
+	}
+	references := make(map[string]string, len(c.spec.ResolvedReferences))
+	for _, r := range c.spec.ResolvedReferences {
+		references[r.Field] = r.Reference
+	}
+	var values []resolvedValue
+	seen := map[string]bool{}
+	_ = c.spec.ForEachReferenceField(func(field string, value *string) error {
+		reference, ok := references[field]
+		if !ok || *value == "" || seen[*value] {
+			return nil
+		}
+		seen[*value] = true
+		values = append(values, resolvedValue{
+			parameter: resolvedReferenceParameterName(reference),
+			reference: reference,
+			value:     *value,
+		})
+		return nil
+	})
+	return values
+}
+
+// parameterizeResolvedValues replaces the values resolved from references in templateBody with references to
+// NoEcho parameters, and returns the template along with the values it takes as parameters; it fails if a value
+// would still be written to the template, e.g. as part of a longer string.
+func (c *StackCollection) parameterizeResolvedValues(stackName string, templateBody []byte) ([]byte, []resolvedValue, error) {
+	values := c.resolvedValues()
+	if len(values) == 0 {
+		return templateBody, nil, nil
+	}
+
+	var template map[string]interface{}
+	if err := json.Unmarshal(templateBody, &template); err != nil {
+		return nil, nil, fmt.Errorf("parsing template for stack %q: %w", stackName, err)
+	}
+	byValue := make(map[string]resolvedValue, len(values))
+	for _, v := range values {
+		byValue[v.value] = v
+	}
+	used := map[string]resolvedValue{}
+	var replace func(node interface{}) interface{}
+	replace = func(node interface{}) interface{} {
+		switch n := node.(type) {
+		case string:
+			if v, ok := byValue[n]; ok {
+				used[v.parameter] = v
+				return map[string]interface{}{"Ref": v.parameter}
+			}
+		case map[string]interface{}:
+			for k, child := range n {
+				n[k] = replace(child)
+			}
+		case []interface{}:
+			for i, child := range n {
+				n[i] = replace(child)
+			}
+		}
+		return node
+	}
+	for _, section := range []string{"Resources", "Outputs"} {
+		if s, ok := template[section]; ok {
+			template[section] = replace(s)
+		}
+	}
+
+	if len(used) > 0 {
+		parameters, _ := template["Parameters"].(map[string]interface{})
+		if parameters == nil {
+			parameters = map[string]interface{}{}
+		}
+		for name := range used {
+			parameters[name] = map[string]interface{}{
+				"Type":   "String",
+				"NoEcho": true,
+			}
+		}
+		template["Parameters"] = parameters
+		var err error
+		if templateBody, err = json.Marshal(template); err != nil {
+			return nil, nil, fmt.Errorf("rendering template for stack %q: %w", stackName, err)
+		}
+	}
+
+	for _, v := range values {
+		encoded, err := json.Marshal(v.value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bytes.Contains(templateBody, bytes.Trim(encoded, `"`)) {
+			return nil, nil, fmt.Errorf("the value resolved from reference %q would be written to the template of stack %q; set the field to the value instead of a reference", v.reference, stackName)
+		}
+	}
+
+	var parameterized []resolvedValue
+	for _, v := range values {
+		if _, ok := used[v.parameter]; ok {
+			parameterized = append(parameterized, v)
+		}
+	}
+	return templateBody, parameterized, nil
+}
+
+// withResolvedValueParameters returns parameters along with the parameters that pass values to a template returned by
+// parameterizeResolvedValues; exported templates take the references instead of the values they were resolved from.
+func withResolvedValueParameters(parameters map[string]string, values []resolvedValue, exporting bool) map[string]string {
+	if len(values) == 0 {
+		return parameters
+	}
+	all := make(map[string]string, len(parameters)+len(values))
+	for k, v := range parameters {
+		all[k] = v
+	}
+	for _, v := range values {
+		if exporting {
+			all[v.parameter] = v.reference
+		} else {
+			all[v.parameter] = v.value
+		}
+	}
+	return all
+}
+
+// resolvedValueChangeSetParameters parameterizes the values resolved from references in the template a stack is
+// updated with, and returns the template along with the parameters that pass these values to it; the parameters of
+// the stack whose references are not resolved by the config, e.g. because the command was run without one, keep
+// their previous values.
+func (c *StackCollection) resolvedValueChangeSetParameters(options UpdateStackOptions) (TemplateData, []types.Parameter, error) {
+	templateData := options.TemplateData
+	var used []resolvedValue
+	if body, ok := templateData.(TemplateBody); ok {
+		parameterized, values, err := c.parameterizeResolvedValues(options.StackName, body)
+		if err != nil {
+			return nil, nil, err
+		}
+		templateData, used = TemplateBody(parameterized), values
+	}
+
+	values := map[string]string{}
+	for _, v := range c.resolvedValues() {
+		values[v.parameter] = v.value
+	}
+	var parameters []types.Parameter
+	for _, v := range used {
+		parameters = append(parameters, types.Parameter{
+			ParameterKey:   aws.String(v.parameter),
+			ParameterValue: aws.String(v.value),
+		})
+	}
+	for _, p := range options.Stack.Parameters {
+		key := aws.ToString(p.ParameterKey)
+		if !strings.HasPrefix(key, resolvedReferenceParameterPrefix) || slices.ContainsFunc(parameters, func(p types.Parameter) bool {
+			return aws.ToString(p.ParameterKey) == key
+		}) {
+			continue
+		}
+		parameter := types.Parameter{ParameterKey: aws.String(key)}
+		if value, ok := values[key]; ok {
+			parameter.ParameterValue = aws.String(value)
+		} else {
+			parameter.UsePreviousValue = aws.Bool(true)
+		}
+		parameters = append(parameters, parameter)
+	}
+	slices.SortFunc(parameters, func(a, b types.Parameter) int {
+		return strings.Compare(aws.ToString(a.ParameterKey), aws.ToString(b.ParameterKey))
+	})
+	return templateData, parameters, nil
+}
+
+// resolveExportedParameters replaces the references that exported templates take as parameters with the values
+// they were resolved from.
+func (c *StackCollection) resolveExportedParameters(stackName string, parameters map[string]string) (map[string]string, error) {
+	var resolved map[string]string
+	for name, reference := range parameters {
+		if !strings.HasPrefix(name, resolvedReferenceParameterPrefix) {
+			continue
+		}
+		if resolved == nil {
+			resolved = make(map[string]string, len(parameters))
+			for k, v := range parameters {
+				resolved[k] = v
+			}
+		}
+		found := false
+		for _, v := range c.resolvedValues() {
+			if v.reference == reference {
+				resolved[name], found = v.value, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("the exported template of stack %q takes the value of reference %q, which is not used in the config", stackName, reference)
+		}
+	}
+	if resolved == nil {
+		return parameters, nil
+	}
+	return resolved, nil
+}
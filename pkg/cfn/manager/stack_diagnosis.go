@@ -0,0 +1,259 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/utils/apierrors"
+)
+
+const (
+	// maxDiagnosisEventPages bounds how far back the events of a stack are walked
+	maxDiagnosisEventPages = 10
+	// cloudTrailLookbehind and cloudTrailLookahead delimit the CloudTrail events correlated with a failure
+	cloudTrailLookbehind = 15 * time.Minute
+	cloudTrailLookahead  = 5 * time.Minute
+)
+
+// StackFailureDiagnosis is the result of the root-cause analysis of a failed stack
+type StackFailureDiagnosis struct {
+	StackName   string                    `json:"stackName"`
+	StackStatus string                    `json:"stackStatus"`
+	Cause       builder.StackFailureCause `json:"cause"`
+	Hint        string                    `json:"hint,omitempty"`
+	// RootCause is the first resource that failed, if any
+	RootCause *FailedResource `json:"rootCause,omitempty"`
+	// Reason is the reason of the root cause failure, or the status reason of the stack
+	Reason           string                      `json:"reason"`
+	CloudTrailEvents []CorrelatedCloudTrailEvent `json:"cloudTrailEvents,omitempty"`
+	// Failures lists, in chronological order, all the resources that failed during the last operation
+	Failures []FailedResource `json:"failures,omitempty"`
+}
+
+// FailedResource is a resource that failed during an operation on a stack
+type FailedResource struct {
+	LogicalResourceID  string    `json:"logicalResourceId"`
+	PhysicalResourceID string    `json:"physicalResourceId,omitempty"`
+	ResourceType       string    `json:"resourceType"`
+	ResourceStatus     string    `json:"resourceStatus"`
+	Reason             string    `json:"reason,omitempty"`
+	Timestamp          time.Time `json:"timestamp"`
+}
+
+// CorrelatedCloudTrailEvent is a failed API call on a failed resource around the time it failed
+type CorrelatedCloudTrailEvent struct {
+	EventName    string    `json:"eventName"`
+	EventSource  string    `json:"eventSource"`
+	EventTime    time.Time `json:"eventTime"`
+	ErrorCode    string    `json:"errorCode"`
+	ErrorMessage string    `json:"errorMessage,omitempty"`
+}
+
+// Summary returns a one-line description of the diagnosis.
+func (d *StackFailureDiagnosis) Summary() string {
+	var msg string
+	if r := d.RootCause; r != nil {
+		msg = fmt.Sprintf("stack %q failed because %s/%s is %s: %q", d.StackName, r.ResourceType, r.LogicalResourceID, r.ResourceStatus, d.Reason)
+	} else {
+		msg = fmt.Sprintf("stack %q is %s: %q", d.StackName, d.StackStatus, d.Reason)
+	}
+	if d.Cause == builder.StackFailureCauseUnknown {
+		return msg
+	}
+	return fmt.Sprintf("%s (cause: %s; hint: %s)", msg, d.Cause, d.Hint)
+}
+
+// StackFailureError is the error returned when waiting on a stack fails, along with the diagnosis of the failure.
+type StackFailureError struct {
+	Err       error
+	Diagnosis *StackFailureDiagnosis
+}
+
+func (e *StackFailureError) Error() string {
+	return fmt.Sprintf("%v; %s", e.Err, e.Diagnosis.Summary())
+}
+
+func (e *StackFailureError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryableStackFailure reports whether err is an AWS API error that is likely to be transient, or the failure of
+// a stack caused by insufficient EC2 capacity, which is usually short-lived.
+func IsRetryableStackFailure(err error) bool {
+	if apierrors.IsRetryableAWSError(err) {
+		return true
+	}
+	var stackErr *StackFailureError
+	return errors.As(err, &stackErr) && stackErr.Diagnosis.Cause == builder.StackFailureCauseInsufficientCapacity
+}
+
+// withFailureDiagnosis returns err along with the diagnosis of the failure of the stack, if the stack has failed.
+func (c *StackCollection) withFailureDiagnosis(ctx context.Context, i *Stack, err error) error {
+	if err == nil || ctx.Err() != nil {
+		// there is nothing to diagnose when waiting was cancelled, e.g. because eksctl was interrupted
+		return err
+	}
+	diagnosis, diagnosisErr := DiagnoseStackFailure(ctx, c.cloudformationAPI, c.cloudTrailAPI, i)
+	if diagnosisErr != nil {
+		logger.Debug("failed to diagnose the failure of stack %q: %v", aws.ToString(i.StackName), diagnosisErr)
+		return err
+	}
+	if diagnosis == nil {
+		return err
+	}
+	logger.Critical(diagnosis.Summary())
+	return &StackFailureError{Err: err, Diagnosis: diagnosis}
+}
+
+// DiagnoseStackFailure walks the events of the last operation on a failed stack, correlates the first resource
+// failure with CloudTrail and classifies it with builder.StackFailureRules. It returns nil if the stack has not failed.
+func DiagnoseStackFailure(ctx context.Context, cfnAPI awsapi.CloudFormation, cloudTrailAPI awsapi.CloudTrail, i *Stack) (*StackFailureDiagnosis, error) {
+	stackName := aws.ToString(i.StackName)
+	input := &cloudformation.DescribeStacksInput{StackName: i.StackName}
+	if api.IsSetAndNonEmptyString(i.StackId) {
+		input.StackName = i.StackId
+	}
+	out, err := cfnAPI.DescribeStacks(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("describing CloudFormation stack %q: %w", stackName, err)
+	}
+	if len(out.Stacks) == 0 {
+		return nil, fmt.Errorf("no CloudFormation stack found for %s", stackName)
+	}
+	stack := out.Stacks[0]
+	if !isFailedStackStatus(stack.StackStatus) {
+		return nil, nil
+	}
+
+	failures, err := lastOperationFailures(ctx, cfnAPI, aws.ToString(input.StackName), stackName)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnosis := &StackFailureDiagnosis{
+		StackName:   stackName,
+		StackStatus: string(stack.StackStatus),
+		Reason:      aws.ToString(stack.StackStatusReason),
+		Failures:    failures,
+	}
+	if len(failures) > 0 {
+		rootCause := rootCauseFailure(failures)
+		diagnosis.RootCause = &rootCause
+		diagnosis.Reason = rootCause.Reason
+	}
+
+	var resourceType string
+	if diagnosis.RootCause != nil {
+		resourceType = diagnosis.RootCause.ResourceType
+	}
+	rule, ok := builder.ClassifyStackFailure(resourceType, "", diagnosis.Reason)
+	if r := diagnosis.RootCause; r != nil && r.PhysicalResourceID != "" {
+		events, err := lookupFailedCalls(ctx, cloudTrailAPI, *r)
+		if err != nil {
+			logger.Debug("failed to look up CloudTrail events for resource %q: %v", r.PhysicalResourceID, err)
+		}
+		diagnosis.CloudTrailEvents = events
+		for _, e := range events {
+			if ok {
+				break
+			}
+			rule, ok = builder.ClassifyStackFailure(resourceType, e.ErrorCode, e.ErrorMessage)
+		}
+	}
+	diagnosis.Cause = rule.Cause
+	diagnosis.Hint = rule.Hint
+	return diagnosis, nil
+}
+
+func isFailedStackStatus(status types.StackStatus) bool {
+	return strings.HasSuffix(string(status), "_FAILED") || strings.Contains(string(status), "ROLLBACK")
+}
+
+// lastOperationFailures returns, in chronological order, the resources that failed during the last operation on the stack
+func lastOperationFailures(ctx context.Context, cfnAPI awsapi.CloudFormation, stackID, stackName string) ([]FailedResource, error) {
+	var failures []FailedResource
+	paginator := cloudformation.NewDescribeStackEventsPaginator(cfnAPI, &cloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackID),
+	})
+	for page := 0; paginator.HasMorePages() && page < maxDiagnosisEventPages; page++ {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing CloudFormation stack %q events: %w", stackName, err)
+		}
+		for _, e := range out.StackEvents {
+			if aws.ToString(e.LogicalResourceId) != stackName && isFailedStatus(e.ResourceStatus) {
+				failures = append([]FailedResource{{
+					LogicalResourceID:  aws.ToString(e.LogicalResourceId),
+					PhysicalResourceID: aws.ToString(e.PhysicalResourceId),
+					ResourceType:       aws.ToString(e.ResourceType),
+					ResourceStatus:     string(e.ResourceStatus),
+					Reason:             aws.ToString(e.ResourceStatusReason),
+					Timestamp:          aws.ToTime(e.Timestamp),
+				}}, failures...)
+			}
+			if isOperationStart(e) {
+				return failures, nil
+			}
+		}
+	}
+	return failures, nil
+}
+
+// rootCauseFailure returns the first failure that was not caused by another one
+func rootCauseFailure(failures []FailedResource) FailedResource {
+	for _, f := range failures {
+		reason := strings.ToLower(f.Reason)
+		if !strings.Contains(reason, "cancelled") && !strings.Contains(reason, "canceled") {
+			return f
+		}
+	}
+	return failures[0]
+}
+
+// lookupFailedCalls returns the failed API calls on the resource around the time it failed
+func lookupFailedCalls(ctx context.Context, cloudTrailAPI awsapi.CloudTrail, r FailedResource) ([]CorrelatedCloudTrailEvent, error) {
+	out, err := cloudTrailAPI.LookupEvents(ctx, &cloudtrail.LookupEventsInput{
+		LookupAttributes: []cttypes.LookupAttribute{{
+			AttributeKey:   cttypes.LookupAttributeKeyResourceName,
+			AttributeValue: aws.String(r.PhysicalResourceID),
+		}},
+		StartTime: aws.Time(r.Timestamp.Add(-cloudTrailLookbehind)),
+		EndTime:   aws.Time(r.Timestamp.Add(cloudTrailLookahead)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []CorrelatedCloudTrailEvent
+	for _, e := range out.Events {
+		var record struct {
+			ErrorCode    string `json:"errorCode"`
+			ErrorMessage string `json:"errorMessage"`
+		}
+		if e.CloudTrailEvent == nil || json.Unmarshal([]byte(*e.CloudTrailEvent), &record) != nil || record.ErrorCode == "" {
+			continue
+		}
+		events = append(events, CorrelatedCloudTrailEvent{
+			EventName:    aws.ToString(e.EventName),
+			EventSource:  aws.ToString(e.EventSource),
+			EventTime:    aws.ToTime(e.EventTime),
+			ErrorCode:    record.ErrorCode,
+			ErrorMessage: record.ErrorMessage,
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,138 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cttypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Stack failure diagnosis", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng"
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	stackEvent := func(logicalID, physicalID string, status types.ResourceStatus, reason string, age time.Duration) types.StackEvent {
+		return types.StackEvent{
+			EventId:              aws.String(logicalID + string(status)),
+			StackName:            aws.String(stackName),
+			LogicalResourceId:    aws.String(logicalID),
+			PhysicalResourceId:   aws.String(physicalID),
+			ResourceType:         aws.String("AWS::AutoScaling::AutoScalingGroup"),
+			ResourceStatus:       status,
+			ResourceStatusReason: aws.String(reason),
+			Timestamp:            aws.Time(time.Now().Add(-age)),
+		}
+	}
+
+	mockStackStatus := func(status types.StackStatus) {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{
+				StackName:         aws.String(stackName),
+				StackStatus:       status,
+				StackStatusReason: aws.String("The following resource(s) failed to create: [NodeGroup]."),
+			}},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg).(*StackCollection)
+	})
+
+	It("does not diagnose stacks that have not failed", func() {
+		mockStackStatus(types.StackStatusCreateComplete)
+
+		diagnosis, err := DiagnoseStackFailure(context.Background(), p.CloudFormation(), p.CloudTrail(), &Stack{StackName: aws.String(stackName)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diagnosis).To(BeNil())
+	})
+
+	It("finds the root cause of the last operation and classifies it with CloudTrail", func() {
+		mockStackStatus(types.StackStatusRollbackComplete)
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+			StackEvents: []types.StackEvent{
+				stackEvent(stackName, "", types.ResourceStatusRollbackComplete, "", 0),
+				stackEvent("LaunchTemplate", "lt-1234", types.ResourceStatusCreateFailed, "Resource creation cancelled", time.Minute),
+				stackEvent("NodeGroup", "eksctl-ng-asg", types.ResourceStatusCreateFailed, "Resource handler returned message: \"Group did not stabilize\"", 2*time.Minute),
+				stackEvent(stackName, "", types.ResourceStatusCreateInProgress, "User Initiated", 5*time.Minute),
+				stackEvent("PreviousOperation", "", types.ResourceStatusCreateFailed, "Resource creation cancelled", time.Hour),
+			},
+		}, nil)
+		p.MockCloudTrail().On("LookupEvents", mock.Anything, mock.MatchedBy(func(input *cloudtrail.LookupEventsInput) bool {
+			return aws.ToString(input.LookupAttributes[0].AttributeValue) == "eksctl-ng-asg"
+		})).Return(&cloudtrail.LookupEventsOutput{
+			Events: []cttypes.Event{
+				{
+					EventName:       aws.String("RunInstances"),
+					EventSource:     aws.String("ec2.amazonaws.com"),
+					CloudTrailEvent: aws.String(`{"eventName": "RunInstances"}`),
+				},
+				{
+					EventName:       aws.String("RunInstances"),
+					EventSource:     aws.String("ec2.amazonaws.com"),
+					CloudTrailEvent: aws.String(`{"errorCode": "Server.InsufficientInstanceCapacity", "errorMessage": "We currently do not have sufficient m5.large capacity in the Availability Zone you requested"}`),
+				},
+			},
+		}, nil)
+
+		diagnosis, err := DiagnoseStackFailure(context.Background(), p.CloudFormation(), p.CloudTrail(), &Stack{StackName: aws.String(stackName)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diagnosis.StackStatus).To(Equal(string(types.StackStatusRollbackComplete)))
+		Expect(diagnosis.Failures).To(HaveLen(2))
+		Expect(diagnosis.RootCause.LogicalResourceID).To(Equal("NodeGroup"))
+		Expect(diagnosis.CloudTrailEvents).To(HaveLen(1))
+		Expect(diagnosis.CloudTrailEvents[0].ErrorCode).To(Equal("Server.InsufficientInstanceCapacity"))
+		Expect(diagnosis.Cause).To(Equal(builder.StackFailureCauseInsufficientCapacity))
+		Expect(diagnosis.Summary()).To(ContainSubstring("AWS::AutoScaling::AutoScalingGroup/NodeGroup is CREATE_FAILED"))
+		Expect(diagnosis.Summary()).To(ContainSubstring("cause: InsufficientCapacity"))
+	})
+
+	It("adds the diagnosis to the error returned when waiting on a stack fails", func() {
+		mockStackStatus(types.StackStatusDeleteFailed)
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+			StackEvents: []types.StackEvent{
+				stackEvent("VPC", "vpc-1234", types.ResourceStatusDeleteFailed, "The vpc 'vpc-1234' has dependencies and cannot be deleted. (Service: Ec2, Status Code: 400, Error Code: DependencyViolation)", 0),
+				stackEvent(stackName, "", types.ResourceStatusDeleteInProgress, "User Initiated", time.Minute),
+			},
+		}, nil)
+		p.MockCloudTrail().On("LookupEvents", mock.Anything, mock.Anything).Return(&cloudtrail.LookupEventsOutput{}, nil)
+
+		waitErr := errors.New("waiter state transitioned to Failure")
+		err := sc.withFailureDiagnosis(context.Background(), &Stack{StackName: aws.String(stackName)}, waitErr)
+		Expect(err).To(MatchError(waitErr))
+		Expect(err.Error()).To(HavePrefix("waiter state transitioned to Failure; "))
+
+		var failureErr *StackFailureError
+		Expect(errors.As(err, &failureErr)).To(BeTrue())
+		Expect(failureErr.Diagnosis.Cause).To(Equal(builder.StackFailureCauseDependencyViolation))
+		Expect(failureErr.Diagnosis.Hint).NotTo(BeEmpty())
+	})
+
+	It("retries stack failures caused by insufficient capacity", func() {
+		failure := func(cause builder.StackFailureCause) error {
+			return &StackFailureError{Err: errors.New("failed"), Diagnosis: &StackFailureDiagnosis{Cause: cause}}
+		}
+		Expect(IsRetryableStackFailure(failure(builder.StackFailureCauseInsufficientCapacity))).To(BeTrue())
+		Expect(IsRetryableStackFailure(failure(builder.StackFailureCauseInvalidAMI))).To(BeFalse())
+		Expect(IsRetryableStackFailure(errors.New("failed"))).To(BeFalse())
+	})
+})
@@ -0,0 +1,324 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// stackEventClockSkew is how long before the start of a wait stack events are still considered part of the
+// operation being waited on; the operation is always started shortly before waiting on it
+const stackEventClockSkew = time.Minute
+
+// StackEventView displays the progress of the stacks being waited on.
+// Stacks are waited on concurrently, so implementations must be safe for concurrent use.
+type StackEventView interface {
+	// Waiting is called every time the status of a stack is polled.
+	Waiting(stackName string, elapsed time.Duration)
+	// Event is called, in chronological order, for every new event of a stack.
+	Event(stackName string, event types.StackEvent, elapsed time.Duration)
+	// Done is called when the wait on a stack has finished.
+	Done(stackName string, elapsed time.Duration)
+}
+
+var (
+	stackEventViewMu sync.RWMutex
+	stackEventView   StackEventView = LineStackEventView{}
+)
+
+// SetStackEventView sets the view used to display the progress of the stacks being waited on.
+func SetStackEventView(view StackEventView) {
+	stackEventViewMu.Lock()
+	defer stackEventViewMu.Unlock()
+	stackEventView = view
+}
+
+func currentStackEventView() StackEventView {
+	stackEventViewMu.RLock()
+	defer stackEventViewMu.RUnlock()
+	return stackEventView
+}
+
+// stackEventStreamer tails the events of a stack while it is being waited on.
+type stackEventStreamer struct {
+	cloudformationAPI awsapi.CloudFormation
+	stack             *Stack
+	view              StackEventView
+	start             time.Time
+	lastEventID       string
+	lastStatus        types.StackStatus
+}
+
+func (c *StackCollection) newStackEventStreamer(i *Stack) *stackEventStreamer {
+	return &stackEventStreamer{
+		cloudformationAPI: c.cloudformationAPI,
+		stack:             i,
+		view:              currentStackEventView(),
+		start:             time.Now(),
+	}
+}
+
+// poll passes the events of the stack that occurred since the last poll to the view.
+// Errors are only logged, as they must not fail the wait.
+func (s *stackEventStreamer) poll(ctx context.Context) {
+	stackName := aws.ToString(s.stack.StackName)
+	s.view.Waiting(stackName, s.elapsed())
+
+	events, err := s.newEvents(ctx)
+	if err != nil {
+		logger.Debug("failed to fetch events of stack %q: %v", stackName, err)
+		return
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		s.view.Event(stackName, events[i], s.elapsed())
+	}
+	if len(events) > 0 {
+		s.lastEventID = aws.ToString(events[0].EventId)
+	}
+}
+
+// observe emits a progress event when the status of the stack has changed since it was last observed.
+func (s *stackEventStreamer) observe(ctx context.Context, stack *types.Stack) {
+	if stack == nil || stack.StackStatus == "" || stack.StackStatus == s.lastStatus {
+		return
+	}
+	tasks.EmitEvent(ctx, tasks.Event{
+		Type:           tasks.EventStackStatus,
+		StackName:      aws.ToString(s.stack.StackName),
+		Status:         string(stack.StackStatus),
+		PreviousStatus: string(s.lastStatus),
+	})
+	s.lastStatus = stack.StackStatus
+}
+
+func (s *stackEventStreamer) done() {
+	s.view.Done(aws.ToString(s.stack.StackName), s.elapsed())
+}
+
+func (s *stackEventStreamer) elapsed() time.Duration {
+	return time.Since(s.start)
+}
+
+// newEvents returns, newest first, the events of the current operation on the stack not returned before.
+func (s *stackEventStreamer) newEvents(ctx context.Context) ([]types.StackEvent, error) {
+	input := &cloudformation.DescribeStackEventsInput{
+		StackName: s.stack.StackName,
+	}
+	// the events of a deleted stack can only be described by its ID
+	if api.IsSetAndNonEmptyString(s.stack.StackId) {
+		input.StackName = s.stack.StackId
+	}
+
+	cutoff := s.start.Add(-stackEventClockSkew)
+	var events []types.StackEvent
+	paginator := cloudformation.NewDescribeStackEventsPaginator(s.cloudformationAPI, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range out.StackEvents {
+			if aws.ToString(e.EventId) == s.lastEventID || (e.Timestamp != nil && e.Timestamp.Before(cutoff)) {
+				return events, nil
+			}
+			events = append(events, e)
+			if isOperationStart(e) {
+				return events, nil
+			}
+		}
+	}
+	return events, nil
+}
+
+// isOperationStart reports whether the event marks the start of an operation on the whole stack.
+func isOperationStart(e types.StackEvent) bool {
+	return aws.ToString(e.LogicalResourceId) == aws.ToString(e.StackName) &&
+		strings.HasSuffix(string(e.ResourceStatus), "_IN_PROGRESS") &&
+		aws.ToString(e.ResourceStatusReason) == "User Initiated"
+}
+
+func isFailedStatus(status types.ResourceStatus) bool {
+	return strings.HasSuffix(string(status), "_FAILED")
+}
+
+func formatStackEvent(stackName string, e types.StackEvent, elapsed time.Duration) string {
+	msg := fmt.Sprintf("[%s] %s/%s: %s", stackName, aws.ToString(e.ResourceType), aws.ToString(e.LogicalResourceId), e.ResourceStatus)
+	if e.ResourceStatusReason != nil && *e.ResourceStatusReason != "User Initiated" {
+		msg = fmt.Sprintf("%s – %q", msg, *e.ResourceStatusReason)
+	}
+	return fmt.Sprintf("%s (%s)", msg, formatElapsed(elapsed))
+}
+
+func formatElapsed(elapsed time.Duration) string {
+	return elapsed.Round(time.Second).String()
+}
+
+// LineStackEventView logs a line for every stack event, prefixed with the name of the stack.
+type LineStackEventView struct{}
+
+// Waiting implements StackEventView.
+func (LineStackEventView) Waiting(stackName string, _ time.Duration) {
+	logger.Info("waiting for CloudFormation stack %q", stackName)
+}
+
+// Event implements StackEventView.
+func (LineStackEventView) Event(stackName string, e types.StackEvent, elapsed time.Duration) {
+	msg := formatStackEvent(stackName, e, elapsed)
+	if isFailedStatus(e.ResourceStatus) {
+		logger.Warning(msg)
+		return
+	}
+	logger.Info(msg)
+}
+
+// Done implements StackEventView.
+func (LineStackEventView) Done(string, time.Duration) {}
+
+// CompactStackEventView keeps one line per stack being waited on at the bottom of a terminal, and
+// redraws it as events arrive. Failures are still logged as they occur.
+// Log lines must be written through the view, so that they are printed above the stack lines.
+type CompactStackEventView struct {
+	mu       sync.Mutex
+	out      io.Writer
+	logs     io.Writer
+	stacks   map[string]*compactStackState
+	rendered int
+}
+
+type compactStackState struct {
+	status    types.ResourceStatus
+	resources map[string]types.ResourceStatus
+	latest    string
+	elapsed   time.Duration
+}
+
+// NewCompactStackEventView returns a view drawing to the terminal out, with log lines written to logs.
+func NewCompactStackEventView(out, logs io.Writer) *CompactStackEventView {
+	return &CompactStackEventView{
+		out:    out,
+		logs:   logs,
+		stacks: map[string]*compactStackState{},
+	}
+}
+
+// Write writes a log line above the stack lines.
+func (v *CompactStackEventView) Write(p []byte) (int, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.clear()
+	n, err := v.logs.Write(p)
+	v.render()
+	return n, err
+}
+
+// Waiting implements StackEventView.
+func (v *CompactStackEventView) Waiting(stackName string, elapsed time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.state(stackName).elapsed = elapsed
+	v.redraw()
+}
+
+// Event implements StackEventView.
+func (v *CompactStackEventView) Event(stackName string, e types.StackEvent, elapsed time.Duration) {
+	v.mu.Lock()
+	state := v.state(stackName)
+	state.elapsed = elapsed
+	if logicalID := aws.ToString(e.LogicalResourceId); logicalID == stackName {
+		state.status = e.ResourceStatus
+	} else {
+		state.resources[logicalID] = e.ResourceStatus
+		state.latest = fmt.Sprintf("%s: %s", logicalID, e.ResourceStatus)
+	}
+	v.redraw()
+	v.mu.Unlock()
+
+	if isFailedStatus(e.ResourceStatus) {
+		logger.Warning(formatStackEvent(stackName, e, elapsed))
+	}
+}
+
+// Done implements StackEventView.
+func (v *CompactStackEventView) Done(stackName string, elapsed time.Duration) {
+	v.mu.Lock()
+	state, ok := v.stacks[stackName]
+	if ok {
+		v.clear()
+		delete(v.stacks, stackName)
+		v.render()
+	}
+	v.mu.Unlock()
+
+	if ok && state.status != "" {
+		logger.Info("[%s] %s (%s)", stackName, state.status, formatElapsed(elapsed))
+	}
+}
+
+func (v *CompactStackEventView) state(stackName string) *compactStackState {
+	state, ok := v.stacks[stackName]
+	if !ok {
+		state = &compactStackState{resources: map[string]types.ResourceStatus{}}
+		v.stacks[stackName] = state
+	}
+	return state
+}
+
+func (v *CompactStackEventView) redraw() {
+	v.clear()
+	v.render()
+}
+
+// clear erases the stack lines drawn last
+func (v *CompactStackEventView) clear() {
+	for ; v.rendered > 0; v.rendered-- {
+		fmt.Fprint(v.out, "\x1b[1A\x1b[2K")
+	}
+}
+
+func (v *CompactStackEventView) render() {
+	names := make([]string, 0, len(v.stacks))
+	for name := range v.stacks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(v.out, v.stacks[name].line(name))
+	}
+	v.rendered = len(names)
+}
+
+func (s *compactStackState) line(stackName string) string {
+	var inProgress, complete, failed int
+	for _, status := range s.resources {
+		switch {
+		case isFailedStatus(status):
+			failed++
+		case strings.HasSuffix(string(status), "_IN_PROGRESS"):
+			inProgress++
+		default:
+			complete++
+		}
+	}
+	status := s.status
+	if status == "" {
+		status = "WAITING"
+	}
+	line := fmt.Sprintf("[%s] %s – %d in progress, %d complete, %d failed", stackName, status, inProgress, complete, failed)
+	if s.latest != "" {
+		line = fmt.Sprintf("%s – %s", line, s.latest)
+	}
+	return fmt.Sprintf("%s (%s)", line, formatElapsed(s.elapsed))
+}
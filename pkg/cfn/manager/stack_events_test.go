@@ -0,0 +1,219 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+type recordingStackEventView struct {
+	mu      sync.Mutex
+	events  []string
+	waiting int
+	done    bool
+}
+
+func (v *recordingStackEventView) Waiting(string, time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.waiting++
+}
+
+func (v *recordingStackEventView) Event(stackName string, e types.StackEvent, _ time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.events = append(v.events, stackName+" "+aws.ToString(e.LogicalResourceId)+" "+string(e.ResourceStatus))
+}
+
+func (v *recordingStackEventView) Done(string, time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.done = true
+}
+
+type recordingEventSink struct {
+	mu     sync.Mutex
+	events []tasks.Event
+}
+
+func (s *recordingEventSink) Emit(event tasks.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+var _ = Describe("Stack event streaming", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng"
+
+	var (
+		p    *mockprovider.MockProvider
+		sc   *StackCollection
+		view *recordingStackEventView
+	)
+
+	stackEvent := func(id, logicalID string, status types.ResourceStatus, reason string, age time.Duration) types.StackEvent {
+		e := types.StackEvent{
+			EventId:           aws.String(id),
+			StackName:         aws.String(stackName),
+			LogicalResourceId: aws.String(logicalID),
+			ResourceType:      aws.String("AWS::EC2::LaunchTemplate"),
+			ResourceStatus:    status,
+			Timestamp:         aws.Time(time.Now().Add(-age)),
+		}
+		if reason != "" {
+			e.ResourceStatusReason = aws.String(reason)
+		}
+		return e
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg).(*StackCollection)
+		view = &recordingStackEventView{}
+		SetStackEventView(view)
+		DeferCleanup(SetStackEventView, LineStackEventView{})
+	})
+
+	It("passes the new events of the current operation to the view in chronological order", func() {
+		previousOperation := []types.StackEvent{
+			stackEvent("4", stackName, types.ResourceStatusCreateComplete, "", 2*time.Hour),
+		}
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+			StackEvents: append([]types.StackEvent{
+				stackEvent("3", "LaunchTemplate", types.ResourceStatusUpdateInProgress, "", 0),
+				stackEvent("2", stackName, types.ResourceStatusUpdateInProgress, "User Initiated", 0),
+				stackEvent("1", stackName, types.ResourceStatusUpdateComplete, "", 0),
+			}, previousOperation...),
+		}, nil).Once()
+
+		events := sc.newStackEventStreamer(&Stack{StackName: aws.String(stackName)})
+		events.poll(context.Background())
+		Expect(view.events).To(Equal([]string{
+			stackName + " " + stackName + " UPDATE_IN_PROGRESS",
+			stackName + " LaunchTemplate UPDATE_IN_PROGRESS",
+		}))
+
+		By("only passing events not seen before on the next poll")
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+			StackEvents: []types.StackEvent{
+				stackEvent("6", stackName, types.ResourceStatusUpdateComplete, "", 0),
+				stackEvent("5", "LaunchTemplate", types.ResourceStatusUpdateComplete, "", 0),
+				stackEvent("3", "LaunchTemplate", types.ResourceStatusUpdateInProgress, "", 0),
+			},
+		}, nil).Once()
+		events.poll(context.Background())
+		events.done()
+		Expect(view.events).To(HaveLen(4))
+		Expect(view.events[2:]).To(Equal([]string{
+			stackName + " LaunchTemplate UPDATE_COMPLETE",
+			stackName + " " + stackName + " UPDATE_COMPLETE",
+		}))
+		Expect(view.waiting).To(Equal(2))
+		Expect(view.done).To(BeTrue())
+	})
+
+	It("describes the events of a stack by ID when it is known", func() {
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.MatchedBy(func(input *cfn.DescribeStackEventsInput) bool {
+			return aws.ToString(input.StackName) == "arn:aws:cloudformation:us-west-2:123456789012:stack/"+stackName+"/id"
+		}), mock.Anything).Return(&cfn.DescribeStackEventsOutput{}, nil)
+
+		sc.newStackEventStreamer(&Stack{
+			StackName: aws.String(stackName),
+			StackId:   aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/" + stackName + "/id"),
+		}).poll(context.Background())
+		Expect(view.waiting).To(Equal(1))
+	})
+
+	It("streams events while waiting for a stack to be deleted", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: types.StackStatusDeleteComplete}},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{
+			StackEvents: []types.StackEvent{
+				stackEvent("2", stackName, types.ResourceStatusDeleteComplete, "", 0),
+				stackEvent("1", stackName, types.ResourceStatusDeleteInProgress, "User Initiated", 0),
+			},
+		}, nil)
+
+		Expect(sc.doWaitUntilStackIsDeleted(context.Background(), &Stack{StackName: aws.String(stackName)})).To(Succeed())
+		Expect(view.events).To(Equal([]string{
+			stackName + " " + stackName + " DELETE_IN_PROGRESS",
+			stackName + " " + stackName + " DELETE_COMPLETE",
+		}))
+		Expect(view.done).To(BeTrue())
+	})
+
+	It("emits a progress event for every status transition of a stack", func() {
+		sink := &recordingEventSink{}
+		ctx := tasks.WithRunOptions(context.Background(), tasks.RunOptions{EventSink: sink})
+
+		events := sc.newStackEventStreamer(&Stack{StackName: aws.String(stackName)})
+		for _, status := range []types.StackStatus{types.StackStatusCreateInProgress, types.StackStatusCreateInProgress, types.StackStatusCreateComplete} {
+			events.observe(ctx, &types.Stack{StackStatus: status})
+		}
+		events.observe(ctx, nil)
+
+		Expect(sink.events).To(HaveLen(2))
+		Expect(sink.events[0]).To(MatchFields(IgnoreExtras, Fields{
+			"Type":           Equal(tasks.EventStackStatus),
+			"StackName":      Equal(stackName),
+			"Status":         Equal("CREATE_IN_PROGRESS"),
+			"PreviousStatus": BeEmpty(),
+		}))
+		Expect(sink.events[1]).To(MatchFields(IgnoreExtras, Fields{
+			"Status":         Equal("CREATE_COMPLETE"),
+			"PreviousStatus": Equal("CREATE_IN_PROGRESS"),
+		}))
+
+		By("observing the status of the stack while waiting on it")
+		sink.events = nil
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: aws.String(stackName), StackStatus: types.StackStatusDeleteComplete}},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{}, nil)
+		Expect(sc.doWaitUntilStackIsDeleted(ctx, &Stack{StackName: aws.String(stackName)})).To(Succeed())
+		Expect(sink.events).To(HaveLen(1))
+		Expect(sink.events[0].Status).To(Equal("DELETE_COMPLETE"))
+	})
+
+	Context("compact view", func() {
+		It("redraws one line per stack and writes log lines above them", func() {
+			out, logs := &bytes.Buffer{}, &bytes.Buffer{}
+			compact := NewCompactStackEventView(out, logs)
+
+			compact.Event("stack-a", stackEvent("1", "LaunchTemplate", types.ResourceStatusCreateInProgress, "", 0), time.Minute)
+			compact.Event("stack-b", stackEvent("2", "stack-b", types.ResourceStatusCreateInProgress, "User Initiated", 0), time.Second)
+			Expect(out.String()).To(HaveSuffix(strings.Join([]string{
+				"[stack-a] WAITING – 1 in progress, 0 complete, 0 failed – LaunchTemplate: CREATE_IN_PROGRESS (1m0s)",
+				"[stack-b] CREATE_IN_PROGRESS – 0 in progress, 0 complete, 0 failed (1s)",
+			}, "\n") + "\n"))
+
+			out.Reset()
+			_, err := compact.Write([]byte("a log line\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logs.String()).To(Equal("a log line\n"))
+			Expect(out.String()).To(HavePrefix(strings.Repeat("\x1b[1A\x1b[2K", 2)))
+
+			out.Reset()
+			compact.Done("stack-a", time.Minute)
+			Expect(out.String()).To(Equal(strings.Repeat("\x1b[1A\x1b[2K", 2) + "[stack-b] CREATE_IN_PROGRESS – 0 in progress, 0 complete, 0 failed (1s)\n"))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -10,9 +11,16 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
+// newNodeGroupRetryPolicy returns the retry policy of the tasks that create nodegroup stacks, which are retried
+// when EC2 is short of capacity or an instance role has not propagated yet
+func newNodeGroupRetryPolicy() *tasks.RetryPolicy {
+	return &tasks.RetryPolicy{Retries: 2, Delay: 30 * time.Second, Retryable: IsRetryableStackFailure}
+}
+
 type createClusterTask struct {
 	info                 string
 	stackCollection      *StackCollection
@@ -22,10 +30,23 @@ type createClusterTask struct {
 
 func (t *createClusterTask) Describe() string { return t.info }
 
+func (t *createClusterTask) TaskMetadata() tasks.Metadata {
+	return tasks.Metadata{
+		Action:       "create",
+		ResourceType: "cluster",
+		ResourceName: t.stackCollection.spec.Metadata.Name,
+		StackName:    t.stackCollection.MakeClusterStackName(),
+	}
+}
+
 func (t *createClusterTask) Do(errorCh chan error) error {
 	return t.stackCollection.createClusterTask(t.ctx, errorCh, t.supportsManagedNodes)
 }
 
+func (t *createClusterTask) Resume() error {
+	return t.stackCollection.loadClusterStackOutputs(t.ctx)
+}
+
 type managedNodeGroupTask struct {
 	info              string
 	nodeGroup         *api.ManagedNodeGroup
@@ -37,8 +58,25 @@ type managedNodeGroupTask struct {
 
 func (t *managedNodeGroupTask) Describe() string { return t.info }
 
+func (t *managedNodeGroupTask) TaskMetadata() tasks.Metadata {
+	return tasks.Metadata{
+		Action:       "create",
+		ResourceType: "managedNodeGroup",
+		ResourceName: t.nodeGroup.Name,
+		StackName:    t.stackCollection.makeNodeGroupStackName(t.nodeGroup.Name),
+	}
+}
+
+func (t *managedNodeGroupTask) TaskRetryPolicy() *tasks.RetryPolicy {
+	return newNodeGroupRetryPolicy()
+}
+
 func (t *managedNodeGroupTask) Do(errorCh chan error) error {
-	return t.stackCollection.createManagedNodeGroupTask(t.ctx, errorCh, t.nodeGroup, t.forceAddCNIPolicy, t.vpcImporter)
+	return t.DoWithContext(t.ctx, errorCh)
+}
+
+func (t *managedNodeGroupTask) DoWithContext(ctx context.Context, errorCh chan error) error {
+	return t.stackCollection.createManagedNodeGroupTask(ctx, errorCh, t.nodeGroup, t.forceAddCNIPolicy, t.vpcImporter)
 }
 
 type managedNodeGroupTagsToASGPropagationTask struct {
@@ -59,11 +97,20 @@ type taskWithClusterIAMServiceAccountSpec struct {
 	stackCollection *StackCollection
 	serviceAccount  *api.ClusterIAMServiceAccount
 	oidc            *iamoidc.OpenIDConnectManager
+	ctx             context.Context
 }
 
 func (t *taskWithClusterIAMServiceAccountSpec) Describe() string { return t.info }
+func (t *taskWithClusterIAMServiceAccountSpec) TaskMetadata() tasks.Metadata {
+	return tasks.Metadata{
+		Action:       "create",
+		ResourceType: "iamServiceAccount",
+		ResourceName: t.serviceAccount.NameString(),
+		StackName:    t.stackCollection.makeIAMServiceAccountStackName(t.serviceAccount.Namespace, t.serviceAccount.Name),
+	}
+}
 func (t *taskWithClusterIAMServiceAccountSpec) Do(errs chan error) error {
-	return t.stackCollection.createIAMServiceAccountTask(context.TODO(), errs, t.serviceAccount, t.oidc)
+	return t.stackCollection.createIAMServiceAccountTask(t.ctx, errs, t.serviceAccount, t.oidc)
 }
 
 type taskWithStackSpec struct {
@@ -73,6 +120,9 @@ type taskWithStackSpec struct {
 }
 
 func (t *taskWithStackSpec) Describe() string { return t.info }
+func (t *taskWithStackSpec) TaskMetadata() tasks.Metadata {
+	return deleteStackMetadata(t.stack)
+}
 func (t *taskWithStackSpec) Do(errs chan error) error {
 	return t.call(context.TODO(), t.stack, errs)
 }
@@ -84,12 +134,50 @@ type asyncTaskWithStackSpec struct {
 }
 
 func (t *asyncTaskWithStackSpec) Describe() string { return t.info + " [async]" }
+func (t *asyncTaskWithStackSpec) TaskMetadata() tasks.Metadata {
+	return deleteStackMetadata(t.stack)
+}
 func (t *asyncTaskWithStackSpec) Do(errs chan error) error {
 	_, err := t.call(context.TODO(), t.stack)
 	close(errs)
 	return err
 }
 
+// deleteAdoptedNodeGroupTask deletes an adopted managed nodegroup and its stack
+type deleteAdoptedNodeGroupTask struct {
+	info            string
+	nodeGroupName   string
+	stack           *Stack
+	stackCollection *StackCollection
+	wait            bool
+}
+
+func (t *deleteAdoptedNodeGroupTask) Describe() string { return t.info }
+func (t *deleteAdoptedNodeGroupTask) TaskMetadata() tasks.Metadata {
+	return deleteStackMetadata(t.stack)
+}
+func (t *deleteAdoptedNodeGroupTask) Do(errs chan error) error {
+	return t.DoWithContext(context.Background(), errs)
+}
+func (t *deleteAdoptedNodeGroupTask) DoWithContext(ctx context.Context, errs chan error) error {
+	go func() {
+		defer close(errs)
+		errs <- t.stackCollection.deleteAdoptedNodeGroup(ctx, t.nodeGroupName, t.stack, t.wait)
+	}()
+	return nil
+}
+
+func deleteStackMetadata(stack *Stack) tasks.Metadata {
+	metadata := tasks.Metadata{
+		Action:       "delete",
+		ResourceType: "stack",
+	}
+	if stack != nil && stack.StackName != nil {
+		metadata.StackName = *stack.StackName
+	}
+	return metadata
+}
+
 type asyncTaskWithoutParams struct {
 	info string
 	call func() error
@@ -19,6 +19,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	vpcfakes "github.com/weaveworks/eksctl/pkg/vpc/fakes"
 )
 
@@ -82,17 +83,17 @@ var _ = Describe("StackCollection Tasks", func() {
 			{
 				tasks := stackManager.NewUnmanagedNodeGroupTask(context.Background(), makeNodeGroups("bar", "foo"), false, false, true, fakeVPCImporter, 0)
 				Expect(tasks.Describe()).To(Equal(`
-2 parallel tasks: { create nodegroup "bar", create nodegroup "foo" 
+2 parallel tasks: { create nodegroup "bar" [retries: 2], create nodegroup "foo" [retries: 2] 
 }
 `))
 			}
 			{
 				tasks := stackManager.NewUnmanagedNodeGroupTask(context.Background(), makeNodeGroups("bar"), false, false, true, fakeVPCImporter, 0)
-				Expect(tasks.Describe()).To(Equal(`1 task: { create nodegroup "bar" }`))
+				Expect(tasks.Describe()).To(Equal(`1 task: { create nodegroup "bar" [retries: 2] }`))
 			}
 			{
 				tasks := stackManager.NewUnmanagedNodeGroupTask(context.Background(), makeNodeGroups("foo"), false, false, true, fakeVPCImporter, 0)
-				Expect(tasks.Describe()).To(Equal(`1 task: { create nodegroup "foo" }`))
+				Expect(tasks.Describe()).To(Equal(`1 task: { create nodegroup "foo" [retries: 2] }`))
 			}
 			{
 				tasks := stackManager.NewUnmanagedNodeGroupTask(context.Background(), nil, false, false, true, fakeVPCImporter, 0)
@@ -103,89 +104,121 @@ var _ = Describe("StackCollection Tasks", func() {
 				AuthenticationMode: ekstypes.AuthenticationModeConfigMap,
 			}
 			{
-				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar", "foo"), nil, accessConfig, nil, 0)
+				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar", "foo"), nil, accessConfig, nil, 0, nil, nil)
 				Expect(tasks.Describe()).To(Equal(`
-2 sequential tasks: { create cluster control plane "test-cluster", 
-    2 parallel sub-tasks: { 
-        create nodegroup "bar",
-        create nodegroup "foo",
-    } 
+3 tasks: { 
+    1: create cluster control plane "test-cluster",
+    2: create nodegroup "bar" [retries: 2] (after 1),
+    3: create nodegroup "foo" [retries: 2] (after 1),
 }
 `))
 			}
 			{
-				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar"), nil, accessConfig, nil, 0)
+				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar"), nil, accessConfig, nil, 0, nil, nil)
 				Expect(tasks.Describe()).To(Equal(`
-2 sequential tasks: { create cluster control plane "test-cluster", create nodegroup "bar" 
+2 tasks: { 
+    1: create cluster control plane "test-cluster",
+    2: create nodegroup "bar" [retries: 2] (after 1),
 }
 `))
 			}
 			{
-				tasks := stackManager.NewTasksToCreateCluster(context.Background(), nil, nil, accessConfig, nil, 0)
-				Expect(tasks.Describe()).To(Equal(`1 task: { create cluster control plane "test-cluster" }`))
+				tasks := stackManager.NewTasksToCreateCluster(context.Background(), nil, nil, accessConfig, nil, 0, nil, nil)
+				Expect(tasks.Describe()).To(Equal(`
+1 task: { 
+    1: create cluster control plane "test-cluster",
+}
+`))
 			}
 			{
-				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar", "foo"), makeManagedNodeGroups("m1", "m2"), accessConfig, nil, 0)
+				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar", "foo"), makeManagedNodeGroups("m1", "m2"), accessConfig, nil, 0, nil, nil)
 				Expect(tasks.Describe()).To(Equal(`
-2 sequential tasks: { create cluster control plane "test-cluster", 
-    2 parallel sub-tasks: { 
-        2 parallel sub-tasks: { 
-            create nodegroup "bar",
-            create nodegroup "foo",
-        },
-        2 parallel sub-tasks: { 
-            create managed nodegroup "m1",
-            create managed nodegroup "m2",
-        },
-    } 
+5 tasks: { 
+    1: create cluster control plane "test-cluster",
+    2: create nodegroup "bar" [retries: 2] (after 1),
+    3: create nodegroup "foo" [retries: 2] (after 1),
+    4: create managed nodegroup "m1" [retries: 2] (after 1),
+    5: create managed nodegroup "m2" [retries: 2] (after 1),
 }
 `))
 			}
 			{
-				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar", "foo"), makeManagedNodeGroupsWithPropagatedTags("m1", "m2"), accessConfig, nil, 0)
+				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar", "foo"), makeManagedNodeGroupsWithPropagatedTags("m1", "m2"), accessConfig, nil, 0, nil, nil)
 				Expect(tasks.Describe()).To(Equal(`
-2 sequential tasks: { create cluster control plane "test-cluster", 
-    2 parallel sub-tasks: { 
-        2 parallel sub-tasks: { 
-            create nodegroup "bar",
-            create nodegroup "foo",
-        },
-        2 parallel sub-tasks: { 
-            2 sequential sub-tasks: { 
-                create managed nodegroup "m1",
-                propagate tags to ASG for managed nodegroup "m1",
-            },
-            2 sequential sub-tasks: { 
-                create managed nodegroup "m2",
-                propagate tags to ASG for managed nodegroup "m2",
-            },
-        },
-    } 
+5 tasks: { 
+    1: create cluster control plane "test-cluster",
+    2: create nodegroup "bar" [retries: 2] (after 1),
+    3: create nodegroup "foo" [retries: 2] (after 1),
+    4: 2 sequential sub-tasks: { 
+        create managed nodegroup "m1" [retries: 2],
+        propagate tags to ASG for managed nodegroup "m1",
+    } (after 1),
+    5: 2 sequential sub-tasks: { 
+        create managed nodegroup "m2" [retries: 2],
+        propagate tags to ASG for managed nodegroup "m2",
+    } (after 1),
 }
 `))
 			}
 			{
-				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("foo"), makeManagedNodeGroups("m1"), accessConfig, nil, 0)
+				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("foo"), makeManagedNodeGroups("m1"), accessConfig, nil, 0, nil, nil)
 				Expect(tasks.Describe()).To(Equal(`
-2 sequential tasks: { create cluster control plane "test-cluster", 
-    2 parallel sub-tasks: { 
-        create nodegroup "foo",
-        create managed nodegroup "m1",
-    } 
+3 tasks: { 
+    1: create cluster control plane "test-cluster",
+    2: create nodegroup "foo" [retries: 2] (after 1),
+    3: create managed nodegroup "m1" [retries: 2] (after 1),
 }
 `))
 			}
 			{
-				tasks := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar"), nil, accessConfig, nil, 0, &task{id: 1})
-				Expect(tasks.Describe()).To(Equal(`
-2 sequential tasks: { create cluster control plane "test-cluster", 
-    2 sequential sub-tasks: { 
-        task 1,
-        create nodegroup "bar",
-    } 
+				postClusterCreationTasks := &tasks.Graph{}
+				prerequisite := &task{id: 1}
+				postClusterCreationTasks.Add(prerequisite)
+				postClusterCreationTasks.Add(&task{id: 2}, prerequisite)
+				postClusterCreationTasks.Add(&task{id: 3})
+				taskGraph := stackManager.NewTasksToCreateCluster(context.Background(), makeNodeGroups("bar"), nil, accessConfig, nil, 0, postClusterCreationTasks, nil, prerequisite)
+				Expect(taskGraph.Describe()).To(Equal(`
+5 tasks: { 
+    1: create cluster control plane "test-cluster",
+    2: task 1 (after 1),
+    3: task 2 (after 2),
+    4: task 3 (after 1),
+    5: create nodegroup "bar" [retries: 2] (after 2),
 }
 `))
 			}
+			{
+				withNodes := func(ng *api.NodeGroupBase, desiredCapacity int) {
+					ng.ScalingConfig = &api.ScalingConfig{DesiredCapacity: &desiredCapacity}
+				}
+				nodeGroups := makeNodeGroups("bar", "windows")
+				nodeGroups[1].AMIFamily = api.NodeImageFamilyWindowsServer2022CoreContainer
+				managedNodeGroups := makeManagedNodeGroups("m1", "m2")
+				for _, ng := range nodeGroups {
+					withNodes(ng.NodeGroupBase, 2)
+				}
+				withNodes(managedNodeGroups[0].NodeGroupBase, 2)
+				withNodes(managedNodeGroups[1].NodeGroupBase, 0)
+				postNodeGroupTasks := &task{id: 1}
+
+				taskGraph := stackManager.NewTasksToCreateCluster(context.Background(), nodeGroups, managedNodeGroups, &api.AccessConfig{
+					AuthenticationMode: ekstypes.AuthenticationModeApi,
+				}, nil, 1, nil, postNodeGroupTasks)
+				Expect(taskGraph.Describe()).To(Equal(`
+6 tasks: { 
+    1: create cluster control plane "test-cluster",
+    2: create nodegroup "bar" [retries: 2] (after 1),
+    3: create nodegroup "windows" [retries: 2] (after 1),
+    4: create managed nodegroup "m1" [retries: 2] (after 1),
+    5: create managed nodegroup "m2" [retries: 2] (after 1),
+    6: task 1 (after any of 2, 4),
+}
+`))
+
+				By("leaving out the post-nodegroup tasks when no nodes join the cluster by themselves")
+				taskGraph = stackManager.NewTasksToCreateCluster(context.Background(), nodeGroups, nil, accessConfig, nil, 0, nil, postNodeGroupTasks)
+				Expect(taskGraph.Has(postNodeGroupTasks)).To(BeFalse())
+			}
 		})
 	})
 
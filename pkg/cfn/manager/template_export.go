@@ -0,0 +1,341 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/terraform"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+// TemplateManifestFile is the name of the manifest written alongside exported templates.
+const TemplateManifestFile = "manifest.json"
+
+// TemplateFormat is the format templates are exported in.
+type TemplateFormat string
+
+const (
+	// TemplateFormatCloudFormation exports the CloudFormation templates of the stacks.
+	TemplateFormatCloudFormation TemplateFormat = "cloudformation"
+	// TemplateFormatTerraform exports the templates of the stacks as Terraform JSON configuration,
+	// one module directory per stack.
+	TemplateFormatTerraform TemplateFormat = "terraform"
+)
+
+// TemplateManifest describes the CloudFormation stacks exported by a command, in the order
+// they would have been created in.
+type TemplateManifest struct {
+	EksctlVersion string                  `json:"eksctlVersion"`
+	ClusterName   string                  `json:"clusterName"`
+	Region        string                  `json:"region"`
+	Format        TemplateFormat          `json:"format,omitempty"`
+	Stacks        []TemplateManifestStack `json:"stacks"`
+}
+
+// TemplateManifestStack describes a single exported stack.
+type TemplateManifestStack struct {
+	// Order is the 1-based position of the stack in the creation order
+	Order        int               `json:"order"`
+	StackName    string            `json:"stackName"`
+	TemplateFile string            `json:"templateFile"`
+	SHA256       string            `json:"sha256"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+	Capabilities []string          `json:"capabilities,omitempty"`
+}
+
+// StackCollectionOption configures a StackCollection.
+type StackCollectionOption func(*StackCollection)
+
+// WithTemplateExporter makes the StackCollection write templates to exporter instead of
+// creating stacks.
+func WithTemplateExporter(exporter *TemplateExporter) StackCollectionOption {
+	return func(c *StackCollection) {
+		c.templateExporter = exporter
+	}
+}
+
+// WithTemplateSource makes the StackCollection create stacks from previously exported templates;
+// creating a stack that is not part of source fails.
+func WithTemplateSource(source *TemplateSource) StackCollectionOption {
+	return func(c *StackCollection) {
+		c.templateSource = source
+	}
+}
+
+// A TemplateExporter writes the templates of the stacks a command would create to a directory.
+type TemplateExporter struct {
+	dir string
+
+	mu       sync.Mutex
+	manifest TemplateManifest
+}
+
+// NewTemplateExporter creates dir if needed and returns an exporter that writes templates to it
+// in the given format; dir must not already contain a manifest.
+func NewTemplateExporter(dir, clusterName, region string, format TemplateFormat) (*TemplateExporter, error) {
+	switch format {
+	case TemplateFormatCloudFormation, TemplateFormatTerraform:
+	default:
+		return nil, fmt.Errorf("unsupported template format %q", format)
+	}
+	manifestPath := filepath.Join(dir, TemplateManifestFile)
+	if _, err := os.Stat(manifestPath); err == nil {
+		return nil, fmt.Errorf("%q already exists; export templates to an empty directory", manifestPath)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating templates directory: %w", err)
+	}
+	return &TemplateExporter{
+		dir: dir,
+		manifest: TemplateManifest{
+			EksctlVersion: version.GetVersion(),
+			ClusterName:   clusterName,
+			Region:        region,
+			Format:        format,
+		},
+	}, nil
+}
+
+// Dir returns the directory templates are exported to.
+func (e *TemplateExporter) Dir() string {
+	return e.dir
+}
+
+// Stacks returns the exported stacks in creation order.
+func (e *TemplateExporter) Stacks() []TemplateManifestStack {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]TemplateManifestStack(nil), e.manifest.Stacks...)
+}
+
+// WriteManifest writes the manifest of all exported stacks.
+func (e *TemplateExporter) WriteManifest() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	manifest := e.manifest
+	if manifest.Stacks == nil {
+		manifest.Stacks = []TemplateManifestStack{}
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(e.dir, TemplateManifestFile), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing templates manifest: %w", err)
+	}
+	return nil
+}
+
+func (e *TemplateExporter) export(stackName string, templateBody []byte, tags map[string]string, parameters map[string]string, capabilities []types.Capability) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.has(stackName) {
+		return fmt.Errorf("template for stack %q has already been exported", stackName)
+	}
+
+	templateFile := stackName + ".json"
+	if e.manifest.Format == TemplateFormatTerraform {
+		var err error
+		if templateBody, err = terraform.Convert(templateBody, stackName); err != nil {
+			return fmt.Errorf("converting template for stack %q to Terraform: %w", stackName, err)
+		}
+		templateFile = filepath.Join(stackName, "main.tf.json")
+		if err := os.MkdirAll(filepath.Join(e.dir, stackName), 0755); err != nil {
+			return fmt.Errorf("creating directory for stack %q: %w", stackName, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(e.dir, templateFile), templateBody, 0644); err != nil {
+		return fmt.Errorf("writing template for stack %q: %w", stackName, err)
+	}
+	stack := TemplateManifestStack{
+		Order:        len(e.manifest.Stacks) + 1,
+		StackName:    stackName,
+		TemplateFile: templateFile,
+		SHA256:       checksum(templateBody),
+		Tags:         tags,
+		Parameters:   parameters,
+	}
+	for _, c := range capabilities {
+		stack.Capabilities = append(stack.Capabilities, string(c))
+	}
+	e.manifest.Stacks = append(e.manifest.Stacks, stack)
+	return nil
+}
+
+// exported reports whether the template for stackName has been exported; it is safe to call on a nil exporter.
+func (e *TemplateExporter) exported(stackName string) bool {
+	if e == nil {
+		return false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.has(stackName)
+}
+
+func (e *TemplateExporter) has(stackName string) bool {
+	for _, s := range e.manifest.Stacks {
+		if s.StackName == stackName {
+			return true
+		}
+	}
+	return false
+}
+
+// A TemplateSource holds templates exported by a TemplateExporter.
+type TemplateSource struct {
+	dir       string
+	manifest  TemplateManifest
+	templates map[string][]byte
+}
+
+// LoadTemplateSource reads the manifest and all templates in dir, verifying their checksums.
+func LoadTemplateSource(dir string) (*TemplateSource, error) {
+	data, err := os.ReadFile(filepath.Join(dir, TemplateManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading templates manifest: %w", err)
+	}
+	source := &TemplateSource{
+		dir:       dir,
+		templates: map[string][]byte{},
+	}
+	if err := json.Unmarshal(data, &source.manifest); err != nil {
+		return nil, fmt.Errorf("parsing templates manifest %q: %w", filepath.Join(dir, TemplateManifestFile), err)
+	}
+	if format := source.manifest.Format; format != "" && format != TemplateFormatCloudFormation {
+		return nil, fmt.Errorf("templates in %q were exported in %s format; only CloudFormation templates can be used to create stacks", dir, format)
+	}
+	if len(source.manifest.Stacks) == 0 {
+		return nil, fmt.Errorf("templates manifest %q does not list any stacks", filepath.Join(dir, TemplateManifestFile))
+	}
+
+	for _, s := range source.manifest.Stacks {
+		if s.StackName == "" || s.TemplateFile == "" {
+			return nil, errors.New("templates manifest entries must set stackName and templateFile")
+		}
+		if _, ok := source.templates[s.StackName]; ok {
+			return nil, fmt.Errorf("stack %q is listed more than once in the templates manifest", s.StackName)
+		}
+		templateFile := filepath.Clean(s.TemplateFile)
+		if filepath.IsAbs(templateFile) || templateFile == ".." || strings.HasPrefix(templateFile, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("template file %q of stack %q must be a relative path inside %q", s.TemplateFile, s.StackName, dir)
+		}
+		body, err := os.ReadFile(filepath.Join(dir, templateFile))
+		if err != nil {
+			return nil, fmt.Errorf("reading template for stack %q: %w", s.StackName, err)
+		}
+		if sum := checksum(body); sum != s.SHA256 {
+			return nil, fmt.Errorf("template %q for stack %q does not match its checksum in the manifest (expected %s, got %s)", s.TemplateFile, s.StackName, s.SHA256, sum)
+		}
+		source.templates[s.StackName] = body
+	}
+	return source, nil
+}
+
+// Manifest returns the manifest the templates were loaded from.
+func (s *TemplateSource) Manifest() TemplateManifest {
+	return s.manifest
+}
+
+func (s *TemplateSource) stack(stackName string) (TemplateManifestStack, []byte, error) {
+	for _, stack := range s.manifest.Stacks {
+		if stack.StackName == stackName {
+			return stack, s.templates[stackName], nil
+		}
+	}
+	return TemplateManifestStack{}, nil, fmt.Errorf("stack %q is not listed in the templates manifest in %q; only stacks from reviewed templates can be created", stackName, s.dir)
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// templateCapabilities returns the capabilities CreateStack is called with.
+func templateCapabilities(withIAM, withNamedIAM bool) []types.Capability {
+	switch {
+	case withNamedIAM:
+		return stackCapabilitiesNamedIAM
+	case withIAM:
+		return stackCapabilitiesIAM
+	}
+	return nil
+}
+
+// exportStack writes the template of a stack instead of creating it.
+func (c *StackCollection) exportStack(stackName string, templateBody []byte, tags, parameters map[string]string, withIAM, withNamedIAM bool) error {
+	allTags := map[string]string{}
+	for _, t := range c.sharedTags {
+		allTags[*t.Key] = *t.Value
+	}
+	for k, v := range tags {
+		allTags[k] = v
+	}
+	if err := c.templateExporter.export(stackName, templateBody, allTags, parameters, templateCapabilities(withIAM, withNamedIAM)); err != nil {
+		return err
+	}
+	logger.Info("exported template for stack %q to %q", stackName, c.templateExporter.Dir())
+	return nil
+}
+
+// createStackFromSource creates a stack using its exported template, tags and parameters.
+func (c *StackCollection) createStackFromSource(ctx context.Context, stack *Stack, renderedTemplate []byte) error {
+	manifestStack, templateBody, err := c.templateSource.stack(*stack.StackName)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(renderedTemplate, templateBody) {
+		logger.Warning("the template for stack %q differs from the exported template %q; creating the stack from the exported template", *stack.StackName, manifestStack.TemplateFile)
+	}
+
+	// shared tags are always set by DoCreateStackRequest
+	tags := map[string]string{}
+	for k, v := range manifestStack.Tags {
+		if !c.isSharedTag(k) {
+			tags[k] = v
+		}
+	}
+	var withIAM, withNamedIAM bool
+	for _, capability := range manifestStack.Capabilities {
+		switch types.Capability(capability) {
+		case types.CapabilityCapabilityIam:
+			withIAM = true
+		case types.CapabilityCapabilityNamedIam:
+			withNamedIAM = true
+		default:
+			return fmt.Errorf("unsupported capability %q for stack %q in the templates manifest", capability, *stack.StackName)
+		}
+	}
+	parameters, err := c.resolveExportedParameters(*stack.StackName, manifestStack.Parameters)
+	if err != nil {
+		return err
+	}
+	return c.DoCreateStackRequest(ctx, stack, TemplateBody(templateBody), tags, parameters, withIAM, withNamedIAM)
+}
+
+func (c *StackCollection) isSharedTag(key string) bool {
+	for _, t := range c.sharedTags {
+		if *t.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// exportedStackDone reports the completion of an exported stack, which is never waited for.
+func exportedStackDone(errs chan error) {
+	defer close(errs)
+	errs <- nil
+}
@@ -0,0 +1,320 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type staticResourceSet struct {
+	template string
+}
+
+func (s *staticResourceSet) RenderJSON() ([]byte, error)     { return []byte(s.template), nil }
+func (s *staticResourceSet) WithIAM() bool                   { return true }
+func (s *staticResourceSet) WithNamedIAM() bool              { return false }
+func (s *staticResourceSet) GetAllOutputs(types.Stack) error { return nil }
+
+var _ = Describe("Exported templates", func() {
+	const (
+		stackName = "eksctl-test-cluster-nodegroup-ng"
+		template  = `{"Resources":{"NodeGroup":{"Type":"AWS::AutoScaling::AutoScalingGroup"}}}`
+	)
+
+	var (
+		cfg *api.ClusterConfig
+		p   *mockprovider.MockProvider
+		dir string
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		p = mockprovider.NewMockProvider()
+		dir = GinkgoT().TempDir()
+	})
+
+	exportTemplateAs := func(format TemplateFormat) *TemplateExporter {
+		exporter, err := NewTemplateExporter(dir, cfg.Metadata.Name, cfg.Metadata.Region, format)
+		Expect(err).NotTo(HaveOccurred())
+		sm := NewStackCollectionWithOptions(p, cfg, WithTemplateExporter(exporter))
+
+		errs := make(chan error)
+		Expect(sm.CreateStack(context.Background(), stackName, &staticResourceSet{template: template}, map[string]string{"team": "platform"}, nil, errs)).To(Succeed())
+		Expect(<-errs).NotTo(HaveOccurred())
+		Expect(exporter.WriteManifest()).To(Succeed())
+		return exporter
+	}
+
+	exportTemplate := func() *TemplateExporter {
+		return exportTemplateAs(TemplateFormatCloudFormation)
+	}
+
+	It("writes templates and a manifest without creating stacks", func() {
+		exporter := exportTemplate()
+		Expect(p.MockCloudFormation().Calls).To(BeEmpty())
+
+		body, err := os.ReadFile(filepath.Join(dir, stackName+".json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(Equal(template))
+
+		data, err := os.ReadFile(filepath.Join(dir, TemplateManifestFile))
+		Expect(err).NotTo(HaveOccurred())
+		var manifest TemplateManifest
+		Expect(json.Unmarshal(data, &manifest)).To(Succeed())
+		Expect(manifest.ClusterName).To(Equal("test-cluster"))
+		Expect(manifest.Region).To(Equal("us-west-2"))
+		Expect(manifest.Stacks).To(Equal(exporter.Stacks()))
+		Expect(manifest.Stacks).To(HaveLen(1))
+		Expect(manifest.Stacks[0].Order).To(Equal(1))
+		Expect(manifest.Stacks[0].TemplateFile).To(Equal(stackName + ".json"))
+		Expect(manifest.Stacks[0].Capabilities).To(ConsistOf("CAPABILITY_IAM"))
+		Expect(manifest.Stacks[0].Tags).To(HaveKeyWithValue("team", "platform"))
+		Expect(manifest.Stacks[0].Tags).To(HaveKeyWithValue(api.ClusterNameTag, "test-cluster"))
+	})
+
+	It("refuses to export into a directory that already has a manifest", func() {
+		exportTemplate()
+		_, err := NewTemplateExporter(dir, cfg.Metadata.Name, cfg.Metadata.Region, TemplateFormatCloudFormation)
+		Expect(err).To(MatchError(ContainSubstring("already exists")))
+	})
+
+	Context("creating stacks from exported templates", func() {
+		var source *TemplateSource
+
+		BeforeEach(func() {
+			exportTemplate()
+			var err error
+			source, err = LoadTemplateSource(dir)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates the stack from the exported template", func() {
+			var input *cfn.CreateStackInput
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.MatchedBy(func(i *cfn.CreateStackInput) bool {
+				input = i
+				return true
+			})).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+
+			sm := NewStackCollectionWithOptions(p, cfg, WithTemplateSource(source)).(*StackCollection)
+			stack, err := sm.createStackRequest(context.Background(), stackName, &staticResourceSet{template: `{"Resources":{}}`}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackId).To(Equal("stack-id"))
+
+			Expect(*input.TemplateBody).To(Equal(template))
+			Expect(input.Capabilities).To(Equal(stackCapabilitiesIAM))
+			tags := map[string]string{}
+			for _, t := range input.Tags {
+				Expect(tags).NotTo(HaveKey(*t.Key))
+				tags[*t.Key] = *t.Value
+			}
+			Expect(tags).To(HaveKeyWithValue("team", "platform"))
+			Expect(tags).To(HaveKeyWithValue(api.ClusterNameTag, "test-cluster"))
+		})
+
+		It("fails for stacks that are not in the manifest", func() {
+			sm := NewStackCollectionWithOptions(p, cfg, WithTemplateSource(source)).(*StackCollection)
+			_, err := sm.createStackRequest(context.Background(), "eksctl-test-cluster-nodegroup-other", &staticResourceSet{template: template}, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring(`stack "eksctl-test-cluster-nodegroup-other" is not listed in the templates manifest`)))
+			Expect(p.MockCloudFormation().Calls).To(BeEmpty())
+		})
+	})
+
+	Context("with values resolved from references", func() {
+		const (
+			keyARN            = "arn:aws:kms:us-west-2:000000000000:key/secret"
+			reference         = "ssm:/test-cluster/key-arn"
+			referenceTemplate = `{"Resources":{"ControlPlane":{"Type":"AWS::EKS::Cluster","Properties":{"EncryptionConfig":[{"Provider":{"KeyArn":"` + keyARN + `"}}]}}}}`
+		)
+
+		var createStackInput *cfn.CreateStackInput
+
+		BeforeEach(func() {
+			cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: keyARN}
+			cfg.ResolvedReferences = []api.ResolvedReference{{Field: "secretsEncryption.keyARN", Reference: reference}}
+			createStackInput = nil
+			p.MockCloudFormation().On("CreateStack", mock.Anything, mock.MatchedBy(func(i *cfn.CreateStackInput) bool {
+				createStackInput = i
+				return true
+			})).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+		})
+
+		expectParameterizedTemplate := func(body string) {
+			Expect(body).NotTo(ContainSubstring(keyARN))
+			var parsed struct {
+				Parameters map[string]map[string]interface{}
+				Resources  map[string]interface{}
+			}
+			Expect(json.Unmarshal([]byte(body), &parsed)).To(Succeed())
+			Expect(parsed.Parameters).To(HaveKeyWithValue(resolvedReferenceParameterName(reference), map[string]interface{}{
+				"Type":   "String",
+				"NoEcho": true,
+			}))
+			Expect(body).To(ContainSubstring(`{"Ref":"` + resolvedReferenceParameterName(reference) + `"}`))
+		}
+
+		createStack := func(sm *StackCollection, template string) error {
+			_, err := sm.createStackRequest(context.Background(), stackName, &staticResourceSet{template: template}, nil, nil)
+			return err
+		}
+
+		It("passes the values to stacks as NoEcho parameters", func() {
+			sm := NewStackCollection(p, cfg).(*StackCollection)
+			Expect(createStack(sm, referenceTemplate)).To(Succeed())
+
+			expectParameterizedTemplate(*createStackInput.TemplateBody)
+			Expect(createStackInput.Parameters).To(ConsistOf(types.Parameter{
+				ParameterKey:   aws.String(resolvedReferenceParameterName(reference)),
+				ParameterValue: aws.String(keyARN),
+			}))
+		})
+
+		It("exports the references instead of the values and resolves them when creating stacks from the templates", func() {
+			exporter, err := NewTemplateExporter(dir, cfg.Metadata.Name, cfg.Metadata.Region, TemplateFormatCloudFormation)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createStack(NewStackCollectionWithOptions(p, cfg, WithTemplateExporter(exporter)).(*StackCollection), referenceTemplate)).To(Succeed())
+			Expect(exporter.WriteManifest()).To(Succeed())
+
+			body, err := os.ReadFile(filepath.Join(dir, stackName+".json"))
+			Expect(err).NotTo(HaveOccurred())
+			expectParameterizedTemplate(string(body))
+			manifest, err := os.ReadFile(filepath.Join(dir, TemplateManifestFile))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifest)).NotTo(ContainSubstring(keyARN))
+			Expect(exporter.Stacks()[0].Parameters).To(Equal(map[string]string{resolvedReferenceParameterName(reference): reference}))
+
+			source, err := LoadTemplateSource(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createStack(NewStackCollectionWithOptions(p, cfg, WithTemplateSource(source)).(*StackCollection), referenceTemplate)).To(Succeed())
+			Expect(*createStackInput.TemplateBody).To(Equal(string(body)))
+			Expect(createStackInput.Parameters).To(ConsistOf(types.Parameter{
+				ParameterKey:   aws.String(resolvedReferenceParameterName(reference)),
+				ParameterValue: aws.String(keyARN),
+			}))
+
+			cfg.ResolvedReferences = nil
+			err = createStack(NewStackCollectionWithOptions(p, cfg, WithTemplateSource(source)).(*StackCollection), referenceTemplate)
+			Expect(err).To(MatchError(ContainSubstring(`takes the value of reference "ssm:/test-cluster/key-arn", which is not used in the config`)))
+		})
+
+		Context("when updating stacks created with the values", func() {
+			var createChangeSetInput *cfn.CreateChangeSetInput
+
+			BeforeEach(func() {
+				createChangeSetInput = nil
+				p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.MatchedBy(func(i *cfn.CreateChangeSetInput) bool {
+					createChangeSetInput = i
+					return true
+				})).Return(&cfn.CreateChangeSetOutput{}, nil)
+				p.MockCloudFormation().On("DescribeChangeSet", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+					StackName:    aws.String(stackName),
+					StatusReason: aws.String("The submitted information didn't contain changes"),
+				}, nil)
+			})
+
+			updateStack := func(sm *StackCollection, template string) error {
+				return sm.UpdateStack(context.Background(), UpdateStackOptions{
+					Stack: &Stack{
+						StackName: aws.String(stackName),
+						Parameters: []types.Parameter{
+							{ParameterKey: aws.String(resolvedReferenceParameterName(reference)), ParameterValue: aws.String("****")},
+						},
+					},
+					ChangeSetName: "eksctl-changeset",
+					Description:   "description",
+					TemplateData:  TemplateBody(template),
+				})
+			}
+
+			It("passes the values to stacks updated with templates that contain them", func() {
+				sm := NewStackCollection(p, cfg).(*StackCollection)
+				Expect(createStack(sm, referenceTemplate)).To(Succeed())
+				current := *createStackInput.TemplateBody
+				updated := current[:len(current)-2] + `,"Key":{"Type":"AWS::KMS::Alias","Properties":{"TargetKeyId":"` + keyARN + `"}}}}`
+
+				Expect(updateStack(sm, updated)).To(Succeed())
+				expectParameterizedTemplate(*createChangeSetInput.TemplateBody)
+				Expect(createChangeSetInput.Parameters).To(ConsistOf(types.Parameter{
+					ParameterKey:   aws.String(resolvedReferenceParameterName(reference)),
+					ParameterValue: aws.String(keyARN),
+				}))
+			})
+
+			It("keeps the previous values when the references are not resolved by the config", func() {
+				Expect(createStack(NewStackCollection(p, cfg).(*StackCollection), referenceTemplate)).To(Succeed())
+				current := *createStackInput.TemplateBody
+
+				Expect(updateStack(NewStackCollection(p, api.NewClusterConfig()).(*StackCollection), current)).To(Succeed())
+				Expect(*createChangeSetInput.TemplateBody).To(Equal(current))
+				Expect(createChangeSetInput.Parameters).To(ConsistOf(types.Parameter{
+					ParameterKey:     aws.String(resolvedReferenceParameterName(reference)),
+					UsePreviousValue: aws.Bool(true),
+				}))
+			})
+		})
+
+		It("fails if a value would be written to a template as part of a longer string", func() {
+			sm := NewStackCollection(p, cfg).(*StackCollection)
+			err := createStack(sm, `{"Resources":{"Role":{"Type":"AWS::IAM::Role","Properties":{"Description":"uses `+keyARN+`"}}}}`)
+			Expect(err).To(MatchError(ContainSubstring(`the value resolved from reference "ssm:/test-cluster/key-arn" would be written to the template of stack`)))
+			Expect(createStackInput).To(BeNil())
+		})
+	})
+
+	It("exports Terraform configuration that cannot be used to create stacks", func() {
+		exporter := exportTemplateAs(TemplateFormatTerraform)
+		Expect(p.MockCloudFormation().Calls).To(BeEmpty())
+
+		stacks := exporter.Stacks()
+		Expect(stacks).To(HaveLen(1))
+		Expect(stacks[0].TemplateFile).To(Equal(filepath.Join(stackName, "main.tf.json")))
+		body, err := os.ReadFile(filepath.Join(dir, stacks[0].TemplateFile))
+		Expect(err).NotTo(HaveOccurred())
+		var config map[string]map[string]interface{}
+		Expect(json.Unmarshal(body, &config)).To(Succeed())
+		Expect(config["resource"]).To(HaveKey("aws_autoscaling_group"))
+
+		_, err = LoadTemplateSource(dir)
+		Expect(err).To(MatchError(ContainSubstring("were exported in terraform format")))
+	})
+
+	It("rejects templates that were modified after the export", func() {
+		exportTemplate()
+		Expect(os.WriteFile(filepath.Join(dir, stackName+".json"), []byte(`{}`), 0644)).To(Succeed())
+		_, err := LoadTemplateSource(dir)
+		Expect(err).To(MatchError(ContainSubstring("does not match its checksum")))
+	})
+
+	It("rejects template files outside of the templates directory", func() {
+		exportTemplate()
+		outside := filepath.Join(GinkgoT().TempDir(), "template.json")
+		Expect(os.WriteFile(outside, []byte(template), 0644)).To(Succeed())
+
+		for _, templateFile := range []string{outside, "../template.json", "nested/../../template.json"} {
+			data, err := os.ReadFile(filepath.Join(dir, TemplateManifestFile))
+			Expect(err).NotTo(HaveOccurred())
+			var manifest TemplateManifest
+			Expect(json.Unmarshal(data, &manifest)).To(Succeed())
+			manifest.Stacks[0].TemplateFile = templateFile
+			data, err = json.Marshal(manifest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(dir, TemplateManifestFile), data, 0644)).To(Succeed())
+
+			_, err = LoadTemplateSource(dir)
+			Expect(err).To(MatchError(ContainSubstring("template file %q of stack %q must be a relative path inside", templateFile, stackName)))
+		}
+	})
+})
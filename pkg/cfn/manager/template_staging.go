@@ -0,0 +1,298 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+const (
+	// maxTemplateBodySize is the size, in bytes, of the largest template CloudFormation accepts inline
+	maxTemplateBodySize = 51200
+	// stagedTemplateRetention is how long staged templates are kept, CloudFormation only reads them
+	// while creating a stack or a change set
+	stagedTemplateRetention = 7 * 24 * time.Hour
+	stagedTemplatePrefix    = "eksctl/"
+)
+
+// templateStager uploads templates too large to be passed inline to an S3 bucket, either the one
+// configured by the user or one eksctl manages per account and region.
+type templateStager struct {
+	s3API       awsapi.S3
+	stsAPI      awsapi.STS
+	region      string
+	clusterName string
+	endpoint    string
+
+	mu     sync.Mutex
+	bucket string
+	// owner is the account the managed bucket must be owned by, as its name is predictable and a bucket with that
+	// name could have been created in another account; it is not set for the bucket configured by the user
+	owner string
+	ready bool
+}
+
+func newTemplateStager(provider api.ClusterProvider, clusterName string) *templateStager {
+	return &templateStager{
+		s3API:       provider.S3(),
+		stsAPI:      provider.STS(),
+		region:      provider.Region(),
+		clusterName: clusterName,
+		endpoint:    s3EndpointFromEnv(),
+		bucket:      provider.CloudFormationTemplateBucket(),
+	}
+}
+
+// resolveTemplateData returns the template body or the template URL to pass to CloudFormation.
+// Template bodies over the inline size limit are staged in S3 and passed by URL.
+func (c *StackCollection) resolveTemplateData(ctx context.Context, stackName string, templateData TemplateData) (templateBody, templateURL *string, err error) {
+	switch data := templateData.(type) {
+	case TemplateBody:
+		if len(data) <= maxTemplateBodySize || c.templateStager == nil {
+			return aws.String(string(data)), nil, nil
+		}
+		url, err := c.templateStager.stage(ctx, stackName, []byte(data))
+		if err != nil {
+			return nil, nil, fmt.Errorf("template for stack %q is %d bytes, over the inline limit of %d bytes, and could not be staged in S3: %w", stackName, len(data), maxTemplateBodySize, err)
+		}
+		return nil, aws.String(url), nil
+	case TemplateURL:
+		return nil, aws.String(string(data)), nil
+	default:
+		return nil, nil, fmt.Errorf("unknown template data type: %T", templateData)
+	}
+}
+
+// stage uploads the template under a key derived from its content and returns its URL.
+func (s *templateStager) stage(ctx context.Context, stackName string, body []byte) (string, error) {
+	bucket, err := s.ensureBucket(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(body)
+	key := fmt.Sprintf("%s%s/%s/%s.json", stagedTemplatePrefix, s.clusterName, stackName, hex.EncodeToString(sum[:]))
+	exists, err := s.objectExists(ctx, bucket, key)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		logger.Info("uploading %d-byte template for stack %q to s3://%s/%s", len(body), stackName, bucket, key)
+		if _, err := s.s3API.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:              aws.String(bucket),
+			Key:                 aws.String(key),
+			Body:                bytes.NewReader(body),
+			ContentType:         aws.String("application/json"),
+			ExpectedBucketOwner: s.expectedBucketOwner(),
+		}); err != nil {
+			return "", fmt.Errorf("uploading template to s3://%s/%s: %w", bucket, key, err)
+		}
+	}
+
+	if err := s.cleanup(ctx, bucket, key); err != nil {
+		logger.Warning("failed to clean up old templates in bucket %q: %v", bucket, err)
+	}
+	return s.objectURL(bucket, key)
+}
+
+// ensureBucket returns the bucket to stage templates in, creating the bucket managed by eksctl if
+// it does not exist yet.
+func (s *templateStager) ensureBucket(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ready {
+		return s.bucket, nil
+	}
+	if s.bucket != "" {
+		s.ready = true
+		return s.bucket, nil
+	}
+
+	identity, err := s.stsAPI.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("getting caller identity: %w", err)
+	}
+	account := aws.ToString(identity.Account)
+	bucket := fmt.Sprintf("eksctl-cfn-templates-%s-%s", account, s.region)
+
+	_, err = s.s3API.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket), ExpectedBucketOwner: aws.String(account)})
+	var (
+		notFound *s3types.NotFound
+		respErr  *awshttp.ResponseError
+	)
+	switch {
+	case err == nil:
+	case errors.As(err, &notFound):
+		if err := s.createBucket(ctx, bucket, account); err != nil {
+			return "", err
+		}
+	case errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusForbidden:
+		return "", fmt.Errorf("template bucket %q is not owned by account %s or cannot be accessed; set a bucket to stage templates in with --cfn-template-bucket: %w", bucket, account, err)
+	default:
+		return "", fmt.Errorf("checking template bucket %q: %w", bucket, err)
+	}
+
+	s.bucket = bucket
+	s.owner = account
+	s.ready = true
+	return bucket, nil
+}
+
+// expectedBucketOwner returns the account the bucket must be owned by, if any
+func (s *templateStager) expectedBucketOwner() *string {
+	if s.owner == "" {
+		return nil
+	}
+	return aws.String(s.owner)
+}
+
+func (s *templateStager) createBucket(ctx context.Context, bucket, owner string) error {
+	logger.Info("creating S3 bucket %q to stage CloudFormation templates", bucket)
+	input := &s3.CreateBucketInput{Bucket: aws.String(bucket)}
+	if s.region != api.RegionUSEast1 {
+		input.CreateBucketConfiguration = &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraint(s.region),
+		}
+	}
+	var alreadyOwned *s3types.BucketAlreadyOwnedByYou
+	if _, err := s.s3API.CreateBucket(ctx, input); errors.As(err, &alreadyOwned) {
+		// the bucket was created by a concurrent run since it was checked; it is still configured
+		// below, in case that run did not get to it
+		logger.Debug("template bucket %q was created concurrently", bucket)
+		if _, err := s.s3API.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket), ExpectedBucketOwner: aws.String(owner)}); err != nil {
+			return fmt.Errorf("checking template bucket %q: %w", bucket, err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("creating template bucket %q: %w", bucket, err)
+	}
+
+	if _, err := s.s3API.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: aws.String(owner),
+		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	}); err != nil {
+		return fmt.Errorf("blocking public access to template bucket %q: %w", bucket, err)
+	}
+
+	// templates left behind by interrupted runs are expired by S3
+	if _, err := s.s3API.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: aws.String(owner),
+		LifecycleConfiguration: &s3types.BucketLifecycleConfiguration{
+			Rules: []s3types.LifecycleRule{
+				{
+					ID:         aws.String("expire-staged-templates"),
+					Status:     s3types.ExpirationStatusEnabled,
+					Filter:     &s3types.LifecycleRuleFilter{Prefix: aws.String(stagedTemplatePrefix)},
+					Expiration: &s3types.LifecycleExpiration{Days: aws.Int32(int32(stagedTemplateRetention.Hours() / 24))},
+				},
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("configuring lifecycle of template bucket %q: %w", bucket, err)
+	}
+	return nil
+}
+
+func (s *templateStager) objectExists(ctx context.Context, bucket, key string) (bool, error) {
+	_, err := s.s3API.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:              aws.String(bucket),
+		Key:                 aws.String(key),
+		ExpectedBucketOwner: s.expectedBucketOwner(),
+	})
+	var notFound *s3types.NotFound
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.As(err, &notFound):
+		return false, nil
+	default:
+		return false, fmt.Errorf("checking for template s3://%s/%s: %w", bucket, key, err)
+	}
+}
+
+// cleanup deletes the templates of the cluster staged longer ago than the retention period, except
+// for the template that was just staged.
+func (s *templateStager) cleanup(ctx context.Context, bucket, currentKey string) error {
+	cutoff := time.Now().Add(-stagedTemplateRetention)
+	var expired []s3types.ObjectIdentifier
+	paginator := s3.NewListObjectsV2Paginator(s.s3API, &s3.ListObjectsV2Input{
+		Bucket:              aws.String(bucket),
+		Prefix:              aws.String(fmt.Sprintf("%s%s/", stagedTemplatePrefix, s.clusterName)),
+		ExpectedBucketOwner: s.expectedBucketOwner(),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, o := range page.Contents {
+			if aws.ToString(o.Key) != currentKey && o.LastModified != nil && o.LastModified.Before(cutoff) {
+				expired = append(expired, s3types.ObjectIdentifier{Key: o.Key})
+			}
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	logger.Debug("deleting %d expired template(s) from bucket %q", len(expired), bucket)
+	// DeleteObjects accepts up to 1000 keys per request, which ListObjectsV2 pages never exceed
+	for start := 0; start < len(expired); start += 1000 {
+		end := min(start+1000, len(expired))
+		if _, err := s.s3API.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket:              aws.String(bucket),
+			Delete:              &s3types.Delete{Objects: expired[start:end], Quiet: aws.Bool(true)},
+			ExpectedBucketOwner: s.expectedBucketOwner(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// objectURL returns the URL CloudFormation reads a staged template from.
+func (s *templateStager) objectURL(bucket, key string) (string, error) {
+	if s.endpoint != "" {
+		return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(s.endpoint, "/"), bucket, key), nil
+	}
+	dnsSuffix, err := api.Partitions.V1SDKDNSPrefixForRegion(s.region)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s.s3.%s.%s/%s", bucket, s.region, dnsSuffix, key), nil
+}
+
+// s3EndpointFromEnv returns the S3 endpoint override, used to stage templates in an S3-compatible
+// store such as MinIO or LocalStack.
+func s3EndpointFromEnv() string {
+	for _, env := range []string{"AWS_S3_ENDPOINT", "AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"} {
+		if value, ok := os.LookupEnv(env); ok {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,196 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Template staging", func() {
+	const (
+		stackName     = "eksctl-test-cluster-cluster"
+		managedBucket = "eksctl-cfn-templates-123456789012-us-west-2"
+	)
+
+	var (
+		p             *mockprovider.MockProvider
+		sc            *StackCollection
+		largeTemplate TemplateBody
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		p.SetRegion("us-west-2")
+		sc = NewStackCollection(p, cfg).(*StackCollection)
+		sc.templateStager.endpoint = ""
+		largeTemplate = TemplateBody(`{"Description":"` + strings.Repeat("x", maxTemplateBodySize) + `"}`)
+
+		p.MockSTS().On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+			Account: aws.String("123456789012"),
+		}, nil)
+		p.MockS3().On("ListObjectsV2", mock.Anything, mock.Anything, mock.Anything).Return(&s3.ListObjectsV2Output{}, nil)
+	})
+
+	mockCreateStack := func() *cfn.CreateStackInput {
+		var input *cfn.CreateStackInput
+		p.MockCloudFormation().On("CreateStack", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			input = args.Get(1).(*cfn.CreateStackInput)
+		}).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+		Expect(sc.DoCreateStackRequest(context.Background(), &Stack{StackName: aws.String(stackName)}, largeTemplate, nil, nil, false, false)).To(Succeed())
+		return input
+	}
+
+	It("passes templates under the inline limit as the template body", func() {
+		p.MockCloudFormation().On("CreateStack", mock.Anything, mock.MatchedBy(func(input *cfn.CreateStackInput) bool {
+			return aws.ToString(input.TemplateBody) == "{}" && input.TemplateURL == nil
+		})).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+
+		Expect(sc.DoCreateStackRequest(context.Background(), &Stack{StackName: aws.String(stackName)}, TemplateBody("{}"), nil, nil, false, false)).To(Succeed())
+		Expect(p.MockS3().Calls).To(BeEmpty())
+	})
+
+	It("creates the managed bucket and uploads templates over the inline limit", func() {
+		p.MockS3().On("HeadBucket", mock.Anything, mock.MatchedBy(func(input *s3.HeadBucketInput) bool {
+			return aws.ToString(input.ExpectedBucketOwner) == "123456789012"
+		})).Return(nil, &s3types.NotFound{})
+		p.MockS3().On("CreateBucket", mock.Anything, mock.MatchedBy(func(input *s3.CreateBucketInput) bool {
+			return aws.ToString(input.Bucket) == managedBucket && input.CreateBucketConfiguration.LocationConstraint == "us-west-2"
+		})).Return(&s3.CreateBucketOutput{}, nil)
+		p.MockS3().On("PutPublicAccessBlock", mock.Anything, mock.Anything).Return(&s3.PutPublicAccessBlockOutput{}, nil)
+		p.MockS3().On("PutBucketLifecycleConfiguration", mock.Anything, mock.Anything).Return(&s3.PutBucketLifecycleConfigurationOutput{}, nil)
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Return(nil, &s3types.NotFound{})
+		p.MockS3().On("PutObject", mock.Anything, mock.MatchedBy(func(input *s3.PutObjectInput) bool {
+			return aws.ToString(input.ExpectedBucketOwner) == "123456789012"
+		})).Return(&s3.PutObjectOutput{}, nil)
+
+		input := mockCreateStack()
+		Expect(input.TemplateBody).To(BeNil())
+		Expect(aws.ToString(input.TemplateURL)).To(MatchRegexp(`^https://%s\.s3\.us-west-2\.amazonaws\.com/eksctl/test-cluster/%s/[0-9a-f]{64}\.json$`, managedBucket, stackName))
+		p.MockS3().AssertNumberOfCalls(GinkgoT(), "PutObject", 1)
+
+		By("reusing the bucket and the uploaded template for a change set with the same template")
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Unset()
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Return(&s3.HeadObjectOutput{}, nil)
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.MatchedBy(func(changeSet *cfn.CreateChangeSetInput) bool {
+			return changeSet.TemplateBody == nil && aws.ToString(changeSet.TemplateURL) == aws.ToString(input.TemplateURL)
+		})).Return(&cfn.CreateChangeSetOutput{}, nil)
+		Expect(sc.doCreateChangeSetRequest(context.Background(), stackName, "update", "", largeTemplate, nil, nil, nil, "")).To(Succeed())
+		p.MockS3().AssertNumberOfCalls(GinkgoT(), "HeadBucket", 1)
+		p.MockS3().AssertNumberOfCalls(GinkgoT(), "PutObject", 1)
+	})
+
+	It("uses the managed bucket when it is created concurrently", func() {
+		p.MockS3().On("HeadBucket", mock.Anything, mock.MatchedBy(func(input *s3.HeadBucketInput) bool {
+			return aws.ToString(input.ExpectedBucketOwner) == "123456789012"
+		})).Return(nil, &s3types.NotFound{}).Once()
+		p.MockS3().On("CreateBucket", mock.Anything, mock.Anything).Return(nil, &s3types.BucketAlreadyOwnedByYou{})
+		p.MockS3().On("HeadBucket", mock.Anything, mock.MatchedBy(func(input *s3.HeadBucketInput) bool {
+			return aws.ToString(input.ExpectedBucketOwner) == "123456789012"
+		})).Return(&s3.HeadBucketOutput{}, nil).Once()
+		p.MockS3().On("PutPublicAccessBlock", mock.Anything, mock.Anything).Return(&s3.PutPublicAccessBlockOutput{}, nil)
+		p.MockS3().On("PutBucketLifecycleConfiguration", mock.Anything, mock.Anything).Return(&s3.PutBucketLifecycleConfigurationOutput{}, nil)
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Return(nil, &s3types.NotFound{})
+		p.MockS3().On("PutObject", mock.Anything, mock.Anything).Return(&s3.PutObjectOutput{}, nil)
+
+		input := mockCreateStack()
+		Expect(aws.ToString(input.TemplateURL)).To(HavePrefix("https://%s.s3.us-west-2.amazonaws.com/", managedBucket))
+		p.MockS3().AssertNumberOfCalls(GinkgoT(), "HeadBucket", 2)
+		p.MockS3().AssertNumberOfCalls(GinkgoT(), "PutObject", 1)
+	})
+
+	It("does not stage templates in a managed bucket owned by another account", func() {
+		p.MockS3().On("HeadBucket", mock.Anything, mock.Anything).Return(nil, &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusForbidden}},
+				Err:      errors.New("Forbidden"),
+			},
+		})
+
+		err := sc.DoCreateStackRequest(context.Background(), &Stack{StackName: aws.String(stackName)}, largeTemplate, nil, nil, false, false)
+		Expect(err).To(MatchError(ContainSubstring(`template bucket "%s" is not owned by account 123456789012`, managedBucket)))
+		p.MockS3().AssertNotCalled(GinkgoT(), "PutObject", mock.Anything, mock.Anything)
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+	})
+
+	It("uses the configured bucket as is", func() {
+		sc.templateStager.bucket = "my-templates"
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Return(nil, &s3types.NotFound{})
+		p.MockS3().On("PutObject", mock.Anything, mock.MatchedBy(func(input *s3.PutObjectInput) bool {
+			return aws.ToString(input.Bucket) == "my-templates"
+		})).Return(&s3.PutObjectOutput{}, nil)
+
+		input := mockCreateStack()
+		Expect(aws.ToString(input.TemplateURL)).To(HavePrefix("https://my-templates.s3.us-west-2.amazonaws.com/eksctl/test-cluster/"))
+		p.MockSTS().AssertNotCalled(GinkgoT(), "GetCallerIdentity", mock.Anything, mock.Anything)
+		p.MockS3().AssertNotCalled(GinkgoT(), "HeadBucket", mock.Anything, mock.Anything)
+	})
+
+	It("uses path-style URLs with an S3-compatible endpoint", func() {
+		sc.templateStager.bucket = "local"
+		sc.templateStager.endpoint = "http://localhost:9000/"
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Return(&s3.HeadObjectOutput{}, nil)
+
+		input := mockCreateStack()
+		Expect(aws.ToString(input.TemplateURL)).To(HavePrefix("http://localhost:9000/local/eksctl/test-cluster/"))
+	})
+
+	It("deletes templates of the cluster older than the retention period", func() {
+		sc.templateStager.bucket = "my-templates"
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Return(&s3.HeadObjectOutput{}, nil)
+		p.MockS3().On("ListObjectsV2", mock.Anything, mock.Anything, mock.Anything).Unset()
+		p.MockS3().On("ListObjectsV2", mock.Anything, mock.MatchedBy(func(input *s3.ListObjectsV2Input) bool {
+			return aws.ToString(input.Prefix) == "eksctl/test-cluster/"
+		}), mock.Anything).Return(&s3.ListObjectsV2Output{
+			Contents: []s3types.Object{
+				{Key: aws.String("eksctl/test-cluster/old.json"), LastModified: aws.Time(time.Now().Add(-30 * 24 * time.Hour))},
+				{Key: aws.String("eksctl/test-cluster/recent.json"), LastModified: aws.Time(time.Now())},
+			},
+		}, nil)
+		var deleted []string
+		p.MockS3().On("DeleteObjects", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			for _, o := range args.Get(1).(*s3.DeleteObjectsInput).Delete.Objects {
+				deleted = append(deleted, aws.ToString(o.Key))
+			}
+		}).Return(&s3.DeleteObjectsOutput{}, nil)
+
+		mockCreateStack()
+		Expect(deleted).To(ConsistOf("eksctl/test-cluster/old.json"))
+	})
+
+	It("fails when the template cannot be staged", func() {
+		sc.templateStager.bucket = "my-templates"
+		p.MockS3().On("HeadObject", mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+
+		err := sc.DoCreateStackRequest(context.Background(), &Stack{StackName: aws.String(stackName)}, largeTemplate, nil, nil, false, false)
+		Expect(err).To(MatchError(ContainSubstring("over the inline limit")))
+		Expect(err).To(MatchError(ContainSubstring("access denied")))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+	})
+
+	It("passes template URLs through", func() {
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything, mock.MatchedBy(func(input *cfn.CreateChangeSetInput) bool {
+			return aws.ToString(input.TemplateURL) == "https://example.com/template.json"
+		})).Return(&cfn.CreateChangeSetOutput{}, nil)
+		Expect(sc.doCreateChangeSetRequest(context.Background(), stackName, "update", "", TemplateURL("https://example.com/template.json"), nil, []types.Capability{}, nil, "")).To(Succeed())
+	})
+})
@@ -73,18 +73,30 @@ func (e *NoChangeError) Error() string {
 // DoWaitUntilStackIsCreated blocks until the given stack's
 // creation has completed.
 func (c *StackCollection) DoWaitUntilStackIsCreated(ctx context.Context, i *Stack) error {
+	events := c.newStackEventStreamer(i)
+	defer events.done()
 	setCustomRetryer := func(o *cloudformation.StackCreateCompleteWaiterOptions) {
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
-			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			events.observe(ctx, describedStack(out))
+			events.poll(ctx)
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
 
 	waiter := cloudformation.NewStackCreateCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+	err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
 		StackName: i.StackName,
 	}, c.waitTimeout, setCustomRetryer)
+	return c.withFailureDiagnosis(ctx, i, err)
+}
+
+// describedStack returns the stack described by out, if any
+func describedStack(out *cloudformation.DescribeStacksOutput) *cfntypes.Stack {
+	if out == nil || len(out.Stacks) == 0 {
+		return nil
+	}
+	return &out.Stacks[0]
 }
 
 func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack, stack builder.ResourceSetReader, errs chan error) {
@@ -107,18 +119,22 @@ func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack,
 }
 
 func (c *StackCollection) doWaitUntilStackIsDeleted(ctx context.Context, i *Stack) error {
+	events := c.newStackEventStreamer(i)
+	defer events.done()
 	setCustomRetryer := func(o *cloudformation.StackDeleteCompleteWaiterOptions) {
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
-			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			events.observe(ctx, describedStack(out))
+			events.poll(ctx)
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
 
 	waiter := cloudformation.NewStackDeleteCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+	err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
 		StackName: i.StackName,
 	}, c.waitTimeout, setCustomRetryer)
+	return c.withFailureDiagnosis(ctx, i, err)
 }
 
 func (c *StackCollection) waitUntilStackIsDeleted(ctx context.Context, i *Stack, errs chan error) {
@@ -132,18 +148,64 @@ func (c *StackCollection) waitUntilStackIsDeleted(ctx context.Context, i *Stack,
 }
 
 func (c *StackCollection) doWaitUntilStackIsUpdated(ctx context.Context, i *Stack) error {
+	events := c.newStackEventStreamer(i)
+	defer events.done()
 	setCustomRetryer := func(o *cloudformation.StackUpdateCompleteWaiterOptions) {
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
-			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			events.observe(ctx, describedStack(out))
+			events.poll(ctx)
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
 
 	waiter := cloudformation.NewStackUpdateCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+	err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
 		StackName: i.StackName,
 	}, c.waitTimeout, setCustomRetryer)
+	return c.withFailureDiagnosis(ctx, i, err)
+}
+
+func (c *StackCollection) doWaitUntilStackRollbackIsComplete(ctx context.Context, i *Stack) error {
+	events := c.newStackEventStreamer(i)
+	defer events.done()
+	setCustomRetryer := func(o *cloudformation.StackRollbackCompleteWaiterOptions) {
+		defaultRetryer := o.Retryable
+		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
+			events.observe(ctx, describedStack(out))
+			events.poll(ctx)
+			return defaultRetryer(ctx, in, out, err)
+		}
+	}
+
+	waiter := cloudformation.NewStackRollbackCompleteWaiter(c.cloudformationAPI)
+	err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+		StackName: i.StackName,
+	}, c.waitTimeout, setCustomRetryer)
+	return c.withFailureDiagnosis(ctx, i, err)
+}
+
+// doWaitUntilStackCreationIsRolledBack waits until the rollback of a stack that failed to be created is done, and
+// returns the stack, whose status is either ROLLBACK_COMPLETE or ROLLBACK_FAILED
+func (c *StackCollection) doWaitUntilStackCreationIsRolledBack(ctx context.Context, i *Stack) (*Stack, error) {
+	var stack *Stack
+	waiter := cloudformation.NewStackCreateCompleteWaiter(c.cloudformationAPI, func(o *cloudformation.StackCreateCompleteWaiterOptions) {
+		o.Retryable = func(_ context.Context, _ *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
+			if err != nil {
+				return false, err
+			}
+			if stack = describedStack(out); stack == nil {
+				return false, fmt.Errorf("stack %q not found", *i.StackName)
+			}
+			return stack.StackStatus == cfntypes.StackStatusRollbackInProgress, nil
+		}
+	})
+	if err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+		StackName: i.StackId,
+	}, c.waitTimeout); err != nil {
+		return nil, err
+	}
+	return stack, nil
 }
 
 func (c *StackCollection) doWaitUntilChangeSetIsCreated(ctx context.Context, i *Stack, changesetName string) error {
@@ -0,0 +1,444 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+// allASGMetrics are the metrics CloudFormation enables when a MetricsCollection does not list any.
+var allASGMetrics = []interface{}{
+	"GroupMinSize", "GroupMaxSize", "GroupDesiredCapacity", "GroupInServiceInstances", "GroupPendingInstances",
+	"GroupStandbyInstances", "GroupTerminatingInstances", "GroupTotalInstances", "GroupInServiceCapacity",
+	"GroupPendingCapacity", "GroupStandbyCapacity", "GroupTerminatingCapacity", "GroupTotalCapacity",
+	"WarmPoolDesiredCapacity", "WarmPoolWarmedCapacity", "WarmPoolPendingCapacity", "WarmPoolTerminatingCapacity",
+	"WarmPoolTotalCapacity", "GroupAndWarmPoolDesiredCapacity", "GroupAndWarmPoolTotalCapacity", "WarmPoolMinSize",
+}
+
+func convertVPCCidrBlock(_ *converter, r *resource) error {
+	if _, ok := r.props["CidrBlock"]; ok {
+		return fmt.Errorf("only IPv6 CIDR blocks are supported")
+	}
+	if v, ok := r.props["AmazonProvidedIpv6CidrBlock"].(bool); ok && !v {
+		delete(r.props, "AmazonProvidedIpv6CidrBlock")
+	}
+	return nil
+}
+
+// convertSubnet merges the AWS::EC2::SubnetCidrBlock resources of a subnet into it, as Terraform
+// sets the IPv6 CIDR block of a subnet on the subnet itself.
+func convertSubnet(c *converter, r *resource) error {
+	for _, logicalID := range c.sortedResources("AWS::EC2::SubnetCidrBlock") {
+		props := c.template.Resources[logicalID].Properties
+		if refTarget(props["SubnetId"]) != r.logicalID {
+			continue
+		}
+		if _, ok := r.body["ipv6_cidr_block"]; ok {
+			return fmt.Errorf("subnets with more than one IPv6 CIDR block are not supported")
+		}
+		block, err := c.value(props["Ipv6CidrBlock"])
+		if err != nil {
+			return fmt.Errorf("converting %q: %w", logicalID, err)
+		}
+		r.body["ipv6_cidr_block"] = block
+	}
+	return nil
+}
+
+func convertSecurityGroup(c *converter, r *resource) error {
+	groupID := expr(r.address() + ".id")
+	hasEgress := false
+	for _, kind := range []string{"ingress", "egress"} {
+		property := "SecurityGroupIngress"
+		if kind == "egress" {
+			property = "SecurityGroupEgress"
+		}
+		v, _ := r.take(property)
+		rules, _ := v.([]interface{})
+		for i, rule := range rules {
+			props, ok := rule.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid %s rule %v", kind, rule)
+			}
+			body, err := securityGroupRule(kind, groupID, props)
+			if err != nil {
+				return err
+			}
+			if _, err := c.addResource("aws_security_group_rule", fmt.Sprintf("%s_%s_%d", r.name, kind, i), body); err != nil {
+				return err
+			}
+			hasEgress = hasEgress || kind == "egress"
+		}
+	}
+
+	// CloudFormation keeps the default rule that allows all outbound IPv4 traffic unless egress
+	// rules are set, while Terraform always removes it
+	if !hasEgress {
+		_, err := c.addResource("aws_security_group_rule", r.name+"_egress", map[string]interface{}{
+			"type":              "egress",
+			"security_group_id": groupID,
+			"protocol":          "-1",
+			"from_port":         json.Number("0"),
+			"to_port":           json.Number("0"),
+			"cidr_blocks":       []interface{}{"0.0.0.0/0"},
+		})
+		return err
+	}
+	return nil
+}
+
+func convertSecurityGroupRule(kind string) func(*converter, *resource) error {
+	return func(_ *converter, r *resource) error {
+		groupID, _ := r.take("GroupId")
+		body, err := securityGroupRule(kind, groupID, r.props)
+		if err != nil {
+			return err
+		}
+		r.props = map[string]interface{}{}
+		r.body = body
+		return nil
+	}
+}
+
+// securityGroupRule converts the properties of an ingress or egress rule to an aws_security_group_rule.
+func securityGroupRule(kind string, groupID interface{}, props map[string]interface{}) (map[string]interface{}, error) {
+	body := map[string]interface{}{
+		"type":              kind,
+		"security_group_id": groupID,
+	}
+	for k, v := range props {
+		switch k {
+		case "IpProtocol":
+			if n, ok := v.(json.Number); ok {
+				v = n.String()
+			}
+			body["protocol"] = v
+		case "FromPort":
+			body["from_port"] = v
+		case "ToPort":
+			body["to_port"] = v
+		case "CidrIp":
+			body["cidr_blocks"] = []interface{}{v}
+		case "CidrIpv6":
+			body["ipv6_cidr_blocks"] = []interface{}{v}
+		case "SourceSecurityGroupId", "DestinationSecurityGroupId":
+			body["source_security_group_id"] = v
+		case "SourcePrefixListId", "DestinationPrefixListId":
+			body["prefix_list_ids"] = []interface{}{v}
+		case "Description":
+			body["description"] = v
+		default:
+			return nil, fmt.Errorf("security group rule property %s is not supported", k)
+		}
+	}
+
+	// Terraform requires ports, and both to be 0 for all protocols
+	if body["protocol"] == "-1" {
+		body["from_port"], body["to_port"] = json.Number("0"), json.Number("0")
+	}
+	for _, port := range []string{"from_port", "to_port"} {
+		if _, ok := body[port]; !ok {
+			body[port] = json.Number("-1")
+		}
+	}
+	return body, nil
+}
+
+// convertLaunchTemplate moves the launch template data to the top level, as in aws_launch_template.
+func convertLaunchTemplate(_ *converter, r *resource) error {
+	if v, ok := r.take("TagSpecifications"); ok {
+		specs, _ := v.([]interface{})
+		for _, spec := range specs {
+			if spec, ok := spec.(map[string]interface{}); ok && spec["ResourceType"] == "launch-template" {
+				t, err := tags(spec["Tags"])
+				if err != nil {
+					return err
+				}
+				r.body["tags"] = t
+			}
+		}
+	}
+	v, _ := r.take("LaunchTemplateData")
+	data, _ := v.(map[string]interface{})
+	for k, v := range data {
+		if _, ok := r.props[k]; ok {
+			return fmt.Errorf("launch template data property %s conflicts with a launch template property", k)
+		}
+		r.props[k] = v
+	}
+	return nil
+}
+
+// convertPlacementGroup names the placement group, as its name is required in Terraform.
+func convertPlacementGroup(c *converter, r *resource) error {
+	stackName, err := c.ref(cft.StackName)
+	if err != nil {
+		return err
+	}
+	r.body["name"] = concat(stackName, "-"+r.logicalID)
+	return nil
+}
+
+func convertRole(_ *converter, r *resource) error {
+	v, ok := r.take("Policies")
+	if !ok {
+		return nil
+	}
+	policies, _ := v.([]interface{})
+	var inlinePolicies []interface{}
+	for _, p := range policies {
+		policy, ok := p.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid policy %v", p)
+		}
+		inlinePolicies = append(inlinePolicies, map[string]interface{}{
+			"name":   policy["PolicyName"],
+			"policy": jsonencode(policy["PolicyDocument"]),
+		})
+	}
+	r.body["inline_policy"] = inlinePolicies
+	return nil
+}
+
+// convertPolicy converts an AWS::IAM::Policy to an aws_iam_role_policy for each of its roles.
+func convertPolicy(c *converter, r *resource) error {
+	for _, unsupported := range []string{"Users", "Groups"} {
+		if _, ok := r.props[unsupported]; ok {
+			return fmt.Errorf("policies for IAM %s are not supported", unsupported)
+		}
+	}
+	roles, err := list(r.props["Roles"], "Roles")
+	if err != nil {
+		return err
+	}
+	for i, role := range roles {
+		name := r.name
+		if i > 0 {
+			name = fmt.Sprintf("%s_%d", r.name, i)
+		}
+		if _, err := c.addResource(r.mapping.tfType, name, map[string]interface{}{
+			"name":   r.props["PolicyName"],
+			"policy": jsonencode(r.props["PolicyDocument"]),
+			"role":   role,
+		}); err != nil {
+			return err
+		}
+	}
+	r.skip = true
+	return nil
+}
+
+// convertManagedPolicy attaches the policy to its roles with aws_iam_role_policy_attachment resources.
+func convertManagedPolicy(c *converter, r *resource) error {
+	for _, unsupported := range []string{"Users", "Groups"} {
+		if _, ok := r.props[unsupported]; ok {
+			return fmt.Errorf("managed policies for IAM %s are not supported", unsupported)
+		}
+	}
+	v, ok := r.take("Roles")
+	if !ok {
+		return nil
+	}
+	roles, err := list(v, "Roles")
+	if err != nil {
+		return err
+	}
+	for i, role := range roles {
+		if _, err := c.addResource("aws_iam_role_policy_attachment", fmt.Sprintf("%s_%d", r.name, i), map[string]interface{}{
+			"role":       role,
+			"policy_arn": expr(r.address() + ".arn"),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convertInstanceProfile(_ *converter, r *resource) error {
+	v, _ := r.take("Roles")
+	roles, err := list(v, "Roles")
+	if err != nil {
+		return err
+	}
+	if len(roles) != 1 {
+		return fmt.Errorf("instance profiles must have exactly one role")
+	}
+	r.body["role"] = roles[0]
+	return nil
+}
+
+func convertCluster(_ *converter, r *resource) error {
+	v, ok := r.take("Logging")
+	if !ok {
+		return nil
+	}
+	logging, _ := v.(map[string]interface{})
+	clusterLogging, _ := logging["ClusterLogging"].(map[string]interface{})
+	enabledTypes, _ := clusterLogging["EnabledTypes"].([]interface{})
+	var types []interface{}
+	for _, t := range enabledTypes {
+		if t, ok := t.(map[string]interface{}); ok {
+			types = append(types, t["Type"])
+		}
+	}
+	if len(types) > 0 {
+		r.body["enabled_cluster_log_types"] = types
+	}
+	return nil
+}
+
+// convertNodegroup sets the launch template version, which CloudFormation defaults to the
+// default version of the launch template while Terraform requires it.
+func convertNodegroup(c *converter, r *resource) error {
+	launchTemplate, ok := r.props["LaunchTemplate"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if _, ok := launchTemplate["Version"]; ok {
+		return nil
+	}
+	raw, _ := c.template.Resources[r.logicalID].Properties["LaunchTemplate"].(map[string]interface{})
+	if logicalID := refTarget(raw["Id"]); logicalID != "" {
+		launchTemplate["Version"] = expr(c.address(logicalID) + ".default_version")
+	}
+	return nil
+}
+
+// convertAccessEntry converts the access policies of an access entry to aws_eks_access_policy_association resources.
+func convertAccessEntry(c *converter, r *resource) error {
+	v, ok := r.take("AccessPolicies")
+	if !ok {
+		return nil
+	}
+	policies, err := list(v, "AccessPolicies")
+	if err != nil {
+		return err
+	}
+	for i, p := range policies {
+		policy, ok := p.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid access policy %v", p)
+		}
+		body := map[string]interface{}{
+			"cluster_name":  expr(r.address() + ".cluster_name"),
+			"principal_arn": expr(r.address() + ".principal_arn"),
+			"policy_arn":    policy["PolicyArn"],
+		}
+		if scope, ok := policy["AccessScope"].(map[string]interface{}); ok {
+			accessScope := map[string]interface{}{"type": scope["Type"]}
+			if namespaces, ok := scope["Namespaces"]; ok {
+				accessScope["namespaces"] = namespaces
+			}
+			body["access_scope"] = accessScope
+		}
+		if _, err := c.addResource("aws_eks_access_policy_association", fmt.Sprintf("%s_%d", r.name, i), body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convertAutoScalingGroup(_ *converter, r *resource) error {
+	if v, ok := r.take("Tags"); ok {
+		tagList, err := list(v, "Tags")
+		if err != nil {
+			return err
+		}
+		var tagBlocks []interface{}
+		for _, t := range tagList {
+			tag, ok := t.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid tag %v", t)
+			}
+			propagate := tag["PropagateAtLaunch"]
+			if propagate == nil {
+				propagate = false
+			}
+			tagBlocks = append(tagBlocks, map[string]interface{}{
+				"key":                 tag["Key"],
+				"value":               tag["Value"],
+				"propagate_at_launch": propagate,
+			})
+		}
+		r.body["tag"] = tagBlocks
+	}
+
+	if v, ok := r.take("MetricsCollection"); ok {
+		collections, err := list(v, "MetricsCollection")
+		if err != nil {
+			return err
+		}
+		var metrics []interface{}
+		for _, mc := range collections {
+			collection, ok := mc.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid metrics collection %v", mc)
+			}
+			r.body["metrics_granularity"] = collection["Granularity"]
+			if m, ok := collection["Metrics"].([]interface{}); ok {
+				metrics = append(metrics, m...)
+			} else {
+				metrics = allASGMetrics
+			}
+		}
+		r.body["enabled_metrics"] = metrics
+	}
+	return nil
+}
+
+// convertEventRule converts the targets of a rule to aws_cloudwatch_event_target resources.
+func convertEventRule(c *converter, r *resource) error {
+	v, ok := r.take("Targets")
+	if !ok {
+		return nil
+	}
+	targets, err := list(v, "Targets")
+	if err != nil {
+		return err
+	}
+	for i, t := range targets {
+		target, ok := t.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid target %v", t)
+		}
+		if _, err := c.addResource("aws_cloudwatch_event_target", fmt.Sprintf("%s_%d", r.name, i), map[string]interface{}{
+			"rule":      expr(r.address() + ".name"),
+			"target_id": target["Id"],
+			"arn":       target["Arn"],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertQueuePolicy converts a queue policy to an aws_sqs_queue_policy for each of its queues.
+func convertQueuePolicy(c *converter, r *resource) error {
+	queues, err := list(r.props["Queues"], "Queues")
+	if err != nil {
+		return err
+	}
+	for i, queue := range queues {
+		name := r.name
+		if i > 0 {
+			name = fmt.Sprintf("%s_%d", r.name, i)
+		}
+		if _, err := c.addResource(r.mapping.tfType, name, map[string]interface{}{
+			"queue_url": queue,
+			"policy":    jsonencode(r.props["PolicyDocument"]),
+		}); err != nil {
+			return err
+		}
+	}
+	r.skip = true
+	return nil
+}
+
+func list(v interface{}, property string) ([]interface{}, error) {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list, got %s", property, toExpr(v))
+	}
+	return l, nil
+}
@@ -0,0 +1,495 @@
+package terraform
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+// value converts a CloudFormation value, evaluating intrinsic functions into Terraform expressions.
+func (c *converter) value(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 1 {
+			for fn, arg := range v {
+				if fn == cft.Ref || strings.HasPrefix(fn, "Fn::") {
+					return c.intrinsic(fn, arg)
+				}
+			}
+		}
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			converted, err := c.value(e)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := converted.(noValue); !ok {
+				out[k] = converted
+			}
+		}
+		return out, nil
+
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		nullable := false
+		for _, e := range v {
+			converted, err := c.value(e)
+			if err != nil {
+				return nil, err
+			}
+			switch converted := converted.(type) {
+			case noValue:
+				continue
+			case expression:
+				nullable = nullable || converted.nullable
+			}
+			out = append(out, converted)
+		}
+		if nullable {
+			// drop the elements that evaluated to AWS::NoValue
+			return expr("compact(" + toExpr(out) + ")"), nil
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+func (c *converter) values(v interface{}, fn string, n int) ([]interface{}, error) {
+	args, ok := v.([]interface{})
+	if !ok || len(args) != n {
+		return nil, fmt.Errorf("%s expects a list of %d arguments", fn, n)
+	}
+	out := make([]interface{}, 0, n)
+	for _, arg := range args {
+		converted, err := c.value(arg)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, converted)
+	}
+	return out, nil
+}
+
+func (c *converter) intrinsic(fn string, arg interface{}) (interface{}, error) {
+	switch fn {
+	case cft.Ref:
+		name, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("Ref expects a string, got %v", arg)
+		}
+		return c.ref(name)
+
+	case cft.FnGetAtt:
+		switch arg := arg.(type) {
+		case string:
+			logicalID, attribute, ok := strings.Cut(arg, ".")
+			if !ok {
+				return nil, fmt.Errorf("invalid Fn::GetAtt %q", arg)
+			}
+			return c.getAtt(logicalID, attribute)
+		case []interface{}:
+			if len(arg) == 2 {
+				logicalID, ok1 := arg[0].(string)
+				attribute, ok2 := arg[1].(string)
+				if ok1 && ok2 {
+					return c.getAtt(logicalID, attribute)
+				}
+			}
+		}
+		return nil, fmt.Errorf("Fn::GetAtt expects a resource and a literal attribute name, got %v", arg)
+
+	case cft.FnImportValue:
+		name, err := c.value(arg)
+		if err != nil {
+			return nil, err
+		}
+		exportName, ok := name.(string)
+		if !ok {
+			return nil, fmt.Errorf("Fn::ImportValue is only supported with literal export names, got %s", toExpr(name))
+		}
+		return c.importValue(exportName), nil
+
+	case cft.FnSub:
+		return c.sub(arg)
+
+	case cft.FnJoin:
+		args, err := c.values(arg, fn, 2)
+		if err != nil {
+			return nil, err
+		}
+		delimiter, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("Fn::Join expects a literal delimiter")
+		}
+		elems, ok := args[1].([]interface{})
+		if !ok {
+			return expr(fmt.Sprintf("join(%s, %s)", toExpr(delimiter), toExpr(args[1]))), nil
+		}
+		var parts []interface{}
+		for i, e := range elems {
+			if i > 0 {
+				parts = append(parts, delimiter)
+			}
+			parts = append(parts, e)
+		}
+		return concat(parts...), nil
+
+	case cft.FnSelect:
+		args, err := c.values(arg, fn, 2)
+		if err != nil {
+			return nil, err
+		}
+		if index, ok := args[0].(json.Number); ok {
+			if elems, ok := args[1].([]interface{}); ok {
+				i, err := index.Int64()
+				if err != nil || i < 0 || int(i) >= len(elems) {
+					return nil, fmt.Errorf("Fn::Select index %s is out of range", index)
+				}
+				return elems[i], nil
+			}
+		}
+		return expr(fmt.Sprintf("element(%s, %s)", toExpr(args[1]), toExpr(args[0]))), nil
+
+	case cft.FnSplit:
+		args, err := c.values(arg, fn, 2)
+		if err != nil {
+			return nil, err
+		}
+		delimiter, ok1 := args[0].(string)
+		source, ok2 := args[1].(string)
+		if ok1 && ok2 {
+			var out []interface{}
+			for _, s := range strings.Split(source, delimiter) {
+				out = append(out, s)
+			}
+			return out, nil
+		}
+		return expr(fmt.Sprintf("split(%s, %s)", toExpr(args[0]), toExpr(args[1]))), nil
+
+	case cft.FnGetAZs:
+		// the provider is configured for the region of the stack
+		return expr(c.useData("aws_availability_zones") + ".names"), nil
+
+	case cft.FnBase64:
+		value, err := c.value(arg)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := value.(string); ok {
+			return base64.StdEncoding.EncodeToString([]byte(s)), nil
+		}
+		return expr("base64encode(" + toExpr(value) + ")"), nil
+
+	case cft.FnFindInMap:
+		return c.findInMap(arg)
+
+	case cft.FnCIDR:
+		args, err := c.values(arg, fn, 3)
+		if err != nil {
+			return nil, err
+		}
+		block, count, bits := toExpr(args[0]), toExpr(args[1]), toExpr(args[2])
+		// Fn::Cidr takes the number of host bits of the subnets, cidrsubnet the number of bits added to the prefix
+		return expr(fmt.Sprintf(`[for i in range(%[2]s) : cidrsubnet(%[1]s, (length(regexall(":", %[1]s)) > 0 ? 128 : 32) - %[3]s - tonumber(split("/", %[1]s)[1]), i)]`,
+			block, count, bits)), nil
+
+	case cft.FnIf:
+		args, ok := arg.([]interface{})
+		if !ok || len(args) != 3 {
+			return nil, fmt.Errorf("Fn::If expects a condition and two values")
+		}
+		name, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("Fn::If expects a condition name")
+		}
+		condition, err := c.condition(name)
+		if err != nil {
+			return nil, err
+		}
+		whenTrue, err := c.value(args[1])
+		if err != nil {
+			return nil, err
+		}
+		whenFalse, err := c.value(args[2])
+		if err != nil {
+			return nil, err
+		}
+		_, nullTrue := whenTrue.(noValue)
+		_, nullFalse := whenFalse.(noValue)
+		return expression{
+			code:     fmt.Sprintf("%s ? %s : %s", condition, toExpr(whenTrue), toExpr(whenFalse)),
+			nullable: nullTrue || nullFalse,
+		}, nil
+	}
+	return nil, fmt.Errorf("intrinsic function %s is not supported", fn)
+}
+
+// ref converts a Ref to a parameter, a pseudo parameter or a resource.
+func (c *converter) ref(name string) (interface{}, error) {
+	switch name {
+	case cft.NoValue:
+		return noValue{}, nil
+	case cft.Partition:
+		return expr(c.useData("aws_partition") + ".partition"), nil
+	case "AWS::URLSuffix":
+		return expr(c.useData("aws_partition") + ".dns_suffix"), nil
+	case cft.Region:
+		return expr(c.useData("aws_region") + ".name"), nil
+	case cft.AccountID:
+		return expr(c.useData("aws_caller_identity") + ".account_id"), nil
+	case cft.StackName:
+		v := map[string]interface{}{
+			"type":        "string",
+			"description": "Name used in place of the CloudFormation stack name",
+		}
+		if c.stackName != "" {
+			v["default"] = c.stackName
+		}
+		c.variables[StackNameVariable] = v
+		return expr("var." + StackNameVariable), nil
+	}
+	if _, ok := c.template.Parameters[name]; ok {
+		return expr("var." + snakeCase(name)), nil
+	}
+	if res, ok := c.template.Resources[name]; ok {
+		m := c.mappings[res.Type]
+		if m.ref == "" {
+			return nil, fmt.Errorf("resources of type %s cannot be referenced", res.Type)
+		}
+		return expr(c.address(name) + "." + m.ref), nil
+	}
+	return nil, fmt.Errorf("unresolved reference %q", name)
+}
+
+func (c *converter) getAtt(logicalID, attribute string) (interface{}, error) {
+	res, ok := c.template.Resources[logicalID]
+	if !ok {
+		return nil, fmt.Errorf("Fn::GetAtt references unknown resource %q", logicalID)
+	}
+	if res.Type == "AWS::EC2::VPC" && attribute == "Ipv6CidrBlocks" {
+		return c.vpcIPv6CidrBlocks(logicalID)
+	}
+	m := c.mappings[res.Type]
+	tfAttribute, ok := m.attributes[attribute]
+	if !ok {
+		return nil, fmt.Errorf("attribute %s of %s is not supported", attribute, res.Type)
+	}
+	return expr(c.address(logicalID) + "." + tfAttribute), nil
+}
+
+// vpcIPv6CidrBlocks returns the IPv6 CIDR blocks of a VPC, which are associated with it by
+// AWS::EC2::VPCCidrBlock resources.
+func (c *converter) vpcIPv6CidrBlocks(vpcLogicalID string) (interface{}, error) {
+	var blocks []interface{}
+	for _, logicalID := range c.sortedResources("AWS::EC2::VPCCidrBlock") {
+		if refTarget(c.template.Resources[logicalID].Properties["VpcId"]) == vpcLogicalID {
+			blocks = append(blocks, expr(c.address(logicalID)+".ipv6_cidr_block"))
+		}
+	}
+	if len(blocks) == 0 {
+		return expr("[" + c.address(vpcLogicalID) + ".ipv6_cidr_block]"), nil
+	}
+	return blocks, nil
+}
+
+// importValue converts an Fn::ImportValue to a variable, as Terraform has no equivalent of stack exports.
+func (c *converter) importValue(exportName string) interface{} {
+	name := importVariablePrefix + strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(exportName), "_"), "_")
+	c.variables[name] = map[string]interface{}{
+		"type":        "string",
+		"description": fmt.Sprintf("Value of the CloudFormation export %q", exportName),
+	}
+	return expr("var." + name)
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// sub converts an Fn::Sub to a template.
+func (c *converter) sub(arg interface{}) (interface{}, error) {
+	var (
+		format    string
+		variables = map[string]interface{}{}
+	)
+	switch arg := arg.(type) {
+	case string:
+		format = arg
+	case []interface{}:
+		if len(arg) != 2 {
+			return nil, fmt.Errorf("Fn::Sub expects a string and a map of variables")
+		}
+		s, ok1 := arg[0].(string)
+		vars, ok2 := arg[1].(map[string]interface{})
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("Fn::Sub expects a string and a map of variables")
+		}
+		format = s
+		for k, v := range vars {
+			converted, err := c.value(v)
+			if err != nil {
+				return nil, err
+			}
+			variables[k] = converted
+		}
+	default:
+		return nil, fmt.Errorf("Fn::Sub expects a string, got %v", arg)
+	}
+
+	var parts []interface{}
+	for {
+		start := strings.Index(format, "${")
+		if start < 0 {
+			parts = append(parts, format)
+			break
+		}
+		end := strings.Index(format[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated variable in Fn::Sub string %q", format)
+		}
+		end += start
+		parts = append(parts, format[:start])
+		name := format[start+2 : end]
+		format = format[end+1:]
+
+		switch {
+		case strings.HasPrefix(name, "!"):
+			parts = append(parts, "${"+name[1:]+"}")
+			continue
+		case variables[name] != nil:
+			parts = append(parts, variables[name])
+			continue
+		}
+		var (
+			value interface{}
+			err   error
+		)
+		if logicalID, attribute, ok := strings.Cut(name, "."); ok {
+			value, err = c.getAtt(logicalID, attribute)
+		} else {
+			value, err = c.ref(name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, value)
+	}
+	return concat(parts...), nil
+}
+
+// findInMap looks up literal keys in the mappings of the template, and otherwise converts the
+// mapping to a local value.
+func (c *converter) findInMap(arg interface{}) (interface{}, error) {
+	args, err := c.values(arg, cft.FnFindInMap, 3)
+	if err != nil {
+		return nil, err
+	}
+	mapName, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("Fn::FindInMap expects a literal map name")
+	}
+	mapping, ok := c.template.Mappings[mapName]
+	if !ok {
+		return nil, fmt.Errorf("Fn::FindInMap references unknown mapping %q", mapName)
+	}
+	topKey, ok1 := args[1].(string)
+	secondKey, ok2 := args[2].(string)
+	if ok1 && ok2 {
+		value, ok := mapping[topKey][secondKey]
+		if !ok {
+			return nil, fmt.Errorf("mapping %q has no value for %q and %q", mapName, topKey, secondKey)
+		}
+		return value, nil
+	}
+
+	local := snakeCase(mapName)
+	values := map[string]interface{}{}
+	for k, v := range mapping {
+		values[k] = v
+	}
+	c.locals[local] = values
+	return expr(fmt.Sprintf("local.%s[%s][%s]", local, toExpr(args[1]), toExpr(args[2]))), nil
+}
+
+// condition converts a condition of the template to a local value and returns a reference to it.
+func (c *converter) condition(name string) (string, error) {
+	definition, ok := c.template.Conditions[name]
+	if !ok {
+		return "", fmt.Errorf("unknown condition %q", name)
+	}
+	local := snakeCase(name)
+	if _, ok := c.locals[local]; ok {
+		return "local." + local, nil
+	}
+	if c.conditions[name] {
+		return "", fmt.Errorf("condition %q references itself", name)
+	}
+	c.conditions[name] = true
+	defer delete(c.conditions, name)
+
+	code, err := c.conditionExpr(definition)
+	if err != nil {
+		return "", fmt.Errorf("converting condition %q: %w", name, err)
+	}
+	c.locals[local] = expr(code)
+	return "local." + local, nil
+}
+
+func (c *converter) conditionExpr(v interface{}) (string, error) {
+	if m, ok := v.(map[string]interface{}); ok && len(m) == 1 {
+		for fn, arg := range m {
+			switch fn {
+			case "Condition":
+				name, ok := arg.(string)
+				if !ok {
+					return "", fmt.Errorf("Condition expects a condition name")
+				}
+				return c.condition(name)
+
+			case cft.FnEquals:
+				args, err := c.values(arg, fn, 2)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("(%s == %s)", toExpr(args[0]), toExpr(args[1])), nil
+
+			case cft.FnNot:
+				args, ok := arg.([]interface{})
+				if !ok || len(args) != 1 {
+					return "", fmt.Errorf("Fn::Not expects a single condition")
+				}
+				code, err := c.conditionExpr(args[0])
+				if err != nil {
+					return "", err
+				}
+				return "!" + code, nil
+
+			case cft.FnAnd, cft.FnOr:
+				args, ok := arg.([]interface{})
+				if !ok || len(args) == 0 {
+					return "", fmt.Errorf("%s expects a list of conditions", fn)
+				}
+				operator := " && "
+				if fn == cft.FnOr {
+					operator = " || "
+				}
+				var codes []string
+				for _, a := range args {
+					code, err := c.conditionExpr(a)
+					if err != nil {
+						return "", err
+					}
+					codes = append(codes, code)
+				}
+				return "(" + strings.Join(codes, operator) + ")", nil
+			}
+		}
+	}
+	value, err := c.value(v)
+	if err != nil {
+		return "", err
+	}
+	return toExpr(value), nil
+}
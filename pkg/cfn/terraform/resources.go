@@ -0,0 +1,489 @@
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A resourceMapping describes how a CloudFormation resource type is converted to Terraform.
+type resourceMapping struct {
+	tfType string
+	// ref is the attribute a Ref to the resource resolves to
+	ref string
+	// attributes maps the attributes of Fn::GetAtt to Terraform attributes
+	attributes map[string]string
+	// renames maps property paths like "ResourcesVpcConfig.SubnetIds" to Terraform names;
+	// other properties are converted to snake_case
+	renames map[string]string
+	// verbatim lists the property paths whose keys are not converted, like labels
+	verbatim []string
+	// policies lists the properties holding JSON documents, which are encoded with jsonencode
+	policies []string
+	// convert handles the properties that have no direct equivalent; it may add more resources
+	convert func(c *converter, r *resource) error
+}
+
+// A resource is a CloudFormation resource being converted.
+type resource struct {
+	logicalID string
+	name      string
+	mapping   resourceMapping
+	// props holds the remaining CloudFormation properties, with intrinsic functions evaluated
+	props map[string]interface{}
+	// body holds the Terraform arguments
+	body map[string]interface{}
+	// skip is set when the resource is converted to other resources only
+	skip bool
+}
+
+func (r *resource) address() string {
+	return r.mapping.tfType + "." + r.name
+}
+
+// take removes a property and returns its value.
+func (r *resource) take(property string) (interface{}, bool) {
+	v, ok := r.props[property]
+	delete(r.props, property)
+	return v, ok
+}
+
+func (c *converter) convertResource(logicalID string) error {
+	res := c.template.Resources[logicalID]
+	if res.Condition != "" {
+		return fmt.Errorf("conditional resources are not supported")
+	}
+	props, err := c.value(res.Properties)
+	if err != nil {
+		return err
+	}
+	r := &resource{
+		logicalID: logicalID,
+		name:      c.names[logicalID],
+		mapping:   c.mappings[res.Type],
+		body:      map[string]interface{}{},
+	}
+	r.props, _ = props.(map[string]interface{})
+	if r.props == nil {
+		r.props = map[string]interface{}{}
+	}
+
+	if r.mapping.convert != nil {
+		if err := r.mapping.convert(c, r); err != nil {
+			return err
+		}
+	}
+	if r.skip {
+		return nil
+	}
+	for _, property := range r.mapping.policies {
+		if v, ok := r.take(property); ok {
+			r.body[c.rename(r.mapping, property, property)] = jsonencode(v)
+		}
+	}
+	for k, v := range r.props {
+		shaped, err := c.shape(r.mapping, k, v)
+		if err != nil {
+			return fmt.Errorf("property %s: %w", k, err)
+		}
+		r.body[c.rename(r.mapping, k, k)] = shaped
+	}
+
+	dependsOn, err := c.dependsOn(res.DependsOn)
+	if err != nil {
+		return err
+	}
+	if len(dependsOn) > 0 {
+		r.body["depends_on"] = dependsOn
+	}
+	_, err = c.addResource(r.mapping.tfType, r.name, r.body)
+	return err
+}
+
+func (c *converter) rename(m resourceMapping, path, key string) string {
+	if name, ok := m.renames[path]; ok {
+		return name
+	}
+	return snakeCase(key)
+}
+
+// shape converts the names of nested properties.
+func (c *converter) shape(m resourceMapping, path string, v interface{}) (interface{}, error) {
+	if path == "Tags" || strings.HasSuffix(path, ".Tags") {
+		return tags(v)
+	}
+	for _, p := range m.verbatim {
+		if p == path {
+			return v, nil
+		}
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			p := path + "." + k
+			shaped, err := c.shape(m, p, e)
+			if err != nil {
+				return nil, err
+			}
+			out[c.rename(m, p, k)] = shaped
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			shaped, err := c.shape(m, path, e)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, shaped)
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+// tags converts a list of Key/Value pairs to a map.
+func tags(v interface{}) (interface{}, error) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return v, nil
+	}
+	out := map[string]interface{}{}
+	for _, e := range list {
+		tag, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid tag %v", e)
+		}
+		key, ok := tag["Key"].(string)
+		if !ok {
+			return nil, fmt.Errorf("tag keys must be literal strings, got %s", toExpr(tag["Key"]))
+		}
+		out[key] = tag["Value"]
+	}
+	return out, nil
+}
+
+func jsonencode(v interface{}) interface{} {
+	if s, ok := v.(string); ok {
+		// already a JSON document
+		return s
+	}
+	return expr("jsonencode(" + toExpr(v) + ")")
+}
+
+func (c *converter) dependsOn(v interface{}) ([]interface{}, error) {
+	var logicalIDs []interface{}
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		logicalIDs = []interface{}{v}
+	case []interface{}:
+		logicalIDs = v
+	default:
+		return nil, fmt.Errorf("invalid DependsOn %v", v)
+	}
+	var addresses []interface{}
+	for _, l := range logicalIDs {
+		logicalID, ok := l.(string)
+		if _, exists := c.template.Resources[logicalID]; !ok || !exists {
+			return nil, fmt.Errorf("DependsOn references unknown resource %v", l)
+		}
+		addresses = append(addresses, c.address(logicalID))
+	}
+	return addresses, nil
+}
+
+// address returns the address of the Terraform resource a CloudFormation resource is converted to.
+func (c *converter) address(logicalID string) string {
+	return c.mappings[c.template.Resources[logicalID].Type].tfType + "." + c.names[logicalID]
+}
+
+func (c *converter) sortedResources(cfnType string) []string {
+	var logicalIDs []string
+	for logicalID, res := range c.template.Resources {
+		if res.Type == cfnType {
+			logicalIDs = append(logicalIDs, logicalID)
+		}
+	}
+	sort.Strings(logicalIDs)
+	return logicalIDs
+}
+
+// refTarget returns the logical ID a raw CloudFormation value refers to with Ref.
+func refTarget(v interface{}) string {
+	if m, ok := v.(map[string]interface{}); ok && len(m) == 1 {
+		if name, ok := m["Ref"].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+var resourceMappings = map[string]resourceMapping{
+	"AWS::EC2::VPC": {
+		tfType: "aws_vpc",
+		ref:    "id",
+		attributes: map[string]string{
+			"CidrBlock":            "cidr_block",
+			"DefaultSecurityGroup": "default_security_group_id",
+			"VpcId":                "id",
+		},
+	},
+	"AWS::EC2::VPCCidrBlock": {
+		tfType:     "aws_vpc_ipv6_cidr_block_association",
+		ref:        "id",
+		attributes: map[string]string{"Ipv6CidrBlock": "ipv6_cidr_block"},
+		renames:    map[string]string{"AmazonProvidedIpv6CidrBlock": "assign_generated_ipv6_cidr_block"},
+		convert:    convertVPCCidrBlock,
+	},
+	"AWS::EC2::Subnet": {
+		tfType: "aws_subnet",
+		ref:    "id",
+		attributes: map[string]string{
+			"AvailabilityZone": "availability_zone",
+			"CidrBlock":        "cidr_block",
+			"SubnetId":         "id",
+			"VpcId":            "vpc_id",
+		},
+		convert: convertSubnet,
+	},
+	"AWS::EC2::SubnetCidrBlock": {
+		// merged into the subnet, see convertSubnet
+		tfType:  "aws_subnet",
+		convert: skip,
+	},
+	"AWS::EC2::RouteTable": {
+		tfType: "aws_route_table",
+		ref:    "id",
+	},
+	"AWS::EC2::Route": {
+		tfType:  "aws_route",
+		ref:     "id",
+		renames: map[string]string{"EgressOnlyInternetGatewayId": "egress_only_gateway_id"},
+	},
+	"AWS::EC2::SubnetRouteTableAssociation": {
+		tfType: "aws_route_table_association",
+		ref:    "id",
+	},
+	"AWS::EC2::InternetGateway": {
+		tfType: "aws_internet_gateway",
+		ref:    "id",
+	},
+	"AWS::EC2::VPCGatewayAttachment": {
+		tfType: "aws_internet_gateway_attachment",
+		ref:    "id",
+	},
+	"AWS::EC2::EgressOnlyInternetGateway": {
+		tfType: "aws_egress_only_internet_gateway",
+		ref:    "id",
+	},
+	"AWS::EC2::EIP": {
+		tfType: "aws_eip",
+		ref:    "public_ip",
+		attributes: map[string]string{
+			"AllocationId": "allocation_id",
+			"PublicIp":     "public_ip",
+		},
+	},
+	"AWS::EC2::NatGateway": {
+		tfType: "aws_nat_gateway",
+		ref:    "id",
+	},
+	"AWS::EC2::VPCEndpoint": {
+		tfType:   "aws_vpc_endpoint",
+		ref:      "id",
+		policies: []string{"PolicyDocument"},
+		renames:  map[string]string{"PolicyDocument": "policy"},
+	},
+	"AWS::EC2::TransitGatewayAttachment": {
+		tfType: "aws_ec2_transit_gateway_vpc_attachment",
+		ref:    "id",
+	},
+	"AWS::EC2::SecurityGroup": {
+		tfType: "aws_security_group",
+		ref:    "id",
+		attributes: map[string]string{
+			"GroupId": "id",
+			"VpcId":   "vpc_id",
+		},
+		renames: map[string]string{
+			"GroupDescription": "description",
+			"GroupName":        "name",
+		},
+		convert: convertSecurityGroup,
+	},
+	"AWS::EC2::SecurityGroupIngress": {
+		tfType:  "aws_security_group_rule",
+		ref:     "id",
+		convert: convertSecurityGroupRule("ingress"),
+	},
+	"AWS::EC2::SecurityGroupEgress": {
+		tfType:  "aws_security_group_rule",
+		ref:     "id",
+		convert: convertSecurityGroupRule("egress"),
+	},
+	"AWS::EC2::LaunchTemplate": {
+		tfType: "aws_launch_template",
+		ref:    "id",
+		attributes: map[string]string{
+			"DefaultVersionNumber": "default_version",
+			"LatestVersionNumber":  "latest_version",
+			"LaunchTemplateId":     "id",
+		},
+		renames: map[string]string{
+			"LaunchTemplateName":       "name",
+			"SecurityGroupIds":         "vpc_security_group_ids",
+			"SecurityGroups":           "security_group_names",
+			"NetworkInterfaces.Groups": "security_groups",
+		},
+		convert: convertLaunchTemplate,
+	},
+	"AWS::EC2::PlacementGroup": {
+		tfType:  "aws_placement_group",
+		ref:     "name",
+		convert: convertPlacementGroup,
+	},
+
+	"AWS::IAM::Role": {
+		tfType: "aws_iam_role",
+		ref:    "name",
+		attributes: map[string]string{
+			"Arn":    "arn",
+			"RoleId": "unique_id",
+		},
+		renames: map[string]string{
+			"AssumeRolePolicyDocument": "assume_role_policy",
+			"RoleName":                 "name",
+		},
+		policies: []string{"AssumeRolePolicyDocument"},
+		convert:  convertRole,
+	},
+	"AWS::IAM::Policy": {
+		tfType:  "aws_iam_role_policy",
+		ref:     "id",
+		convert: convertPolicy,
+	},
+	"AWS::IAM::ManagedPolicy": {
+		tfType:     "aws_iam_policy",
+		ref:        "arn",
+		attributes: map[string]string{"PolicyArn": "arn"},
+		renames: map[string]string{
+			"ManagedPolicyName": "name",
+			"PolicyDocument":    "policy",
+		},
+		policies: []string{"PolicyDocument"},
+		convert:  convertManagedPolicy,
+	},
+	"AWS::IAM::InstanceProfile": {
+		tfType:     "aws_iam_instance_profile",
+		ref:        "name",
+		attributes: map[string]string{"Arn": "arn"},
+		renames:    map[string]string{"InstanceProfileName": "name"},
+		convert:    convertInstanceProfile,
+	},
+
+	"AWS::EKS::Cluster": {
+		tfType: "aws_eks_cluster",
+		ref:    "name",
+		attributes: map[string]string{
+			"Arn":                      "arn",
+			"CertificateAuthorityData": "certificate_authority[0].data",
+			"ClusterSecurityGroupId":   "vpc_config[0].cluster_security_group_id",
+			"Endpoint":                 "endpoint",
+			"Id":                       "cluster_id",
+			"OpenIdConnectIssuerUrl":   "identity[0].oidc[0].issuer",
+		},
+		renames: map[string]string{
+			"ResourcesVpcConfig": "vpc_config",
+		},
+		convert: convertCluster,
+	},
+	"AWS::EKS::Nodegroup": {
+		tfType: "aws_eks_node_group",
+		ref:    "id",
+		attributes: map[string]string{
+			"Arn":           "arn",
+			"ClusterName":   "cluster_name",
+			"NodegroupName": "node_group_name",
+		},
+		renames: map[string]string{
+			"NodegroupName":                     "node_group_name",
+			"NodeRole":                          "node_role_arn",
+			"Subnets":                           "subnet_ids",
+			"Taints":                            "taint",
+			"RemoteAccess.SourceSecurityGroups": "source_security_group_ids",
+		},
+		verbatim: []string{"Labels"},
+		convert:  convertNodegroup,
+	},
+	"AWS::EKS::AccessEntry": {
+		tfType:     "aws_eks_access_entry",
+		ref:        "id",
+		attributes: map[string]string{"AccessEntryArn": "access_entry_arn"},
+		renames:    map[string]string{"Username": "user_name"},
+		convert:    convertAccessEntry,
+	},
+
+	"AWS::AutoScaling::AutoScalingGroup": {
+		tfType: "aws_autoscaling_group",
+		ref:    "name",
+		renames: map[string]string{
+			"AutoScalingGroupName":                          "name",
+			"LaunchTemplate.LaunchTemplateId":               "id",
+			"LaunchTemplate.LaunchTemplateName":             "name",
+			"LoadBalancerNames":                             "load_balancers",
+			"MixedInstancesPolicy.LaunchTemplate.Overrides": "override",
+		},
+		convert: convertAutoScalingGroup,
+	},
+
+	"AWS::Events::Rule": {
+		tfType:     "aws_cloudwatch_event_rule",
+		ref:        "name",
+		attributes: map[string]string{"Arn": "arn"},
+		policies:   []string{"EventPattern"},
+		convert:    convertEventRule,
+	},
+	"AWS::SQS::Queue": {
+		tfType: "aws_sqs_queue",
+		ref:    "url",
+		attributes: map[string]string{
+			"Arn":       "arn",
+			"QueueName": "name",
+			"QueueUrl":  "url",
+		},
+		renames: map[string]string{
+			"MessageRetentionPeriod": "message_retention_seconds",
+			"QueueName":              "name",
+		},
+	},
+	"AWS::SQS::QueuePolicy": {
+		tfType:  "aws_sqs_queue_policy",
+		ref:     "id",
+		convert: convertQueuePolicy,
+	},
+
+	"AWS::RolesAnywhere::TrustAnchor": {
+		tfType: "aws_rolesanywhere_trust_anchor",
+		ref:    "id",
+		attributes: map[string]string{
+			"TrustAnchorArn": "arn",
+			"TrustAnchorId":  "id",
+		},
+	},
+	"AWS::RolesAnywhere::Profile": {
+		tfType: "aws_rolesanywhere_profile",
+		ref:    "id",
+		attributes: map[string]string{
+			"ProfileArn": "arn",
+			"ProfileId":  "id",
+		},
+	},
+}
+
+func skip(_ *converter, r *resource) error {
+	r.skip = true
+	return nil
+}
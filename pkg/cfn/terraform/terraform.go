@@ -0,0 +1,256 @@
+// Package terraform renders the CloudFormation templates of the resource sets in pkg/cfn/builder
+// as Terraform JSON configuration (https://developer.hashicorp.com/terraform/language/syntax/json)
+// with equivalent aws_* resources.
+//
+// CloudFormation intrinsic functions are mapped to Terraform references and functions:
+// Ref and Fn::GetAtt become references to the converted resources, pseudo parameters become
+// aws_partition, aws_region and aws_caller_identity data sources, and Fn::ImportValue becomes an
+// input variable that has to be set to the value the imported output has in the configuration
+// of the stack that exports it. Template parameters become variables, mappings and conditions
+// become locals and outputs become outputs.
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+const (
+	// StackNameVariable is the variable AWS::StackName is replaced with.
+	StackNameVariable = "stack_name"
+
+	importVariablePrefix = "import_"
+)
+
+// Render renders the template of a resource set as Terraform JSON, see Convert.
+func Render(rs builder.ResourceSetReader, stackName string) ([]byte, error) {
+	templateBody, err := rs.RenderJSON()
+	if err != nil {
+		return nil, err
+	}
+	return Convert(templateBody, stackName)
+}
+
+// Convert converts a CloudFormation template in JSON to Terraform JSON. stackName is the default
+// of the variable that replaces AWS::StackName, which has no default if stackName is empty.
+func Convert(templateBody []byte, stackName string) ([]byte, error) {
+	var t cfnTemplate
+	dec := json.NewDecoder(bytes.NewReader(templateBody))
+	dec.UseNumber()
+	if err := dec.Decode(&t); err != nil {
+		return nil, fmt.Errorf("parsing CloudFormation template: %w", err)
+	}
+
+	c := newConverter(t, stackName)
+	if err := c.convert(); err != nil {
+		return nil, err
+	}
+	return c.render()
+}
+
+type cfnTemplate struct {
+	Description string                                       `json:"Description"`
+	Parameters  map[string]cfnParameter                      `json:"Parameters"`
+	Mappings    map[string]map[string]map[string]interface{} `json:"Mappings"`
+	Conditions  map[string]interface{}                       `json:"Conditions"`
+	Resources   map[string]cfnResource                       `json:"Resources"`
+	Outputs     map[string]cfnOutput                         `json:"Outputs"`
+}
+
+type cfnParameter struct {
+	Type        string      `json:"Type"`
+	Default     interface{} `json:"Default"`
+	Description string      `json:"Description"`
+}
+
+type cfnResource struct {
+	Type       string                 `json:"Type"`
+	Properties map[string]interface{} `json:"Properties"`
+	DependsOn  interface{}            `json:"DependsOn"`
+	Condition  string                 `json:"Condition"`
+}
+
+type cfnOutput struct {
+	Description string      `json:"Description"`
+	Value       interface{} `json:"Value"`
+	Condition   string      `json:"Condition"`
+}
+
+type converter struct {
+	template  cfnTemplate
+	stackName string
+	mappings  map[string]resourceMapping
+	// names holds the Terraform names of the resources by logical ID
+	names map[string]string
+
+	variables map[string]interface{}
+	data      map[string]map[string]interface{}
+	locals    map[string]interface{}
+	resources map[string]map[string]interface{}
+	outputs   map[string]interface{}
+
+	// conditions holds the conditions that are being converted, to detect cycles
+	conditions map[string]bool
+}
+
+func newConverter(t cfnTemplate, stackName string) *converter {
+	return &converter{
+		template:   t,
+		stackName:  stackName,
+		mappings:   resourceMappings,
+		names:      map[string]string{},
+		variables:  map[string]interface{}{},
+		data:       map[string]map[string]interface{}{},
+		locals:     map[string]interface{}{},
+		resources:  map[string]map[string]interface{}{},
+		outputs:    map[string]interface{}{},
+		conditions: map[string]bool{},
+	}
+}
+
+func (c *converter) convert() error {
+	logicalIDs := make([]string, 0, len(c.template.Resources))
+	for logicalID := range c.template.Resources {
+		logicalIDs = append(logicalIDs, logicalID)
+	}
+	sort.Strings(logicalIDs)
+
+	taken := map[string]string{}
+	for _, logicalID := range logicalIDs {
+		res := c.template.Resources[logicalID]
+		m, ok := c.mappings[res.Type]
+		if !ok {
+			return fmt.Errorf("resource %q: CloudFormation type %q has no Terraform equivalent", logicalID, res.Type)
+		}
+		name := snakeCase(logicalID)
+		address := m.tfType + "." + name
+		if other, ok := taken[address]; ok {
+			return fmt.Errorf("resources %q and %q would both be named %q", other, logicalID, address)
+		}
+		taken[address] = logicalID
+		c.names[logicalID] = name
+	}
+
+	for name, p := range c.template.Parameters {
+		c.variables[snakeCase(name)] = variable(p)
+	}
+
+	for _, logicalID := range logicalIDs {
+		if err := c.convertResource(logicalID); err != nil {
+			return fmt.Errorf("converting resource %q: %w", logicalID, err)
+		}
+	}
+
+	for name, o := range c.template.Outputs {
+		if o.Condition != "" {
+			return fmt.Errorf("output %q: conditional outputs are not supported", name)
+		}
+		value, err := c.value(o.Value)
+		if err != nil {
+			return fmt.Errorf("converting output %q: %w", name, err)
+		}
+		output := map[string]interface{}{"value": value}
+		if o.Description != "" {
+			output["description"] = o.Description
+		}
+		c.outputs[name] = output
+	}
+	return nil
+}
+
+func variable(p cfnParameter) map[string]interface{} {
+	v := map[string]interface{}{}
+	switch {
+	case p.Type == "Number":
+		v["type"] = "number"
+	case p.Type == "List<Number>":
+		v["type"] = "list(number)"
+	case p.Type == "CommaDelimitedList" || strings.HasPrefix(p.Type, "List<"):
+		v["type"] = "list(string)"
+		if s, ok := p.Default.(string); ok {
+			p.Default = strings.Split(s, ",")
+		}
+	default:
+		v["type"] = "string"
+	}
+	if p.Default != nil {
+		v["default"] = p.Default
+	}
+	if p.Description != "" {
+		v["description"] = p.Description
+	}
+	return v
+}
+
+// addResource adds a Terraform resource and returns its address.
+func (c *converter) addResource(tfType, name string, body map[string]interface{}) (string, error) {
+	if c.resources[tfType] == nil {
+		c.resources[tfType] = map[string]interface{}{}
+	}
+	if _, ok := c.resources[tfType][name]; ok {
+		return "", fmt.Errorf("Terraform resource %s.%s is defined more than once", tfType, name)
+	}
+	c.resources[tfType][name] = body
+	return tfType + "." + name, nil
+}
+
+// useData declares a data source that takes no arguments.
+func (c *converter) useData(dataType string) string {
+	if c.data[dataType] == nil {
+		c.data[dataType] = map[string]interface{}{}
+	}
+	name := "current"
+	if dataType == "aws_availability_zones" {
+		name = "available"
+	}
+	c.data[dataType][name] = map[string]interface{}{}
+	return "data." + dataType + "." + name
+}
+
+func (c *converter) render() ([]byte, error) {
+	doc := struct {
+		Terraform map[string]interface{}            `json:"terraform"`
+		Variable  map[string]interface{}            `json:"variable,omitempty"`
+		Data      map[string]map[string]interface{} `json:"data,omitempty"`
+		Locals    interface{}                       `json:"locals,omitempty"`
+		Resource  interface{}                       `json:"resource,omitempty"`
+		Output    interface{}                       `json:"output,omitempty"`
+	}{
+		Terraform: map[string]interface{}{
+			"required_providers": map[string]interface{}{
+				"aws": map[string]interface{}{
+					"source": "hashicorp/aws",
+				},
+			},
+		},
+		Variable: c.variables,
+		Data:     c.data,
+	}
+	if len(c.locals) > 0 {
+		doc.Locals = finalize(c.locals)
+	}
+	if len(c.resources) > 0 {
+		resources := map[string]interface{}{}
+		for tfType, r := range c.resources {
+			resources[tfType] = finalize(r)
+		}
+		doc.Resource = resources
+	}
+	if len(c.outputs) > 0 {
+		doc.Output = finalize(c.outputs)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,11 @@
+package terraform_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestTerraform(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,283 @@
+package terraform_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/terraform"
+)
+
+type config struct {
+	Terraform map[string]interface{}                       `json:"terraform"`
+	Variable  map[string]map[string]interface{}            `json:"variable"`
+	Data      map[string]map[string]interface{}            `json:"data"`
+	Locals    map[string]interface{}                       `json:"locals"`
+	Resource  map[string]map[string]map[string]interface{} `json:"resource"`
+	Output    map[string]map[string]interface{}            `json:"output"`
+}
+
+func convert(template string) config {
+	out, err := terraform.Convert([]byte(template), "")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	var c config
+	ExpectWithOffset(1, json.Unmarshal(out, &c)).To(Succeed())
+	return c
+}
+
+var _ = Describe("Terraform", func() {
+	Describe("intrinsic functions", func() {
+		It("converts references to resources, parameters and pseudo parameters", func() {
+			c := convert(`{
+				"Parameters": {"ClusterName": {"Type": "String", "Default": "test"}},
+				"Resources": {
+					"VPC": {"Type": "AWS::EC2::VPC", "Properties": {"CidrBlock": "192.168.0.0/16"}},
+					"SubnetPublicA": {"Type": "AWS::EC2::Subnet", "Properties": {
+						"VpcId": {"Ref": "VPC"},
+						"CidrBlock": {"Fn::Select": [0, {"Fn::Cidr": [{"Fn::GetAtt": ["VPC", "CidrBlock"]}, 2, 13]}]},
+						"AvailabilityZone": {"Fn::Select": [1, {"Fn::GetAZs": ""}]},
+						"Tags": [
+							{"Key": "Name", "Value": {"Fn::Sub": "${AWS::StackName}/${ClusterName}-${AWS::Region}"}},
+							{"Key": "kubernetes.io/role/elb", "Value": "1"}
+						]
+					}}
+				},
+				"Outputs": {
+					"Subnets": {"Value": {"Fn::Join": [",", [{"Ref": "SubnetPublicA"}, {"Ref": "AWS::AccountId"}]]}},
+					"VPC": {"Value": {"Ref": "VPC"}, "Description": "the VPC"}
+				}
+			}`)
+
+			subnet := c.Resource["aws_subnet"]["subnet_public_a"]
+			Expect(subnet["vpc_id"]).To(Equal("${aws_vpc.vpc.id}"))
+			Expect(subnet["cidr_block"]).To(Equal(`${element([for i in range(2) : cidrsubnet(aws_vpc.vpc.cidr_block, (length(regexall(":", aws_vpc.vpc.cidr_block)) > 0 ? 128 : 32) - 13 - tonumber(split("/", aws_vpc.vpc.cidr_block)[1]), i)], 0)}`))
+			Expect(subnet["availability_zone"]).To(Equal("${element(data.aws_availability_zones.available.names, 1)}"))
+			Expect(subnet["tags"]).To(Equal(map[string]interface{}{
+				"Name":                   "${var.stack_name}/${var.cluster_name}-${data.aws_region.current.name}",
+				"kubernetes.io/role/elb": "1",
+			}))
+
+			Expect(c.Variable).To(HaveKeyWithValue("cluster_name", map[string]interface{}{"type": "string", "default": "test"}))
+			Expect(c.Variable).To(HaveKey(terraform.StackNameVariable))
+			Expect(c.Data).To(HaveKey("aws_availability_zones"))
+			Expect(c.Data).To(HaveKey("aws_region"))
+			Expect(c.Data).To(HaveKey("aws_caller_identity"))
+
+			Expect(c.Output["Subnets"]).To(Equal(map[string]interface{}{
+				"value": "${aws_subnet.subnet_public_a.id},${data.aws_caller_identity.current.account_id}",
+			}))
+			Expect(c.Output["VPC"]).To(Equal(map[string]interface{}{
+				"value":       "${aws_vpc.vpc.id}",
+				"description": "the VPC",
+			}))
+		})
+
+		It("defaults the stack name variable to the stack name", func() {
+			out, err := terraform.Convert([]byte(`{"Resources": {"IGW": {"Type": "AWS::EC2::InternetGateway", "Properties": {"Tags": [{"Key": "Name", "Value": {"Ref": "AWS::StackName"}}]}}}}`), "eksctl-test-cluster")
+			Expect(err).NotTo(HaveOccurred())
+			var c config
+			Expect(json.Unmarshal(out, &c)).To(Succeed())
+			Expect(c.Variable[terraform.StackNameVariable]).To(HaveKeyWithValue("default", "eksctl-test-cluster"))
+			Expect(c.Resource["aws_internet_gateway"]["igw"]["tags"]).To(Equal(map[string]interface{}{"Name": "${var.stack_name}"}))
+		})
+
+		It("converts imported values to variables", func() {
+			c := convert(`{
+				"Resources": {
+					"SG": {"Type": "AWS::EC2::SecurityGroup", "Properties": {
+						"GroupDescription": "nodes",
+						"VpcId": {"Fn::ImportValue": "eksctl-test-cluster::VPC"}
+					}}
+				}
+			}`)
+
+			Expect(c.Resource["aws_security_group"]["sg"]["vpc_id"]).To(Equal("${var.import_eksctl_test_cluster_vpc}"))
+			Expect(c.Variable["import_eksctl_test_cluster_vpc"]["description"]).To(ContainSubstring(`"eksctl-test-cluster::VPC"`))
+		})
+
+		It("resolves mappings, conditions and escapes literal template sequences", func() {
+			c := convert(`{
+				"Mappings": {"PartitionMap": {"aws": {"EC2": "ec2.amazonaws.com"}, "aws-cn": {"EC2": "ec2.amazonaws.com.cn"}}},
+				"Conditions": {"IsAWSPartition": {"Fn::Equals": [{"Ref": "AWS::Partition"}, "aws"]}},
+				"Resources": {
+					"Role": {"Type": "AWS::IAM::Role", "Properties": {
+						"AssumeRolePolicyDocument": {
+							"Statement": [{"Effect": "Allow", "Action": ["sts:AssumeRole"], "Principal": {"Service": [{"Fn::FindInMap": ["PartitionMap", {"Ref": "AWS::Partition"}, "EC2"]}]}}]
+						},
+						"ManagedPolicyArns": [
+							{"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/A"},
+							{"Fn::If": ["IsAWSPartition", {"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/B"}, {"Ref": "AWS::NoValue"}]}
+						],
+						"Description": {"Fn::Sub": "literal ${!Variable} and %{x}"},
+						"PermissionsBoundary": {"Ref": "AWS::NoValue"}
+					}}
+				}
+			}`)
+
+			role := c.Resource["aws_iam_role"]["role"]
+			Expect(role["assume_role_policy"]).To(Equal(`${jsonencode({"Statement" = [{"Action" = ["sts:AssumeRole"], "Effect" = "Allow", "Principal" = {"Service" = [local.partition_map[data.aws_partition.current.partition]["EC2"]]}}]})}`))
+			Expect(role["managed_policy_arns"]).To(Equal(`${compact(["arn:${data.aws_partition.current.partition}:iam::aws:policy/A", local.is_aws_partition ? "arn:${data.aws_partition.current.partition}:iam::aws:policy/B" : null])}`))
+			Expect(role["description"]).To(Equal("literal $${Variable} and %%{x}"))
+			Expect(role).NotTo(HaveKey("permissions_boundary"))
+
+			Expect(c.Locals).To(HaveKeyWithValue("is_aws_partition", `${(data.aws_partition.current.partition == "aws")}`))
+			Expect(c.Locals).To(HaveKey("partition_map"))
+		})
+
+		It("looks up mappings with literal keys", func() {
+			c := convert(`{
+				"Mappings": {"PartitionMap": {"aws": {"EC2": "ec2.amazonaws.com"}}},
+				"Resources": {
+					"Queue": {"Type": "AWS::SQS::Queue", "Properties": {"QueueName": {"Fn::FindInMap": ["PartitionMap", "aws", "EC2"]}, "MessageRetentionPeriod": 300}}
+				}
+			}`)
+
+			Expect(c.Resource["aws_sqs_queue"]["queue"]).To(Equal(map[string]interface{}{
+				"name":                      "ec2.amazonaws.com",
+				"message_retention_seconds": float64(300),
+			}))
+			Expect(c.Locals).To(BeEmpty())
+		})
+
+		It("fails on references it cannot resolve", func() {
+			_, err := terraform.Convert([]byte(`{"Resources": {"VPC": {"Type": "AWS::EC2::VPC", "Properties": {"CidrBlock": {"Ref": "Missing"}}}}}`), "")
+			Expect(err).To(MatchError(ContainSubstring(`unresolved reference "Missing"`)))
+
+			_, err = terraform.Convert([]byte(`{"Resources": {"VPC": {"Type": "AWS::EC2::VPC"}}, "Outputs": {"X": {"Value": {"Fn::GetAtt": ["VPC", "Ipv6Pool"]}}}}`), "")
+			Expect(err).To(MatchError(ContainSubstring("attribute Ipv6Pool of AWS::EC2::VPC is not supported")))
+		})
+	})
+
+	Describe("resources", func() {
+		It("converts security groups and their rules to aws_security_group_rule resources", func() {
+			c := convert(`{
+				"Resources": {
+					"SG": {"Type": "AWS::EC2::SecurityGroup", "Properties": {
+						"GroupDescription": "control plane",
+						"SecurityGroupIngress": [{"IpProtocol": "tcp", "FromPort": 443, "ToPort": 443, "CidrIp": "10.0.0.0/8"}]
+					}},
+					"IngressInterNodeGroupSG": {"Type": "AWS::EC2::SecurityGroupIngress", "Properties": {
+						"GroupId": {"Ref": "SG"}, "SourceSecurityGroupId": {"Ref": "SG"}, "IpProtocol": "-1", "FromPort": 0, "ToPort": 65535
+					}}
+				}
+			}`)
+
+			Expect(c.Resource["aws_security_group"]["sg"]).To(Equal(map[string]interface{}{"description": "control plane"}))
+			rules := c.Resource["aws_security_group_rule"]
+			Expect(rules).To(HaveLen(3))
+			Expect(rules["sg_ingress_0"]).To(Equal(map[string]interface{}{
+				"type":              "ingress",
+				"security_group_id": "${aws_security_group.sg.id}",
+				"protocol":          "tcp",
+				"from_port":         float64(443),
+				"to_port":           float64(443),
+				"cidr_blocks":       []interface{}{"10.0.0.0/8"},
+			}))
+			Expect(rules["sg_egress"]).To(HaveKeyWithValue("cidr_blocks", []interface{}{"0.0.0.0/0"}))
+			Expect(rules["ingress_inter_node_group_sg"]).To(Equal(map[string]interface{}{
+				"type":                     "ingress",
+				"security_group_id":        "${aws_security_group.sg.id}",
+				"source_security_group_id": "${aws_security_group.sg.id}",
+				"protocol":                 "-1",
+				"from_port":                float64(0),
+				"to_port":                  float64(0),
+			}))
+		})
+
+		It("attaches managed policies and inline policies to roles", func() {
+			c := convert(`{
+				"Resources": {
+					"Role": {"Type": "AWS::IAM::Role", "Properties": {"AssumeRolePolicyDocument": "{}"}},
+					"ManagedPolicy": {"Type": "AWS::IAM::ManagedPolicy", "Properties": {
+						"PolicyDocument": {"Statement": []},
+						"Roles": [{"Ref": "Role"}]
+					}},
+					"Policy": {"Type": "AWS::IAM::Policy", "Properties": {
+						"PolicyName": "inline",
+						"PolicyDocument": {"Statement": []},
+						"Roles": [{"Ref": "Role"}]
+					}},
+					"Profile": {"Type": "AWS::IAM::InstanceProfile", "Properties": {"Roles": [{"Ref": "Role"}]}}
+				}
+			}`)
+
+			Expect(c.Resource["aws_iam_policy"]["managed_policy"]).To(Equal(map[string]interface{}{"policy": `${jsonencode({"Statement" = []})}`}))
+			Expect(c.Resource["aws_iam_role_policy_attachment"]["managed_policy_0"]).To(Equal(map[string]interface{}{
+				"role":       "${aws_iam_role.role.name}",
+				"policy_arn": "${aws_iam_policy.managed_policy.arn}",
+			}))
+			Expect(c.Resource["aws_iam_role_policy"]["policy"]).To(Equal(map[string]interface{}{
+				"name":   "inline",
+				"policy": `${jsonencode({"Statement" = []})}`,
+				"role":   "${aws_iam_role.role.name}",
+			}))
+			Expect(c.Resource["aws_iam_instance_profile"]["profile"]).To(Equal(map[string]interface{}{"role": "${aws_iam_role.role.name}"}))
+		})
+
+		It("converts managed nodegroups with launch templates", func() {
+			c := convert(`{
+				"Resources": {
+					"LaunchTemplate": {"Type": "AWS::EC2::LaunchTemplate", "Properties": {
+						"LaunchTemplateName": {"Fn::Sub": "${AWS::StackName}"},
+						"LaunchTemplateData": {"SecurityGroupIds": ["sg-1"], "MetadataOptions": {"HttpTokens": "required"}}
+					}},
+					"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {
+						"ClusterName": "test",
+						"NodegroupName": "ng",
+						"NodeRole": "arn:aws:iam::123:role/node",
+						"Subnets": ["subnet-1"],
+						"Labels": {"alpha.eksctl.io/nodegroup-name": "ng"},
+						"Taints": [{"Key": "dedicated", "Effect": "NO_SCHEDULE"}],
+						"ScalingConfig": {"MinSize": 1, "MaxSize": 2, "DesiredSize": 1},
+						"LaunchTemplate": {"Id": {"Ref": "LaunchTemplate"}}
+					}}
+				}
+			}`)
+
+			Expect(c.Resource["aws_launch_template"]["launch_template"]).To(Equal(map[string]interface{}{
+				"name":                   "${var.stack_name}",
+				"vpc_security_group_ids": []interface{}{"sg-1"},
+				"metadata_options":       map[string]interface{}{"http_tokens": "required"},
+			}))
+			ng := c.Resource["aws_eks_node_group"]["managed_node_group"]
+			Expect(ng).To(HaveKeyWithValue("node_group_name", "ng"))
+			Expect(ng).To(HaveKeyWithValue("node_role_arn", "arn:aws:iam::123:role/node"))
+			Expect(ng).To(HaveKeyWithValue("subnet_ids", []interface{}{"subnet-1"}))
+			Expect(ng).To(HaveKeyWithValue("labels", map[string]interface{}{"alpha.eksctl.io/nodegroup-name": "ng"}))
+			Expect(ng).To(HaveKeyWithValue("taint", []interface{}{map[string]interface{}{"key": "dedicated", "effect": "NO_SCHEDULE"}}))
+			Expect(ng).To(HaveKeyWithValue("scaling_config", map[string]interface{}{"min_size": float64(1), "max_size": float64(2), "desired_size": float64(1)}))
+			Expect(ng).To(HaveKeyWithValue("launch_template", map[string]interface{}{
+				"id":      "${aws_launch_template.launch_template.id}",
+				"version": "${aws_launch_template.launch_template.default_version}",
+			}))
+		})
+
+		It("fails on resource types without a Terraform equivalent", func() {
+			_, err := terraform.Convert([]byte(`{"Resources": {"Custom": {"Type": "Custom::EksAccessEntry"}}}`), "")
+			Expect(err).To(MatchError(ContainSubstring(`resource "Custom": CloudFormation type "Custom::EksAccessEntry" has no Terraform equivalent`)))
+		})
+	})
+
+	It("renders IAM role resource sets", func() {
+		rs := builder.NewIAMRoleResourceSetForPodIdentity(&api.PodIdentityAssociation{
+			Namespace:            "default",
+			ServiceAccountName:   "app",
+			PermissionPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+		})
+		Expect(rs.AddAllResources()).To(Succeed())
+
+		out, err := terraform.Render(rs, "eksctl-test-podidentityrole-default-app")
+		Expect(err).NotTo(HaveOccurred())
+		var c config
+		Expect(json.Unmarshal(out, &c)).To(Succeed())
+
+		Expect(c.Resource["aws_iam_role"]).To(HaveKey("role1"))
+		role := c.Resource["aws_iam_role"]["role1"]
+		Expect(role["managed_policy_arns"]).To(ConsistOf("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"))
+		Expect(role["assume_role_policy"]).To(HavePrefix("${jsonencode("))
+		Expect(c.Output).To(HaveKeyWithValue("Role1", map[string]interface{}{"value": "${aws_iam_role.role1.arn}"}))
+	})
+})
@@ -0,0 +1,191 @@
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// An expression is a Terraform expression, written as "${...}" when it is the whole value
+// of an attribute.
+type expression struct {
+	code string
+	// nullable is set for conditional expressions that may evaluate to null
+	nullable bool
+}
+
+// A template is the concatenation of literal strings and expressions.
+type template []interface{}
+
+// noValue is the result of a reference to AWS::NoValue; the property it is assigned to is omitted.
+type noValue struct{}
+
+func expr(code string) expression {
+	return expression{code: code}
+}
+
+// escapeLiteral escapes the template sequences Terraform would otherwise interpret in a literal string.
+func escapeLiteral(s string) string {
+	s = strings.ReplaceAll(s, "${", "$${")
+	return strings.ReplaceAll(s, "%{", "%%{")
+}
+
+// quote returns s as a quoted HCL string, s being the contents of a template.
+func quote(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// finalize turns a converted value into the JSON value Terraform reads it from.
+func finalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return escapeLiteral(v)
+	case expression:
+		return "${" + v.code + "}"
+	case template:
+		return v.contents()
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			out = append(out, finalize(e))
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[k] = finalize(e)
+		}
+		return out
+	}
+	return v
+}
+
+// contents returns the template as the contents of a Terraform string template.
+func (t template) contents() string {
+	var sb strings.Builder
+	for _, part := range t {
+		switch part := part.(type) {
+		case string:
+			sb.WriteString(escapeLiteral(part))
+		case expression:
+			sb.WriteString("${" + part.code + "}")
+		}
+	}
+	return sb.String()
+}
+
+// toExpr returns the HCL expression of a converted value.
+func toExpr(v interface{}) string {
+	switch v := v.(type) {
+	case nil, noValue:
+		return "null"
+	case string:
+		return quote(escapeLiteral(v))
+	case expression:
+		return v.code
+	case template:
+		return quote(v.contents())
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	case []interface{}:
+		elems := make([]string, 0, len(v))
+		for _, e := range v {
+			elems = append(elems, toExpr(e))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]interface{}:
+		elems := make([]string, 0, len(v))
+		for _, k := range sortedKeys(v) {
+			elems = append(elems, quote(escapeLiteral(k))+" = "+toExpr(v[k]))
+		}
+		return "{" + strings.Join(elems, ", ") + "}"
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// concat joins literal strings, expressions and templates into a single value.
+func concat(parts ...interface{}) interface{} {
+	var t template
+	for _, part := range parts {
+		switch part := part.(type) {
+		case template:
+			t = append(t, part...)
+		case json.Number:
+			t = append(t, part.String())
+		case bool:
+			t = append(t, toExpr(part))
+		default:
+			t = append(t, part)
+		}
+	}
+
+	// merge adjacent literals
+	var merged template
+	for _, part := range t {
+		if s, ok := part.(string); ok && len(merged) > 0 {
+			if prev, ok := merged[len(merged)-1].(string); ok {
+				merged[len(merged)-1] = prev + s
+				continue
+			}
+		}
+		if s, ok := part.(string); ok && s == "" {
+			continue
+		}
+		merged = append(merged, part)
+	}
+
+	switch len(merged) {
+	case 0:
+		return ""
+	case 1:
+		switch merged[0].(type) {
+		case string, expression:
+			return merged[0]
+		}
+	}
+	return merged
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// snakeCase converts CloudFormation names like "VPCZoneIdentifier" or "TargetGroupARNs" to
+// the snake_case names Terraform uses.
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// a trailing "s" pluralises an acronym, as in "ARNs"
+			plural := nextLower && runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2]))
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower && !plural) {
+				sb.WriteRune('_')
+			}
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			r = '_'
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
@@ -26,7 +26,9 @@ var ClusterCreationNextDelay = func(attempts int) time.Duration {
 type NextDelay func(attempts int) time.Duration
 
 // WaitForStack waits for the cluster stack to reach a success or failure state, and returns the stack.
-func WaitForStack(ctx context.Context, cfnAPI awsapi.CloudFormation, stackID, stackName string, nextDelay NextDelay) (*types.Stack, error) {
+// onPoll, if set, is called with the polled stack every time the stack status is polled to report progress,
+// instead of logging that the stack is being waited on. The stack is nil if it could not be described.
+func WaitForStack(ctx context.Context, cfnAPI awsapi.CloudFormation, stackID, stackName string, nextDelay NextDelay, onPoll func(context.Context, *types.Stack)) (*types.Stack, error) {
 	var lastStack *types.Stack
 	waiter := &Waiter{
 		NextDelay: nextDelay,
@@ -35,7 +37,12 @@ func WaitForStack(ctx context.Context, cfnAPI awsapi.CloudFormation, stackID, st
 				err     error
 				success bool
 			)
-			lastStack, success, err = describeStackStatus(context.Background(), cfnAPI, stackID, stackName)
+			lastStack, success, err = describeStackStatus(context.Background(), cfnAPI, stackID)
+			if onPoll != nil {
+				onPoll(ctx, lastStack)
+			} else {
+				logger.Info("waiting for CloudFormation stack %q", stackName)
+			}
 			return success, err
 		},
 	}
@@ -51,8 +58,7 @@ func WaitForStack(ctx context.Context, cfnAPI awsapi.CloudFormation, stackID, st
 	return lastStack, nil
 }
 
-func describeStackStatus(ctx context.Context, cfnAPI awsapi.CloudFormation, stackID, stackName string) (*types.Stack, bool, error) {
-	logger.Info("waiting for CloudFormation stack %q", stackName)
+func describeStackStatus(ctx context.Context, cfnAPI awsapi.CloudFormation, stackID string) (*types.Stack, bool, error) {
 	output, err := cfnAPI.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackID),
 	})
@@ -0,0 +1,135 @@
+// Package apply provides the `eksctl apply` command
+package apply
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/apply"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type applyOptions struct {
+	prune bool
+	drain bool
+}
+
+// Command registers the apply command to the root command
+func Command(flagGrouping *cmdutils.FlagGrouping, rootCmd *cobra.Command) *cobra.Command {
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, applyCmd)
+	return rootCmd
+}
+
+func applyCmd(cmd *cmdutils.Cmd) {
+	applyCmdWithRunFunc(cmd, doApply)
+}
+
+func applyCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options applyOptions) error) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"apply",
+		"Reconcile a cluster with its ClusterConfig",
+		"Creates, updates and, with --prune, deletes nodegroups, addons, access entries, pod identity associations, "+
+			"Fargate profiles, identity providers and capabilities so that the cluster matches the given config file",
+	)
+
+	var options applyOptions
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return runFunc(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&options.prune, "prune", false, "Delete resources that exist in the cluster but are not defined in the config file, except for default addons")
+		fs.BoolVar(&options.drain, "drain", true, "Drain and cordon all nodes in nodegroups before they are pruned")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, true)
+}
+
+func doApply(cmd *cmdutils.Cmd, options applyOptions) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file")
+	}
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+
+	ctx := cmd.Context()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+
+	stateReader := &apply.StateReader{
+		ClusterName: cfg.Metadata.Name,
+		StackLister: stackManager,
+		EKSAPI:      ctl.AWSProvider.EKS(),
+	}
+	liveState, err := stateReader.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("reading state of cluster %q: %w", cfg.Metadata.Name, err)
+	}
+
+	changes, unsupportedUpdates := apply.SplitUnsupportedUpdates(apply.ComputeChanges(cfg, liveState, options.prune))
+	for _, c := range unsupportedUpdates {
+		logger.Warning("not checking %s %q for changes: updating existing resources of type %s is not supported, so differences between the config and the cluster are not applied", c.ResourceType, c.Name, c.ResourceType)
+	}
+	if len(changes) == 0 {
+		if len(unsupportedUpdates) > 0 {
+			logger.Info("no changes to apply to cluster %q", cfg.Metadata.Name)
+		} else {
+			logger.Info("cluster %q is up-to-date", cfg.Metadata.Name)
+		}
+		return nil
+	}
+
+	appliers, err := apply.NewAppliers(ctx, apply.ApplierOptions{
+		ClusterConfig:   cfg,
+		ClusterProvider: ctl,
+		StackManager:    stackManager,
+		ClientSet:       clientSet,
+		Cmd:             cmd,
+		WaitTimeout:     cmd.ProviderConfig.WaitTimeout,
+		Drain:           options.drain,
+	})
+	if err != nil {
+		return err
+	}
+	reconciler := &apply.Reconciler{Appliers: appliers}
+	taskTree, err := reconciler.PlanTasks(ctx, changes, cmd.Plan)
+	if err != nil {
+		return err
+	}
+
+	cmdutils.LogIntendedAction(ctx, cmd.Plan, "apply %d change(s) to cluster %q", len(changes), cfg.Metadata.Name)
+	taskTree.Context = ctx
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		logger.Warning("%d error(s) occurred while applying changes to cluster %q, you may wish to check CloudFormation console", len(errs), cfg.Metadata.Name)
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to apply changes to cluster %q", cfg.Metadata.Name)
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "applied %d change(s) to cluster %q", len(changes), cfg.Metadata.Name)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
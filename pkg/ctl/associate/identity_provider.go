@@ -1,7 +1,6 @@
 package associate
 
 import (
-	"context"
 	"errors"
 	"time"
 
@@ -60,7 +59,7 @@ func doAssociateIdentityProvider(cmd *cmdutils.Cmd, timeout time.Duration) error
 
 	cfg := cmd.ClusterConfig
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
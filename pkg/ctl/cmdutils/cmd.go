@@ -24,20 +24,48 @@ type Cmd struct {
 
 	ClusterConfigFile string
 
+	PlanOutput, PlanFormat string
+
+	// Resume is only used by commands that add the resume flag
+	Resume string
+
+	// RollbackOnInterrupt is only used by commands that add the rollback-on-interrupt flag
+	RollbackOnInterrupt bool
+
 	ProviderConfig api.ProviderConfig
 	ClusterConfig  *api.ClusterConfig
 
 	Include, Exclude []string
+
+	// Lint is only used by commands that add the lint flags
+	Lint LintOptions
+
+	// Templates is only used by commands that add the templates flags
+	Templates TemplatesOptions
+
+	// configSource annotates validation errors with their position in the config file
+	configSource *eks.ConfigSource
+}
+
+// Context returns the context of the command, which is cancelled when eksctl receives SIGINT or SIGTERM
+func (c *Cmd) Context() context.Context {
+	if c.CobraCommand != nil && c.CobraCommand.Context() != nil {
+		return c.CobraCommand.Context()
+	}
+	return context.Background()
 }
 
 // NewCtl performs common defaulting and validation and constructs a new
 // instance of eks.ClusterProvider, it may return an error if configuration
 // is invalid or region is not supported
 func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
-	ctl, err := eks.New(context.TODO(), &c.ProviderConfig, c.ClusterConfig)
+	ctl, err := eks.New(c.Context(), &c.ProviderConfig, c.ClusterConfig)
 	if err != nil {
 		return nil, err
 	}
+	if err := eks.ResolveReferences(c.Context(), ctl.AWSProvider, c.ClusterConfig); err != nil {
+		return nil, err
+	}
 
 	cvm, err := eks.NewClusterVersionsManager(ctl.AWSProvider.EKS())
 	if err != nil {
@@ -53,19 +81,43 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 	if err := c.InitializeClusterConfig(); err != nil {
 		return nil, err
 	}
+	if err := c.lintClusterConfig(cvm); err != nil {
+		return nil, err
+	}
 
 	if !ctl.IsSupportedRegion() {
 		return nil, ErrUnsupportedRegion(&c.ProviderConfig)
 	}
+	if ctl.StackCollectionOptions, err = c.Templates.StackCollectionOptions(c.ClusterConfig.Metadata); err != nil {
+		return nil, err
+	}
 
 	return ctl, nil
 }
 
 // InitializeClusterConfig validates and initializes the ClusterConfig.
 func (c *Cmd) InitializeClusterConfig() error {
+	// org defaults are merged into config files when they are loaded
+	if c.ClusterConfigFile == "" {
+		defaults, err := orgDefaults(c.CobraCommand)
+		if err != nil {
+			return err
+		}
+		var fields map[string]bool
+		if c.CobraCommand != nil {
+			fields = flagFields(c.CobraCommand.Flags(), c.ClusterConfig)
+		}
+		if err := defaults.ApplyTo(c.ClusterConfig, fields); err != nil {
+			if c.Validate {
+				return err
+			}
+			logger.Warning("ignoring validation error: %s", err.Error())
+		}
+	}
+
 	api.SetClusterConfigDefaults(c.ClusterConfig)
 
-	if err := api.ValidateClusterConfig(c.ClusterConfig); err != nil {
+	if err := c.configSource.Annotate(api.ValidateClusterConfig(c.ClusterConfig)); err != nil {
 		if c.Validate {
 			return err
 		}
@@ -73,7 +125,7 @@ func (c *Cmd) InitializeClusterConfig() error {
 	}
 
 	for i, ng := range c.ClusterConfig.NodeGroups {
-		if err := api.ValidateNodeGroup(i, ng, c.ClusterConfig); err != nil {
+		if err := c.configSource.Annotate(api.ValidateNodeGroup(i, ng, c.ClusterConfig)); err != nil {
 			if c.Validate {
 				return err
 			}
@@ -90,7 +142,7 @@ func (c *Cmd) InitializeClusterConfig() error {
 		if err := api.SetManagedNodeGroupDefaults(ng, c.ClusterConfig.Metadata, c.ClusterConfig.IsControlPlaneOnOutposts()); err != nil {
 			return err
 		}
-		if err := api.ValidateManagedNodeGroup(i, ng); err != nil {
+		if err := c.configSource.Annotate(api.ValidateManagedNodeGroup(i, ng)); err != nil {
 			return err
 		}
 	}
@@ -111,6 +163,9 @@ func (c *Cmd) NewProviderForExistingClusterHelper(ctx context.Context, standardi
 	if err != nil {
 		return nil, fmt.Errorf("could not create cluster provider from options: %w", err)
 	}
+	if err := eks.ResolveReferences(ctx, clusterProvider.AWSProvider, c.ClusterConfig); err != nil {
+		return nil, err
+	}
 	if !clusterProvider.IsSupportedRegion() {
 		return nil, ErrUnsupportedRegion(&c.ProviderConfig)
 	}
@@ -130,9 +185,15 @@ func (c *Cmd) NewProviderForExistingClusterHelper(ctx context.Context, standardi
 	if err := c.InitializeClusterConfig(); err != nil {
 		return nil, err
 	}
+	if err := c.lintClusterConfig(cvm); err != nil {
+		return nil, err
+	}
 	if c.ClusterConfig.IsControlPlaneOnOutposts() {
 		clusterProvider.AWSProvider = outposts.WrapClusterProvider(clusterProvider.AWSProvider)
 	}
+	if clusterProvider.StackCollectionOptions, err = c.Templates.StackCollectionOptions(c.ClusterConfig.Metadata); err != nil {
+		return nil, err
+	}
 	return clusterProvider, nil
 }
 
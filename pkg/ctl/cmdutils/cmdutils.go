@@ -1,6 +1,7 @@
 package cmdutils
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -14,6 +15,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -49,13 +51,27 @@ func AddPreRun(cmd *cobra.Command, newFn func(cmd *cobra.Command, args []string)
 	}
 }
 
-// LogIntendedAction calls logger.Info with appropriate prefix
-func LogIntendedAction(plan bool, msgFmt string, args ...interface{}) {
+// addPostRunE chains cmd.PostRunE handlers
+func addPostRunE(cmd *cobra.Command, newFn func(cmd *cobra.Command, args []string) error) {
+	currentFn := cmd.PostRunE
+	cmd.PostRunE = func(cmd *cobra.Command, args []string) error {
+		if currentFn != nil {
+			if err := currentFn(cmd, args); err != nil {
+				return err
+			}
+		}
+		return newFn(cmd, args)
+	}
+}
+
+// LogIntendedAction calls logger.Info with appropriate prefix, and records the action with the plan recorder of ctx
+func LogIntendedAction(ctx context.Context, plan bool, msgFmt string, args ...interface{}) {
 	prefix := "will "
 	if plan {
 		prefix = "(plan) would "
 	}
 	logger.Info(prefix+msgFmt, args...)
+	tasks.RecordAction(ctx, fmt.Sprintf(msgFmt, args...), tasks.Metadata{})
 }
 
 // LogCompletedAction calls logger.Success with appropriate prefix
@@ -82,7 +98,7 @@ func LogRegionAndVersionInfo(meta *api.ClusterMeta) {
 	}
 }
 
-// AddApproveFlag adds common `--approve` flag
+// AddApproveFlag adds common `--approve`, `--preview-change-sets`, `--plan-output` and `--plan-format` flags
 func AddApproveFlag(fs *pflag.FlagSet, cmd *Cmd) {
 	approve := fs.Bool("approve", !cmd.Plan, "Apply the changes")
 	AddPreRun(cmd.CobraCommand, func(cobraCmd *cobra.Command, args []string) {
@@ -90,6 +106,8 @@ func AddApproveFlag(fs *pflag.FlagSet, cmd *Cmd) {
 			cmd.Plan = !*approve
 		}
 	})
+	AddPreviewChangeSetsFlag(fs, cmd)
+	AddPlanOutputFlag(fs, cmd)
 }
 
 // GetNameArg tests to ensure there is only 1 name argument
@@ -111,6 +129,7 @@ func AddCommonFlagsForAWS(cmd *Cmd, p *api.ProviderConfig, addCfnOptions bool) {
 		if addCfnOptions {
 			fs.StringVar(&p.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
 			fs.BoolVar(&p.CloudFormationDisableRollback, "cfn-disable-rollback", false, "for debugging: If a stack fails, do not roll it back. Be careful, this may lead to unintentional resource consumption!")
+			fs.StringVar(&p.CloudFormationTemplateBucket, "cfn-template-bucket", "", "S3 bucket to upload CloudFormation templates too large to be passed inline to (defaults to the value of the EKSCTL_CFN_TEMPLATE_BUCKET environment variable, or a bucket managed by eksctl)")
 		}
 	})
 
@@ -123,6 +142,9 @@ func AddCommonFlagsForAWS(cmd *Cmd, p *api.ProviderConfig, addCfnOptions bool) {
 				}
 			}
 		}
+		if p.CloudFormationTemplateBucket == "" {
+			p.CloudFormationTemplateBucket = os.Getenv("EKSCTL_CFN_TEMPLATE_BUCKET")
+		}
 	})
 }
 
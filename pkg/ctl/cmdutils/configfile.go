@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"strconv"
@@ -25,7 +27,106 @@ import (
 
 // AddConfigFileFlag adds common --config-file flag
 func AddConfigFileFlag(fs *pflag.FlagSet, path *string) {
-	fs.StringVarP(path, "config-file", "f", "", "load configuration from a file (or stdin if set to '-')")
+	fs.VarP(&configFileValue{path: path}, "config-file", "f", "load configuration from a file (or stdin if set to '-'); repeat to merge overlays onto the first file")
+	fs.Bool("config-vars", false, "expand ${VAR} and ${VAR:-default} references in the config file from environment variables")
+	fs.String("config-vars-file", "", "file with KEY=VALUE lines used to expand ${VAR} references in the config file, taking precedence over environment variables; implies --config-vars")
+	fs.String("defaults-file", "", fmt.Sprintf("org defaults file merged into the config (defaults to $%s or ~/.eksctl/defaults.yaml if it exists)", eks.OrgDefaultsEnvName))
+}
+
+// orgDefaults loads the org defaults file passed with --defaults-file, set in $EKSCTL_DEFAULTS or found at
+// ~/.eksctl/defaults.yaml, or returns nil if there is none
+func orgDefaults(cmd *cobra.Command) (*eks.OrgDefaults, error) {
+	var file string
+	if cmd != nil {
+		if flag := cmd.Flag("defaults-file"); flag != nil {
+			file = flag.Value.String()
+		}
+	}
+	if file == "" {
+		file = os.Getenv(eks.OrgDefaultsEnvName)
+	}
+	if file == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil
+		}
+		file = filepath.Join(home, ".eksctl", "defaults.yaml")
+		if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+	}
+	return eks.LoadOrgDefaults(file)
+}
+
+// configVars returns the variables used to expand the config file, or nil if expansion was not enabled
+func configVars(cmd *cobra.Command) (*eks.ConfigVars, error) {
+	if cmd == nil {
+		return nil, nil
+	}
+	var (
+		enabled  bool
+		varsFile string
+	)
+	if flag := cmd.Flag("config-vars"); flag != nil {
+		enabled = flag.Value.String() == "true"
+	}
+	if flag := cmd.Flag("config-vars-file"); flag != nil {
+		varsFile = flag.Value.String()
+	}
+	if !enabled && varsFile == "" {
+		return nil, nil
+	}
+
+	vars := &eks.ConfigVars{}
+	if varsFile != "" {
+		values, err := eks.LoadConfigVarsFile(varsFile)
+		if err != nil {
+			return nil, err
+		}
+		vars.Values = values
+	}
+	return vars, nil
+}
+
+// configFileValue is a pflag.Value for --config-file which can be repeated;
+// the first file is the base config and any further files are overlays
+type configFileValue struct {
+	path     *string
+	overlays []string
+	set      bool
+}
+
+func (v *configFileValue) String() string {
+	return *v.path
+}
+
+func (v *configFileValue) Set(s string) error {
+	if !v.set {
+		*v.path = s
+		v.set = true
+		return nil
+	}
+	v.overlays = append(v.overlays, s)
+	return nil
+}
+
+func (v *configFileValue) Type() string {
+	return "string"
+}
+
+// configFileOverlays returns the overlay files passed with repeated --config-file flags
+func configFileOverlays(cmd *cobra.Command) []string {
+	if cmd == nil {
+		return nil
+	}
+	flag := cmd.Flag("config-file")
+	if flag == nil {
+		return nil
+	}
+	if v, ok := flag.Value.(*configFileValue); ok {
+		return v.overlays
+	}
+	return nil
 }
 
 // ClusterConfigLoader is an interface that loaders should implement
@@ -62,6 +163,7 @@ var (
 	commonCreateFlagsIncompatibleWithDryRun = []string{
 		"cfn-disable-rollback",
 		"cfn-role-arn",
+		"cfn-template-bucket",
 		"install-neuron-plugin",
 		"install-nvidia-plugin",
 		"profile",
@@ -123,12 +225,23 @@ func (l *commonClusterConfigLoader) Load() error {
 		return l.validateWithoutConfigFile()
 	}
 
-	var err error
+	vars, err := configVars(l.CobraCommand)
+	if err != nil {
+		return err
+	}
+	defaults, err := orgDefaults(l.CobraCommand)
+	if err != nil {
+		return err
+	}
 
 	// The reference to ClusterConfig should only be reassigned if ClusterConfigFile is specified
 	// because other parts of the code store the pointer locally and access it directly instead of via
 	// the Cmd reference
-	if l.ClusterConfig, err = eks.LoadConfigWithReader(l.ClusterConfigFile, l.configReader); err != nil {
+	if l.ClusterConfig, l.configSource, err = eks.LoadConfigWithSource(l.ClusterConfigFile, l.configReader, eks.LoadConfigOptions{
+		OverlayFiles: configFileOverlays(l.CobraCommand),
+		Vars:         vars,
+		Defaults:     defaults,
+	}); err != nil {
 		return err
 	}
 	meta := l.ClusterConfig.Metadata
@@ -154,7 +267,7 @@ func (l *commonClusterConfigLoader) Load() error {
 	}
 	l.ProviderConfig.Region = meta.Region
 
-	return l.validateWithConfigFile()
+	return l.configSource.Annotate(l.validateWithConfigFile())
 }
 
 func findChangedFlag(cmd *cobra.Command, flagNames []string) (string, bool) {
@@ -589,12 +702,18 @@ func NewCreateNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *filter.Node
 func validateUnsetNodeGroups(clusterConfig *api.ClusterConfig) error {
 	for i, ng := range clusterConfig.NodeGroups {
 		if ng == nil {
-			return fmt.Errorf("invalid ClusterConfig: nodeGroups[%d] is not set", i)
+			return &api.FieldError{
+				Path: fmt.Sprintf("nodeGroups[%d]", i),
+				Err:  fmt.Errorf("invalid ClusterConfig: nodeGroups[%d] is not set", i),
+			}
 		}
 	}
 	for i, ng := range clusterConfig.ManagedNodeGroups {
 		if ng == nil {
-			return fmt.Errorf("invalid ClusterConfig: managedNodeGroups[%d] is not set", i)
+			return &api.FieldError{
+				Path: fmt.Sprintf("managedNodeGroups[%d]", i),
+				Err:  fmt.Errorf("invalid ClusterConfig: managedNodeGroups[%d] is not set", i),
+			}
 		}
 	}
 	return nil
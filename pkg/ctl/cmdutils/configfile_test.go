@@ -1,6 +1,8 @@
 package cmdutils
 
 import (
+	"bytes"
+	"os"
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -140,7 +142,7 @@ var _ = Describe("cmdutils configfile", func() {
 					l.flagsIncompatibleWithConfigFile.Delete("name")
 
 					err := l.Load()
-					Expect(err).To(MatchError(ContainSubstring("error unmarshaling JSON: while decoding JSON: json: unknown field \"containerRuntime\"")))
+					Expect(err).To(MatchError(HavePrefix("test_data/managed-nodegroup-with-container-runtime.yaml:14:5: unknown field \"containerRuntime\"")))
 				})
 			})
 		})
@@ -212,7 +214,10 @@ var _ = Describe("cmdutils configfile", func() {
 				ProviderConfig:    api.ProviderConfig{},
 			}
 			params := &CreateClusterCmdParams{}
-			Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, params).Load()).To(MatchError("invalid ClusterConfig: nodeGroups[1] is not set"))
+			Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, params).Load()).To(MatchError("test_data/unset-nodegroups.yaml:11:4: invalid ClusterConfig: nodeGroups[1] is not set\n" +
+				"  10 |   - name: test\n" +
+				"  11 |   -\n" +
+				"     |    ^"))
 		})
 
 		When("using zones and node-zones together", func() {
@@ -649,6 +654,60 @@ var _ = Describe("cmdutils configfile", func() {
 		})
 	})
 
+	Describe("config vars", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = &Cmd{
+				CobraCommand:   newCmd(),
+				ClusterConfig:  api.NewClusterConfig(),
+				ProviderConfig: api.ProviderConfig{},
+			}
+			AddConfigFileFlag(cmd.CobraCommand.Flags(), &cmd.ClusterConfigFile)
+		})
+
+		It("should expand variables from the vars file and environment", func() {
+			GinkgoT().Setenv("CLUSTER_NAME", "env-cluster")
+			GinkgoT().Setenv("INSTANCE_TYPE", "m5.large")
+			varsFile := filepath.Join(GinkgoT().TempDir(), "vars.env")
+			Expect(os.WriteFile(varsFile, []byte("CLUSTER_NAME=vars-cluster\n"), 0o600)).To(Succeed())
+			Expect(cmd.CobraCommand.ParseFlags([]string{
+				"-f", filepath.Join("test_data", "cluster-with-vars.yaml"),
+				"--config-vars-file", varsFile,
+			})).To(Succeed())
+
+			Expect(NewMetadataLoader(cmd).Load()).To(Succeed())
+			cfg := cmd.ClusterConfig
+			Expect(cfg.Metadata.Name).To(Equal("vars-cluster"))
+			Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+			Expect(cfg.ManagedNodeGroups[0].InstanceType).To(Equal("m5.large"))
+
+			var out bytes.Buffer
+			Expect(PrintDryRunConfig(cfg, &out)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("name: vars-cluster"))
+			Expect(out.String()).To(ContainSubstring("instanceType: m5.large"))
+		})
+
+		It("should report unset variables", func() {
+			Expect(cmd.CobraCommand.ParseFlags([]string{
+				"-f", filepath.Join("test_data", "cluster-with-vars.yaml"),
+				"--config-vars",
+			})).To(Succeed())
+
+			err := NewMetadataLoader(cmd).Load()
+			Expect(err).To(MatchError(ContainSubstring("config file references unset variables without a default: CLUSTER_NAME, INSTANCE_TYPE")))
+		})
+
+		It("should not expand variables unless enabled", func() {
+			Expect(cmd.CobraCommand.ParseFlags([]string{
+				"-f", filepath.Join("test_data", "cluster-with-vars.yaml"),
+			})).To(Succeed())
+
+			Expect(NewMetadataLoader(cmd).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("${CLUSTER_NAME}"))
+		})
+	})
+
 	Describe("NewCreateIAMServiceAccountLoader", func() {
 		When("subject-pattern flag is used with config file", func() {
 			It("should return an error", func() {
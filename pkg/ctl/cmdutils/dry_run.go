@@ -7,10 +7,10 @@ import (
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
-// PrintDryRunConfig prints ClusterConfig for dry-run
+// PrintDryRunConfig prints ClusterConfig for dry-run, with the values resolved from references redacted
 func PrintDryRunConfig(clusterConfig *v1alpha5.ClusterConfig, writer io.Writer) error {
 	yamlPrinter := printers.NewYAMLPrinter()
-	return yamlPrinter.PrintObj(clusterConfig, writer)
+	return yamlPrinter.PrintObj(clusterConfig.Redacted(), writer)
 }
 
 // PrintNodeGroupDryRunConfig prints the dry-run config for nodegroups, omitting any cluster-wide defaults
@@ -20,6 +20,8 @@ func PrintNodeGroupDryRunConfig(clusterConfig *v1alpha5.ClusterConfig, writer io
 		Metadata:          clusterConfig.Metadata,
 		NodeGroups:        clusterConfig.NodeGroups,
 		ManagedNodeGroups: clusterConfig.ManagedNodeGroups,
+
+		ResolvedReferences: clusterConfig.ResolvedReferences,
 	}
 	return PrintDryRunConfig(output, writer)
 }
@@ -0,0 +1,43 @@
+package cmdutils_test
+
+import (
+	"bytes"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("dry-run config", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng"
+		mng.SSH = &api.NodeGroupSSH{Allow: api.Enabled(), PublicKey: aws.String("ssh-ed25519 AAAA")}
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+		cfg.ResolvedReferences = []api.ResolvedReference{
+			{Field: "managedNodeGroups[mng].ssh.publicKey", Reference: "ssm:/eksctl/ssh-key"},
+		}
+	})
+
+	DescribeTable("redacts values resolved from references", func(print func(*api.ClusterConfig, *bytes.Buffer) error) {
+		var out bytes.Buffer
+		Expect(print(cfg, &out)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("publicKey: ssm:/eksctl/ssh-key"))
+		Expect(out.String()).NotTo(ContainSubstring("ssh-ed25519"))
+		Expect(*cfg.ManagedNodeGroups[0].SSH.PublicKey).To(Equal("ssh-ed25519 AAAA"))
+	},
+		Entry("cluster config", func(c *api.ClusterConfig, out *bytes.Buffer) error {
+			return cmdutils.PrintDryRunConfig(c, out)
+		}),
+		Entry("nodegroup config", func(c *api.ClusterConfig, out *bytes.Buffer) error {
+			return cmdutils.PrintNodeGroupDryRunConfig(c, out)
+		}),
+	)
+})
@@ -0,0 +1,95 @@
+package cmdutils
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// flagFields returns the paths of the fields of clusterConfig that flags are bound to, e.g.
+// "managedNodeGroups[0].volumeSize", mapped to whether the flag was set on the command line.
+// Flags are matched to fields by the address of the variable they are bound to.
+func flagFields(flags *pflag.FlagSet, clusterConfig *api.ClusterConfig) map[string]bool {
+	type variable struct {
+		addr uintptr
+		kind reflect.Kind
+	}
+	paths := map[variable]string{}
+	var walk func(v reflect.Value, path string)
+	walk = func(v reflect.Value, path string) {
+		switch v.Kind() {
+		case reflect.Pointer:
+			if !v.IsNil() {
+				walk(v.Elem(), path)
+			}
+			return
+		case reflect.Struct:
+			t := v.Type()
+			for i := 0; i < t.NumField(); i++ {
+				field := t.Field(i)
+				name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+				if !field.IsExported() || name == "-" {
+					continue
+				}
+				fieldPath := path
+				switch {
+				case field.Anonymous && name == "":
+					// the fields of embedded structs, e.g. NodeGroupBase, are inlined
+				case path == "":
+					fieldPath = jsonName(name, field.Name)
+				default:
+					fieldPath = path + "." + jsonName(name, field.Name)
+				}
+				walk(v.Field(i), fieldPath)
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+		if v.CanAddr() && path != "" {
+			paths[variable{addr: v.UnsafeAddr(), kind: v.Kind()}] = path
+		}
+	}
+	walk(reflect.ValueOf(clusterConfig), "")
+
+	fields := map[string]bool{}
+	flags.VisitAll(func(f *pflag.Flag) {
+		addr, kind, ok := flagVariable(f.Value)
+		if !ok {
+			return
+		}
+		if path, ok := paths[variable{addr: addr, kind: kind}]; ok {
+			fields[path] = f.Changed
+		}
+	})
+	return fields
+}
+
+// flagVariable returns the address and kind of the variable a flag is bound to, for the flag types of pflag,
+// which either point at the variable or hold a pointer to it in their value field
+func flagVariable(value pflag.Value) (uintptr, reflect.Kind, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return 0, reflect.Invalid, false
+	}
+	if v.Elem().Kind() != reflect.Struct {
+		return v.Pointer(), v.Elem().Kind(), true
+	}
+	target := v.Elem().FieldByName("value")
+	if !target.IsValid() || target.Kind() != reflect.Pointer || target.IsNil() {
+		return 0, reflect.Invalid, false
+	}
+	return target.Pointer(), target.Type().Elem().Kind(), true
+}
+
+func jsonName(tagName, fieldName string) string {
+	if tagName != "" {
+		return tagName
+	}
+	return fieldName
+}
@@ -0,0 +1,35 @@
+package cmdutils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("flagFields", func() {
+	It("maps the fields of the config that flags are bound to to whether the flags were set", func() {
+		cfg := api.NewClusterConfig()
+		ng := api.NewManagedNodeGroup()
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, ng)
+
+		var region string
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.StringVar(&cfg.Metadata.Version, "version", cfg.Metadata.Version, "")
+		fs.StringVar(&region, "region", "", "")
+		fs.BoolVar(&ng.PrivateNetworking, "node-private-networking", false, "")
+		fs.StringSliceVar(&ng.AvailabilityZones, "node-zones", nil, "")
+		fs.IntVar(ng.VolumeSize, "node-volume-size", *ng.VolumeSize, "")
+		fs.BoolVar(ng.IAM.WithAddonPolicies.AutoScaler, "asg-access", false, "")
+		Expect(fs.Parse([]string{"--node-private-networking=false", "--node-zones=us-west-2a", "--asg-access", "--region=us-west-2"})).To(Succeed())
+
+		Expect(flagFields(fs, cfg)).To(Equal(map[string]bool{
+			"metadata.version":                                      false,
+			"managedNodeGroups[0].privateNetworking":                true,
+			"managedNodeGroups[0].availabilityZones":                true,
+			"managedNodeGroups[0].volumeSize":                       false,
+			"managedNodeGroups[0].iam.withAddonPolicies.autoScaler": true,
+		}))
+	})
+})
@@ -58,11 +58,23 @@ func (l *GitOpsConfigLoader) Load() error {
 		return ErrMustBeSet("--config-file/-f <file>")
 	}
 
+	vars, err := configVars(l.cmd.CobraCommand)
+	if err != nil {
+		return err
+	}
+	defaults, err := orgDefaults(l.cmd.CobraCommand)
+	if err != nil {
+		return err
+	}
+
 	// The reference to ClusterConfig should only be reassigned if ClusterConfigFile is specified
 	// because other parts of the code store the pointer locally and access it directly instead of via
 	// the Cmd reference
-	var err error
-	if l.cmd.ClusterConfig, err = eks.LoadConfigFromFile(l.cmd.ClusterConfigFile); err != nil {
+	if l.cmd.ClusterConfig, l.cmd.configSource, err = eks.LoadConfigWithSource(l.cmd.ClusterConfigFile, nil, eks.LoadConfigOptions{
+		OverlayFiles: configFileOverlays(l.cmd.CobraCommand),
+		Vars:         vars,
+		Defaults:     defaults,
+	}); err != nil {
 		return err
 	}
 
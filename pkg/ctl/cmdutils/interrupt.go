@@ -0,0 +1,163 @@
+package cmdutils
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/pflag"
+	"golang.org/x/term"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// AddRollbackOnInterruptFlag adds the `--rollback-on-interrupt` flag, which deletes the stacks created by the
+// command without asking if it is interrupted
+func AddRollbackOnInterruptFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.BoolVar(&cmd.RollbackOnInterrupt, "rollback-on-interrupt", false, "Delete the CloudFormation stacks created by the command, without asking, if it is interrupted with SIGINT or SIGTERM")
+}
+
+// StackDeleter deletes the stacks created by an interrupted run. Stacks are described before they are deleted,
+// as only stacks that bear the tags of the cluster can be deleted.
+type StackDeleter interface {
+	DescribeStack(ctx context.Context, s *manager.Stack) (*manager.Stack, error)
+	DeleteStackSync(ctx context.Context, s *manager.Stack) error
+}
+
+// HandleInterrupt returns err as is, unless the tasks run in ctx were interrupted by SIGINT or SIGTERM. In that
+// case, it offers to delete the stacks created by the tasks started in this run, or deletes them if
+// `--rollback-on-interrupt` is set, and lists what was left behind along with how to resume the run or clean up.
+func HandleInterrupt(ctx context.Context, cmd *Cmd, stackDeleter StackDeleter, err error) error {
+	if err == nil || !tasks.Interrupted(ctx) {
+		return err
+	}
+	journal := tasks.RunOptionsFrom(ctx).Journal
+	if journal == nil {
+		logger.Warning("the command was interrupted; resources it created may have been left behind")
+		return err
+	}
+
+	var stacks []tasks.JournalEntry
+	for _, e := range journal.Started() {
+		if e.Action == "create" && e.StackName != "" {
+			stacks = append(stacks, e)
+		}
+	}
+
+	deleted := map[string]bool{}
+	if len(stacks) > 0 && (cmd.RollbackOnInterrupt || confirmRollback(cmd, stacks)) {
+		// the context of the command has been cancelled, so stacks are deleted in a new one
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+		defer cancel()
+		// stacks are deleted in the reverse order they were created in, as later stacks may depend on earlier ones
+		for i := len(stacks) - 1; i >= 0; i-- {
+			stackName := stacks[i].StackName
+			logger.Info("deleting stack %q created by interrupted run %q", stackName, journal.RunID)
+			stack, err := stackDeleter.DescribeStack(ctx, &manager.Stack{StackName: aws.String(stackName)})
+			if err != nil {
+				if manager.IsStackDoesNotExistError(err) {
+					// the stack was never created, e.g. as the run was interrupted before its creation was requested
+					deleted[stackName] = true
+					continue
+				}
+				logger.Critical("failed to describe stack %q: %v", stackName, err)
+				continue
+			}
+			if err := stackDeleter.DeleteStackSync(ctx, stack); err != nil {
+				logger.Critical("failed to delete stack %q: %v", stackName, err)
+				continue
+			}
+			deleted[stackName] = true
+		}
+	}
+
+	printInterruptSummary(cmd, journal, stacks, deleted)
+	return fmt.Errorf("interrupted: %w", err)
+}
+
+// confirmRollback asks whether to delete stacks, when stdin is a terminal
+func confirmRollback(cmd *Cmd, stacks []tasks.JournalEntry) bool {
+	if cmd.CobraCommand == nil {
+		return false
+	}
+	in, ok := cmd.CobraCommand.InOrStdin().(*os.File)
+	if !ok || !term.IsTerminal(int(in.Fd())) {
+		return false
+	}
+	out := cmd.CobraCommand.ErrOrStderr()
+	fmt.Fprintf(out, "the following stacks were created by the interrupted run:\n")
+	for _, s := range stacks {
+		fmt.Fprintf(out, "  - %s (%s)\n", s.StackName, s.Status)
+	}
+	fmt.Fprintf(out, "delete them? [y/N]: ")
+	return readConfirmation(in)
+}
+
+func readConfirmation(in io.Reader) bool {
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && answer == "" {
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+func printInterruptSummary(cmd *Cmd, journal *tasks.Journal, stacks []tasks.JournalEntry, deleted map[string]bool) {
+	logger.Warning("run %q was interrupted", journal.RunID)
+
+	var leftBehind []tasks.JournalEntry
+	for _, s := range stacks {
+		if !deleted[s.StackName] {
+			leftBehind = append(leftBehind, s)
+			logger.Warning("left behind stack %q (%s): %s", s.StackName, s.Status, s.Description)
+		}
+	}
+	for _, e := range journal.Started() {
+		if e.StackName == "" && e.Status != tasks.TaskStatusCompleted {
+			logger.Warning("task did not complete (%s): %s", e.Status, e.Description)
+		}
+	}
+	if pending := journal.Counts()[tasks.TaskStatusPending]; pending > 0 {
+		logger.Info("%d task(s) were not started", pending)
+	}
+
+	if len(deleted) > 0 {
+		if len(leftBehind) == 0 {
+			logger.Info("all stacks created by the run were deleted; to start over, rerun the command without --resume")
+			return
+		}
+		logger.Info("some stacks created by the run were deleted, so the run cannot be resumed")
+	} else {
+		logger.Info("to resume the run, rerun the command with --resume=%s", journal.RunID)
+	}
+	for _, c := range cleanupCommands(cmd, leftBehind) {
+		logger.Info("to clean up, run '%s'", c)
+	}
+}
+
+// cleanupCommands returns the commands that delete the resources of stacks
+func cleanupCommands(cmd *Cmd, stacks []tasks.JournalEntry) []string {
+	meta := cmd.ClusterConfig.Metadata
+	var commands []string
+	for _, s := range stacks {
+		switch s.ResourceType {
+		case "cluster":
+			return []string{fmt.Sprintf("eksctl delete cluster --region=%s --name=%s", meta.Region, meta.Name)}
+		case "nodeGroup", "managedNodeGroup":
+			commands = append(commands, fmt.Sprintf("eksctl delete nodegroup --region=%s --cluster=%s --name=%s", meta.Region, meta.Name, s.ResourceName))
+		default:
+			commands = append(commands, fmt.Sprintf("aws cloudformation delete-stack --region=%s --stack-name=%s", meta.Region, s.StackName))
+		}
+	}
+	return commands
+}
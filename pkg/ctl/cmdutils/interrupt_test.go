@@ -0,0 +1,153 @@
+package cmdutils_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfn "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+type fakeStackDeleter struct {
+	deleted []string
+}
+
+func (d *fakeStackDeleter) DescribeStack(_ context.Context, s *manager.Stack) (*manager.Stack, error) {
+	return s, nil
+}
+
+func (d *fakeStackDeleter) DeleteStackSync(_ context.Context, s *manager.Stack) error {
+	d.deleted = append(d.deleted, aws.ToString(s.StackName))
+	return nil
+}
+
+var _ = Describe("HandleInterrupt", func() {
+	var (
+		ctx          context.Context
+		cmd          *cmdutils.Cmd
+		stackDeleter *fakeStackDeleter
+		errCreate    = errors.New("creating cluster was interrupted")
+	)
+
+	newStackTask := func(resourceType, name string, do func() error) *tasks.GenericTask {
+		return &tasks.GenericTask{
+			Description: "create " + name,
+			Metadata:    tasks.Metadata{Action: "create", ResourceType: resourceType, ResourceName: name, StackName: "eksctl-test-" + name},
+			Doer:        do,
+		}
+	}
+
+	// run runs the tasks of a cluster creation that is interrupted while the nodegroup stack is being created
+	run := func() {
+		var (
+			cancel context.CancelFunc
+			err    error
+		)
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		ctx, err = cmdutils.StartJournal(ctx, cmd)
+		Expect(err).NotTo(HaveOccurred())
+
+		taskTree := &tasks.TaskTree{Context: ctx}
+		taskTree.Append(
+			newStackTask("cluster", "cluster", func() error { return nil }),
+			newStackTask("nodeGroup", "ng-1", func() error {
+				cancel()
+				return ctx.Err()
+			}),
+			newStackTask("nodeGroup", "ng-2", func() error { return nil }),
+		)
+		Expect(taskTree.DoAllSync()).To(HaveLen(1))
+	}
+
+	BeforeEach(func() {
+		GinkgoT().Setenv(tasks.StateDirEnvName, GinkgoT().TempDir())
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		cfg.Metadata.Region = "us-west-2"
+		cobraCmd := &cobra.Command{Use: "cluster"}
+		cobraCmd.SetIn(&bytes.Buffer{})
+		cmd = &cmdutils.Cmd{
+			CobraCommand:   cobraCmd,
+			ClusterConfig:  cfg,
+			ProviderConfig: api.ProviderConfig{WaitTimeout: time.Minute},
+		}
+		stackDeleter = &fakeStackDeleter{}
+		ctx = context.Background()
+	})
+
+	It("returns errors as is when the run was not interrupted", func() {
+		Expect(cmdutils.HandleInterrupt(ctx, cmd, stackDeleter, errCreate)).To(Equal(errCreate))
+		Expect(cmdutils.HandleInterrupt(ctx, cmd, stackDeleter, nil)).To(Succeed())
+	})
+
+	It("leaves the stacks created by the run behind unless asked to delete them", func() {
+		run()
+		Expect(cmdutils.HandleInterrupt(ctx, cmd, stackDeleter, errCreate)).To(MatchError("interrupted: creating cluster was interrupted"))
+		Expect(stackDeleter.deleted).To(BeEmpty())
+	})
+
+	It("deletes the stacks created by the run, in reverse order, with --rollback-on-interrupt", func() {
+		cmd.RollbackOnInterrupt = true
+		run()
+		Expect(cmdutils.HandleInterrupt(ctx, cmd, stackDeleter, errCreate)).To(MatchError(errCreate))
+		Expect(stackDeleter.deleted).To(Equal([]string{"eksctl-test-ng-1", "eksctl-test-cluster"}))
+	})
+
+	It("deletes the stacks created by the run as described by CloudFormation", func() {
+		cmd.RollbackOnInterrupt = true
+		run()
+
+		p := mockprovider.NewMockProvider()
+		stackID := func(stackName string) string {
+			return "arn:aws:cloudformation:us-west-2:123456789012:stack/" + stackName + "/id"
+		}
+		withStackName := func(stackName string) interface{} {
+			return mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+				return aws.ToString(input.StackName) == stackName || aws.ToString(input.StackName) == stackID(stackName)
+			})
+		}
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, withStackName("eksctl-test-ng-1")).Return(nil, &smithy.OperationError{
+			ServiceID:     "CloudFormation",
+			OperationName: "DescribeStacks",
+			Err:           errors.New("ValidationError: Stack with id eksctl-test-ng-1 does not exist"),
+		})
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, withStackName("eksctl-test-cluster")).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{
+				StackName:                   aws.String("eksctl-test-cluster"),
+				StackId:                     aws.String(stackID("eksctl-test-cluster")),
+				StackStatus:                 types.StackStatusCreateComplete,
+				EnableTerminationProtection: aws.Bool(true),
+				Tags:                        []types.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test")}},
+			}},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything, withStackName("eksctl-test-cluster"), mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []types.Stack{{StackName: aws.String("eksctl-test-cluster"), StackStatus: types.StackStatusDeleteComplete}},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cfn.DescribeStackEventsOutput{}, nil)
+		p.MockCloudFormation().On("UpdateTerminationProtection", mock.Anything, mock.MatchedBy(func(input *cfn.UpdateTerminationProtectionInput) bool {
+			return aws.ToString(input.StackName) == stackID("eksctl-test-cluster")
+		})).Return(&cfn.UpdateTerminationProtectionOutput{}, nil)
+		p.MockCloudFormation().On("DeleteStack", mock.Anything, mock.MatchedBy(func(input *cfn.DeleteStackInput) bool {
+			return aws.ToString(input.StackName) == stackID("eksctl-test-cluster")
+		})).Return(&cfn.DeleteStackOutput{}, nil)
+
+		Expect(cmdutils.HandleInterrupt(ctx, cmd, manager.NewStackCollection(p, cmd.ClusterConfig), errCreate)).To(MatchError(errCreate))
+		p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "UpdateTerminationProtection", 1)
+		p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DeleteStack", 1)
+	})
+})
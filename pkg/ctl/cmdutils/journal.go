@@ -0,0 +1,63 @@
+package cmdutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// AddResumeFlag adds the `--resume` flag, which resumes an interrupted run of the command
+func AddResumeFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.StringVar(&cmd.Resume, "resume", "", "Resume the run with the given ID, skipping the tasks it completed (see 'eksctl utils show-run'); "+
+		"only runs of 'eksctl create cluster' and 'eksctl create nodegroup' are recorded and can be resumed")
+}
+
+// StartJournal returns a copy of ctx whose tasks are recorded in the journal of a new run, or of the run passed to
+// `--resume`. Tasks are only recorded for commands run from the CLI.
+func StartJournal(ctx context.Context, cmd *Cmd) (context.Context, error) {
+	if cmd.CobraCommand == nil {
+		return ctx, nil
+	}
+	dir, err := tasks.JournalDir()
+	if err != nil {
+		if cmd.Resume != "" {
+			return nil, err
+		}
+		logger.Warning("tasks will not be recorded: %v", err)
+		return ctx, nil
+	}
+
+	command := cmd.CobraCommand.CommandPath()
+	cluster := cmd.ClusterConfig.Metadata.Name
+	var journal *tasks.Journal
+	if cmd.Resume == "" {
+		journal = tasks.NewJournal(dir, command, cluster)
+	} else {
+		if journal, err = tasks.LoadJournal(dir, cmd.Resume); err != nil {
+			return nil, err
+		}
+		if journal.Command != command || journal.Cluster != cluster {
+			return nil, fmt.Errorf("run %q of %q for cluster %q cannot be resumed by %q for cluster %q",
+				journal.RunID, journal.Command, journal.Cluster, command, cluster)
+		}
+		logger.Info("resuming run %q, skipping %d completed task(s)", journal.RunID, journal.Counts()[tasks.TaskStatusCompleted])
+	}
+	options := tasks.RunOptionsFrom(ctx)
+	options.Journal = journal
+	return tasks.WithRunOptions(ctx, options), nil
+}
+
+// CompleteJournal removes the journal of the run of ctx, if any, once the command has succeeded.
+func CompleteJournal(ctx context.Context) {
+	journal := tasks.RunOptionsFrom(ctx).Journal
+	if journal == nil {
+		return
+	}
+	if err := journal.Remove(); err != nil {
+		logger.Warning("%v", err)
+	}
+}
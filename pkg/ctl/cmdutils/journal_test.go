@@ -0,0 +1,72 @@
+package cmdutils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("journal", func() {
+	var cmd *cmdutils.Cmd
+
+	run := func() string {
+		ctx, err := cmdutils.StartJournal(context.Background(), cmd)
+		Expect(err).NotTo(HaveOccurred())
+		taskTree := &tasks.TaskTree{Context: ctx}
+		taskTree.Append(&tasks.GenericTask{
+			Description: "create cluster",
+			Metadata:    tasks.Metadata{Action: "create", ResourceType: "cluster"},
+			Doer:        func() error { return nil },
+		})
+		Expect(taskTree.DoAllSync()).To(BeEmpty())
+
+		dir, err := tasks.JournalDir()
+		Expect(err).NotTo(HaveOccurred())
+		journals, err := tasks.ListJournals(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(journals).NotTo(BeEmpty())
+		return journals[0].RunID
+	}
+
+	BeforeEach(func() {
+		GinkgoT().Setenv(tasks.StateDirEnvName, GinkgoT().TempDir())
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		cmd = &cmdutils.Cmd{
+			CobraCommand:  &cobra.Command{Use: "cluster"},
+			ClusterConfig: cfg,
+		}
+	})
+
+	It("resumes a run of the same command for the same cluster", func() {
+		cmd.Resume = run()
+		ctx, err := cmdutils.StartJournal(context.Background(), cmd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.IsResumingRun(ctx)).To(BeTrue())
+	})
+
+	It("refuses to resume a run for another cluster", func() {
+		cmd.Resume = run()
+		cmd.ClusterConfig.Metadata.Name = "other"
+		_, err := cmdutils.StartJournal(context.Background(), cmd)
+		Expect(err).To(MatchError(ContainSubstring(`cannot be resumed by "cluster" for cluster "other"`)))
+	})
+
+	It("fails to resume a run that does not exist", func() {
+		cmd.Resume = "20261017T092145Z-3f9a1c"
+		_, err := cmdutils.StartJournal(context.Background(), cmd)
+		Expect(err).To(MatchError(ContainSubstring(`no run "20261017T092145Z-3f9a1c" found`)))
+	})
+
+	It("fails to resume a run whose ID is invalid", func() {
+		cmd.Resume = "../unknown"
+		_, err := cmdutils.StartJournal(context.Background(), cmd)
+		Expect(err).To(MatchError(ContainSubstring(`invalid run ID "../unknown"`)))
+	})
+})
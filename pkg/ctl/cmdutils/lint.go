@@ -0,0 +1,70 @@
+package cmdutils
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/lint"
+)
+
+// LintOptions holds the options for linting a ClusterConfig before it is acted upon.
+type LintOptions struct {
+	// Enabled makes the command fail on error-level findings
+	Enabled bool
+	// RulesFile holds additional CEL rules, and implies Enabled
+	RulesFile string
+}
+
+// AddLintFlags adds flags that lint the ClusterConfig before the command makes any changes.
+func AddLintFlags(fs *pflag.FlagSet, options *LintOptions) {
+	fs.BoolVar(&options.Enabled, "lint", false, "Fail if the config has error-level findings of the rules used by `eksctl utils lint-config`")
+	fs.StringVar(&options.RulesFile, "lint-rules", "", "A file with additional CEL rules to lint the config against, implies --lint")
+}
+
+// LintClusterConfig checks clusterConfig against the built-in rules and those in rulesFile, if set;
+// the Kubernetes version is only checked if versionsManager is set.
+func LintClusterConfig(clusterConfig *api.ClusterConfig, versionsManager eks.ClusterVersionsManagerInterface, rulesFile string) ([]lint.Finding, error) {
+	rules := lint.BuiltInRules(versionsManager)
+	if rulesFile != "" {
+		fileRules, err := lint.LoadRules(rulesFile)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, fileRules...)
+	}
+	return lint.Lint(clusterConfig, rules)
+}
+
+// lintClusterConfig logs the lint findings of the ClusterConfig, and returns an error if there are
+// error-level findings.
+func (c *Cmd) lintClusterConfig(versionsManager eks.ClusterVersionsManagerInterface) error {
+	if !c.Lint.Enabled && c.Lint.RulesFile == "" {
+		return nil
+	}
+	findings, err := LintClusterConfig(c.ClusterConfig, versionsManager, c.Lint.RulesFile)
+	if err != nil {
+		return err
+	}
+	for _, f := range findings {
+		msg := fmt.Sprintf("%s: %s (%s)", f.Path, f.Message, f.RuleID)
+		if f.Fix != "" {
+			msg += ", fix: " + f.Fix
+		}
+		switch f.Severity {
+		case lint.SeverityError:
+			logger.Critical(msg)
+		case lint.SeverityWarning:
+			logger.Warning(msg)
+		default:
+			logger.Info(msg)
+		}
+	}
+	if count := lint.CountErrors(findings); count > 0 {
+		return fmt.Errorf("config has %d error-level lint finding(s)", count)
+	}
+	return nil
+}
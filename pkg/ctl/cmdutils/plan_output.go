@@ -0,0 +1,99 @@
+package cmdutils
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// AddPlanOutputFlag adds the `--plan-output` flag, which writes the tasks, actions and
+// CloudFormation change sets of the command to a file, and the `--plan-format` flag; it wraps
+// the RunE of the command, so it must be added once RunE is set
+func AddPlanOutputFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.StringVar(&cmd.PlanOutput, "plan-output", "", "Write a machine-readable plan of all tasks and changes to the given file")
+	cmd.PlanFormat = tasks.PlanFormatJSON
+	fs.Var(&planFormatValue{format: &cmd.PlanFormat}, "plan-format", fmt.Sprintf("Format of the plan written with --plan-output; dot and mermaid render the order of tasks as a graph (valid options: %s)", strings.Join(tasks.PlanFormats, ", ")))
+
+	recorder := &tasks.PlanRecorder{}
+	AddPreRun(cmd.CobraCommand, func(c *cobra.Command, _ []string) {
+		if cmd.PlanOutput == "" {
+			return
+		}
+		options := tasks.RunOptionsFrom(cmd.Context())
+		options.PlanRecorder = recorder
+		c.SetContext(tasks.WithRunOptions(cmd.Context(), options))
+	})
+
+	// the plan is written once the command has run, including when it fails, as PostRunE is not run then
+	runE := cmd.CobraCommand.RunE
+	if runE == nil {
+		return
+	}
+	cmd.CobraCommand.RunE = func(c *cobra.Command, args []string) error {
+		err := runE(c, args)
+		if cmd.PlanOutput == "" {
+			return err
+		}
+		if writeErr := writePlanOutput(recorder, cmd.PlanOutput, cmd.PlanFormat); writeErr != nil {
+			if err == nil {
+				return writeErr
+			}
+			logger.Warning(writeErr.Error())
+		}
+		return err
+	}
+}
+
+// AddPreviewChangeSetsFlag adds the `--preview-change-sets` flag, which makes plan mode create CloudFormation change
+// sets to list the changes to stack resources
+func AddPreviewChangeSetsFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	previewChangeSets := fs.Bool("preview-change-sets", false, "Without --approve, create a CloudFormation change set for each stack update to list the changes to its resources, and delete it afterwards")
+	AddPreRun(cmd.CobraCommand, func(c *cobra.Command, _ []string) {
+		if *previewChangeSets {
+			options := tasks.RunOptionsFrom(cmd.Context())
+			options.PreviewChangeSets = true
+			c.SetContext(tasks.WithRunOptions(cmd.Context(), options))
+		}
+	})
+}
+
+// planFormatValue is a pflag.Value for --plan-format that rejects unknown formats
+type planFormatValue struct {
+	format *string
+}
+
+func (v *planFormatValue) String() string {
+	return *v.format
+}
+
+func (v *planFormatValue) Set(s string) error {
+	if !slices.Contains(tasks.PlanFormats, s) {
+		return fmt.Errorf("unknown plan format %q (valid options: %s)", s, strings.Join(tasks.PlanFormats, ", "))
+	}
+	*v.format = s
+	return nil
+}
+
+func (v *planFormatValue) Type() string {
+	return "string"
+}
+
+func writePlanOutput(recorder *tasks.PlanRecorder, path, format string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating plan output file: %w", err)
+	}
+	defer f.Close()
+	if err := recorder.Write(f, format); err != nil {
+		return fmt.Errorf("writing plan output: %w", err)
+	}
+	logger.Info("wrote plan to %q", path)
+	return nil
+}
@@ -0,0 +1,121 @@
+package cmdutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("plan output", func() {
+	var (
+		cmd        *cmdutils.Cmd
+		outputFile string
+		runErr     error
+	)
+
+	BeforeEach(func() {
+		cmd = &cmdutils.Cmd{
+			CobraCommand: &cobra.Command{Use: "test"},
+			Plan:         true,
+		}
+		runErr = nil
+		cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			taskTree := &tasks.TaskTree{PlanMode: cmd.Plan, Context: ctx}
+			taskTree.Append(&tasks.GenericTask{
+				Description: "delete nodegroup \"ng-1\"",
+				Metadata: tasks.Metadata{
+					Action:       "delete",
+					ResourceType: "nodeGroup",
+					ResourceName: "ng-1",
+				},
+			})
+			cmdutils.LogIntendedAction(ctx, cmd.Plan, "upgrade cluster %q control plane", "cluster")
+			taskTree.DoAllSync()
+			return runErr
+		}
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmd.CobraCommand.Flags().AddFlagSet(fs)
+		outputFile = filepath.Join(GinkgoT().TempDir(), "plan.json")
+	})
+
+	It("writes the tasks and actions of the command as JSON", func() {
+		cmd.CobraCommand.SetArgs([]string{"--plan-output", outputFile})
+		Expect(cmd.CobraCommand.Execute()).To(Succeed())
+
+		data, err := os.ReadFile(outputFile)
+		Expect(err).NotTo(HaveOccurred())
+		var document struct {
+			Plans []tasks.Plan `json:"plans"`
+		}
+		Expect(json.Unmarshal(data, &document)).To(Succeed())
+		Expect(document.Plans).To(HaveLen(2))
+		Expect(document.Plans[0]).To(Equal(tasks.Plan{Kind: "action", Description: `upgrade cluster "cluster" control plane`}))
+		Expect(document.Plans[1].Kind).To(Equal("taskTree"))
+		Expect(document.Plans[1].Tasks).To(ConsistOf(tasks.Plan{
+			Kind:        "genericTask",
+			Description: `delete nodegroup "ng-1"`,
+			Metadata: tasks.Metadata{
+				Action:       "delete",
+				ResourceType: "nodeGroup",
+				ResourceName: "ng-1",
+			},
+		}))
+	})
+
+	It("writes the plan in the given format", func() {
+		cmd.CobraCommand.SetArgs([]string{"--plan-output", outputFile, "--plan-format", "mermaid"})
+		Expect(cmd.CobraCommand.Execute()).To(Succeed())
+
+		data, err := os.ReadFile(outputFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`flowchart TD
+    t1["upgrade cluster #quot;cluster#quot; control plane"]
+    t2["delete nodegroup #quot;ng-1#quot;"]
+    t1 --> t2
+`))
+	})
+
+	It("writes the plan when the command fails", func() {
+		runErr = errors.New("upgrading cluster failed")
+		cmd.CobraCommand.SetArgs([]string{"--plan-output", outputFile})
+		Expect(cmd.CobraCommand.Execute()).To(MatchError("upgrading cluster failed"))
+		Expect(outputFile).To(BeAnExistingFile())
+	})
+
+	It("previews change sets only when asked to", func() {
+		var previewChangeSets bool
+		cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+			previewChangeSets = tasks.RunOptionsFrom(cmd.Context()).PreviewChangeSets
+			return nil
+		}
+		cmd.CobraCommand.SetArgs([]string{})
+		Expect(cmd.CobraCommand.Execute()).To(Succeed())
+		Expect(previewChangeSets).To(BeFalse())
+
+		cmd.CobraCommand.SetArgs([]string{"--preview-change-sets"})
+		Expect(cmd.CobraCommand.Execute()).To(Succeed())
+		Expect(previewChangeSets).To(BeTrue())
+	})
+
+	It("rejects unknown plan formats", func() {
+		cmd.CobraCommand.SetArgs([]string{"--plan-output", outputFile, "--plan-format", "svg"})
+		Expect(cmd.CobraCommand.Execute()).To(MatchError(ContainSubstring(`unknown plan format "svg" (valid options: json, dot, mermaid)`)))
+		Expect(outputFile).NotTo(BeAnExistingFile())
+	})
+
+	It("does not write a plan when the flag is not set", func() {
+		Expect(cmd.CobraCommand.Execute()).To(Succeed())
+		Expect(outputFile).NotTo(BeAnExistingFile())
+	})
+})
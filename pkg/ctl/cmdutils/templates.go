@@ -0,0 +1,89 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// TemplatesOptions holds the options for exporting CloudFormation templates instead of
+// creating stacks, and for creating stacks from exported templates.
+type TemplatesOptions struct {
+	ExportDir    string
+	ExportFormat string
+	FromDir      string
+
+	exporter *manager.TemplateExporter
+	source   *manager.TemplateSource
+}
+
+// Exporting reports whether templates are exported instead of creating stacks.
+func (o *TemplatesOptions) Exporting() bool {
+	return o.ExportDir != ""
+}
+
+// AddTemplatesFlags adds the `--export-templates`, `--export-format` and `--from-templates` flags;
+// the manifest of exported templates is written once the command has succeeded.
+func AddTemplatesFlags(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.StringVar(&cmd.Templates.ExportDir, "export-templates", "", "Write the CloudFormation templates and a manifest of the stacks to the given directory instead of creating anything")
+	fs.StringVar(&cmd.Templates.ExportFormat, "export-format", string(manager.TemplateFormatCloudFormation), fmt.Sprintf("Format of the templates written with --export-templates, either %q or %q", manager.TemplateFormatCloudFormation, manager.TemplateFormatTerraform))
+	fs.StringVar(&cmd.Templates.FromDir, "from-templates", "", "Create the CloudFormation stacks from the templates exported to the given directory with --export-templates")
+
+	addPostRunE(cmd.CobraCommand, func(_ *cobra.Command, _ []string) error {
+		exporter := cmd.Templates.exporter
+		if exporter == nil {
+			return nil
+		}
+		if err := exporter.WriteManifest(); err != nil {
+			return err
+		}
+		logger.Success("exported %d template(s) and a manifest to %q, no resources have been created", len(exporter.Stacks()), exporter.Dir())
+		return nil
+	})
+}
+
+// StackCollectionOptions returns the options that make stack managers export templates or
+// create stacks from exported templates.
+func (o *TemplatesOptions) StackCollectionOptions(meta *api.ClusterMeta) ([]manager.StackCollectionOption, error) {
+	switch {
+	case o.ExportDir != "" && o.FromDir != "":
+		return nil, errors.New("--export-templates and --from-templates cannot be used together")
+
+	case o.ExportDir != "":
+		if o.exporter == nil {
+			format := manager.TemplateFormat(o.ExportFormat)
+			if format == "" {
+				format = manager.TemplateFormatCloudFormation
+			}
+			exporter, err := manager.NewTemplateExporter(o.ExportDir, meta.Name, meta.Region, format)
+			if err != nil {
+				return nil, err
+			}
+			o.exporter = exporter
+		}
+		return []manager.StackCollectionOption{manager.WithTemplateExporter(o.exporter)}, nil
+
+	case o.FromDir != "":
+		if o.source == nil {
+			source, err := manager.LoadTemplateSource(o.FromDir)
+			if err != nil {
+				return nil, err
+			}
+			manifest := source.Manifest()
+			if manifest.ClusterName != meta.Name || manifest.Region != meta.Region {
+				return nil, fmt.Errorf("templates in %q were exported for cluster %q in region %q, not for cluster %q in region %q",
+					o.FromDir, manifest.ClusterName, manifest.Region, meta.Name, meta.Region)
+			}
+			logger.Info("creating stacks only from the %d template(s) exported to %q", len(manifest.Stacks), o.FromDir)
+			o.source = source
+		}
+		return []manager.StackCollectionOption{manager.WithTemplateSource(o.source)}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,95 @@
+package cmdutils_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("templates flags", func() {
+	var (
+		cmd  *cmdutils.Cmd
+		meta *api.ClusterMeta
+		dir  string
+	)
+
+	BeforeEach(func() {
+		cmd = &cmdutils.Cmd{
+			CobraCommand: &cobra.Command{Use: "test"},
+		}
+		meta = &api.ClusterMeta{Name: "test-cluster", Region: "us-west-2"}
+		dir = filepath.Join(GinkgoT().TempDir(), "templates")
+
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		cmdutils.AddTemplatesFlags(fs, cmd)
+		cmd.CobraCommand.Flags().AddFlagSet(fs)
+		cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+			_, err := cmd.Templates.StackCollectionOptions(meta)
+			return err
+		}
+	})
+
+	It("writes the manifest after the command has succeeded", func() {
+		cmd.CobraCommand.SetArgs([]string{"--export-templates", dir})
+		Expect(cmd.CobraCommand.Execute()).To(Succeed())
+		Expect(cmd.Templates.Exporting()).To(BeTrue())
+
+		data, err := os.ReadFile(filepath.Join(dir, manager.TemplateManifestFile))
+		Expect(err).NotTo(HaveOccurred())
+		var manifest manager.TemplateManifest
+		Expect(json.Unmarshal(data, &manifest)).To(Succeed())
+		Expect(manifest.ClusterName).To(Equal("test-cluster"))
+		Expect(manifest.Stacks).To(BeEmpty())
+	})
+
+	It("exports in the requested format", func() {
+		cmd.CobraCommand.SetArgs([]string{"--export-templates", dir, "--export-format", "terraform"})
+		Expect(cmd.CobraCommand.Execute()).To(Succeed())
+
+		data, err := os.ReadFile(filepath.Join(dir, manager.TemplateManifestFile))
+		Expect(err).NotTo(HaveOccurred())
+		var manifest manager.TemplateManifest
+		Expect(json.Unmarshal(data, &manifest)).To(Succeed())
+		Expect(manifest.Format).To(Equal(manager.TemplateFormatTerraform))
+	})
+
+	It("rejects unknown export formats", func() {
+		cmd.CobraCommand.SetArgs([]string{"--export-templates", dir, "--export-format", "pulumi"})
+		Expect(cmd.CobraCommand.Execute()).To(MatchError(`unsupported template format "pulumi"`))
+	})
+
+	It("does not allow exporting and creating from templates at the same time", func() {
+		cmd.CobraCommand.SetArgs([]string{"--export-templates", dir, "--from-templates", dir})
+		Expect(cmd.CobraCommand.Execute()).To(MatchError("--export-templates and --from-templates cannot be used together"))
+	})
+
+	It("rejects templates exported for another cluster", func() {
+		Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "stack.json"), []byte("{}"), 0644)).To(Succeed())
+		manifest := manager.TemplateManifest{
+			ClusterName: "other-cluster",
+			Region:      "us-west-2",
+			Stacks: []manager.TemplateManifestStack{{
+				Order:        1,
+				StackName:    "stack",
+				TemplateFile: "stack.json",
+				SHA256:       "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a",
+			}},
+		}
+		data, err := json.Marshal(manifest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(dir, manager.TemplateManifestFile), data, 0644)).To(Succeed())
+
+		cmd.CobraCommand.SetArgs([]string{"--from-templates", dir})
+		Expect(cmd.CobraCommand.Execute()).To(MatchError(ContainSubstring(`were exported for cluster "other-cluster"`)))
+	})
+})
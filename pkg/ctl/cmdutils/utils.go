@@ -1,15 +1,13 @@
 package cmdutils
 
 import (
-	"context"
-
 	"k8s.io/client-go/kubernetes"
 )
 
 // KubernetesClientAndConfigFrom returns a Kubernetes client set and REST
 // configuration object for the currently configured cluster.
 func KubernetesClientAndConfigFrom(cmd *Cmd) (kubernetes.Interface, error) {
-	ctl, err := cmd.NewProviderForExistingCluster(context.TODO())
+	ctl, err := cmd.NewProviderForExistingCluster(cmd.Context())
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func createAccessEntryCmd(cmd *cmdutils.Cmd) {
 }
 
 func doCreateAccessEntry(cmd *cmdutils.Cmd) error {
-	ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+	ctx, cancel := context.WithTimeout(cmd.Context(), cmd.ProviderConfig.WaitTimeout)
 	defer cancel()
 
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
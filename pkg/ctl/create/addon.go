@@ -49,6 +49,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddTemplatesFlags(fs, cmd)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 
@@ -66,7 +67,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
-		ctx := context.TODO()
+		ctx := cmd.Context()
 		clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 		if err != nil {
 			return err
@@ -105,6 +106,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 		if err != nil {
 			return err
 		}
+		addonManager.ExportTemplates = cmd.Templates.Exporting()
 
 		iamRoleCreator := &podidentityassociation.IAMRoleCreator{
 			ClusterName:  cmd.ClusterConfig.Metadata.Name,
@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os/exec"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	"github.com/kris-nova/logger"
@@ -107,6 +110,11 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		fs.BoolVarP(&params.InstallWindowsVPCController, "install-vpc-controllers", "", false, "Install VPC controller that's required for Windows workloads")
 		fs.BoolVarP(&params.Fargate, "fargate", "", false, "Create a Fargate profile scheduling pods in the default and kube-system namespaces onto Fargate")
 		fs.BoolVarP(&params.DryRun, "dry-run", "", false, "Dry-run mode that skips cluster creation and outputs a ClusterConfig")
+		cmdutils.AddLintFlags(fs, &cmd.Lint)
+		cmdutils.AddTemplatesFlags(fs, cmd)
+		cmdutils.AddResumeFlag(fs, cmd)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
+		cmdutils.AddRollbackOnInterruptFlag(fs, cmd)
 
 		_ = fs.MarkDeprecated("install-vpc-controllers", vpcControllerInfoMessage)
 	})
@@ -196,7 +204,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		params.KubeconfigPath = kubeconfig.AutoPath(meta.Name)
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 
 	if checkSubnetsGivenAsFlags(params) {
 		// undo defaulting and reset it, as it's not set via config file;
@@ -287,15 +295,23 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			"either create the nodegroups after cluster creation or consider creating the control plane on Outposts")
 	}
 
-	if err := createOrImportVPC(ctx, cmd, cfg, params, ctl); err != nil {
+	resumedVPC, err := loadResumedClusterVPC(ctx, cmd, cfg, ctl)
+	if err != nil {
 		return err
 	}
+	if !resumedVPC {
+		if err := createOrImportVPC(ctx, cmd, cfg, params, ctl); err != nil {
+			return err
+		}
+	}
 
 	instanceSelector, err := selector.New(ctx, ctl.AWSProvider.AWSConfig())
 	if err != nil {
 		return err
 	}
 	nodeGroupService := eks.NewNodeGroupService(ctl.AWSProvider, instanceSelector, outpostsService)
+	// exporting templates must not change anything, so SSH public keys are only imported when the stacks are created
+	nodeGroupService.SkipKeyImport = cmd.Templates.Exporting()
 	nodePools := nodes.ToNodePools(cfg)
 	if err := nodeGroupService.ExpandInstanceSelectorOptions(nodePools, cfg.AvailabilityZones); err != nil {
 		return err
@@ -319,7 +335,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	// we should also make a call to resolve the AMI and write the result, similarly
 	// the body of the SSH key can be read
 
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
@@ -350,10 +366,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	logger.Info("if you encounter any issues, check CloudFormation console or try 'eksctl utils describe-stacks --region=%s --cluster=%s'", meta.Region, meta.Name)
 	eks.LogEnabledFeatures(cfg)
 
-	var (
-		postNodeGroupAddons      *tasks.TaskTree
-		postClusterCreationTasks *tasks.TaskTree
-	)
+	var postNodeGroupAddons *tasks.TaskTree
 
 	iamRoleCreator := &podidentityassociation.IAMRoleCreator{
 		ClusterName:  cfg.Metadata.Name,
@@ -376,12 +389,33 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		logger.Info("default addons %s were not specified, will install them as EKS addons", strings.Join(autoDefaultAddons, ", "))
 	}
 	postNodeGroupAddons = postAddons
-	postClusterCreationTasks = ctl.CreateExtraClusterConfigTasks(ctx, cfg, preNodegroupAddons, updateVPCCNITask)
+	postClusterCreationTasks, nodeGroupPrerequisites := ctl.CreateExtraClusterConfigTasks(ctx, cfg, preNodegroupAddons, updateVPCCNITask)
 
-	taskTree := stackManager.NewTasksToCreateCluster(ctx, cfg.NodeGroups, cfg.ManagedNodeGroups, cfg.AccessConfig, makeAccessEntryCreator(cfg.Metadata.Name, stackManager), params.NodeGroupParallelism, postClusterCreationTasks)
+	var postNodeGroupTasks tasks.Task
+	if postNodeGroupAddons != nil && postNodeGroupAddons.Len() > 0 {
+		postNodeGroupTasks = postNodeGroupAddons
+	}
+	taskGraph := stackManager.NewTasksToCreateCluster(ctx, cfg.NodeGroups, cfg.ManagedNodeGroups, cfg.AccessConfig, makeAccessEntryCreator(cfg.Metadata.Name, stackManager), params.NodeGroupParallelism, postClusterCreationTasks, postNodeGroupTasks, nodeGroupPrerequisites...)
+	if taskGraph.Has(postNodeGroupTasks) {
+		// the addons are created along with the nodegroups
+		postNodeGroupAddons.IsSubTask = true
+		postNodeGroupAddons = nil
+	}
+
+	if cmd.Templates.Exporting() {
+		return exportClusterTemplates(ctx, taskGraph, meta)
+	}
 
-	logger.Info(taskTree.Describe())
-	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+	if ctx, err = cmdutils.StartJournal(ctx, cmd); err != nil {
+		return err
+	}
+	taskGraph.Context = ctx
+
+	logger.Info(taskGraph.Describe())
+	if errs := taskGraph.DoAllSync(); len(errs) > 0 {
+		if tasks.Interrupted(ctx) {
+			return cmdutils.HandleInterrupt(ctx, cmd, stackManager, fmt.Errorf("creating cluster %q was interrupted", meta.Name))
+		}
 		logger.Warning("%d error(s) occurred and cluster hasn't been created properly, you may wish to check CloudFormation console", len(errs))
 		logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
 		for _, err := range errs {
@@ -417,6 +451,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 
 		ngTasks := ctl.ClusterTasksForNodeGroups(cfg, params.InstallNeuronDevicePlugin, params.InstallNvidiaDevicePlugin)
 
+		ngTasks.Context = ctx
 		logger.Info(ngTasks.Describe())
 		if errs := ngTasks.DoAllSync(); len(errs) > 0 {
 			logger.Warning("%d error(s) occurred and post actions have failed, you may wish to check CloudFormation console", len(errs))
@@ -473,6 +508,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			}
 		}
 		if postNodeGroupAddons != nil && postNodeGroupAddons.Len() > 0 {
+			postNodeGroupAddons.Context = ctx
 			if errs := postNodeGroupAddons.DoAllSync(); len(errs) > 0 {
 				logger.Warning("%d error(s) occurred while creating addons", len(errs))
 				for _, err := range errs {
@@ -528,6 +564,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 				return err
 			}
 
+			cmdutils.CompleteJournal(ctx)
 			//TODO why was it returning early before? I want to remove this line :thinking:
 			return nil
 		}
@@ -559,9 +596,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		}
 	}
 
+	cmdutils.CompleteJournal(ctx)
 	logger.Success("%s is ready", meta.LogString())
 
-	return printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg)
+	return printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted())
 }
 
 // installKarpenter prepares the environment for Karpenter, by creating the following resources:
@@ -581,6 +619,46 @@ func installKarpenter(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.Cl
 	return nil
 }
 
+// loadResumedClusterVPC loads the VPC of the cluster stack created by the run being resumed, so that the
+// nodegroups use the availability zones and subnets chosen by that run instead of choosing them again.
+// It returns false when the config sets the subnets, or the stack was not created.
+func loadResumedClusterVPC(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (bool, error) {
+	if cmd.Resume == "" || cfg.HasAnySubnets() {
+		return false, nil
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	stack, err := stackManager.DescribeClusterStackIfExists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("describing cluster stack of the resumed run: %w", err)
+	}
+	if stack == nil {
+		return false, nil
+	}
+	if stack.StackStatus == cfntypes.StackStatusCreateInProgress {
+		logger.Info("waiting for the cluster stack %q created by the resumed run", *stack.StackName)
+		if err := stackManager.DoWaitUntilStackIsCreated(ctx, stack); err != nil {
+			return false, err
+		}
+		if stack, err = stackManager.DescribeStack(ctx, stack); err != nil {
+			return false, err
+		}
+	}
+	if stack.StackStatus != cfntypes.StackStatusCreateComplete {
+		return false, nil
+	}
+	if err := ctl.LoadClusterVPC(ctx, cfg, stack, false); err != nil {
+		return false, fmt.Errorf("loading VPC of cluster stack %q: %w", *stack.StackName, err)
+	}
+	cfg.AvailabilityZones = nil
+	for _, subnets := range []api.AZSubnetMapping{cfg.VPC.Subnets.Public, cfg.VPC.Subnets.Private} {
+		for _, az := range slices.Sorted(maps.Keys(subnets)) {
+			cfg.AppendAvailabilityZone(subnets[az].AZ)
+		}
+	}
+	logger.Info("using the VPC %q and availability zones %v of the cluster stack created by the resumed run", cfg.VPC.ID, cfg.AvailabilityZones)
+	return true, nil
+}
+
 func createOrImportVPC(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmdutils.CreateClusterCmdParams, ctl *eks.ClusterProvider) error {
 	customNetworkingNotice := "custom VPC/subnets will be used; if resulting cluster doesn't function as expected, make sure to review the configuration of VPC/subnets"
 
@@ -737,3 +815,24 @@ func logAmazonLinux2EndOfSupportWarningIfNeeded(cfg *api.ClusterConfig) {
 		logger.Warning(amazonLinux2EndOfSupportWarning)
 	}
 }
+
+// exportClusterTemplates exports the templates of the cluster and nodegroup stacks in the order they are created in
+func exportClusterTemplates(ctx context.Context, taskGraph *tasks.Graph, meta *api.ClusterMeta) error {
+	stackTasks := taskGraph.Select(func(m tasks.Metadata) bool {
+		switch m.ResourceType {
+		case "cluster", "nodeGroup", "managedNodeGroup":
+			return m.Action == "create" && m.StackName != ""
+		}
+		return false
+	})
+	logger.Info("stacks for addons, IAM service accounts, pod identity associations and access entries require a running cluster and are not exported")
+	stackTasks.Context = ctx
+	logger.Info(stackTasks.Describe())
+	if errs := stackTasks.DoAllSync(); len(errs) > 0 {
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to export templates for cluster %q", meta.Name)
+	}
+	return nil
+}
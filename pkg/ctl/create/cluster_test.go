@@ -226,6 +226,8 @@ var _ = Describe("create cluster", func() {
 		configureKarpenterInstaller func(*karpenterfakes.FakeInstallerTaskCreator)
 		mockOutposts                bool
 		fullyPrivateCluster         bool
+		exportTemplates             bool
+		verifyMocks                 func(*mockprovider.MockProvider)
 
 		expectedErr string
 	}
@@ -278,6 +280,12 @@ var _ = Describe("create cluster", func() {
 				WaitTimeout: time.Second * 1,
 			},
 		}
+		if ce.exportTemplates {
+			cmd.Templates.ExportDir = GinkgoT().TempDir()
+			var err error
+			ctl.StackCollectionOptions, err = cmd.Templates.StackCollectionOptions(clusterConfig.Metadata)
+			Expect(err).NotTo(HaveOccurred())
+		}
 		filter := filter.NewNodeGroupFilter()
 		params := &cmdutils.CreateClusterCmdParams{
 			Subnets: map[api.SubnetTopology]*[]string{
@@ -309,8 +317,32 @@ var _ = Describe("create cluster", func() {
 		if fakeInstallerTaskCreator.CreateStub != nil {
 			Expect(fakeInstallerTaskCreator.CreateCallCount()).To(Equal(1))
 		}
+		if ce.verifyMocks != nil {
+			ce.verifyMocks(p)
+		}
 	},
 
+		Entry("[Cluster with NodeGroups] does not import SSH public keys when exporting templates", createClusterEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				nodeGroup := getDefaultNodeGroup()
+				nodeGroup.SSH = &api.NodeGroupSSH{
+					Allow:     api.Enabled(),
+					PublicKey: aws.String("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJdipwj1E3EMFZzKBYid6O9YK7D2a2zOD/1+8HbLIa3T"),
+				}
+				c.NodeGroups = append(c.NodeGroups, nodeGroup)
+			},
+			updateMocks:     updateMocksForNodegroups(cftypes.StackStatusCreateComplete, defaultOutputForNodeGroup),
+			exportTemplates: true,
+			verifyMocks: func(p *mockprovider.MockProvider) {
+				for _, call := range p.MockEC2().Calls {
+					Expect(call.Method).To(HavePrefix("Describe"), "exporting templates must not change EC2 resources")
+				}
+				p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeKeyPairs", mock.Anything, mock.Anything)
+				p.MockEC2().AssertNotCalled(GinkgoT(), "ImportKeyPair", mock.Anything, mock.Anything)
+				p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything, mock.Anything)
+			},
+		}),
+
 		Entry("[Cluster with NodeGroups] fails to install device plugins", createClusterEntry{
 			updateClusterConfig: func(c *api.ClusterConfig) {
 				nodeGroup := getDefaultNodeGroup()
@@ -970,6 +1002,8 @@ func defaultProviderMocks(p *mockprovider.MockProvider, output []cftypes.Output,
 				ZoneId:    aws.String("id"),
 			}},
 	}, nil)
+	// mock for when stack events are streamed while waiting on stacks
+	p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.DescribeStackEventsOutput{}, nil)
 	p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{
 		StackSummaries: []cftypes.StackSummary{
 			{
@@ -1,7 +1,6 @@
 package create
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -35,13 +34,14 @@ func createFargateProfile(cmd *cmdutils.Cmd) {
 }
 
 func doCreateFargateProfile(cmd *cmdutils.Cmd) error {
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return fmt.Errorf("couldn't create cluster provider from command line options: %w", err)
 	}
 
 	manager := actionsfargate.New(cmd.ClusterConfig, ctl, ctl.NewStackManager(cmd.ClusterConfig))
+	manager.ExportTemplates = cmd.Templates.Exporting()
 	return manager.Create(ctx)
 }
 
@@ -55,6 +55,7 @@ func configureCreateFargateProfileCmd(cmd *cmdutils.Cmd) *fargate.CreateOptions
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddTemplatesFlags(fs, cmd)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 	return &options
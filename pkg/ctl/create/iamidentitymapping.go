@@ -1,8 +1,6 @@
 package create
 
 import (
-	"context"
-
 	"github.com/lithammer/dedent"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -63,7 +61,7 @@ func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd) error {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -1,7 +1,6 @@
 package create
 
 import (
-	"context"
 	"errors"
 
 	"github.com/weaveworks/eksctl/pkg/actions/irsa"
@@ -61,6 +60,7 @@ func createIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 
 		cmdutils.AddIAMServiceAccountFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTemplatesFlags(fs, cmd)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
@@ -81,7 +81,7 @@ func doCreateIAMServiceAccount(cmd *cmdutils.Cmd, overrideExistingServiceAccount
 
 	printer := printers.NewJSONPrinter()
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -130,9 +130,13 @@ func doCreateIAMServiceAccount(cmd *cmdutils.Cmd, overrideExistingServiceAccount
 		logger.Warning("serviceaccounts that exist in Kubernetes will be excluded, use --override-existing-serviceaccounts to override")
 	}
 
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
-	return irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet).CreateIAMServiceAccount(filteredServiceAccounts, cmd.Plan)
+	irsaManager := irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet)
+	if cmd.Templates.Exporting() {
+		return irsaManager.ExportIAMServiceAccountTemplates(ctx, filteredServiceAccounts)
+	}
+	return irsaManager.CreateIAMServiceAccount(ctx, filteredServiceAccounts, cmd.Plan)
 }
@@ -1,7 +1,6 @@
 package create
 
 import (
-	"context"
 	"fmt"
 	"io"
 
@@ -51,7 +50,7 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 			logger.Warning(amazonLinux2EndOfSupportWarning)
 		}
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 		ctl, err := cmd.NewProviderForExistingClusterHelper(ctx, checkNodeGroupVersion)
 		if err != nil {
 			return fmt.Errorf("could not create cluster provider from options: %w", err)
@@ -77,8 +76,14 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
+		if !options.DryRun && !cmd.Templates.Exporting() {
+			if ctx, err = cmdutils.StartJournal(ctx, cmd); err != nil {
+				return err
+			}
+		}
+
 		manager := nodegroup.New(cmd.ClusterConfig, ctl, clientSet, instanceSelector)
-		return manager.Create(ctx, nodegroup.CreateOpts{
+		err = manager.Create(ctx, nodegroup.CreateOpts{
 			InstallNeuronDevicePlugin: options.InstallNeuronDevicePlugin,
 			InstallNvidiaDevicePlugin: options.InstallNvidiaDevicePlugin,
 			UpdateAuthConfigMap:       options.UpdateAuthConfigMap,
@@ -89,7 +94,12 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 			SkipOutdatedAddonsCheck: options.SkipOutdatedAddonsCheck,
 			ConfigFileProvided:      cmd.ClusterConfigFile != "",
 			Parallelism:             options.NodeGroupParallelism,
+			ExportTemplates:         cmd.Templates.Exporting(),
 		}, ngFilter)
+		if err == nil {
+			cmdutils.CompleteJournal(ctx)
+		}
+		return cmdutils.HandleInterrupt(ctx, cmd, ctl.NewStackManager(cmd.ClusterConfig), err)
 	})
 }
 
@@ -123,6 +133,10 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 		cmdutils.AddSubnetIDs(fs, &options.SubnetIDs, "Define an optional list of subnet IDs to create the nodegroup in")
 		fs.BoolVarP(&options.DryRun, "dry-run", "", false, "Dry-run mode that skips nodegroup creation and outputs a ClusterConfig")
 		fs.BoolVarP(&options.SkipOutdatedAddonsCheck, "skip-outdated-addons-check", "", false, "whether the creation of ARM nodegroups should proceed when the cluster addons are outdated")
+		cmdutils.AddLintFlags(fs, &cmd.Lint)
+		cmdutils.AddTemplatesFlags(fs, cmd)
+		cmdutils.AddResumeFlag(fs, cmd)
+		cmdutils.AddRollbackOnInterruptFlag(fs, cmd)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("New nodegroup", func(fs *pflag.FlagSet) {
@@ -1,7 +1,6 @@
 package create
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -35,7 +34,7 @@ func createPodIdentityAssociationCmd(cmd *cmdutils.Cmd) {
 
 func doCreatePodIdentityAssociation(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
-	ctx := context.Background()
+	ctx := cmd.Context()
 
 	for _, pia := range cfg.IAM.PodIdentityAssociations {
 		if pia.Policy != nil && (pia.DisableSessionTags == nil || !*pia.DisableSessionTags) {
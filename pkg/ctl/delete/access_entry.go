@@ -1,8 +1,6 @@
 package delete
 
 import (
-	"context"
-
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -44,7 +42,7 @@ func deleteAccessEntryCmd(cmd *cmdutils.Cmd) {
 }
 
 func doDeleteAccessEntry(cmd *cmdutils.Cmd) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
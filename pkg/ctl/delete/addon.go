@@ -1,7 +1,6 @@
 package delete
 
 import (
-	"context"
 	"fmt"
 
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
@@ -48,7 +47,7 @@ func deleteAddon(cmd *cmdutils.Cmd, preserve bool) error {
 		return err
 	}
 
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
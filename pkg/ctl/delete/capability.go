@@ -32,7 +32,7 @@ func deleteCapabilityCmd(cmd *cmdutils.Cmd) {
 }
 
 func doDeleteCapability(cmd *cmdutils.Cmd, capability *api.Capability) error {
-	ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+	ctx, cancel := context.WithTimeout(cmd.Context(), cmd.ProviderConfig.WaitTimeout)
 	defer cancel()
 
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
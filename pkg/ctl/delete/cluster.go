@@ -1,7 +1,6 @@
 package delete
 
 import (
-	"context"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
@@ -52,6 +51,7 @@ func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd,
 
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddPlanOutputFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, true)
@@ -65,7 +65,7 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction boo
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 	printer := printers.NewJSONPrinter()
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		if !force {
@@ -81,7 +81,7 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction boo
 	}
 
 	logger.Info("deleting EKS cluster %q", meta.Name)
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
@@ -1,7 +1,6 @@
 package delete
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -53,7 +52,7 @@ func configureDeleteFargateProfileCmd(cmd *cmdutils.Cmd) *fargate.Options {
 }
 
 func doDeleteFargateProfile(cmd *cmdutils.Cmd, opts *fargate.Options) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -1,7 +1,6 @@
 package delete
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -49,7 +48,7 @@ func doDeleteIAMIdentityMapping(cmd *cmdutils.Cmd, arn, account string, all bool
 
 	cfg := cmd.ClusterConfig
 
-	ctl, err := cmd.NewProviderForExistingCluster(context.Background())
+	ctl, err := cmd.NewProviderForExistingCluster(cmd.Context())
 	if err != nil {
 		return err
 	}
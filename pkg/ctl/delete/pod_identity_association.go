@@ -1,8 +1,6 @@
 package delete
 
 import (
-	"context"
-
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -45,7 +43,7 @@ func doDeletePodIdentityAssociation(cmd *cmdutils.Cmd, options cmdutils.PodIdent
 	}
 
 	cfg := cmd.ClusterConfig
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
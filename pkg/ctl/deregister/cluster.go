@@ -1,7 +1,6 @@
 package deregister
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -35,7 +34,7 @@ func deregisterClusterCmd(cmd *cmdutils.Cmd) {
 }
 
 func deregisterCluster(cmd *cmdutils.Cmd, clusterName string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := eks.New(ctx, &cmd.ProviderConfig, nil)
 	if err != nil {
 		return err
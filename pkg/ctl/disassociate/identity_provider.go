@@ -1,7 +1,6 @@
 package disassociate
 
 import (
-	"context"
 	"errors"
 	"time"
 
@@ -74,7 +73,7 @@ func doDisassociateIdentityProvider(cmd *cmdutils.Cmd, cliProvidedIDP cliProvide
 
 	cfg := cmd.ClusterConfig
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -74,7 +74,7 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 
 	cfg := cmd.ClusterConfig
 
-	ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+	ctx, cancel := context.WithTimeout(cmd.Context(), cmd.ProviderConfig.WaitTimeout)
 	defer cancel()
 
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
@@ -115,7 +115,7 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 	}
 
 	logAction := func(resource string, count int) {
-		cmdutils.LogIntendedAction(cmd.Plan, "%s %d %s in cluster %q", verb, count, resource, cfg.Metadata.Name)
+		cmdutils.LogIntendedAction(ctx, cmd.Plan, "%s %d %s in cluster %q", verb, count, resource, cfg.Metadata.Name)
 	}
 	logFiltered()
 
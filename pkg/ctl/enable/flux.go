@@ -1,7 +1,6 @@
 package enable
 
 import (
-	"context"
 	"os"
 
 	"github.com/kris-nova/logger"
@@ -52,7 +51,7 @@ func flux2Install(cmd *cmdutils.Cmd) error {
 	logger.Info("will install Flux v2 components on cluster %s", cmd.ClusterConfig.Metadata.Name)
 
 	if kubeconfAndContextNotSet(cmd.ClusterConfig.GitOps.Flux.Flags) {
-		ctl, err := cmd.NewProviderForExistingCluster(context.TODO())
+		ctl, err := cmd.NewProviderForExistingCluster(cmd.Context())
 		if err != nil {
 			return err
 		}
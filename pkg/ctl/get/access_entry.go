@@ -1,7 +1,6 @@
 package get
 
 import (
-	"context"
 	"fmt"
 	"os"
 
@@ -56,7 +55,7 @@ func doGetAccessEntry(cmd *cmdutils.Cmd, principalARN api.ARN, params *getCmdPar
 		logger.Writer = os.Stderr
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -1,7 +1,6 @@
 package get
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"slices"
@@ -59,7 +58,7 @@ func getAddon(cmd *cmdutils.Cmd, a *api.Addon, params *getCmdParams) error {
 		logger.Writer = os.Stderr
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
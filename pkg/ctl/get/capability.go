@@ -1,7 +1,6 @@
 package get
 
 import (
-	"context"
 	"fmt"
 	"os"
 
@@ -54,7 +53,7 @@ func doGetCapability(cmd *cmdutils.Cmd, capabilityName string, params *getCmdPar
 		logger.Writer = os.Stderr
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/export"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/printers"
 
@@ -26,7 +27,10 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
-	var listAllRegions bool
+	var (
+		listAllRegions bool
+		exportConfig   bool
+	)
 
 	params := &getCmdParams{}
 
@@ -34,7 +38,7 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doGetCluster(cmd, params, listAllRegions)
+		return doGetCluster(cmd, params, listAllRegions, exportConfig)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -42,6 +46,7 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 		fs.BoolVarP(&listAllRegions, "all-regions", "A", false, "List clusters across all supported regions")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		fs.BoolVar(&exportConfig, "export", false, "Export the cluster as a ClusterConfig that can be used with `eksctl create cluster` and `eksctl apply`")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 	})
@@ -49,13 +54,22 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 }
 
-func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool) error {
+func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions, exportConfig bool) error {
 	if err := cmdutils.NewGetClusterLoader(cmd).Load(); err != nil {
 		return err
 	}
 	cfg := cmd.ClusterConfig
 	regionGiven := cfg.Metadata.Region != "" // eks.New resets this field, so we need to check if it was set in the first place
 
+	if exportConfig {
+		if cfg.Metadata.Name == "" && cmd.NameArg == "" {
+			return fmt.Errorf("--export requires a cluster name")
+		}
+		if params.output == printers.TableType {
+			params.output = printers.YAMLType
+		}
+	}
+
 	if params.output != printers.TableType {
 		logger.Writer = os.Stderr
 	}
@@ -86,7 +100,11 @@ func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool)
 		logger.Writer = os.Stderr
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
+	if exportConfig {
+		return exportCluster(ctx, cmd, cfg, ctl, params)
+	}
+
 	if cfg.Metadata.Name == "" {
 		return getAndPrinterClusters(ctx, cmd, ctl, params, listAllRegions)
 	}
@@ -142,6 +160,20 @@ func getAndPrintCluster(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.Cluster
 	return printer.PrintObjWithKind("clusters", []*ekstypes.Cluster{cluster}, cmd.CobraCommand.OutOrStdout())
 }
 
+func exportCluster(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, params *getCmdParams) error {
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	exported, err := export.NewExporter(cfg.Metadata.Name, ctl.AWSProvider, ctl.NewStackManager(cfg)).Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	return printer.PrintObj(exported, cmd.CobraCommand.OutOrStdout())
+}
+
 func addGetClusterSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("NAME", func(c *ekstypes.Cluster) string {
 		if c.Name == nil {
@@ -23,6 +23,11 @@ var _ = Describe("get", func() {
 			_, err = cmd.execute()
 			Expect(err).To(MatchError(ContainSubstring("Error: cannot use --name when --config-file/-f is set")))
 		})
+		It("--export without a cluster name", func() {
+			cmd := newMockCmd("cluster", "--export")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --export requires a cluster name")))
+		})
 	})
 })
 
@@ -1,7 +1,6 @@
 package get
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -55,7 +54,7 @@ func doGetIAMIdentityMapping(cmd *cmdutils.Cmd, params *getCmdParams, arn string
 		logger.Writer = os.Stderr
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster(context.Background())
+	ctl, err := cmd.NewProviderForExistingCluster(cmd.Context())
 	if err != nil {
 		return err
 	}
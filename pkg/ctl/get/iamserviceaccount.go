@@ -1,7 +1,6 @@
 package get
 
 import (
-	"context"
 	"os"
 
 	"github.com/kris-nova/logger"
@@ -65,7 +64,7 @@ func doGetIAMServiceAccount(cmd *cmdutils.Cmd, options IAMServiceAccountOptions)
 		logger.Writer = os.Stderr
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
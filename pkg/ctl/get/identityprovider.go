@@ -1,7 +1,6 @@
 package get
 
 import (
-	"context"
 	"os"
 
 	"github.com/kris-nova/logger"
@@ -51,7 +50,7 @@ func doGetIdentityProvider(cmd *cmdutils.Cmd, params getCmdParams, name string)
 		logger.Writer = os.Stderr
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
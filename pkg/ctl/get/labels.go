@@ -1,8 +1,6 @@
 package get
 
 import (
-	"context"
-
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/managed"
 
@@ -48,7 +46,7 @@ func getLabels(cmd *cmdutils.Cmd, nodeGroupName string) error {
 	}
 	cfg := cmd.ClusterConfig
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -1,8 +1,6 @@
 package get
 
 import (
-	"context"
-
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/actions/podidentityassociation"
@@ -35,7 +33,7 @@ func getPodIdentityAssociationCmd(cmd *cmdutils.Cmd) {
 
 func doGetPodIdentityAssociation(cmd *cmdutils.Cmd, namespace, serviceAccountName string, params *getCmdParams) error {
 	cfg := cmd.ClusterConfig
-	ctx := context.Background()
+	ctx := cmd.Context()
 
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
@@ -1,7 +1,6 @@
 package register
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -40,7 +39,7 @@ func registerClusterCmd(cmd *cmdutils.Cmd) {
 }
 
 func registerCluster(cmd *cmdutils.Cmd, cluster connector.ExternalCluster) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := eks.New(ctx, &cmd.ProviderConfig, nil)
 	if err != nil {
 		return err
@@ -1,8 +1,6 @@
 package scale
 
 import (
-	"context"
-
 	"github.com/aws/amazon-ec2-instance-selector/v3/pkg/selector"
 
 	"github.com/spf13/cobra"
@@ -89,7 +87,7 @@ func scaleAllNodegroups(cmd *cmdutils.Cmd) error {
 
 func scaleNodegroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
 	cfg := cmd.ClusterConfig
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
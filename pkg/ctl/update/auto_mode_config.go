@@ -61,7 +61,7 @@ func updateAutoMode(cmd *cmdutils.Cmd, options autoModeOptions) error {
 	if options.drainParallel < 0 {
 		return fmt.Errorf("invalid value %v for --drain-parallel", options.drainParallel)
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+	ctx, cancel := context.WithTimeout(cmd.Context(), cmd.ProviderConfig.WaitTimeout)
 	defer cancel()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
@@ -27,7 +27,7 @@ func updateCapabilityCmd(cmd *cmdutils.Cmd) {
 }
 
 func doUpdateCapability(cmd *cmdutils.Cmd, capability *api.Capability, attachPolicyStr string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+	ctx, cancel := context.WithTimeout(cmd.Context(), cmd.ProviderConfig.WaitTimeout)
 	defer cancel()
 
 	var capabilities []api.Capability
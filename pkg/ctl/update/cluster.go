@@ -21,6 +21,17 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 	cmd.SetDescription("cluster", "DEPRECATED: use 'upgrade cluster' instead. Upgrade control plane to the next version. ",
 		"DEPRECATED: use 'upgrade cluster' instead. Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing.")
 
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		logger.Warning("This command is to be deprecated. Please use 'eksctl upgrade cluster' instead")
+
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+
+		return upgrade.DoUpgradeCluster(cmd)
+	}
+
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -40,16 +51,4 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 		// updating from 1.15 to 1.16 has been observed to take longer than the default value of 25 minutes
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, 35*time.Minute)
 	})
-
-	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
-		cmd.NameArg = cmdutils.GetNameArg(args)
-		logger.Warning("This command is to be deprecated. Please use 'eksctl upgrade cluster' instead")
-
-		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
-			return err
-		}
-
-		return upgrade.DoUpgradeCluster(cmd)
-	}
-
 }
@@ -1,8 +1,6 @@
 package update
 
 import (
-	"context"
-
 	"github.com/aws/amazon-ec2-instance-selector/v3/pkg/selector"
 
 	"github.com/lithammer/dedent"
@@ -47,7 +45,7 @@ func updateNodegroup(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -1,8 +1,6 @@
 package update
 
 import (
-	"context"
-
 	"github.com/weaveworks/eksctl/pkg/actions/podidentityassociation"
 
 	"github.com/spf13/cobra"
@@ -70,7 +68,7 @@ func doUpdatePodIdentityAssociation(cmd *cmdutils.Cmd, options cmdutils.UpdatePo
 	}
 
 	cfg := cmd.ClusterConfig
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
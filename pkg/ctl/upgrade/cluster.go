@@ -1,7 +1,6 @@
 package upgrade
 
 import (
-	"context"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
@@ -31,15 +30,30 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 	cmd.SetDescription("cluster", "Upgrade control plane to the next version",
 		"Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing.")
 
+	var force bool
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		// Override force from provided config file if cli flag is provided
+		if force {
+			cmd.ClusterConfig.Metadata.ForceUpdateVersion = &force
+		}
+
+		return runFunc(cmd)
+	}
+
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 
-	var force bool
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddVersionFlag(fs, cfg.Metadata, "")
 		fs.BoolVar(&force, "force", false, "Override upgrade-blocking readiness checks")
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddLintFlags(fs, &cmd.Lint)
 
 		// cmdutils.AddVersionFlag(fs, cfg.Metadata, `"next" and "latest" can be used to automatically increment version by one, or force latest`)
 
@@ -48,25 +62,12 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeClusterTimeout)
 	})
 
-	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
-		cmd.NameArg = cmdutils.GetNameArg(args)
-
-		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
-			return err
-		}
-		// Override force from provided config file if cli flag is provided
-		if force {
-			cmd.ClusterConfig.Metadata.ForceUpdateVersion = &force
-		}
-
-		return runFunc(cmd)
-	}
 }
 
 // DoUpgradeCluster made public so that it can be shared with update/cluster.go until this is deprecated
 // TODO Once `eksctl update cluster` is officially deprecated this can be made package private again
 func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
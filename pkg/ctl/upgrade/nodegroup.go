@@ -1,7 +1,6 @@
 package upgrade
 
 import (
-	"context"
 	"time"
 
 	"github.com/aws/amazon-ec2-instance-selector/v3/pkg/selector"
@@ -42,6 +41,7 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		// found with experimentation
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeNodegroupTimeout)
+		cmdutils.AddApproveFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
@@ -65,8 +65,9 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error
 	if options.NodegroupName == "" {
 		return cmdutils.ErrMustBeSet("name")
 	}
+	options.Plan = cmd.Plan
 
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -85,5 +86,9 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error
 	if err != nil {
 		return err
 	}
-	return nodegroup.New(cfg, ctl, clientSet, instanceSelector).Upgrade(ctx, options)
+	if err := nodegroup.New(cfg, ctl, clientSet, instanceSelector).Upgrade(ctx, options); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(options.Plan)
+	return nil
 }
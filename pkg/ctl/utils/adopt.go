@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/adopt"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func adoptCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options adopt.Options
+
+	cmd.SetDescription("adopt", "Adopt existing resources into eksctl-managed CloudFormation stacks",
+		"Imports existing managed nodegroups, IAM roles, security groups and the IAM OIDC provider of a cluster into new CloudFormation stacks, after which eksctl manages them like the resources it created")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doAdopt(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringSliceVar(&options.NodeGroups, "nodegroups", nil, "names of the managed nodegroups to adopt")
+		fs.StringSliceVar(&options.IAMRoles, "iam-roles", nil, "names of the IAM roles to adopt")
+		fs.StringSliceVar(&options.SecurityGroups, "security-groups", nil, "IDs of the security groups to adopt")
+		fs.BoolVar(&options.OIDCProvider, "oidc-provider", false, "adopt the IAM OIDC provider of the cluster")
+		fs.BoolVar(&options.DeleteWithStacks, "delete-with-stacks", false, "delete the adopted resources when their stacks are deleted, e.g. by 'eksctl delete cluster', instead of retaining them")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doAdopt(cmd *cmdutils.Cmd, options adopt.Options) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if len(options.NodeGroups) == 0 && len(options.IAMRoles) == 0 && len(options.SecurityGroups) == 0 && !options.OIDCProvider {
+		return errors.New("at least one of --nodegroups, --iam-roles, --security-groups or --oidc-provider must be set")
+	}
+
+	ctx := cmd.Context()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	adopter := adopt.New(cfg, ctl.NewStackManager(cfg), ctl.AWSProvider)
+	if err := adopter.Adopt(ctx, options, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+type convertConfigOptions struct {
+	to      string
+	inPlace bool
+}
+
+func convertConfigCmd(cmd *cmdutils.Cmd) {
+	cmd.SetDescription(
+		"convert-config",
+		"Convert a config file to another API version",
+		"Rewrites a config file in another API version, keeping its comments where possible. "+
+			"Fails if the config uses fields that cannot be represented in the target version",
+	)
+
+	var options convertConfigOptions
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(c *cobra.Command, _ []string) error {
+		return doConvertConfig(cmd, options, c.OutOrStdout())
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&options.to, "to", v1alpha6.CurrentGroupVersion, "The API version to convert to (valid options: v1alpha5, v1alpha6)")
+		fs.BoolVar(&options.inPlace, "in-place", false, "Rewrite the config file instead of printing the converted config")
+	})
+}
+
+func doConvertConfig(cmd *cmdutils.Cmd, options convertConfigOptions, out io.Writer) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file")
+	}
+	if options.inPlace && cmd.ClusterConfigFile == "-" {
+		return errors.New("--in-place cannot be used when reading the config from stdin")
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if cmd.ClusterConfigFile == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(cmd.ClusterConfigFile)
+	}
+	if err != nil {
+		return fmt.Errorf("reading config file %q: %w", cmd.ClusterConfigFile, err)
+	}
+
+	apiVersion := options.to
+	if !strings.Contains(apiVersion, "/") {
+		apiVersion = api.GroupName + "/" + apiVersion
+	}
+	converted, err := eks.ConvertConfig(data, apiVersion)
+	if err != nil {
+		return fmt.Errorf("converting config file %q: %w", cmd.ClusterConfigFile, err)
+	}
+
+	if !options.inPlace {
+		_, err := out.Write(converted)
+		return err
+	}
+	info, err := os.Stat(cmd.ClusterConfigFile)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(cmd.ClusterConfigFile, converted, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("writing config file %q: %w", cmd.ClusterConfigFile, err)
+	}
+	logger.Success("converted config file %q to %s", cmd.ClusterConfigFile, apiVersion)
+	return nil
+}
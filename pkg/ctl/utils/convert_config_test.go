@@ -0,0 +1,62 @@
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("convert-config", func() {
+	const clusterConfig = `apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: test
+  region: us-west-2
+managedNodeGroups:
+  # the only nodegroup
+  - name: mng
+`
+
+	const converted = `apiVersion: eksctl.io/v1alpha6
+kind: ClusterConfig
+metadata:
+  name: test
+  region: us-west-2
+nodeGroups:
+  # the only nodegroup
+  - name: mng
+`
+
+	var file string
+
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+		Expect(os.WriteFile(file, []byte(clusterConfig), 0o600)).To(Succeed())
+	})
+
+	It("requires a config file", func() {
+		_, err := newMockCmd("convert-config").execute()
+		Expect(err).To(MatchError(ContainSubstring("--config-file must be set")))
+	})
+
+	It("prints the converted config", func() {
+		out, err := newMockCmd("convert-config", "--config-file", file, "--to", "v1alpha6").execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(converted))
+	})
+
+	It("rewrites the config file with --in-place", func() {
+		_, err := newMockCmd("convert-config", "--config-file", file, "--in-place").execute()
+		Expect(err).NotTo(HaveOccurred())
+		data, err := os.ReadFile(file)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(converted))
+	})
+
+	It("rejects unsupported versions", func() {
+		_, err := newMockCmd("convert-config", "--config-file", file, "--to", "v1alpha4").execute()
+		Expect(err).To(MatchError(ContainSubstring(`unsupported apiVersion "eksctl.io/v1alpha4"`)))
+	})
+})
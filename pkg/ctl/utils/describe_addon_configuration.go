@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -46,7 +45,7 @@ func describeAddonConfigurationCmd(cmd *cmdutils.Cmd) {
 }
 
 func describeAddonConfiguration(cmd *cmdutils.Cmd, addonName, addonVersion string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := eks.New(ctx, &cmd.ProviderConfig, nil)
 	if err != nil {
 		return err
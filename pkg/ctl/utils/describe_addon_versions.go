@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -55,7 +54,7 @@ func describeAddonVersions(cmd *cmdutils.Cmd, addonName, k8sVersion, clusterName
 		return err
 	}
 
-	ctx := context.TODO()
+	ctx := cmd.Context()
 
 	//you can provide kubernetes version or cluster name
 	//if cluster name we lookup its version
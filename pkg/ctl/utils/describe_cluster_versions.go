@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"fmt"
 	"os"
 
@@ -51,7 +50,7 @@ func describeClusterVersions(cmd *cmdutils.Cmd, clusterTypes string, clusterVers
 		return err
 	}
 
-	ctx := context.TODO()
+	ctx := cmd.Context()
 
 	versions, err := clusterProvider.AWSProvider.EKS().DescribeClusterVersions(
 		ctx,
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
@@ -24,7 +25,7 @@ func describeStacksCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
-	var all, events, trail bool
+	var all, events, trail, diagnose bool
 	var resourceStatus []string
 	var output printers.Type
 
@@ -52,7 +53,7 @@ func describeStacksCmd(cmd *cmdutils.Cmd) {
 				return err
 			}
 		}
-		return doDescribeStacksCmd(cmd, all, events, trail, resourceStatus, printer)
+		return doDescribeStacksCmd(cmd, all, events, trail, diagnose, resourceStatus, printer)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -62,6 +63,7 @@ func describeStacksCmd(cmd *cmdutils.Cmd) {
 		fs.BoolVar(&events, "events", false, "include stack events")
 		fs.StringSliceVar(&resourceStatus, "resource-status", nil, "resource statuses to filter events by, e.g. `CREATE_FAILED`, `UPDATE_FAILED`")
 		fs.BoolVar(&trail, "trail", false, "lookup CloudTrail events for the cluster")
+		fs.BoolVar(&diagnose, "diagnose", false, "diagnose the root cause of failed stacks; with --output, print the diagnosis reports instead of the stacks")
 		fs.StringVarP(&output, "output", "o", "", "specifies the output formats (valid option: json and yaml)")
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
@@ -70,7 +72,7 @@ func describeStacksCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 }
 
-func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool, resourceStatus []string, printer printers.OutputPrinter) error {
+func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail, diagnose bool, resourceStatus []string, printer printers.OutputPrinter) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -79,7 +81,7 @@ func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool, resourceSta
 		logger.Writer = os.Stderr
 	}
 
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -112,6 +114,26 @@ func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool, resourceSta
 		logger.Warning("only %d stacks found, for a ready-to-use cluster there should be at least 2", len(stacks))
 	}
 
+	if diagnose {
+		diagnoses, err := diagnoseStacks(ctx, ctl.AWSProvider, stacks)
+		if err != nil {
+			return err
+		}
+		if printer != nil {
+			return printer.PrintObj(diagnoses, cmd.CobraCommand.OutOrStdout())
+		}
+		if len(diagnoses) == 0 {
+			logger.Info("no failed stacks found for cluster %q", cfg.Metadata.Name)
+		}
+		for _, d := range diagnoses {
+			logger.Critical(d.Summary())
+			for _, e := range d.CloudTrailEvents {
+				logger.Info("CloudTrail.events/%s: %s %s at %s: %s – %q", d.StackName, e.EventSource, e.EventName, e.EventTime, e.ErrorCode, e.ErrorMessage)
+			}
+		}
+		return nil
+	}
+
 	if printer != nil {
 		return printer.PrintObj(stacks, cmd.CobraCommand.OutOrStdout())
 	}
@@ -159,6 +181,23 @@ func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool, resourceSta
 	return nil
 }
 
+func diagnoseStacks(ctx context.Context, provider api.ClusterProvider, stacks []*manager.Stack) ([]*manager.StackFailureDiagnosis, error) {
+	diagnoses := []*manager.StackFailureDiagnosis{}
+	for _, s := range stacks {
+		if s.StackStatus == types.StackStatusDeleteComplete {
+			continue
+		}
+		d, err := manager.DiagnoseStackFailure(ctx, provider.CloudFormation(), provider.CloudTrail(), s)
+		if err != nil {
+			return nil, fmt.Errorf("diagnosing stack %q: %w", *s.StackName, err)
+		}
+		if d != nil {
+			diagnoses = append(diagnoses, d)
+		}
+	}
+	return diagnoses, nil
+}
+
 func StackEventToString(event *types.StackEvent) string {
 	internalEvent := struct {
 		TimeStamp            time.Time
@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/drift"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+type detectDriftOptions struct {
+	output      printers.Type
+	failOnDrift bool
+	remediate   bool
+}
+
+// driftRow is a row of the drift table, one per drifted property or resource.
+type driftRow struct {
+	StackName      string
+	LogicalID      string
+	ResourceType   string
+	Status         string
+	PropertyPath   string
+	DifferenceType string
+	ExpectedValue  string
+	ActualValue    string
+}
+
+func detectDriftCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options detectDriftOptions
+
+	cmd.SetDescription("detect-drift", "Detect drift in the CloudFormation stacks of a cluster",
+		"Runs CloudFormation drift detection on every stack eksctl manages for the cluster and reports the resources whose actual configuration differs from their template")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doDetectDrift(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&options.output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json or yaml)")
+		fs.BoolVar(&options.failOnDrift, "fail-on-drift", false, "exit with a non-zero code if any stack has drifted")
+		fs.BoolVar(&options.remediate, "remediate", false, "revert the drifted resources of every drifted stack to their template")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doDetectDrift(cmd *cmdutils.Cmd, options detectDriftOptions) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+
+	printer, err := printers.NewPrinter(options.output)
+	if err != nil {
+		return err
+	}
+	if options.output != printers.TableType {
+		logger.Writer = os.Stderr
+	}
+
+	ctx := cmd.Context()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	detector := drift.NewDetector(ctl.NewStackManager(cfg), ctl.AWSProvider.CloudFormation(), cmd.ProviderConfig.WaitTimeout)
+	drifts, err := detector.Detect(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := printDrift(printer, drifts, cmd.CobraCommand.OutOrStdout()); err != nil {
+		return err
+	}
+
+	var drifted, failed int
+	for _, d := range drifts {
+		switch {
+		case d.Drifted():
+			drifted++
+		case d.Failed():
+			failed++
+		}
+	}
+	if drifted == 0 {
+		if failed == 0 {
+			logger.Success("no drift detected in %d stack(s) of cluster %q", len(drifts), cfg.Metadata.Name)
+		}
+	} else {
+		logger.Warning("drift detected in %d of %d stack(s) of cluster %q", drifted, len(drifts), cfg.Metadata.Name)
+		if options.remediate {
+			if err := detector.Remediate(ctx, drifts, cmd.Plan); err != nil {
+				return err
+			}
+			cmdutils.LogPlanModeWarning(cmd.Plan)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("drift detection failed for %d of %d stack(s)", failed, len(drifts))
+	}
+	if drifted > 0 && options.failOnDrift {
+		return fmt.Errorf("drift detected in %d stack(s)", drifted)
+	}
+	return nil
+}
+
+func printDrift(printer printers.OutputPrinter, drifts []drift.StackDrift, w io.Writer) error {
+	if tablePrinter, ok := printer.(*printers.TablePrinter); ok {
+		addDriftTableColumns(tablePrinter)
+		return printer.PrintObjWithKind("stacks", driftRows(drifts), w)
+	}
+	return printer.PrintObjWithKind("stacks", drifts, w)
+}
+
+// driftRows flattens the drift of each stack into table rows.
+func driftRows(drifts []drift.StackDrift) []driftRow {
+	var rows []driftRow
+	for _, d := range drifts {
+		if len(d.Resources) == 0 {
+			rows = append(rows, driftRow{StackName: d.StackName, Status: d.Status})
+			continue
+		}
+		for _, r := range d.Resources {
+			row := driftRow{
+				StackName:    d.StackName,
+				LogicalID:    r.LogicalResourceID,
+				ResourceType: r.ResourceType,
+				Status:       r.Status,
+			}
+			if len(r.Differences) == 0 {
+				rows = append(rows, row)
+				continue
+			}
+			for _, p := range r.Differences {
+				row.PropertyPath = p.PropertyPath
+				row.DifferenceType = p.DifferenceType
+				row.ExpectedValue = p.ExpectedValue
+				row.ActualValue = p.ActualValue
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows
+}
+
+func addDriftTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("STACK", func(r driftRow) string {
+		return r.StackName
+	})
+	printer.AddColumn("RESOURCE", func(r driftRow) string {
+		return r.LogicalID
+	})
+	printer.AddColumn("TYPE", func(r driftRow) string {
+		return r.ResourceType
+	})
+	printer.AddColumn("STATUS", func(r driftRow) string {
+		return r.Status
+	})
+	printer.AddColumn("PROPERTY", func(r driftRow) string {
+		return r.PropertyPath
+	})
+	printer.AddColumn("DIFFERENCE", func(r driftRow) string {
+		return r.DifferenceType
+	})
+	printer.AddColumn("EXPECTED", func(r driftRow) string {
+		return r.ExpectedValue
+	})
+	printer.AddColumn("ACTUAL", func(r driftRow) string {
+		return r.ActualValue
+	})
+}
@@ -0,0 +1,71 @@
+package utils_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/drift"
+	"github.com/weaveworks/eksctl/pkg/ctl/utils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+var _ = Describe("detect-drift", func() {
+	drifts := []drift.StackDrift{
+		{
+			StackName: "eksctl-test-cluster",
+			Status:    "IN_SYNC",
+		},
+		{
+			StackName: "eksctl-test-nodegroup-ng",
+			Status:    "DRIFTED",
+			Resources: []drift.ResourceDrift{
+				{
+					LogicalResourceID: "SG",
+					ResourceType:      "AWS::EC2::SecurityGroup",
+					Status:            "MODIFIED",
+					Differences: []drift.PropertyDifference{
+						{
+							PropertyPath:   "/SecurityGroupIngress/0/CidrIp",
+							DifferenceType: "NOT_EQUAL",
+							ExpectedValue:  "10.0.0.0/16",
+							ActualValue:    "0.0.0.0/0",
+						},
+					},
+				},
+				{
+					LogicalResourceID: "NodeInstanceRole",
+					ResourceType:      "AWS::IAM::Role",
+					Status:            "DELETED",
+				},
+			},
+		},
+	}
+
+	It("prints a row per property difference in table format", func() {
+		printer, err := printers.NewPrinter(printers.TableType)
+		Expect(err).NotTo(HaveOccurred())
+		var out bytes.Buffer
+		Expect(utils.PrintDrift(printer, drifts, &out)).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(4))
+		Expect(lines[0]).To(MatchRegexp(`^STACK\s+RESOURCE\s+TYPE\s+STATUS\s+PROPERTY\s+DIFFERENCE\s+EXPECTED\s+ACTUAL\s*$`))
+		Expect(lines[1:]).To(ConsistOf(
+			MatchRegexp(`^eksctl-test-cluster\s+IN_SYNC\s*$`),
+			MatchRegexp(`^eksctl-test-nodegroup-ng\s+SG\s+AWS::EC2::SecurityGroup\s+MODIFIED\s+/SecurityGroupIngress/0/CidrIp\s+NOT_EQUAL\s+10.0.0.0/16\s+0.0.0.0/0\s*$`),
+			MatchRegexp(`^eksctl-test-nodegroup-ng\s+NodeInstanceRole\s+AWS::IAM::Role\s+DELETED\s*$`),
+		))
+	})
+
+	It("prints the property differences in JSON format", func() {
+		printer, err := printers.NewPrinter(printers.JSONType)
+		Expect(err).NotTo(HaveOccurred())
+		var out bytes.Buffer
+		Expect(utils.PrintDrift(printer, drifts, &out)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring(`"expectedValue": "10.0.0.0/16"`))
+		Expect(out.String()).To(ContainSubstring(`"actualValue": "0.0.0.0/0"`))
+	})
+})
@@ -54,7 +54,7 @@ func enableSecretsEncryptionCmd(cmd *cmdutils.Cmd) {
 func doEnableSecretsEncryption(cmd *cmdutils.Cmd, encryptExistingSecrets bool) error {
 	clusterConfig := cmd.ClusterConfig
 
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -68,7 +68,7 @@ func doEnableSecretsEncryption(cmd *cmdutils.Cmd, encryptExistingSecrets bool) e
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), ctl.AWSProvider.WaitTimeout())
+	ctx, cancel := context.WithTimeout(cmd.Context(), ctl.AWSProvider.WaitTimeout())
 	defer cancel()
 
 	if err := ctl.EnableKMSEncryption(ctx, clusterConfig); err != nil {
@@ -1,5 +1,16 @@
 package utils
 
+import (
+	"io"
+
+	"github.com/weaveworks/eksctl/pkg/actions/drift"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
 func ValidateLoggingFlags(toEnable, toDisable []string) error {
 	return validateLoggingFlags(toEnable, toDisable)
 }
+
+func PrintDrift(printer printers.OutputPrinter, drifts []drift.StackDrift, w io.Writer) error {
+	return printDrift(printer, drifts, w)
+}
@@ -45,7 +45,7 @@ func doInstallWindowsVPCController(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
-	parentCtx := context.TODO()
+	parentCtx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(parentCtx)
 	if err != nil {
 		return err
@@ -71,6 +71,7 @@ func doInstallWindowsVPCController(cmd *cmdutils.Cmd) error {
 		Tasks: []tasks.Task{vpcControllerTask},
 	}
 
+	taskTree.Context = ctx
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
 		return errs[0]
 	}
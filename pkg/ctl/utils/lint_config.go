@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/lint"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+type lintConfigOptions struct {
+	rulesFile string
+	offline   bool
+	output    printers.Type
+}
+
+func lintConfigCmd(cmd *cmdutils.Cmd) {
+	lintConfigWithRunFunc(cmd, doLintConfig)
+}
+
+func lintConfigWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options lintConfigOptions) error) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"lint-config",
+		"Lint a ClusterConfig against best-practice and org-policy rules",
+		"Checks a config file against the built-in rules and, with --rules, against CEL rules from a file, without creating anything. "+
+			"Reports the rule ID, severity, field path and a suggested fix of each finding, and fails if there are error-level findings",
+	)
+
+	var options lintConfigOptions
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return runFunc(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&options.rulesFile, "rules", "", "A file with additional CEL rules")
+		fs.BoolVar(&options.offline, "offline", false, "Skip the rules that call AWS APIs, e.g. the check for deprecated Kubernetes versions")
+		fs.StringVarP(&options.output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json, yaml)")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doLintConfig(cmd *cmdutils.Cmd, options lintConfigOptions) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file")
+	}
+	printer, err := printers.NewPrinter(options.output)
+	if err != nil {
+		return err
+	}
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+
+	var versionsManager eks.ClusterVersionsManagerInterface
+	if !options.offline {
+		if versionsManager, err = newClusterVersionsManager(cmd); err != nil {
+			logger.Warning("skipping rule %q: %v", lint.RuleDeprecatedKubernetesVersion, err)
+		}
+	}
+
+	findings, err := cmdutils.LintClusterConfig(cfg, versionsManager, options.rulesFile)
+	if err != nil {
+		return err
+	}
+
+	if options.output == printers.TableType {
+		addLintFindingTableColumns(printer.(*printers.TablePrinter))
+	}
+	if err := printer.PrintObjWithKind("findings", findings, os.Stdout); err != nil {
+		return err
+	}
+
+	if count := lint.CountErrors(findings); count > 0 {
+		return fmt.Errorf("config %q has %d error-level finding(s)", cmd.ClusterConfigFile, count)
+	}
+	logger.Success("config %q has no error-level findings", cmd.ClusterConfigFile)
+	return nil
+}
+
+func newClusterVersionsManager(cmd *cmdutils.Cmd) (eks.ClusterVersionsManagerInterface, error) {
+	ctl, err := eks.New(cmd.Context(), &cmd.ProviderConfig, cmd.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	return eks.NewClusterVersionsManager(ctl.AWSProvider.EKS())
+}
+
+func addLintFindingTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("RULE", func(f lint.Finding) string {
+		return f.RuleID
+	})
+	printer.AddColumn("SEVERITY", func(f lint.Finding) string {
+		return string(f.Severity)
+	})
+	printer.AddColumn("PATH", func(f lint.Finding) string {
+		return f.Path
+	})
+	printer.AddColumn("MESSAGE", func(f lint.Finding) string {
+		return f.Message
+	})
+	printer.AddColumn("FIX", func(f lint.Finding) string {
+		return f.Fix
+	})
+}
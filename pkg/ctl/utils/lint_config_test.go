@@ -0,0 +1,53 @@
+package utils_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lint-config", func() {
+	writeConfig := func(config string) string {
+		file := filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+		Expect(os.WriteFile(file, []byte(config), 0o600)).To(Succeed())
+		return file
+	}
+
+	const clusterConfig = `apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: test
+  region: us-west-2
+vpc:
+  publicAccessCIDRs: ["192.0.2.0/24"]
+managedNodeGroups:
+- name: mng
+  volumeEncrypted: %s
+`
+
+	It("requires a config file", func() {
+		_, err := newMockCmd("lint-config").execute()
+		Expect(err).To(MatchError(ContainSubstring("--config-file must be set")))
+	})
+
+	It("succeeds for a config without error-level findings", func() {
+		file := writeConfig(fmt.Sprintf(clusterConfig, "true"))
+		_, err := newMockCmd("lint-config", "--config-file", file, "--offline").execute()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails for a config with error-level findings", func() {
+		file := writeConfig(fmt.Sprintf(clusterConfig, "false"))
+		_, err := newMockCmd("lint-config", "--config-file", file, "--offline").execute()
+		Expect(err).To(MatchError(ContainSubstring("has 1 error-level finding(s)")))
+	})
+
+	It("rejects unsupported output formats", func() {
+		file := writeConfig(fmt.Sprintf(clusterConfig, "true"))
+		_, err := newMockCmd("lint-config", "--config-file", file, "--offline", "--output", "xml").execute()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,8 +1,6 @@
 package utils
 
 import (
-	"context"
-
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -18,6 +16,12 @@ func migrateAccessEntryCmd(cmd *cmdutils.Cmd) {
 	cmd.SetDescription("migrate-to-access-entry", "Migrates aws-auth to API authentication mode for the cluster", "")
 
 	var options accessentryactions.MigrationOptions
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		options.Approve = !cmd.Plan
+		return doMigrateToAccessEntry(cmd, options)
+	}
+
 	cmd.FlagSetGroup.InFlagSet("Migrate to Access Entry", func(fs *pflag.FlagSet) {
 		fs.StringVar(&options.TargetAuthMode, "target-authentication-mode", "API_AND_CONFIG_MAP", "Target Authentication mode of migration")
 	})
@@ -29,11 +33,6 @@ func migrateAccessEntryCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddApproveFlag(fs, cmd)
 	})
 
-	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
-		cmd.NameArg = cmdutils.GetNameArg(args)
-		options.Approve = !cmd.Plan
-		return doMigrateToAccessEntry(cmd, options)
-	}
 }
 
 func doMigrateToAccessEntry(cmd *cmdutils.Cmd, options accessentryactions.MigrationOptions) error {
@@ -42,7 +41,7 @@ func doMigrateToAccessEntry(cmd *cmdutils.Cmd, options accessentryactions.Migrat
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
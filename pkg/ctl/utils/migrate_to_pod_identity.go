@@ -44,7 +44,7 @@ func doMigrateToPodIdentity(cmd *cmdutils.Cmd, options podidentityassociation.Po
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+
+	"github.com/weaveworks/eksctl/pkg/actions/recovery"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/elb"
+)
+
+func recoverStackCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options recovery.Options
+
+	cmd.SetDescription("recover-stack", "Recover a CloudFormation stack stuck in UPDATE_ROLLBACK_FAILED or DELETE_FAILED status",
+		"Continues rolling back a stack in UPDATE_ROLLBACK_FAILED status, skipping the resources that failed to be rolled back, "+
+			"or retries the deletion of a stack in DELETE_FAILED status after cleaning up dangling network interfaces, and load balancers for the cluster stack, "+
+			"retaining and then deleting the resources that failed to be deleted")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doRecoverStack(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&options.StackName, "stack", "", "name of the stack to recover")
+		fs.StringSliceVar(&options.Resources, "resources", nil, "logical IDs of the resources to skip during the rollback or to retain during the deletion (default: the resources that failed)")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doRecoverStack(cmd *cmdutils.Cmd, options recovery.Options) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if options.StackName == "" {
+		return cmdutils.ErrMustBeSet("--stack")
+	}
+
+	ctx := cmd.Context()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		// the stack of a cluster that failed to be created or deleted can be recovered without the cluster
+		logger.Warning("failed to create provider for cluster, proceeding without access to the cluster: %v", err)
+		if ctl, err = cmd.NewCtl(); err != nil {
+			return err
+		}
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	loadClusterVPC(ctx, ctl, cfg, stackManager)
+
+	recoverer := recovery.New(cfg, stackManager, ctl.AWSProvider, newLoadBalancerCleaner(ctl, cfg))
+	if err := recoverer.Recover(ctx, options, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
+
+// loadClusterVPC loads the VPC of the cluster so that dangling network interfaces can be cleaned up
+func loadClusterVPC(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, stackManager manager.StackManager) {
+	clusterStack, err := stackManager.GetClusterStackIfExists(ctx)
+	if err != nil || clusterStack == nil {
+		logger.Debug("cluster stack not found, dangling network interfaces will not be cleaned up: %v", err)
+		return
+	}
+	if err := ctl.LoadClusterVPC(ctx, cfg, clusterStack, true); err != nil {
+		logger.Warning("failed to load the VPC of cluster %q, dangling network interfaces will not be cleaned up: %v", cfg.Metadata.Name, err)
+	}
+}
+
+// newLoadBalancerCleaner returns nil if the Kubernetes API of the cluster cannot be reached
+func newLoadBalancerCleaner(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) recovery.LoadBalancerCleaner {
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		logger.Debug("cannot operate cluster: %v", err)
+		return nil
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		logger.Warning("failed to create Kubernetes client: %v", err)
+		return nil
+	}
+	cfg.Metadata.Version = *ctl.Status.ClusterInfo.Cluster.Version
+
+	// if the raw client cannot be created, Gateway resources are skipped
+	var restConfig *rest.Config
+	if rawClient, err := ctl.NewRawClient(cfg); err != nil {
+		logger.Warning("failed to create Kubernetes client for Gateway API cleanup, will skip Gateway resources: %v", err)
+	} else {
+		restConfig = rawClient.RestConfig()
+	}
+
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+		defer cancel()
+		return elb.Cleanup(ctx, ctl.AWSProvider.EC2(), ctl.AWSProvider.ELB(), ctl.AWSProvider.ELBV2(), clientSet, restConfig, cfg)
+	}
+}
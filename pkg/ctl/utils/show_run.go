@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"io"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+func showRunCmd(cmd *cmdutils.Cmd) {
+	var output printers.Type
+
+	cmd.SetDescription("show-run", "Show the runs recorded by eksctl, or the tasks of a run",
+		"Lists the runs of commands that record their tasks, such as 'eksctl create cluster', most recent first. "+
+			"When a run ID is given, shows the status of each task of the run; an interrupted run can be resumed with --resume=<run-id>")
+
+	cmd.CobraCommand.Args = cobra.MaximumNArgs(1)
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doShowRun(cmd, output)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVarP(&output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json or yaml)")
+	})
+}
+
+func doShowRun(cmd *cmdutils.Cmd, output printers.Type) error {
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+	dir, err := tasks.JournalDir()
+	if err != nil {
+		return err
+	}
+	w := cmd.CobraCommand.OutOrStdout()
+
+	if cmd.NameArg != "" {
+		journal, err := tasks.LoadJournal(dir, cmd.NameArg)
+		if err != nil {
+			return err
+		}
+		return printRun(printer, journal, w)
+	}
+
+	journals, err := tasks.ListJournals(dir)
+	if err != nil {
+		return err
+	}
+	if len(journals) == 0 {
+		logger.Info("no runs found in %q", dir)
+		return nil
+	}
+	return printRuns(printer, journals, w)
+}
+
+func printRuns(printer printers.OutputPrinter, journals []*tasks.Journal, w io.Writer) error {
+	if tablePrinter, ok := printer.(*printers.TablePrinter); ok {
+		addRunsTableColumns(tablePrinter)
+	}
+	return printer.PrintObjWithKind("runs", journals, w)
+}
+
+func printRun(printer printers.OutputPrinter, journal *tasks.Journal, w io.Writer) error {
+	if tablePrinter, ok := printer.(*printers.TablePrinter); ok {
+		addRunTableColumns(tablePrinter)
+		return printer.PrintObjWithKind("tasks", journal.Tasks, w)
+	}
+	return printer.PrintObjWithKind("run", journal, w)
+}
+
+func addRunsTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("RUN ID", func(j *tasks.Journal) string {
+		return j.RunID
+	})
+	printer.AddColumn("COMMAND", func(j *tasks.Journal) string {
+		return j.Command
+	})
+	printer.AddColumn("CLUSTER", func(j *tasks.Journal) string {
+		return j.Cluster
+	})
+	printer.AddColumn("STARTED", func(j *tasks.Journal) string {
+		return j.StartedAt.Format(time.RFC3339)
+	})
+	printer.AddColumn("COMPLETED", func(j *tasks.Journal) int {
+		return j.Counts()[tasks.TaskStatusCompleted]
+	})
+	printer.AddColumn("FAILED", func(j *tasks.Journal) int {
+		return j.Counts()[tasks.TaskStatusFailed]
+	})
+	// tasks still running when a run was interrupted did not complete, and are run again when it is resumed
+	printer.AddColumn("PENDING", func(j *tasks.Journal) int {
+		counts := j.Counts()
+		return counts[tasks.TaskStatusPending] + counts[tasks.TaskStatusRunning]
+	})
+}
+
+func addRunTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("TASK ID", func(e *tasks.JournalEntry) string {
+		return e.ID
+	})
+	printer.AddColumn("STATUS", func(e *tasks.JournalEntry) string {
+		return string(e.Status)
+	})
+	printer.AddColumn("DESCRIPTION", func(e *tasks.JournalEntry) string {
+		return e.Description
+	})
+	printer.AddColumn("ERROR", func(e *tasks.JournalEntry) string {
+		return e.Error
+	})
+}
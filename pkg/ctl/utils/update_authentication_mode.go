@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -45,7 +44,7 @@ func doUpdateAuthenticationMode(cmd *cmdutils.Cmd, authenticationMode string) er
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := cmd.Context()
 	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
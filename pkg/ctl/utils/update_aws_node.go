@@ -1,8 +1,6 @@
 package utils
 
 import (
-	"context"
-
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -35,7 +33,7 @@ func updateAWSNodeCmd(cmd *cmdutils.Cmd) {
 }
 
 func doUpdateAWSNode(cmd *cmdutils.Cmd) error {
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	return updateAddon(ctx, cmd, api.VPCCNIAddon, func(rawClient *kubernetes.RawClient, _ defaultaddons.AddonVersionDescriber) (bool, error) {
 		return defaultaddons.UpdateAWSNode(ctx, defaultaddons.AddonInput{
 			RawClient: rawClient,
@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -64,7 +63,7 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 
 	printer := printers.NewJSONPrinter()
 
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
@@ -87,7 +86,7 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 	willBeDisabled := sets.New[string](api.SupportedCloudWatchClusterLogTypes()...).Difference(willBeEnabled)
 	updateRequired := !currentlyEnabled.Equal(willBeEnabled)
 
-	if err = printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err = printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
@@ -102,11 +101,11 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 			describeTypesToDisable = fmt.Sprintf("disable types: %s", strings.Join(sets.List(willBeDisabled), ", "))
 		}
 
-		cmdutils.LogIntendedAction(cmd.Plan, "update CloudWatch logging for cluster %q in %q (%s & %s)",
+		cmdutils.LogIntendedAction(ctx, cmd.Plan, "update CloudWatch logging for cluster %q in %q (%s & %s)",
 			meta.Name, meta.Region, describeTypesToEnable, describeTypesToDisable,
 		)
 		if period := cfg.CloudWatch.ClusterLogging.LogRetentionInDays; period > 0 {
-			cmdutils.LogIntendedAction(cmd.Plan, "update CloudWatch logging for log retention period set to %d",
+			cmdutils.LogIntendedAction(ctx, cmd.Plan, "update CloudWatch logging for log retention period set to %d",
 				period,
 			)
 		}
@@ -1,8 +1,6 @@
 package utils
 
 import (
-	"context"
-
 	"github.com/kris-nova/logger"
 	"github.com/lithammer/dedent"
 	"github.com/spf13/cobra"
@@ -62,7 +60,7 @@ func updateClusterVPCConfigCmd(cmd *cmdutils.Cmd) {
 }
 
 func doUpdateClusterVPCConfig(cmd *cmdutils.Cmd) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
 		return err
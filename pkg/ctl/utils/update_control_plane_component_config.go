@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"errors"
 	"fmt"
 
@@ -47,7 +46,7 @@ func updateControlPlaneComponentConfigCmd(cmd *cmdutils.Cmd) {
 
 func doUpdateControlPlaneComponentConfig(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
-	ctx := context.Background()
+	ctx := cmd.Context()
 	if cfg.Metadata.Name == "" {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
 	}
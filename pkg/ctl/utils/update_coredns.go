@@ -1,8 +1,6 @@
 package utils
 
 import (
-	"context"
-
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -35,7 +33,7 @@ func updateCoreDNSCmd(cmd *cmdutils.Cmd) {
 }
 
 func doUpdateCoreDNS(cmd *cmdutils.Cmd) error {
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	return updateAddon(ctx, cmd, api.CoreDNSAddon, func(rawClient *kubernetes.RawClient, _ defaultaddons.AddonVersionDescriber) (bool, error) {
 		kubernetesVersion, err := rawClient.ServerVersion()
 		if err != nil {
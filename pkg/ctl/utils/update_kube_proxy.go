@@ -1,8 +1,6 @@
 package utils
 
 import (
-	"context"
-
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -35,7 +33,7 @@ func updateKubeProxyCmd(cmd *cmdutils.Cmd) {
 }
 
 func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
-	ctx := context.TODO()
+	ctx := cmd.Context()
 	return updateAddon(ctx, cmd, api.KubeProxyAddon, func(rawClient *kubernetes.RawClient, addonDescriber defaultaddons.AddonVersionDescriber) (bool, error) {
 		kubernetesVersion, err := rawClient.ServerVersion()
 		if err != nil {
@@ -32,6 +32,13 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, migrateAccessEntryCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateZonalShiftConfigCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateControlPlaneComponentConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, lintConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, convertConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, detectDriftCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, adoptCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, recoverStackCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateTagsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, showRunCmd)
 
 	return verbCmd
 }
@@ -74,7 +74,7 @@ func (v *VPCHelper) updateSubnetsSecurityGroups(ctx context.Context, vpc *api.Cl
 		if !slices.Equal(currentValues, newValues) {
 			updateFn()
 			hasUpdate = true
-			cmdutils.LogIntendedAction(v.PlanMode, "update %s for cluster %q in %q to: %v", resourceName,
+			cmdutils.LogIntendedAction(ctx, v.PlanMode, "update %s for cluster %q in %q to: %v", resourceName,
 				v.ClusterMeta.Name, v.ClusterMeta.Region, newValues)
 		} else {
 			logger.Success("%s for cluster %q in %q are already up-to-date", resourceName, v.ClusterMeta.Name, v.ClusterMeta.Region)
@@ -111,7 +111,7 @@ func (v *VPCHelper) updateControlPlaneEgressMode(ctx context.Context, vpc *api.C
 			v.ClusterMeta.Name, v.ClusterMeta.Region, current)
 		return nil
 	}
-	cmdutils.LogIntendedAction(v.PlanMode, "update control plane egress mode for cluster %q in %q to: %s",
+	cmdutils.LogIntendedAction(ctx, v.PlanMode, "update control plane egress mode for cluster %q in %q to: %s",
 		v.ClusterMeta.Name, v.ClusterMeta.Region, vpc.ControlPlaneEgressMode)
 	if v.PlanMode {
 		return nil
@@ -140,7 +140,7 @@ func (v *VPCHelper) updateEndpointAccess(ctx context.Context, desired api.Cluste
 		return nil
 	}
 
-	cmdutils.LogIntendedAction(
+	cmdutils.LogIntendedAction(ctx,
 		v.PlanMode, "update Kubernetes API endpoint access for cluster %q in %q to: privateAccess=%v, publicAccess=%v",
 		v.ClusterMeta.Name, v.ClusterMeta.Region, *desired.PrivateAccess, *desired.PublicAccess)
 	if api.PrivateOnly(&desired) {
@@ -172,7 +172,7 @@ func (v *VPCHelper) updatePublicAccessCIDRs(ctx context.Context, vpc *api.Cluste
 	}
 
 	logger.Info("current public access CIDRs: %v", v.Cluster.ResourcesVpcConfig.PublicAccessCidrs)
-	cmdutils.LogIntendedAction(
+	cmdutils.LogIntendedAction(ctx,
 		v.PlanMode, "update public access CIDRs for cluster %q in %q to: %v",
 		v.ClusterMeta.Name, v.ClusterMeta.Region, vpc.PublicAccessCIDRs)
 
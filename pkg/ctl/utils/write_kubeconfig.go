@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/kris-nova/logger"
@@ -72,7 +71,7 @@ func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setCont
 		outputPath = kubeconfig.AutoPath(cfg.Metadata.Name)
 	}
 
-	ctl, err := cmd.NewProviderForExistingCluster(context.Background())
+	ctl, err := cmd.NewProviderForExistingCluster(cmd.Context())
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,8 @@ package eks
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -20,6 +22,7 @@ import (
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/kris-nova/logger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -27,6 +30,7 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/az"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
@@ -45,6 +49,8 @@ type ClusterProvider struct {
 	AWSProvider api.ClusterProvider
 	// informative fields, i.e. used as outputs
 	Status *ProviderStatus
+	// StackCollectionOptions are applied to all stack managers, e.g. to export templates
+	StackCollectionOptions []manager.StackCollectionOption
 }
 
 // KubernetesProvider provides helper methods to handle Kubernetes operations.
@@ -84,6 +90,11 @@ func (p ProviderServices) CloudFormationDisableRollback() bool {
 	return p.spec.CloudFormationDisableRollback
 }
 
+// CloudFormationTemplateBucket returns, if any, the S3 bucket used to stage large templates
+func (p ProviderServices) CloudFormationTemplateBucket() string {
+	return p.spec.CloudFormationTemplateBucket
+}
+
 // ASG returns a representation of the AutoScaling API
 func (p ProviderServices) ASG() awsapi.ASG { return p.asg }
 
@@ -214,12 +225,18 @@ func newAWSProvider(spec *api.ProviderConfig, configurationLoader AWSConfigurati
 
 // ParseConfig parses data into a ClusterConfig
 func ParseConfig(data []byte) (*api.ClusterConfig, error) {
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, err
+	}
+
 	// strict mode is not available in runtime.Decode, so we use the parser
 	// directly; we don't store the resulting object, this is just the means
 	// of detecting any unknown keys
 	// NOTE: we must use sigs.k8s.io/yaml, as it behaves differently from
 	// github.com/ghodss/yaml, which didn't handle nested structs well
-	if err := yaml.UnmarshalStrict(data, &api.ClusterConfig{}); err != nil {
+	clusterConfig, _ := newConfigObjects(typeMeta.APIVersion)
+	if err := yaml.UnmarshalStrict(data, clusterConfig); err != nil {
 		return nil, err
 	}
 
@@ -228,11 +245,19 @@ func ParseConfig(data []byte) (*api.ClusterConfig, error) {
 		return nil, err
 	}
 
-	cfg, ok := obj.(*api.ClusterConfig)
-	if !ok {
-		return nil, fmt.Errorf("expected to decode object of type %T; got %T", &api.ClusterConfig{}, cfg)
+	switch obj := obj.(type) {
+	case *api.ClusterConfig:
+		return obj, nil
+	case *v1alpha6.ClusterConfig:
+		// v1alpha6 configs are converted to v1alpha5, which is what the rest of eksctl works with
+		cfg := &api.ClusterConfig{}
+		if err := scheme.Scheme.Convert(obj, cfg, nil); err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	default:
+		return nil, fmt.Errorf("expected to decode object of type %T; got %T", &api.ClusterConfig{}, obj)
 	}
-	return cfg, nil
 }
 
 // LoadConfigFromFile loads ClusterConfig from configFile
@@ -240,27 +265,126 @@ func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
 	return LoadConfigWithReader(configFile, nil)
 }
 
+// LoadConfigOptions holds the options for LoadConfigWithOptions.
+type LoadConfigOptions struct {
+	// OverlayFiles are merged onto the config file in order.
+	OverlayFiles []string
+	// Vars, when set, expands variable references in the config and overlay files.
+	Vars *ConfigVars
+	// Defaults, when set, are merged into the config.
+	Defaults *OrgDefaults
+}
+
 // LoadConfigWithReader loads ClusterConfig from configFile or configReader.
-func LoadConfigWithReader(configFile string, configReader io.Reader) (*api.ClusterConfig, error) {
-	data, err := readConfig(configFile, configReader)
+// The config file may contain multiple `---` separated documents, including a ClusterConfigList;
+// these documents and any overlayFiles are merged in order, with nodegroups, addons and
+// Fargate profiles merged by name.
+func LoadConfigWithReader(configFile string, configReader io.Reader, overlayFiles ...string) (*api.ClusterConfig, error) {
+	return LoadConfigWithOptions(configFile, configReader, LoadConfigOptions{OverlayFiles: overlayFiles})
+}
+
+// LoadConfigWithOptions is like LoadConfigWithReader, but also supports variable expansion.
+func LoadConfigWithOptions(configFile string, configReader io.Reader, options LoadConfigOptions) (*api.ClusterConfig, error) {
+	clusterConfig, _, err := LoadConfigWithSource(configFile, configReader, options)
+	return clusterConfig, err
+}
+
+// LoadConfigWithSource is like LoadConfigWithOptions, but also returns the ConfigSource of the
+// config, which annotates errors with the position of the offending fields.
+func LoadConfigWithSource(configFile string, configReader io.Reader, options LoadConfigOptions) (*api.ClusterConfig, *ConfigSource, error) {
+	overlayFiles := options.OverlayFiles
+	for _, overlayFile := range overlayFiles {
+		// stdin can only be read once, so it can only be used for the base config
+		if overlayFile == "-" {
+			return nil, nil, errors.New("overlay config files cannot be read from stdin, only the first config file can be '-'")
+		}
+	}
+	data, err := readConfig(configFile, configReader, options.Vars)
 	if err != nil {
-		return nil, fmt.Errorf("reading config file %q: %w", configFile, err)
+		return nil, nil, fmt.Errorf("reading config file %q: %w", configFile, err)
+	}
+	source := &ConfigSource{}
+	source.add(configFile, data)
+
+	if rawDocuments, err := splitDocuments(data); err != nil || (len(overlayFiles) == 0 && options.Defaults == nil && isSingleClusterConfig(rawDocuments)) {
+		clusterConfig, err := ParseConfig(data)
+		if err != nil {
+			return nil, nil, loadConfigError(source, err, "loading config file %q: %w", configFile)
+		}
+		return clusterConfig, source, nil
 	}
-	clusterConfig, err := ParseConfig(data)
+
+	documents, err := parseDocuments(configFile, data)
 	if err != nil {
-		return nil, fmt.Errorf("loading config file %q: %w", configFile, err)
+		return nil, nil, loadConfigError(source, err, "loading config file %q: %w", configFile)
 	}
-	return clusterConfig, nil
+
+	for _, overlayFile := range overlayFiles {
+		overlayData, err := readConfig(overlayFile, configReader, options.Vars)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading config file %q: %w", overlayFile, err)
+		}
+		overlaySource := &ConfigSource{}
+		overlaySource.add(overlayFile, overlayData)
+		overlayDocuments, err := parseDocuments(overlayFile, overlayData)
+		if err != nil {
+			return nil, nil, loadConfigError(overlaySource, err, "loading config file %q: %w", overlayFile)
+		}
+		documents = append(documents, overlayDocuments...)
+		source.documents = append(source.documents, overlaySource.documents...)
+	}
+	merged, err := mergeDocuments(documents)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading config file %q: %w", configFile, err)
+	}
+	if options.Defaults != nil {
+		if merged, err = options.Defaults.apply(merged); err != nil {
+			return nil, nil, loadConfigError(source, err, "loading config file %q: %w", configFile)
+		}
+	}
+	mergedData, err := json.Marshal(merged)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading config file %q: %w", configFile, err)
+	}
+	clusterConfig, err := ParseConfig(mergedData)
+	if err != nil {
+		return nil, nil, loadConfigError(source, err, "loading merged config file %q: %w", configFile)
+	}
+	return clusterConfig, source, nil
+}
+
+// loadConfigError returns err as a ConfigError if it can be annotated with its position in the config files, which
+// names the file already; otherwise err is wrapped with format, which takes the config file and err.
+func loadConfigError(source *ConfigSource, err error, format, configFile string) error {
+	annotated := err
+	var documentErr *documentError
+	if errors.As(err, &documentErr) {
+		annotated = documentErr.err
+	}
+	var configErr *ConfigError
+	if errors.As(source.Annotate(annotated), &configErr) {
+		return configErr
+	}
+	return fmt.Errorf(format, configFile, err)
 }
 
-func readConfig(configFile string, reader io.Reader) ([]byte, error) {
+func readConfig(configFile string, reader io.Reader, vars *ConfigVars) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
 	if configFile == "-" {
 		if reader == nil {
 			reader = os.Stdin
 		}
-		return io.ReadAll(reader)
+		data, err = io.ReadAll(reader)
+	} else {
+		data, err = os.ReadFile(configFile)
+	}
+	if err != nil || vars == nil {
+		return data, err
 	}
-	return os.ReadFile(configFile)
+	return vars.Expand(data)
 }
 
 // IsSupportedRegion check if given region is supported
@@ -483,7 +607,7 @@ func ValidateLocalZones(ctx context.Context, ec2API awsapi.EC2, localZones []str
 
 // NewStackManager returns a new stack manager
 func (c *ClusterProvider) NewStackManager(spec *api.ClusterConfig) manager.StackManager {
-	return manager.NewStackCollection(c.AWSProvider, spec)
+	return manager.NewStackCollectionWithOptions(c.AWSProvider, spec, c.StackCollectionOptions...)
 }
 
 // LoadClusterIntoSpecFromStack uses stack information to load the cluster
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -215,13 +216,13 @@ var _ = Describe("eksctl API", func() {
 		It("should reject unknown field in a YAML config", func() {
 			_, err := eks.LoadConfigFromFile("testdata/bad-field-1.yaml")
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/bad-field-1.yaml": error unmarshaling JSON: while decoding JSON: json: unknown field "zone"`))
+			Expect(err.Error()).To(HavePrefix(`testdata/bad-field-1.yaml:7:3: unknown field "zone"`))
 		})
 
 		It("should reject unknown field in a YAML config", func() {
 			_, err := eks.LoadConfigFromFile("testdata/bad-field-2.yaml")
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/bad-field-2.yaml": error unmarshaling JSON: while decoding JSON: json: unknown field "bar"`))
+			Expect(err.Error()).To(HavePrefix(`testdata/bad-field-2.yaml:17:10: unknown field "bar"`))
 		})
 
 		It("should reject unknown field in a JSON config", func() {
@@ -241,6 +242,98 @@ var _ = Describe("eksctl API", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal(`reading config file "../../examples/nothing.xml": open ../../examples/nothing.xml: no such file or directory`))
 		})
+
+		It("should merge multiple documents and ClusterConfigList items", func() {
+			cfg, err := eks.LoadConfigFromFile("testdata/multi-document.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Metadata.Name).To(Equal("cluster-1"))
+			Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+			Expect(cfg.NodeGroups).To(HaveLen(2))
+			Expect(cfg.NodeGroups[0].Name).To(Equal("ng-1"))
+			Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.large"))
+			Expect(*cfg.NodeGroups[0].DesiredCapacity).To(Equal(3))
+			Expect(cfg.NodeGroups[1].Name).To(Equal("ng-2"))
+		})
+
+		It("should merge overlays onto the base config by name", func() {
+			cfg, err := eks.LoadConfigWithReader("testdata/base.yaml", nil, "testdata/prod-overlay.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+			Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"team": "platform", "environment": "prod"}))
+
+			var names []string
+			for _, ng := range cfg.ManagedNodeGroups {
+				names = append(names, ng.Name)
+			}
+			Expect(names).To(Equal([]string{"mng-1", "mng-2", "mng-3"}))
+			Expect(cfg.ManagedNodeGroups[0].InstanceType).To(Equal("m5.large"))
+			Expect(*cfg.ManagedNodeGroups[0].DesiredCapacity).To(Equal(5))
+			Expect(cfg.ManagedNodeGroups[2].InstanceType).To(Equal("c5.xlarge"))
+
+			Expect(cfg.Addons).To(HaveLen(2))
+			Expect(cfg.Addons[0].Name).To(Equal("vpc-cni"))
+			Expect(cfg.Addons[0].Version).To(Equal("v1.18.0"))
+			Expect(cfg.Addons[1].Name).To(Equal("coredns"))
+		})
+
+		It("should reject unnamed items of lists that are merged by name", func() {
+			_, err := eks.LoadConfigWithReader("testdata/base.yaml", nil, "testdata/unnamed-overlay.yaml")
+			Expect(err).To(MatchError(`loading config file "testdata/base.yaml": testdata/unnamed-overlay.yaml: managedNodeGroups[1].name must be set, as managedNodeGroups are merged by name across documents`))
+		})
+
+		It("should reject overlays read from stdin", func() {
+			_, err := eks.LoadConfigWithReader("-", strings.NewReader("{}"), "-")
+			Expect(err).To(MatchError("overlay config files cannot be read from stdin, only the first config file can be '-'"))
+		})
+
+		It("should reject unknown fields in any document", func() {
+			_, err := eks.LoadConfigFromFile("testdata/multi-document-bad-field.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`testdata/multi-document-bad-field.yaml:13:5: unknown field "instanceTyp"`))
+		})
+
+		It("should number the documents in errors that have no position", func() {
+			_, err := eks.LoadConfigFromFile("testdata/multi-document-bad-type.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/multi-document-bad-type.yaml": document 2: error unmarshaling JSON`))
+		})
+
+		It("should reject unknown fields in overlays", func() {
+			_, err := eks.LoadConfigWithReader("testdata/base.yaml", nil, "testdata/bad-field-1.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`testdata/bad-field-1.yaml:7:3: unknown field "zone"`))
+		})
+
+		It("should convert a v1alpha6 config to v1alpha5", func() {
+			cfg, err := eks.LoadConfigFromFile("testdata/v1alpha6.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.APIVersion).To(Equal("eksctl.io/v1alpha5"))
+			Expect(cfg.NodeGroups).To(HaveLen(1))
+			Expect(cfg.NodeGroups[0].Name).To(Equal("ng-1"))
+			Expect(cfg.NodeGroups[0].ClusterDNS).To(Equal("169.254.20.10"))
+			Expect(*cfg.NodeGroups[0].IAM.WithAddonPolicies.AutoScaler).To(BeTrue())
+			Expect(*cfg.NodeGroups[0].IAM.WithAddonPolicies.EBS).To(BeTrue())
+			Expect(cfg.NodeGroups[0].IAM.WithAddonPolicies.ImageBuilder).To(BeNil())
+			Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+			Expect(cfg.ManagedNodeGroups[0].Name).To(Equal("mng-1"))
+			Expect(cfg.ManagedNodeGroups[0].InstanceTypes).To(Equal([]string{"m5.large", "m5a.large"}))
+			Expect(cfg.ManagedNodeGroups[0].Spot).To(BeTrue())
+		})
+
+		It("should reject v1alpha6 nodegroup fields that are not supported by the kind of nodegroup", func() {
+			_, err := eks.LoadConfigFromFile("testdata/v1alpha6-bad-field.yaml")
+			Expect(err).To(MatchError(ContainSubstring("nodeGroups[0].cpuCredits is not supported for managed nodegroups")))
+		})
+
+		It("should reject documents with different apiVersions", func() {
+			_, err := eks.LoadConfigWithReader("testdata/base.yaml", nil, "testdata/v1alpha6.yaml")
+			Expect(err).To(MatchError(ContainSubstring(`apiVersion eksctl.io/v1alpha6 does not match eksctl.io/v1alpha5`)))
+		})
+
+		It("should reject documents for different clusters", func() {
+			_, err := eks.LoadConfigFromFile("testdata/multi-cluster.yaml")
+			Expect(err).To(MatchError(ContainSubstring(`cluster name "cluster-2" does not match "cluster-1"; only one cluster can be defined per config`)))
+		})
 	})
 
 	Context("Dynamic AMI Resolution", func() {
@@ -60,6 +60,7 @@ func newV2Config(pc *api.ProviderConfig, credentialsCacheFilePath string, config
 		}),
 		config.WithAPIOptions([]func(stack *middleware.Stack) error{
 			middlewarev2.AddUserAgentKeyValue("eksctl", version.String()),
+			addRetryEventsMiddleware,
 		}),
 		// Some CloudFormation operations can take a long time to complete, and we
 		// don't want any temporary credentials to expire before this occurs. So if
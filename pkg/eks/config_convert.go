@@ -0,0 +1,365 @@
+package eks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+)
+
+// ConvertConfig converts the ClusterConfig documents in data, including the items of a ClusterConfigList,
+// to apiVersion. The documents are rewritten rather than re-generated, so that comments and the order of
+// fields are preserved; documents without an apiVersion are converted from the version of the first document.
+func ConvertConfig(data []byte, apiVersion string) ([]byte, error) {
+	if apiVersion != api.SchemeGroupVersion.String() && apiVersion != v1alpha6.SchemeGroupVersion.String() {
+		return nil, fmt.Errorf("unsupported apiVersion %q, must be one of %s, %s", apiVersion, api.SchemeGroupVersion, v1alpha6.SchemeGroupVersion)
+	}
+
+	var (
+		documents      []*yamlv3.Node
+		defaultVersion string
+	)
+	decoder := yamlv3.NewDecoder(bytes.NewReader(data))
+	for i := 1; ; i++ {
+		var document yamlv3.Node
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		if len(document.Content) == 0 {
+			continue
+		}
+		if document.Content[0].Kind != yamlv3.MappingNode {
+			return nil, fmt.Errorf("document %d: expected a ClusterConfig", i)
+		}
+		documents = append(documents, &document)
+		root := document.Content[0]
+		if i == 1 {
+			defaultVersion = scalarValue(root, "apiVersion")
+		}
+
+		if scalarValue(root, "kind") != api.ClusterConfigListKind {
+			if err := convertClusterConfigNode(root, defaultVersion, apiVersion); err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+			continue
+		}
+		listVersion := scalarValue(root, "apiVersion")
+		if listVersion == "" {
+			listVersion = defaultVersion
+		}
+		if items := mappingValue(root, "items"); items != nil && items.Kind == yamlv3.SequenceNode {
+			for j, item := range items.Content {
+				if item.Kind != yamlv3.MappingNode {
+					return nil, fmt.Errorf("document %d: item %d is not an object", i, j+1)
+				}
+				if err := convertClusterConfigNode(item, listVersion, apiVersion); err != nil {
+					return nil, fmt.Errorf("document %d (item %d): %w", i, j+1, err)
+				}
+			}
+		}
+		if value := mappingValue(root, "apiVersion"); value != nil {
+			value.Value = apiVersion
+		}
+	}
+
+	var out bytes.Buffer
+	encoder := yamlv3.NewEncoder(&out)
+	encoder.SetIndent(2)
+	for _, document := range documents {
+		if err := encoder.Encode(document); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// convertClusterConfigNode converts a ClusterConfig, which is of defaultVersion if it has no apiVersion, to apiVersion.
+func convertClusterConfigNode(node *yamlv3.Node, defaultVersion, apiVersion string) error {
+	version := scalarValue(node, "apiVersion")
+	if version == "" {
+		version = defaultVersion
+	}
+	switch version {
+	case apiVersion:
+		return nil
+	case api.SchemeGroupVersion.String(), v1alpha6.SchemeGroupVersion.String():
+	default:
+		return fmt.Errorf("unsupported apiVersion %q", version)
+	}
+
+	// the typed conversion checks that the config can be converted, and produces the values of fields
+	// whose shape changes between versions
+	typed := &yamlv3.Node{Kind: yamlv3.MappingNode, Content: node.Content}
+	if mappingValue(node, "apiVersion") == nil {
+		typed.Content = append(scalarPair("apiVersion", version), typed.Content...)
+	}
+	if mappingValue(node, "kind") == nil {
+		typed.Content = append(scalarPair("kind", api.ClusterConfigKind), typed.Content...)
+	}
+	data, err := yamlv3.Marshal(typed)
+	if err != nil {
+		return err
+	}
+	clusterConfig, err := ParseConfig(data)
+	if err != nil {
+		return err
+	}
+
+	if value := mappingValue(node, "apiVersion"); value != nil {
+		value.Value = apiVersion
+	}
+	if apiVersion == v1alpha6.SchemeGroupVersion.String() {
+		converted := &v1alpha6.ClusterConfig{}
+		if err := v1alpha6.ConvertFromV1alpha5(clusterConfig, converted); err != nil {
+			return err
+		}
+		return convertNodeToV1alpha6(node, converted)
+	}
+	return convertNodeToV1alpha5(node)
+}
+
+// convertNodeToV1alpha6 rewrites a v1alpha5 ClusterConfig node, using the nodegroups of converted, which
+// hold the self-managed nodegroups followed by the managed ones.
+func convertNodeToV1alpha6(node *yamlv3.Node, converted *v1alpha6.ClusterConfig) error {
+	removeMappingKey(node, "gitops")
+
+	nodeGroups := mappingValue(node, "nodeGroups")
+	var index int
+	if nodeGroups != nil && nodeGroups.Kind == yamlv3.SequenceNode {
+		for _, item := range nodeGroups.Content {
+			removeMappingKey(item, "containerRuntime")
+			insertMappingPair(item, "name", scalarPair("managed", "false"))
+			if err := convertNodeGroupNodeToV1alpha6(item, converted.NodeGroups[index]); err != nil {
+				return err
+			}
+			index++
+		}
+	}
+
+	managedKey, managedNodeGroups := mappingPair(node, "managedNodeGroups")
+	if managedNodeGroups == nil || managedNodeGroups.Kind != yamlv3.SequenceNode {
+		return nil
+	}
+	for _, item := range managedNodeGroups.Content {
+		if err := convertNodeGroupNodeToV1alpha6(item, converted.NodeGroups[index]); err != nil {
+			return err
+		}
+		index++
+	}
+	if nodeGroups == nil || nodeGroups.Kind != yamlv3.SequenceNode {
+		managedKey.Value = "nodeGroups"
+		return nil
+	}
+	if len(managedNodeGroups.Content) > 0 {
+		first := managedNodeGroups.Content[0]
+		first.HeadComment = joinComments(managedKey.HeadComment, first.HeadComment)
+	}
+	nodeGroups.Content = append(nodeGroups.Content, managedNodeGroups.Content...)
+	removeMappingKey(node, "managedNodeGroups")
+	return nil
+}
+
+func convertNodeGroupNodeToV1alpha6(node *yamlv3.Node, converted *v1alpha6.NodeGroup) error {
+	if key, value := mappingPair(node, "taints"); value != nil && value.Kind == yamlv3.MappingNode {
+		taints := &yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq"}
+		for _, t := range converted.Taints {
+			taint := &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			taint.Content = append(taint.Content, scalarPair("key", t.Key)...)
+			if t.Value != "" {
+				taint.Content = append(taint.Content, scalarPair("value", t.Value)...)
+			}
+			taint.Content = append(taint.Content, scalarPair("effect", string(t.Effect))...)
+			taints.Content = append(taints.Content, taint)
+		}
+		setMappingValue(node, key, taints)
+	}
+
+	iam := mappingValue(node, "iam")
+	key, withAddonPolicies := mappingPair(iam, "withAddonPolicies")
+	if key == nil {
+		return nil
+	}
+	if converted.IAM == nil || len(converted.IAM.AddonPolicies) == 0 {
+		removeMappingKey(iam, "withAddonPolicies")
+		if len(iam.Content) == 0 {
+			removeMappingKey(node, "iam")
+		}
+		return nil
+	}
+	policies := &yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq"}
+	items := map[string]*yamlv3.Node{}
+	for _, name := range converted.IAM.AddonPolicies {
+		item := &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: name}
+		policies.Content = append(policies.Content, item)
+		items[name] = item
+	}
+	// keep the comments of the enabled policies
+	for i := 0; withAddonPolicies.Kind == yamlv3.MappingNode && i+1 < len(withAddonPolicies.Content); i += 2 {
+		name := withAddonPolicies.Content[i].Value
+		if name == "albIngress" {
+			name = v1alpha6.AddonPolicyAWSLoadBalancerController
+		}
+		if item, ok := items[name]; ok {
+			copyComments(item, withAddonPolicies.Content[i], withAddonPolicies.Content[i+1])
+		}
+	}
+	key.Value = "addonPolicies"
+	setMappingValue(iam, key, policies)
+	return nil
+}
+
+// convertNodeToV1alpha5 rewrites a v1alpha6 ClusterConfig node, moving managed nodegroups to managedNodeGroups.
+func convertNodeToV1alpha5(node *yamlv3.Node) error {
+	nodeGroupsKey, nodeGroups := mappingPair(node, "nodeGroups")
+	if nodeGroups == nil || nodeGroups.Kind != yamlv3.SequenceNode {
+		return nil
+	}
+
+	var selfManaged, managed []*yamlv3.Node
+	for _, item := range nodeGroups.Content {
+		isManaged := true
+		if value := mappingValue(item, "managed"); value != nil {
+			var v bool
+			if err := value.Decode(&v); err != nil {
+				return err
+			}
+			isManaged = v
+			removeMappingKey(item, "managed")
+		}
+		if key, value := mappingPair(mappingValue(item, "iam"), "addonPolicies"); value != nil {
+			var names []string
+			if err := value.Decode(&names); err != nil {
+				return err
+			}
+			policies := &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			for i, name := range names {
+				pair := scalarPair(name, "true")
+				if i < len(value.Content) {
+					copyComments(pair[0], value.Content[i])
+				}
+				policies.Content = append(policies.Content, pair...)
+			}
+			key.Value = "withAddonPolicies"
+			setMappingValue(mappingValue(item, "iam"), key, policies)
+		}
+		if isManaged {
+			managed = append(managed, item)
+		} else {
+			selfManaged = append(selfManaged, item)
+		}
+	}
+
+	switch {
+	case len(managed) == 0:
+	case len(selfManaged) == 0:
+		nodeGroupsKey.Value = "managedNodeGroups"
+	default:
+		nodeGroups.Content = selfManaged
+		insertMappingPair(node, "nodeGroups", []*yamlv3.Node{
+			{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: "managedNodeGroups"},
+			{Kind: yamlv3.SequenceNode, Tag: "!!seq", Content: managed},
+		})
+	}
+	return nil
+}
+
+func scalarValue(node *yamlv3.Node, key string) string {
+	if value := mappingValue(node, key); value != nil && value.Kind == yamlv3.ScalarNode {
+		return value.Value
+	}
+	return ""
+}
+
+func scalarPair(key, value string) []*yamlv3.Node {
+	valueNode := &yamlv3.Node{Kind: yamlv3.ScalarNode, Value: value}
+	if value == "true" || value == "false" {
+		valueNode.Tag = "!!bool"
+	} else {
+		valueNode.Tag = "!!str"
+	}
+	return []*yamlv3.Node{{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, valueNode}
+}
+
+// copyComments appends the comments of the nodes in from to those of node.
+func copyComments(node *yamlv3.Node, from ...*yamlv3.Node) {
+	for _, f := range from {
+		node.HeadComment = joinComments(node.HeadComment, f.HeadComment)
+		node.LineComment = joinComments(node.LineComment, f.LineComment)
+		node.FootComment = joinComments(node.FootComment, f.FootComment)
+	}
+}
+
+func mappingPair(node *yamlv3.Node, key string) (*yamlv3.Node, *yamlv3.Node) {
+	if node == nil || node.Kind != yamlv3.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// setMappingValue replaces the value of key, keeping the comments of the old value.
+func setMappingValue(node, key, value *yamlv3.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i] == key {
+			old := node.Content[i+1]
+			value.LineComment = joinComments(old.LineComment, value.LineComment)
+			value.FootComment = joinComments(old.FootComment, value.FootComment)
+			node.Content[i+1] = value
+			return
+		}
+	}
+}
+
+func removeMappingKey(node *yamlv3.Node, key string) {
+	if node == nil || node.Kind != yamlv3.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = slices.Delete(node.Content, i, i+2)
+			return
+		}
+	}
+}
+
+// insertMappingPair inserts pair after the value of key, or at the start of the mapping if it has no key.
+func insertMappingPair(node *yamlv3.Node, key string, pair []*yamlv3.Node) {
+	if node == nil || node.Kind != yamlv3.MappingNode {
+		return
+	}
+	at := 0
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			at = i + 2
+			break
+		}
+	}
+	node.Content = slices.Insert(node.Content, at, pair...)
+}
+
+func joinComments(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	default:
+		return a + "\n" + b
+	}
+}
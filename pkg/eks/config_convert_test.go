@@ -0,0 +1,131 @@
+package eks_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("ConvertConfig", func() {
+	const v1alpha5Config = `# cluster config
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-1 # the name
+  region: us-west-2
+gitops: {}
+nodeGroups:
+  # self-managed
+  - name: ng-1
+    instanceType: m5.large
+    containerRuntime: containerd
+    taints:
+      dedicated: "gpu:NoSchedule"
+    iam:
+      withAddonPolicies:
+        autoScaler: true # for cluster-autoscaler
+        albIngress: true
+        ebs: false
+# managed
+managedNodeGroups:
+  - name: mng-1 # primary
+    instanceTypes: [m5.large]
+`
+
+	const v1alpha6Config = `# cluster config
+apiVersion: eksctl.io/v1alpha6
+kind: ClusterConfig
+metadata:
+  name: cluster-1 # the name
+  region: us-west-2
+nodeGroups:
+  # self-managed
+  - name: ng-1
+    managed: false
+    instanceType: m5.large
+    taints:
+      - key: dedicated
+        value: gpu
+        effect: NoSchedule
+    iam:
+      addonPolicies:
+        - autoScaler # for cluster-autoscaler
+        - awsLoadBalancerController
+  # managed
+  - name: mng-1 # primary
+    instanceTypes: [m5.large]
+`
+
+	It("converts v1alpha5 configs to v1alpha6, keeping comments", func() {
+		converted, err := eks.ConvertConfig([]byte(v1alpha5Config), "eksctl.io/v1alpha6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(converted)).To(Equal(v1alpha6Config))
+	})
+
+	It("converts v1alpha6 configs back to v1alpha5", func() {
+		converted, err := eks.ConvertConfig([]byte(v1alpha6Config), "eksctl.io/v1alpha5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(converted)).To(Equal(`# cluster config
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-1 # the name
+  region: us-west-2
+nodeGroups:
+  # self-managed
+  - name: ng-1
+    instanceType: m5.large
+    taints:
+      - key: dedicated
+        value: gpu
+        effect: NoSchedule
+    iam:
+      withAddonPolicies:
+        autoScaler: true # for cluster-autoscaler
+        awsLoadBalancerController: true
+managedNodeGroups:
+  # managed
+  - name: mng-1 # primary
+    instanceTypes: [m5.large]
+`))
+	})
+
+	It("converts all documents and ClusterConfigList items", func() {
+		converted, err := eks.ConvertConfig([]byte(`apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+items:
+  - metadata:
+      name: cluster-1
+---
+managedNodeGroups:
+  - name: mng-1
+`), "eksctl.io/v1alpha6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(converted)).To(Equal(`apiVersion: eksctl.io/v1alpha6
+kind: ClusterConfigList
+items:
+  - metadata:
+      name: cluster-1
+---
+nodeGroups:
+  - name: mng-1
+`))
+	})
+
+	DescribeTable("rejects configs that cannot be converted", func(config, apiVersion, expectedErr string) {
+		_, err := eks.ConvertConfig([]byte(config), apiVersion)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("unsupported target version", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\n", "eksctl.io/v1alpha4",
+			`unsupported apiVersion "eksctl.io/v1alpha4"`),
+		Entry("gitops", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\ngitops:\n  flux: {}\n", "eksctl.io/v1alpha6",
+			"document 1: gitops is not supported in v1alpha6"),
+		Entry("container runtime", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nnodeGroups:\n  - name: ng-1\n    containerRuntime: dockerd\n", "eksctl.io/v1alpha6",
+			"nodeGroups[0].containerRuntime: only containerd is supported in v1alpha6"),
+		Entry("unknown fields", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nnodeGroups:\n  - name: ng-1\n    instanceTyp: m5.large\n", "eksctl.io/v1alpha6",
+			`unknown field "instanceTyp"`),
+		Entry("managed-only fields on self-managed nodegroups", "apiVersion: eksctl.io/v1alpha6\nkind: ClusterConfig\nnodeGroups:\n  - name: ng-1\n    managed: false\n    spot: true\n", "eksctl.io/v1alpha5",
+			"nodeGroups[0].spot is only supported for managed nodegroups"),
+	)
+})
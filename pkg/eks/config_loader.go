@@ -0,0 +1,260 @@
+package eks
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+)
+
+// mergeByNameFields are the ClusterConfig fields whose items are merged by name when
+// overlaying documents; all other lists are replaced.
+var mergeByNameFields = map[string]bool{
+	"nodeGroups":        true,
+	"managedNodeGroups": true,
+	"addons":            true,
+	"fargateProfiles":   true,
+}
+
+// configDocument is a ClusterConfig document read from a file.
+type configDocument struct {
+	source string
+	object map[string]interface{}
+}
+
+// A documentError is an error in a document of a config file; documents are only numbered in files that
+// have more than one.
+type documentError struct {
+	document int
+	err      error
+}
+
+func (e *documentError) Error() string {
+	if e.document == 0 {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("document %d: %v", e.document, e.err)
+}
+
+func (e *documentError) Unwrap() error {
+	return e.err
+}
+
+// splitDocuments splits data into its `---` separated YAML (or JSON) documents, skipping empty ones.
+func splitDocuments(data []byte) ([][]byte, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	var documents [][]byte
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var object interface{}
+		if err := yaml.Unmarshal(document, &object); err != nil {
+			return nil, err
+		}
+		if object != nil {
+			documents = append(documents, document)
+		}
+	}
+}
+
+// isSingleClusterConfig returns true if documents hold at most one document, and it is not a ClusterConfigList.
+func isSingleClusterConfig(documents [][]byte) bool {
+	if len(documents) != 1 {
+		return len(documents) == 0
+	}
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(documents[0], &typeMeta); err != nil {
+		return true
+	}
+	return typeMeta.Kind != api.ClusterConfigListKind
+}
+
+// parseDocuments strictly parses each document of a config file, expanding a
+// ClusterConfigList into its items.
+func parseDocuments(source string, data []byte) ([]configDocument, error) {
+	documents, err := splitDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var configDocuments []configDocument
+	for i, document := range documents {
+		number, documentSource := 0, source
+		if len(documents) > 1 {
+			number = i + 1
+			documentSource = fmt.Sprintf("%s (document %d)", source, number)
+		}
+		var object map[string]interface{}
+		if err := yaml.Unmarshal(document, &object); err != nil {
+			return nil, &documentError{document: number, err: err}
+		}
+
+		clusterConfig, clusterConfigList := newConfigObjects(object["apiVersion"])
+		if object["kind"] != api.ClusterConfigListKind {
+			if err := yaml.UnmarshalStrict(document, clusterConfig); err != nil {
+				return nil, &documentError{document: number, err: err}
+			}
+			configDocuments = append(configDocuments, configDocument{source: documentSource, object: object})
+			continue
+		}
+
+		if err := yaml.UnmarshalStrict(document, clusterConfigList); err != nil {
+			return nil, &documentError{document: number, err: err}
+		}
+		items, _, err := unstructured.NestedSlice(object, "items")
+		if err != nil {
+			return nil, &documentError{document: number, err: err}
+		}
+		for j, item := range items {
+			itemObject, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, &documentError{document: number, err: fmt.Errorf("item %d is not an object", j)}
+			}
+			if _, ok := itemObject["apiVersion"]; !ok {
+				itemObject["apiVersion"] = object["apiVersion"]
+			}
+			if _, ok := itemObject["kind"]; !ok {
+				itemObject["kind"] = api.ClusterConfigKind
+			}
+			configDocuments = append(configDocuments, configDocument{
+				source: fmt.Sprintf("%s (item %d)", documentSource, j+1),
+				object: itemObject,
+			})
+		}
+	}
+	return configDocuments, nil
+}
+
+// newConfigObjects returns an empty ClusterConfig and ClusterConfigList of apiVersion to strictly parse documents into.
+func newConfigObjects(apiVersion interface{}) (clusterConfig, clusterConfigList interface{}) {
+	if apiVersion == v1alpha6.SchemeGroupVersion.String() {
+		return &v1alpha6.ClusterConfig{}, &v1alpha6.ClusterConfigList{}
+	}
+	return &api.ClusterConfig{}, &api.ClusterConfigList{}
+}
+
+// mergeDocuments overlays documents in order; all documents must describe the same cluster.
+func mergeDocuments(documents []configDocument) (map[string]interface{}, error) {
+	if len(documents) == 0 {
+		return nil, errors.New("no ClusterConfig documents found")
+	}
+
+	var (
+		merged      map[string]interface{}
+		clusterName string
+		apiVersion  interface{}
+	)
+	for i, document := range documents {
+		if i == 0 {
+			apiVersion = document.object["apiVersion"]
+		} else if v, ok := document.object["apiVersion"]; ok && v != apiVersion {
+			return nil, fmt.Errorf("%s: apiVersion %v does not match %v; all documents must use the same apiVersion", document.source, v, apiVersion)
+		}
+		name, _, _ := unstructured.NestedString(document.object, "metadata", "name")
+		if name != "" {
+			if clusterName != "" && name != clusterName {
+				return nil, fmt.Errorf("%s: cluster name %q does not match %q; only one cluster can be defined per config", document.source, name, clusterName)
+			}
+			clusterName = name
+		}
+		if len(documents) > 1 {
+			if err := checkItemNames(document.object); err != nil {
+				return nil, fmt.Errorf("%s: %w", document.source, err)
+			}
+		}
+		merged = mergeObjects(merged, document.object, "")
+	}
+	return merged, nil
+}
+
+// checkItemNames checks that the items of the fields in mergeByNameFields are named, as
+// unnamed items would otherwise all be merged into each other.
+func checkItemNames(object map[string]interface{}) error {
+	for _, field := range slices.Sorted(maps.Keys(mergeByNameFields)) {
+		items, _ := object[field].([]interface{})
+		for i, item := range items {
+			if item, ok := item.(map[string]interface{}); ok {
+				if name, _ := item["name"].(string); name != "" {
+					continue
+				}
+			}
+			return fmt.Errorf("%s[%d].name must be set, as %s are merged by name across documents", field, i, field)
+		}
+	}
+	return nil
+}
+
+// mergeObjects merges overlay into base: objects are merged recursively, null values
+// remove the key, fields in mergeByNameFields are merged by name and all other values are replaced.
+func mergeObjects(base, overlay map[string]interface{}, path string) map[string]interface{} {
+	if base == nil {
+		base = map[string]interface{}{}
+	}
+	for key, overlayValue := range overlay {
+		if overlayValue == nil {
+			delete(base, key)
+			continue
+		}
+		switch overlayValue := overlayValue.(type) {
+		case map[string]interface{}:
+			baseValue, _ := base[key].(map[string]interface{})
+			base[key] = mergeObjects(baseValue, overlayValue, path+"."+key)
+		case []interface{}:
+			baseValue, isList := base[key].([]interface{})
+			if path == "" && mergeByNameFields[key] && isList {
+				base[key] = mergeListByName(baseValue, overlayValue, path+"."+key)
+			} else {
+				base[key] = overlayValue
+			}
+		default:
+			base[key] = overlayValue
+		}
+	}
+	return base
+}
+
+// mergeListByName merges items in overlay into items in base with the same name and
+// appends the rest.
+func mergeListByName(base, overlay []interface{}, path string) []interface{} {
+	indexByName := map[string]int{}
+	for i, item := range base {
+		if object, ok := item.(map[string]interface{}); ok {
+			if name, ok := object["name"].(string); ok {
+				indexByName[name] = i
+			}
+		}
+	}
+	for _, item := range overlay {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			base = append(base, item)
+			continue
+		}
+		name, _ := object["name"].(string)
+		i, found := indexByName[name]
+		if !found {
+			indexByName[name] = len(base)
+			base = append(base, object)
+			continue
+		}
+		baseObject, _ := base[i].(map[string]interface{})
+		base[i] = mergeObjects(baseObject, object, path+"."+name)
+	}
+	return base
+}
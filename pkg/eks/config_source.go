@@ -0,0 +1,308 @@
+package eks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// decodingErrorPrefix prefixes the errors of strictly decoding YAML, which add nothing once they are annotated.
+const decodingErrorPrefix = "error unmarshaling JSON: while decoding JSON: json: "
+
+var (
+	unknownFieldPattern = regexp.MustCompile(`unknown field "([^"]+)"`)
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// ConfigSource maps field paths of a loaded ClusterConfig to their positions in the config files,
+// so that errors can point at the offending line.
+type ConfigSource struct {
+	documents []sourceDocument
+}
+
+type sourceDocument struct {
+	file  string
+	lines []string
+	root  *yaml.Node
+}
+
+// A ConfigError is an error annotated with its position in a config file.
+type ConfigError struct {
+	File    string
+	Line    int
+	Column  int
+	Snippet string
+	Err     error
+}
+
+// Error returns the original error prefixed with its position, in the `file:line:column: message` format
+// understood by editors and CI annotators, followed by a snippet of the config file.
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s\n%s", e.File, e.Line, e.Column, strings.TrimPrefix(e.Err.Error(), decodingErrorPrefix), e.Snippet)
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// add parses all YAML documents in data, expanding ClusterConfigList items; documents that
+// cannot be parsed are skipped, as their errors already include a position, and so are JSON
+// documents, which are usually generated on a single line.
+func (s *ConfigSource) add(file string, data []byte) {
+	lines := strings.Split(string(data), "\n")
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); err != nil {
+			return
+		}
+		if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode || document.Content[0].Style&yaml.FlowStyle != 0 {
+			continue
+		}
+		root := document.Content[0]
+		if kind := mappingValue(root, "kind"); kind != nil && kind.Value == api.ClusterConfigListKind {
+			if items := mappingValue(root, "items"); items != nil && items.Kind == yaml.SequenceNode {
+				for _, item := range items.Content {
+					s.documents = append(s.documents, sourceDocument{file: file, lines: lines, root: item})
+				}
+			}
+			continue
+		}
+		s.documents = append(s.documents, sourceDocument{file: file, lines: lines, root: root})
+	}
+}
+
+// Annotate returns err as a ConfigError if the unknown field it refers to, or the path of the
+// api.FieldError it wraps, can be found in the config files; otherwise err is returned as-is.
+func (s *ConfigSource) Annotate(err error) error {
+	if s == nil || err == nil {
+		return err
+	}
+	var configErr *ConfigError
+	if errors.As(err, &configErr) {
+		return err
+	}
+
+	document, node := s.find(err)
+	if node == nil {
+		return err
+	}
+	return &ConfigError{
+		File:    document.file,
+		Line:    node.Line,
+		Column:  node.Column,
+		Snippet: document.snippet(node.Line, node.Column),
+		Err:     err,
+	}
+}
+
+func (s *ConfigSource) find(err error) (*sourceDocument, *yaml.Node) {
+	if match := unknownFieldPattern.FindStringSubmatch(err.Error()); match != nil {
+		for i := range s.documents {
+			if node := findUnknownField(s.documents[i].root, reflect.TypeOf(api.ClusterConfig{}), match[1]); node != nil {
+				return &s.documents[i], node
+			}
+		}
+		return nil, nil
+	}
+
+	var fieldErr *api.FieldError
+	if !errors.As(err, &fieldErr) {
+		return nil, nil
+	}
+	var (
+		bestDocument *sourceDocument
+		bestNode     *yaml.Node
+		bestDepth    int
+	)
+	// later documents override earlier ones, so they are searched first
+	segments := splitFieldPath(fieldErr.Path)
+	for i := len(s.documents) - 1; i >= 0; i-- {
+		if node, depth := resolveFieldPath(s.documents[i].root, segments); depth > bestDepth {
+			bestDocument, bestNode, bestDepth = &s.documents[i], node, depth
+		}
+	}
+	return bestDocument, bestNode
+}
+
+func (d *sourceDocument) snippet(line, column int) string {
+	var b strings.Builder
+	width := len(strconv.Itoa(line))
+	for l := max(line-1, 1); l <= line && l <= len(d.lines); l++ {
+		fmt.Fprintf(&b, "  %*d | %s\n", width, l, d.lines[l-1])
+	}
+	fmt.Fprintf(&b, "  %*s | %s^", width, "", strings.Repeat(" ", max(column-1, 0)))
+	return b.String()
+}
+
+// splitFieldPath splits a path such as `managedNodeGroups[3].instanceSelector` into
+// the segments `managedNodeGroups`, `[3]` and `instanceSelector`.
+func splitFieldPath(path string) []string {
+	var segments []string
+	for _, part := range strings.Split(path, ".") {
+		name, indices, _ := strings.Cut(part, "[")
+		segments = append(segments, name)
+		if indices != "" {
+			for _, index := range strings.Split(strings.TrimSuffix(indices, "]"), "][") {
+				segments = append(segments, "["+index+"]")
+			}
+		}
+	}
+	return segments
+}
+
+// resolveFieldPath returns the deepest node matching a prefix of segments, and the length of that prefix.
+func resolveFieldPath(node *yaml.Node, segments []string) (*yaml.Node, int) {
+	var (
+		found *yaml.Node
+		depth int
+	)
+	for _, segment := range segments {
+		if strings.HasPrefix(segment, "[") {
+			index, err := strconv.Atoi(strings.Trim(segment, "[]"))
+			if err != nil || node.Kind != yaml.SequenceNode || index >= len(node.Content) {
+				break
+			}
+			node = node.Content[index]
+			found = node
+		} else {
+			key := mappingKey(node, segment)
+			if key == nil {
+				break
+			}
+			found = key
+			node = mappingValue(node, segment)
+		}
+		depth++
+	}
+	return found, depth
+}
+
+// findUnknownField returns the first mapping key named name that does not correspond to a field of t.
+func findUnknownField(node *yaml.Node, t reflect.Type, name string) *yaml.Node {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		fields := jsonFields(t)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			fieldType, ok := lookupJSONField(fields, key.Value)
+			if !ok {
+				if key.Value == name {
+					return key
+				}
+				continue
+			}
+			if found := findUnknownField(node.Content[i+1], fieldType, name); found != nil {
+				return found
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yaml.SequenceNode {
+			return nil
+		}
+		for _, item := range node.Content {
+			if found := findUnknownField(item, t.Elem(), name); found != nil {
+				return found
+			}
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		for i := 1; i < len(node.Content); i += 2 {
+			if found := findUnknownField(node.Content[i], t.Elem(), name); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the JSON field names of struct type t, including those of embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for k, v := range jsonFields(embedded) {
+					fields[k] = v
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// lookupJSONField looks up a field the way encoding/json does, preferring an exact match.
+func lookupJSONField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package eks_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("ConfigSource", func() {
+	BeforeEach(func() {
+		Expect(api.Register()).To(Succeed())
+	})
+
+	It("annotates unknown field errors with their position", func() {
+		_, err := eks.LoadConfigFromFile("testdata/bad-field-1.yaml")
+		var configErr *eks.ConfigError
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.File).To(Equal("testdata/bad-field-1.yaml"))
+		Expect(configErr.Line).To(Equal(7))
+		Expect(configErr.Column).To(Equal(3))
+		Expect(err.Error()).To(ContainSubstring("testdata/bad-field-1.yaml:7:3: unknown field \"zone\"\n" +
+			"  6 |   name: cluster-1\n" +
+			"  7 |   zone: eu-north-1a\n" +
+			"    |   ^"))
+	})
+
+	It("annotates unknown field errors in overlays with the overlay position", func() {
+		_, _, err := eks.LoadConfigWithSource("testdata/base.yaml", nil, eks.LoadConfigOptions{
+			OverlayFiles: []string{"testdata/multi-document-bad-field.yaml"},
+		})
+		var configErr *eks.ConfigError
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.File).To(Equal("testdata/multi-document-bad-field.yaml"))
+		Expect(configErr.Line).To(Equal(13))
+		Expect(configErr.Column).To(Equal(5))
+	})
+
+	It("annotates validation errors with the position of their field path", func() {
+		cfg, source, err := eks.LoadConfigWithSource("testdata/invalid-nodegroups.yaml", nil, eks.LoadConfigOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(api.SetManagedNodeGroupDefaults(cfg.ManagedNodeGroups[1], cfg.Metadata, false)).To(Succeed())
+		validationErr := api.ValidateManagedNodeGroup(1, cfg.ManagedNodeGroups[1])
+		Expect(validationErr).To(HaveOccurred())
+		err = source.Annotate(validationErr)
+		var configErr *eks.ConfigError
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.File).To(Equal("testdata/invalid-nodegroups.yaml"))
+		Expect(configErr.Line).To(Equal(14))
+		Expect(configErr.Column).To(Equal(7))
+		Expect(err.Error()).To(HavePrefix("testdata/invalid-nodegroups.yaml:14:7: instanceProfileARN is not supported for Managed Nodes (managedNodeGroups[1].instanceProfileARN)"))
+		Expect(errors.Is(err, validationErr)).To(BeTrue())
+	})
+
+	It("annotates access entry validation errors with the position of their field path", func() {
+		cfg, source, err := eks.LoadConfigWithSource("testdata/invalid-access-entries.yaml", nil, eks.LoadConfigOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		api.SetClusterConfigDefaults(cfg)
+		validationErr := api.ValidateClusterConfig(cfg)
+		Expect(validationErr).To(HaveOccurred())
+		var fieldErr *api.FieldError
+		Expect(errors.As(validationErr, &fieldErr)).To(BeTrue())
+		Expect(fieldErr.Path).To(Equal("accessConfig.accessEntries[1].accessPolicies[1].policyARN"))
+
+		err = source.Annotate(validationErr)
+		var configErr *eks.ConfigError
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.File).To(Equal("testdata/invalid-access-entries.yaml"))
+		Expect(configErr.Line).To(Equal(17))
+		Expect(configErr.Column).To(Equal(11))
+		Expect(err.Error()).To(HavePrefix("testdata/invalid-access-entries.yaml:17:11: accessEntries[1].policyARN must be a cluster-access-policy resource"))
+	})
+
+	It("annotates cluster validation errors with the position of their field path", func() {
+		cfg, source, err := eks.LoadConfigWithSource("testdata/invalid-vpc.yaml", nil, eks.LoadConfigOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		api.SetClusterConfigDefaults(cfg)
+		validationErr := api.ValidateClusterConfig(cfg)
+		Expect(validationErr).To(HaveOccurred())
+		var fieldErr *api.FieldError
+		Expect(errors.As(validationErr, &fieldErr)).To(BeTrue())
+		Expect(fieldErr.Path).To(Equal("vpc.hostnameType"))
+
+		err = source.Annotate(validationErr)
+		var configErr *eks.ConfigError
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.Line).To(Equal(10))
+		Expect(configErr.Column).To(Equal(3))
+		Expect(err.Error()).To(HavePrefix(`testdata/invalid-vpc.yaml:10:3: invalid value "invalid-type" for vpc.hostnameType`))
+	})
+
+	It("annotates errors about unset fields with the position of their closest parent", func() {
+		cfg, source, err := eks.LoadConfigWithSource("testdata/invalid-vpc.yaml", nil, eks.LoadConfigOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		api.SetClusterConfigDefaults(cfg)
+		cfg.VPC.HostnameType = ""
+		cfg.VPC.ExtraIPv6CIDRs = []string{"2002::1234:abcd:ffff:c0a8:101/64"}
+		validationErr := api.ValidateClusterConfig(cfg)
+		Expect(validationErr).To(MatchError(ContainSubstring("cannot specify vpc.extraIPv6CIDRs with an IPv4 cluster")))
+
+		err = source.Annotate(validationErr)
+		var configErr *eks.ConfigError
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.Line).To(Equal(8))
+		Expect(configErr.Column).To(Equal(1))
+	})
+
+	It("leaves errors without a known field path unchanged", func() {
+		_, source, err := eks.LoadConfigWithSource("testdata/invalid-nodegroups.yaml", nil, eks.LoadConfigOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		original := errors.New("something went wrong")
+		Expect(source.Annotate(original)).To(BeIdenticalTo(original))
+	})
+
+	It("does not take field paths from error messages", func() {
+		_, source, err := eks.LoadConfigWithSource("testdata/invalid-nodegroups.yaml", nil, eks.LoadConfigOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		original := errors.New("managedNodeGroups[1].iam could not be created: metadata.name is in use")
+		Expect(source.Annotate(original)).To(BeIdenticalTo(original))
+	})
+})
@@ -0,0 +1,194 @@
+package eks
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// configVarPattern matches `${VAR}` and `${VAR:-default}` references, and their `$${...}` escapes.
+var configVarPattern = regexp.MustCompile(`\$(\$)?\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// ConfigVars expands `${VAR}` and `${VAR:-default}` references in config files;
+// `$${VAR}` is left as the literal `${VAR}`.
+type ConfigVars struct {
+	// Values take precedence over environment variables.
+	Values map[string]string
+	// LookupEnv looks up environment variables; it defaults to os.LookupEnv.
+	LookupEnv func(key string) (string, bool)
+}
+
+// Expand replaces all variable references in data, except in YAML comments, and returns an error listing
+// all variables that are unset and have no default.
+func (v *ConfigVars) Expand(data []byte) ([]byte, error) {
+	lookupEnv := v.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	unset := map[string]struct{}{}
+	expand := func(match []byte) []byte {
+		groups := configVarPattern.FindSubmatch(match)
+		if groups[1] != nil {
+			return match[1:]
+		}
+		name := string(groups[2])
+		if value, ok := v.Values[name]; ok {
+			return []byte(value)
+		}
+		if value, ok := lookupEnv(name); ok {
+			return []byte(value)
+		}
+		if groups[3] != nil {
+			return groups[4]
+		}
+		unset[name] = struct{}{}
+		return match
+	}
+
+	var (
+		expanded []byte
+		scanner  yamlCommentScanner
+	)
+	for _, line := range bytes.SplitAfter(data, []byte("\n")) {
+		comment := scanner.commentStart(line)
+		expanded = append(expanded, configVarPattern.ReplaceAllFunc(line[:comment], expand)...)
+		expanded = append(expanded, line[comment:]...)
+	}
+
+	if len(unset) > 0 {
+		var names []string
+		for name := range unset {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("config file references unset variables without a default: %s", strings.Join(names, ", "))
+	}
+	return expanded, nil
+}
+
+// yamlCommentScanner finds the comments of a YAML file, line by line. It tracks quoted scalars, which may span
+// lines, and block scalars, so that a `#` in their content is not mistaken for a comment.
+type yamlCommentScanner struct {
+	// quote is the quote character of the quoted scalar that continues on the next line, if any
+	quote byte
+	// inBlock is set in block scalars, whose content is indented deeper than blockIndent
+	inBlock     bool
+	blockIndent int
+}
+
+// blockScalarIndicator matches the end of a line starting a block scalar, such as `key: |` or `- >-`.
+var blockScalarIndicator = regexp.MustCompile(`(^|[\s:-])[|>][-+0-9]*$`)
+
+// commentStart returns the offset at which the comment of line starts, or the length of line if it has no comment.
+func (s *yamlCommentScanner) commentStart(line []byte) int {
+	content := bytes.TrimRight(line, "\r\n")
+	indent := len(content) - len(bytes.TrimLeft(content, " \t"))
+	if s.inBlock {
+		if indent == len(content) || indent > s.blockIndent {
+			return len(line)
+		}
+		s.inBlock = false
+	}
+
+	comment := len(line)
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case s.quote == '"' && c == '\\':
+			i++
+		case s.quote != 0:
+			if c == s.quote {
+				if s.quote == '\'' && i+1 < len(content) && content[i+1] == '\'' {
+					i++
+				} else {
+					s.quote = 0
+				}
+			}
+		case c == '#' && (i == 0 || content[i-1] == ' ' || content[i-1] == '\t'):
+			comment = i
+		case (c == '"' || c == '\'') && startsScalar(content[:i]):
+			s.quote = c
+		}
+		if comment < len(line) {
+			break
+		}
+	}
+
+	if s.quote == 0 && blockScalarIndicator.Match(bytes.TrimRight(content[:min(comment, len(content))], " \t")) {
+		s.inBlock, s.blockIndent = true, nodeIndent(content)
+		if s.blockIndent > indent && (content[s.blockIndent] == '|' || content[s.blockIndent] == '>') {
+			// the block scalar is a sequence entry, whose content is indented deeper than its `-`
+			s.blockIndent -= 2
+		}
+	}
+	return comment
+}
+
+// nodeIndent returns the indentation of the node that starts on line, after any sequence entry indicators.
+func nodeIndent(line []byte) int {
+	i := 0
+	for i < len(line) {
+		switch {
+		case line[i] == ' ' || line[i] == '\t':
+			i++
+		case line[i] == '-' && i+1 < len(line) && (line[i+1] == ' ' || line[i+1] == '\t'):
+			i += 2
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// startsScalar reports whether a scalar may start after prefix, i.e. after indentation or an indicator.
+func startsScalar(prefix []byte) bool {
+	trimmed := bytes.TrimRight(prefix, " \t")
+	if len(trimmed) == 0 {
+		return true
+	}
+	switch trimmed[len(trimmed)-1] {
+	case '[', '{', ',':
+		return true
+	case ':', '-', '?':
+		// these are only indicators when followed by whitespace
+		return len(trimmed) < len(prefix)
+	}
+	return false
+}
+
+// LoadConfigVarsFile reads variables from a file with one KEY=VALUE pair per line;
+// empty lines and lines starting with `#` are ignored.
+func LoadConfigVarsFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config vars file %q: %w", path, err)
+	}
+
+	values := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("config vars file %q, line %d: expected KEY=VALUE", path, lineNumber)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		values[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading config vars file %q: %w", path, err)
+	}
+	return values, nil
+}
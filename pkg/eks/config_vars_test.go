@@ -0,0 +1,96 @@
+package eks_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("ConfigVars", func() {
+	type expandEntry struct {
+		input  string
+		values map[string]string
+		env    map[string]string
+
+		expected    string
+		expectedErr string
+	}
+
+	DescribeTable("Expand", func(e expandEntry) {
+		vars := &eks.ConfigVars{
+			Values: e.values,
+			LookupEnv: func(key string) (string, bool) {
+				value, ok := e.env[key]
+				return value, ok
+			},
+		}
+		output, err := vars.Expand([]byte(e.input))
+		if e.expectedErr != "" {
+			Expect(err).To(MatchError(e.expectedErr))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(output)).To(Equal(e.expected))
+	},
+		Entry("expands environment variables", expandEntry{
+			input:    "name: ${CLUSTER_NAME}\nregion: ${REGION}",
+			env:      map[string]string{"CLUSTER_NAME": "dev", "REGION": "us-west-2"},
+			expected: "name: dev\nregion: us-west-2",
+		}),
+		Entry("prefers values over environment variables", expandEntry{
+			input:    "name: ${CLUSTER_NAME}",
+			values:   map[string]string{"CLUSTER_NAME": "prod"},
+			env:      map[string]string{"CLUSTER_NAME": "dev"},
+			expected: "name: prod",
+		}),
+		Entry("uses defaults for unset variables", expandEntry{
+			input:    "instanceType: ${INSTANCE_TYPE:-m5.large}\ndesiredCapacity: ${CAPACITY:-}",
+			expected: "instanceType: m5.large\ndesiredCapacity: ",
+		}),
+		Entry("leaves escaped references and other dollar signs alone", expandEntry{
+			input:    "preBootstrapCommands: [\"echo $${HOME} $HOSTNAME $$\"]",
+			expected: "preBootstrapCommands: [\"echo ${HOME} $HOSTNAME $$\"]",
+		}),
+		Entry("leaves comments alone", expandEntry{
+			input:    "# set ${CLUSTER_NAME} before creating the cluster\nname: ${CLUSTER_NAME} # or ${OTHER_NAME}\n  # ${UNSET}\n",
+			env:      map[string]string{"CLUSTER_NAME": "dev"},
+			expected: "# set ${CLUSTER_NAME} before creating the cluster\nname: dev # or ${OTHER_NAME}\n  # ${UNSET}\n",
+		}),
+		Entry("expands references after a # that does not start a comment", expandEntry{
+			input: "name: \"dev #${ID}\"\ndescription: 'it''s #${ID}' # ${UNSET}\ntag: a#${ID}\n" +
+				"preBootstrapCommands:\n  - |\n    # install ${ID}\n    echo done\n  # ${UNSET}\n",
+			env: map[string]string{"ID": "1"},
+			expected: "name: \"dev #1\"\ndescription: 'it''s #1' # ${UNSET}\ntag: a#1\n" +
+				"preBootstrapCommands:\n  - |\n    # install 1\n    echo done\n  # ${UNSET}\n",
+		}),
+		Entry("reports all unset variables", expandEntry{
+			input:       "name: ${CLUSTER_NAME}\nregion: ${REGION}\nversion: ${VERSION:-1.32}\nzone: ${REGION}",
+			expectedErr: "config file references unset variables without a default: CLUSTER_NAME, REGION",
+		}),
+	)
+
+	Describe("LoadConfigVarsFile", func() {
+		It("reads KEY=VALUE lines", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "vars.env")
+			Expect(os.WriteFile(path, []byte("# cluster settings\nCLUSTER_NAME=dev\n\nexport REGION = \"us-west-2\"\nTAGS='a=b'\n"), 0o600)).To(Succeed())
+			values, err := eks.LoadConfigVarsFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]string{
+				"CLUSTER_NAME": "dev",
+				"REGION":       "us-west-2",
+				"TAGS":         "a=b",
+			}))
+		})
+
+		It("rejects malformed lines", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "vars.env")
+			Expect(os.WriteFile(path, []byte("CLUSTER_NAME=dev\nREGION\n"), 0o600)).To(Succeed())
+			_, err := eks.LoadConfigVarsFile(path)
+			Expect(err).To(MatchError(ContainSubstring("line 2: expected KEY=VALUE")))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package eks
 
 var (
-	NewHelper      = newHelper
-	NewAWSProvider = newAWSProvider
+	NewHelper                = newHelper
+	NewAWSProvider           = newAWSProvider
+	AddRetryEventsMiddleware = addRetryEventsMiddleware
 )
@@ -0,0 +1,8000 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocksv2
+
+import (
+	context "context"
+
+	s3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// S3 is an autogenerated mock type for the S3 type
+type S3 struct {
+	mock.Mock
+}
+
+type S3_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *S3) EXPECT() *S3_Expecter {
+	return &S3_Expecter{mock: &_m.Mock}
+}
+
+// AbortMultipartUpload provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbortMultipartUpload")
+	}
+
+	var r0 *s3.AbortMultipartUploadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) *s3.AbortMultipartUploadOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.AbortMultipartUploadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_AbortMultipartUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbortMultipartUpload'
+type S3_AbortMultipartUpload_Call struct {
+	*mock.Call
+}
+
+// AbortMultipartUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.AbortMultipartUploadInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) AbortMultipartUpload(ctx interface{}, params interface{}, optFns ...interface{}) *S3_AbortMultipartUpload_Call {
+	return &S3_AbortMultipartUpload_Call{Call: _e.mock.On("AbortMultipartUpload",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_AbortMultipartUpload_Call) Run(run func(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options))) *S3_AbortMultipartUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.AbortMultipartUploadInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_AbortMultipartUpload_Call) Return(_a0 *s3.AbortMultipartUploadOutput, _a1 error) *S3_AbortMultipartUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_AbortMultipartUpload_Call) RunAndReturn(run func(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)) *S3_AbortMultipartUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteMultipartUpload provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteMultipartUpload")
+	}
+
+	var r0 *s3.CompleteMultipartUploadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) *s3.CompleteMultipartUploadOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.CompleteMultipartUploadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_CompleteMultipartUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteMultipartUpload'
+type S3_CompleteMultipartUpload_Call struct {
+	*mock.Call
+}
+
+// CompleteMultipartUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.CompleteMultipartUploadInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) CompleteMultipartUpload(ctx interface{}, params interface{}, optFns ...interface{}) *S3_CompleteMultipartUpload_Call {
+	return &S3_CompleteMultipartUpload_Call{Call: _e.mock.On("CompleteMultipartUpload",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_CompleteMultipartUpload_Call) Run(run func(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options))) *S3_CompleteMultipartUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.CompleteMultipartUploadInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_CompleteMultipartUpload_Call) Return(_a0 *s3.CompleteMultipartUploadOutput, _a1 error) *S3_CompleteMultipartUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_CompleteMultipartUpload_Call) RunAndReturn(run func(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)) *S3_CompleteMultipartUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyObject provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) CopyObject(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyObject")
+	}
+
+	var r0 *s3.CopyObjectOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CopyObjectInput, ...func(*s3.Options)) (*s3.CopyObjectOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CopyObjectInput, ...func(*s3.Options)) *s3.CopyObjectOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.CopyObjectOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.CopyObjectInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_CopyObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyObject'
+type S3_CopyObject_Call struct {
+	*mock.Call
+}
+
+// CopyObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.CopyObjectInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) CopyObject(ctx interface{}, params interface{}, optFns ...interface{}) *S3_CopyObject_Call {
+	return &S3_CopyObject_Call{Call: _e.mock.On("CopyObject",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_CopyObject_Call) Run(run func(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options))) *S3_CopyObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.CopyObjectInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_CopyObject_Call) Return(_a0 *s3.CopyObjectOutput, _a1 error) *S3_CopyObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_CopyObject_Call) RunAndReturn(run func(context.Context, *s3.CopyObjectInput, ...func(*s3.Options)) (*s3.CopyObjectOutput, error)) *S3_CopyObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBucket provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) CreateBucket(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBucket")
+	}
+
+	var r0 *s3.CreateBucketOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) (*s3.CreateBucketOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) *s3.CreateBucketOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.CreateBucketOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_CreateBucket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBucket'
+type S3_CreateBucket_Call struct {
+	*mock.Call
+}
+
+// CreateBucket is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.CreateBucketInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) CreateBucket(ctx interface{}, params interface{}, optFns ...interface{}) *S3_CreateBucket_Call {
+	return &S3_CreateBucket_Call{Call: _e.mock.On("CreateBucket",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_CreateBucket_Call) Run(run func(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options))) *S3_CreateBucket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.CreateBucketInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_CreateBucket_Call) Return(_a0 *s3.CreateBucketOutput, _a1 error) *S3_CreateBucket_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_CreateBucket_Call) RunAndReturn(run func(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) (*s3.CreateBucketOutput, error)) *S3_CreateBucket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBucketMetadataConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) CreateBucketMetadataConfiguration(ctx context.Context, params *s3.CreateBucketMetadataConfigurationInput, optFns ...func(*s3.Options)) (*s3.CreateBucketMetadataConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBucketMetadataConfiguration")
+	}
+
+	var r0 *s3.CreateBucketMetadataConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateBucketMetadataConfigurationInput, ...func(*s3.Options)) (*s3.CreateBucketMetadataConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateBucketMetadataConfigurationInput, ...func(*s3.Options)) *s3.CreateBucketMetadataConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.CreateBucketMetadataConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.CreateBucketMetadataConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_CreateBucketMetadataConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBucketMetadataConfiguration'
+type S3_CreateBucketMetadataConfiguration_Call struct {
+	*mock.Call
+}
+
+// CreateBucketMetadataConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.CreateBucketMetadataConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) CreateBucketMetadataConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_CreateBucketMetadataConfiguration_Call {
+	return &S3_CreateBucketMetadataConfiguration_Call{Call: _e.mock.On("CreateBucketMetadataConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_CreateBucketMetadataConfiguration_Call) Run(run func(ctx context.Context, params *s3.CreateBucketMetadataConfigurationInput, optFns ...func(*s3.Options))) *S3_CreateBucketMetadataConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.CreateBucketMetadataConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_CreateBucketMetadataConfiguration_Call) Return(_a0 *s3.CreateBucketMetadataConfigurationOutput, _a1 error) *S3_CreateBucketMetadataConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_CreateBucketMetadataConfiguration_Call) RunAndReturn(run func(context.Context, *s3.CreateBucketMetadataConfigurationInput, ...func(*s3.Options)) (*s3.CreateBucketMetadataConfigurationOutput, error)) *S3_CreateBucketMetadataConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBucketMetadataTableConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) CreateBucketMetadataTableConfiguration(ctx context.Context, params *s3.CreateBucketMetadataTableConfigurationInput, optFns ...func(*s3.Options)) (*s3.CreateBucketMetadataTableConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBucketMetadataTableConfiguration")
+	}
+
+	var r0 *s3.CreateBucketMetadataTableConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateBucketMetadataTableConfigurationInput, ...func(*s3.Options)) (*s3.CreateBucketMetadataTableConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateBucketMetadataTableConfigurationInput, ...func(*s3.Options)) *s3.CreateBucketMetadataTableConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.CreateBucketMetadataTableConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.CreateBucketMetadataTableConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_CreateBucketMetadataTableConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBucketMetadataTableConfiguration'
+type S3_CreateBucketMetadataTableConfiguration_Call struct {
+	*mock.Call
+}
+
+// CreateBucketMetadataTableConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.CreateBucketMetadataTableConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) CreateBucketMetadataTableConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_CreateBucketMetadataTableConfiguration_Call {
+	return &S3_CreateBucketMetadataTableConfiguration_Call{Call: _e.mock.On("CreateBucketMetadataTableConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_CreateBucketMetadataTableConfiguration_Call) Run(run func(ctx context.Context, params *s3.CreateBucketMetadataTableConfigurationInput, optFns ...func(*s3.Options))) *S3_CreateBucketMetadataTableConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.CreateBucketMetadataTableConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_CreateBucketMetadataTableConfiguration_Call) Return(_a0 *s3.CreateBucketMetadataTableConfigurationOutput, _a1 error) *S3_CreateBucketMetadataTableConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_CreateBucketMetadataTableConfiguration_Call) RunAndReturn(run func(context.Context, *s3.CreateBucketMetadataTableConfigurationInput, ...func(*s3.Options)) (*s3.CreateBucketMetadataTableConfigurationOutput, error)) *S3_CreateBucketMetadataTableConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMultipartUpload provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMultipartUpload")
+	}
+
+	var r0 *s3.CreateMultipartUploadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) *s3.CreateMultipartUploadOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.CreateMultipartUploadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_CreateMultipartUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMultipartUpload'
+type S3_CreateMultipartUpload_Call struct {
+	*mock.Call
+}
+
+// CreateMultipartUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.CreateMultipartUploadInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) CreateMultipartUpload(ctx interface{}, params interface{}, optFns ...interface{}) *S3_CreateMultipartUpload_Call {
+	return &S3_CreateMultipartUpload_Call{Call: _e.mock.On("CreateMultipartUpload",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_CreateMultipartUpload_Call) Run(run func(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options))) *S3_CreateMultipartUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.CreateMultipartUploadInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_CreateMultipartUpload_Call) Return(_a0 *s3.CreateMultipartUploadOutput, _a1 error) *S3_CreateMultipartUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_CreateMultipartUpload_Call) RunAndReturn(run func(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)) *S3_CreateMultipartUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSession provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) CreateSession(ctx context.Context, params *s3.CreateSessionInput, optFns ...func(*s3.Options)) (*s3.CreateSessionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 *s3.CreateSessionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateSessionInput, ...func(*s3.Options)) (*s3.CreateSessionOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.CreateSessionInput, ...func(*s3.Options)) *s3.CreateSessionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.CreateSessionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.CreateSessionInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type S3_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.CreateSessionInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) CreateSession(ctx interface{}, params interface{}, optFns ...interface{}) *S3_CreateSession_Call {
+	return &S3_CreateSession_Call{Call: _e.mock.On("CreateSession",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_CreateSession_Call) Run(run func(ctx context.Context, params *s3.CreateSessionInput, optFns ...func(*s3.Options))) *S3_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.CreateSessionInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_CreateSession_Call) Return(_a0 *s3.CreateSessionOutput, _a1 error) *S3_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_CreateSession_Call) RunAndReturn(run func(context.Context, *s3.CreateSessionInput, ...func(*s3.Options)) (*s3.CreateSessionOutput, error)) *S3_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucket provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucket(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucket")
+	}
+
+	var r0 *s3.DeleteBucketOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketInput, ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketInput, ...func(*s3.Options)) *s3.DeleteBucketOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucket'
+type S3_DeleteBucket_Call struct {
+	*mock.Call
+}
+
+// DeleteBucket is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucket(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucket_Call {
+	return &S3_DeleteBucket_Call{Call: _e.mock.On("DeleteBucket",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucket_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options))) *S3_DeleteBucket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucket_Call) Return(_a0 *s3.DeleteBucketOutput, _a1 error) *S3_DeleteBucket_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucket_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketInput, ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)) *S3_DeleteBucket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketAnalyticsConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketAnalyticsConfiguration(ctx context.Context, params *s3.DeleteBucketAnalyticsConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketAnalyticsConfiguration")
+	}
+
+	var r0 *s3.DeleteBucketAnalyticsConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...func(*s3.Options)) *s3.DeleteBucketAnalyticsConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketAnalyticsConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketAnalyticsConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketAnalyticsConfiguration'
+type S3_DeleteBucketAnalyticsConfiguration_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketAnalyticsConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketAnalyticsConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketAnalyticsConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketAnalyticsConfiguration_Call {
+	return &S3_DeleteBucketAnalyticsConfiguration_Call{Call: _e.mock.On("DeleteBucketAnalyticsConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketAnalyticsConfiguration_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketAnalyticsConfigurationInput, optFns ...func(*s3.Options))) *S3_DeleteBucketAnalyticsConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketAnalyticsConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketAnalyticsConfiguration_Call) Return(_a0 *s3.DeleteBucketAnalyticsConfigurationOutput, _a1 error) *S3_DeleteBucketAnalyticsConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketAnalyticsConfiguration_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketAnalyticsConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)) *S3_DeleteBucketAnalyticsConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketCors provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketCors(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketCors")
+	}
+
+	var r0 *s3.DeleteBucketCorsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketCorsInput, ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketCorsInput, ...func(*s3.Options)) *s3.DeleteBucketCorsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketCorsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketCorsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketCors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketCors'
+type S3_DeleteBucketCors_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketCors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketCorsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketCors(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketCors_Call {
+	return &S3_DeleteBucketCors_Call{Call: _e.mock.On("DeleteBucketCors",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketCors_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketCorsInput, optFns ...func(*s3.Options))) *S3_DeleteBucketCors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketCorsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketCors_Call) Return(_a0 *s3.DeleteBucketCorsOutput, _a1 error) *S3_DeleteBucketCors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketCors_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketCorsInput, ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error)) *S3_DeleteBucketCors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketEncryption provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketEncryption(ctx context.Context, params *s3.DeleteBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketEncryption")
+	}
+
+	var r0 *s3.DeleteBucketEncryptionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketEncryptionInput, ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketEncryptionInput, ...func(*s3.Options)) *s3.DeleteBucketEncryptionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketEncryptionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketEncryptionInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketEncryption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketEncryption'
+type S3_DeleteBucketEncryption_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketEncryption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketEncryptionInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketEncryption(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketEncryption_Call {
+	return &S3_DeleteBucketEncryption_Call{Call: _e.mock.On("DeleteBucketEncryption",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketEncryption_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketEncryptionInput, optFns ...func(*s3.Options))) *S3_DeleteBucketEncryption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketEncryptionInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketEncryption_Call) Return(_a0 *s3.DeleteBucketEncryptionOutput, _a1 error) *S3_DeleteBucketEncryption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketEncryption_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketEncryptionInput, ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)) *S3_DeleteBucketEncryption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketIntelligentTieringConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketIntelligentTieringConfiguration(ctx context.Context, params *s3.DeleteBucketIntelligentTieringConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketIntelligentTieringConfiguration")
+	}
+
+	var r0 *s3.DeleteBucketIntelligentTieringConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) *s3.DeleteBucketIntelligentTieringConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketIntelligentTieringConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketIntelligentTieringConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketIntelligentTieringConfiguration'
+type S3_DeleteBucketIntelligentTieringConfiguration_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketIntelligentTieringConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketIntelligentTieringConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketIntelligentTieringConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketIntelligentTieringConfiguration_Call {
+	return &S3_DeleteBucketIntelligentTieringConfiguration_Call{Call: _e.mock.On("DeleteBucketIntelligentTieringConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketIntelligentTieringConfiguration_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketIntelligentTieringConfigurationInput, optFns ...func(*s3.Options))) *S3_DeleteBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketIntelligentTieringConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketIntelligentTieringConfiguration_Call) Return(_a0 *s3.DeleteBucketIntelligentTieringConfigurationOutput, _a1 error) *S3_DeleteBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketIntelligentTieringConfiguration_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)) *S3_DeleteBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketInventoryConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketInventoryConfiguration(ctx context.Context, params *s3.DeleteBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketInventoryConfiguration")
+	}
+
+	var r0 *s3.DeleteBucketInventoryConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketInventoryConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketInventoryConfigurationInput, ...func(*s3.Options)) *s3.DeleteBucketInventoryConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketInventoryConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketInventoryConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketInventoryConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketInventoryConfiguration'
+type S3_DeleteBucketInventoryConfiguration_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketInventoryConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketInventoryConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketInventoryConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketInventoryConfiguration_Call {
+	return &S3_DeleteBucketInventoryConfiguration_Call{Call: _e.mock.On("DeleteBucketInventoryConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketInventoryConfiguration_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketInventoryConfigurationInput, optFns ...func(*s3.Options))) *S3_DeleteBucketInventoryConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketInventoryConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketInventoryConfiguration_Call) Return(_a0 *s3.DeleteBucketInventoryConfigurationOutput, _a1 error) *S3_DeleteBucketInventoryConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketInventoryConfiguration_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketInventoryConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)) *S3_DeleteBucketInventoryConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketLifecycle provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketLifecycle(ctx context.Context, params *s3.DeleteBucketLifecycleInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketLifecycle")
+	}
+
+	var r0 *s3.DeleteBucketLifecycleOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketLifecycleInput, ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketLifecycleInput, ...func(*s3.Options)) *s3.DeleteBucketLifecycleOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketLifecycleOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketLifecycleInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketLifecycle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketLifecycle'
+type S3_DeleteBucketLifecycle_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketLifecycle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketLifecycleInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketLifecycle(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketLifecycle_Call {
+	return &S3_DeleteBucketLifecycle_Call{Call: _e.mock.On("DeleteBucketLifecycle",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketLifecycle_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketLifecycleInput, optFns ...func(*s3.Options))) *S3_DeleteBucketLifecycle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketLifecycleInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketLifecycle_Call) Return(_a0 *s3.DeleteBucketLifecycleOutput, _a1 error) *S3_DeleteBucketLifecycle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketLifecycle_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketLifecycleInput, ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error)) *S3_DeleteBucketLifecycle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketMetadataConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketMetadataConfiguration(ctx context.Context, params *s3.DeleteBucketMetadataConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketMetadataConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketMetadataConfiguration")
+	}
+
+	var r0 *s3.DeleteBucketMetadataConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketMetadataConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketMetadataConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketMetadataConfigurationInput, ...func(*s3.Options)) *s3.DeleteBucketMetadataConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketMetadataConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketMetadataConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketMetadataConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketMetadataConfiguration'
+type S3_DeleteBucketMetadataConfiguration_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketMetadataConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketMetadataConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketMetadataConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketMetadataConfiguration_Call {
+	return &S3_DeleteBucketMetadataConfiguration_Call{Call: _e.mock.On("DeleteBucketMetadataConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketMetadataConfiguration_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketMetadataConfigurationInput, optFns ...func(*s3.Options))) *S3_DeleteBucketMetadataConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketMetadataConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketMetadataConfiguration_Call) Return(_a0 *s3.DeleteBucketMetadataConfigurationOutput, _a1 error) *S3_DeleteBucketMetadataConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketMetadataConfiguration_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketMetadataConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketMetadataConfigurationOutput, error)) *S3_DeleteBucketMetadataConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketMetadataTableConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketMetadataTableConfiguration(ctx context.Context, params *s3.DeleteBucketMetadataTableConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketMetadataTableConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketMetadataTableConfiguration")
+	}
+
+	var r0 *s3.DeleteBucketMetadataTableConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketMetadataTableConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketMetadataTableConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketMetadataTableConfigurationInput, ...func(*s3.Options)) *s3.DeleteBucketMetadataTableConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketMetadataTableConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketMetadataTableConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketMetadataTableConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketMetadataTableConfiguration'
+type S3_DeleteBucketMetadataTableConfiguration_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketMetadataTableConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketMetadataTableConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketMetadataTableConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketMetadataTableConfiguration_Call {
+	return &S3_DeleteBucketMetadataTableConfiguration_Call{Call: _e.mock.On("DeleteBucketMetadataTableConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketMetadataTableConfiguration_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketMetadataTableConfigurationInput, optFns ...func(*s3.Options))) *S3_DeleteBucketMetadataTableConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketMetadataTableConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketMetadataTableConfiguration_Call) Return(_a0 *s3.DeleteBucketMetadataTableConfigurationOutput, _a1 error) *S3_DeleteBucketMetadataTableConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketMetadataTableConfiguration_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketMetadataTableConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketMetadataTableConfigurationOutput, error)) *S3_DeleteBucketMetadataTableConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketMetricsConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketMetricsConfiguration(ctx context.Context, params *s3.DeleteBucketMetricsConfigurationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketMetricsConfiguration")
+	}
+
+	var r0 *s3.DeleteBucketMetricsConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketMetricsConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketMetricsConfigurationInput, ...func(*s3.Options)) *s3.DeleteBucketMetricsConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketMetricsConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketMetricsConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketMetricsConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketMetricsConfiguration'
+type S3_DeleteBucketMetricsConfiguration_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketMetricsConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketMetricsConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketMetricsConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketMetricsConfiguration_Call {
+	return &S3_DeleteBucketMetricsConfiguration_Call{Call: _e.mock.On("DeleteBucketMetricsConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketMetricsConfiguration_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketMetricsConfigurationInput, optFns ...func(*s3.Options))) *S3_DeleteBucketMetricsConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketMetricsConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketMetricsConfiguration_Call) Return(_a0 *s3.DeleteBucketMetricsConfigurationOutput, _a1 error) *S3_DeleteBucketMetricsConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketMetricsConfiguration_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketMetricsConfigurationInput, ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)) *S3_DeleteBucketMetricsConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketOwnershipControls provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketOwnershipControls(ctx context.Context, params *s3.DeleteBucketOwnershipControlsInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketOwnershipControls")
+	}
+
+	var r0 *s3.DeleteBucketOwnershipControlsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketOwnershipControlsInput, ...func(*s3.Options)) *s3.DeleteBucketOwnershipControlsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketOwnershipControlsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketOwnershipControlsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketOwnershipControls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketOwnershipControls'
+type S3_DeleteBucketOwnershipControls_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketOwnershipControls is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketOwnershipControlsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketOwnershipControls(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketOwnershipControls_Call {
+	return &S3_DeleteBucketOwnershipControls_Call{Call: _e.mock.On("DeleteBucketOwnershipControls",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketOwnershipControls_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketOwnershipControlsInput, optFns ...func(*s3.Options))) *S3_DeleteBucketOwnershipControls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketOwnershipControlsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketOwnershipControls_Call) Return(_a0 *s3.DeleteBucketOwnershipControlsOutput, _a1 error) *S3_DeleteBucketOwnershipControls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketOwnershipControls_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)) *S3_DeleteBucketOwnershipControls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketPolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketPolicy(ctx context.Context, params *s3.DeleteBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketPolicy")
+	}
+
+	var r0 *s3.DeleteBucketPolicyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketPolicyInput, ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketPolicyInput, ...func(*s3.Options)) *s3.DeleteBucketPolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketPolicyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketPolicyInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketPolicy'
+type S3_DeleteBucketPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketPolicyInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketPolicy(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketPolicy_Call {
+	return &S3_DeleteBucketPolicy_Call{Call: _e.mock.On("DeleteBucketPolicy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketPolicy_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketPolicyInput, optFns ...func(*s3.Options))) *S3_DeleteBucketPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketPolicyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketPolicy_Call) Return(_a0 *s3.DeleteBucketPolicyOutput, _a1 error) *S3_DeleteBucketPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketPolicy_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketPolicyInput, ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error)) *S3_DeleteBucketPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketReplication provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketReplication(ctx context.Context, params *s3.DeleteBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketReplication")
+	}
+
+	var r0 *s3.DeleteBucketReplicationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketReplicationInput, ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketReplicationInput, ...func(*s3.Options)) *s3.DeleteBucketReplicationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketReplicationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketReplicationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketReplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketReplication'
+type S3_DeleteBucketReplication_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketReplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketReplicationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketReplication(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketReplication_Call {
+	return &S3_DeleteBucketReplication_Call{Call: _e.mock.On("DeleteBucketReplication",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketReplication_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketReplicationInput, optFns ...func(*s3.Options))) *S3_DeleteBucketReplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketReplicationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketReplication_Call) Return(_a0 *s3.DeleteBucketReplicationOutput, _a1 error) *S3_DeleteBucketReplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketReplication_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketReplicationInput, ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error)) *S3_DeleteBucketReplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketTagging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketTagging(ctx context.Context, params *s3.DeleteBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketTagging")
+	}
+
+	var r0 *s3.DeleteBucketTaggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketTaggingInput, ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketTaggingInput, ...func(*s3.Options)) *s3.DeleteBucketTaggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketTaggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketTaggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketTagging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketTagging'
+type S3_DeleteBucketTagging_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketTagging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketTaggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketTagging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketTagging_Call {
+	return &S3_DeleteBucketTagging_Call{Call: _e.mock.On("DeleteBucketTagging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketTagging_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketTaggingInput, optFns ...func(*s3.Options))) *S3_DeleteBucketTagging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketTaggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketTagging_Call) Return(_a0 *s3.DeleteBucketTaggingOutput, _a1 error) *S3_DeleteBucketTagging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketTagging_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketTaggingInput, ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error)) *S3_DeleteBucketTagging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBucketWebsite provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteBucketWebsite(ctx context.Context, params *s3.DeleteBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBucketWebsite")
+	}
+
+	var r0 *s3.DeleteBucketWebsiteOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketWebsiteInput, ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteBucketWebsiteInput, ...func(*s3.Options)) *s3.DeleteBucketWebsiteOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteBucketWebsiteOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteBucketWebsiteInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteBucketWebsite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBucketWebsite'
+type S3_DeleteBucketWebsite_Call struct {
+	*mock.Call
+}
+
+// DeleteBucketWebsite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteBucketWebsiteInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteBucketWebsite(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteBucketWebsite_Call {
+	return &S3_DeleteBucketWebsite_Call{Call: _e.mock.On("DeleteBucketWebsite",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteBucketWebsite_Call) Run(run func(ctx context.Context, params *s3.DeleteBucketWebsiteInput, optFns ...func(*s3.Options))) *S3_DeleteBucketWebsite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteBucketWebsiteInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteBucketWebsite_Call) Return(_a0 *s3.DeleteBucketWebsiteOutput, _a1 error) *S3_DeleteBucketWebsite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteBucketWebsite_Call) RunAndReturn(run func(context.Context, *s3.DeleteBucketWebsiteInput, ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error)) *S3_DeleteBucketWebsite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteObject provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteObject")
+	}
+
+	var r0 *s3.DeleteObjectOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) *s3.DeleteObjectOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteObjectOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteObject'
+type S3_DeleteObject_Call struct {
+	*mock.Call
+}
+
+// DeleteObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteObjectInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteObject(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteObject_Call {
+	return &S3_DeleteObject_Call{Call: _e.mock.On("DeleteObject",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteObject_Call) Run(run func(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options))) *S3_DeleteObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteObjectInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteObject_Call) Return(_a0 *s3.DeleteObjectOutput, _a1 error) *S3_DeleteObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteObject_Call) RunAndReturn(run func(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)) *S3_DeleteObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteObjectTagging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteObjectTagging(ctx context.Context, params *s3.DeleteObjectTaggingInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectTaggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteObjectTagging")
+	}
+
+	var r0 *s3.DeleteObjectTaggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteObjectTaggingInput, ...func(*s3.Options)) (*s3.DeleteObjectTaggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteObjectTaggingInput, ...func(*s3.Options)) *s3.DeleteObjectTaggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteObjectTaggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteObjectTaggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteObjectTagging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteObjectTagging'
+type S3_DeleteObjectTagging_Call struct {
+	*mock.Call
+}
+
+// DeleteObjectTagging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteObjectTaggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteObjectTagging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteObjectTagging_Call {
+	return &S3_DeleteObjectTagging_Call{Call: _e.mock.On("DeleteObjectTagging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteObjectTagging_Call) Run(run func(ctx context.Context, params *s3.DeleteObjectTaggingInput, optFns ...func(*s3.Options))) *S3_DeleteObjectTagging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteObjectTaggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteObjectTagging_Call) Return(_a0 *s3.DeleteObjectTaggingOutput, _a1 error) *S3_DeleteObjectTagging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteObjectTagging_Call) RunAndReturn(run func(context.Context, *s3.DeleteObjectTaggingInput, ...func(*s3.Options)) (*s3.DeleteObjectTaggingOutput, error)) *S3_DeleteObjectTagging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteObjects provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteObjects")
+	}
+
+	var r0 *s3.DeleteObjectsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteObjectsInput, ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeleteObjectsInput, ...func(*s3.Options)) *s3.DeleteObjectsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeleteObjectsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeleteObjectsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeleteObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteObjects'
+type S3_DeleteObjects_Call struct {
+	*mock.Call
+}
+
+// DeleteObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeleteObjectsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeleteObjects(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeleteObjects_Call {
+	return &S3_DeleteObjects_Call{Call: _e.mock.On("DeleteObjects",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeleteObjects_Call) Run(run func(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options))) *S3_DeleteObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeleteObjectsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeleteObjects_Call) Return(_a0 *s3.DeleteObjectsOutput, _a1 error) *S3_DeleteObjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeleteObjects_Call) RunAndReturn(run func(context.Context, *s3.DeleteObjectsInput, ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)) *S3_DeleteObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePublicAccessBlock provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) DeletePublicAccessBlock(ctx context.Context, params *s3.DeletePublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePublicAccessBlock")
+	}
+
+	var r0 *s3.DeletePublicAccessBlockOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeletePublicAccessBlockInput, ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.DeletePublicAccessBlockInput, ...func(*s3.Options)) *s3.DeletePublicAccessBlockOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.DeletePublicAccessBlockOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.DeletePublicAccessBlockInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_DeletePublicAccessBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePublicAccessBlock'
+type S3_DeletePublicAccessBlock_Call struct {
+	*mock.Call
+}
+
+// DeletePublicAccessBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.DeletePublicAccessBlockInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) DeletePublicAccessBlock(ctx interface{}, params interface{}, optFns ...interface{}) *S3_DeletePublicAccessBlock_Call {
+	return &S3_DeletePublicAccessBlock_Call{Call: _e.mock.On("DeletePublicAccessBlock",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_DeletePublicAccessBlock_Call) Run(run func(ctx context.Context, params *s3.DeletePublicAccessBlockInput, optFns ...func(*s3.Options))) *S3_DeletePublicAccessBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.DeletePublicAccessBlockInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_DeletePublicAccessBlock_Call) Return(_a0 *s3.DeletePublicAccessBlockOutput, _a1 error) *S3_DeletePublicAccessBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_DeletePublicAccessBlock_Call) RunAndReturn(run func(context.Context, *s3.DeletePublicAccessBlockInput, ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)) *S3_DeletePublicAccessBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketAbac provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketAbac(ctx context.Context, params *s3.GetBucketAbacInput, optFns ...func(*s3.Options)) (*s3.GetBucketAbacOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketAbac")
+	}
+
+	var r0 *s3.GetBucketAbacOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAbacInput, ...func(*s3.Options)) (*s3.GetBucketAbacOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAbacInput, ...func(*s3.Options)) *s3.GetBucketAbacOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketAbacOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketAbacInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketAbac_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketAbac'
+type S3_GetBucketAbac_Call struct {
+	*mock.Call
+}
+
+// GetBucketAbac is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketAbacInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketAbac(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketAbac_Call {
+	return &S3_GetBucketAbac_Call{Call: _e.mock.On("GetBucketAbac",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketAbac_Call) Run(run func(ctx context.Context, params *s3.GetBucketAbacInput, optFns ...func(*s3.Options))) *S3_GetBucketAbac_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketAbacInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketAbac_Call) Return(_a0 *s3.GetBucketAbacOutput, _a1 error) *S3_GetBucketAbac_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketAbac_Call) RunAndReturn(run func(context.Context, *s3.GetBucketAbacInput, ...func(*s3.Options)) (*s3.GetBucketAbacOutput, error)) *S3_GetBucketAbac_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketAccelerateConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketAccelerateConfiguration(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketAccelerateConfiguration")
+	}
+
+	var r0 *s3.GetBucketAccelerateConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAccelerateConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAccelerateConfigurationInput, ...func(*s3.Options)) *s3.GetBucketAccelerateConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketAccelerateConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketAccelerateConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketAccelerateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketAccelerateConfiguration'
+type S3_GetBucketAccelerateConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketAccelerateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketAccelerateConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketAccelerateConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketAccelerateConfiguration_Call {
+	return &S3_GetBucketAccelerateConfiguration_Call{Call: _e.mock.On("GetBucketAccelerateConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketAccelerateConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketAccelerateConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketAccelerateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketAccelerateConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketAccelerateConfiguration_Call) Return(_a0 *s3.GetBucketAccelerateConfigurationOutput, _a1 error) *S3_GetBucketAccelerateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketAccelerateConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketAccelerateConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error)) *S3_GetBucketAccelerateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketAcl provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketAcl(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketAcl")
+	}
+
+	var r0 *s3.GetBucketAclOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAclInput, ...func(*s3.Options)) (*s3.GetBucketAclOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAclInput, ...func(*s3.Options)) *s3.GetBucketAclOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketAclOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketAclInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketAcl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketAcl'
+type S3_GetBucketAcl_Call struct {
+	*mock.Call
+}
+
+// GetBucketAcl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketAclInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketAcl(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketAcl_Call {
+	return &S3_GetBucketAcl_Call{Call: _e.mock.On("GetBucketAcl",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketAcl_Call) Run(run func(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options))) *S3_GetBucketAcl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketAclInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketAcl_Call) Return(_a0 *s3.GetBucketAclOutput, _a1 error) *S3_GetBucketAcl_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketAcl_Call) RunAndReturn(run func(context.Context, *s3.GetBucketAclInput, ...func(*s3.Options)) (*s3.GetBucketAclOutput, error)) *S3_GetBucketAcl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketAnalyticsConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketAnalyticsConfiguration(ctx context.Context, params *s3.GetBucketAnalyticsConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketAnalyticsConfiguration")
+	}
+
+	var r0 *s3.GetBucketAnalyticsConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAnalyticsConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketAnalyticsConfigurationInput, ...func(*s3.Options)) *s3.GetBucketAnalyticsConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketAnalyticsConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketAnalyticsConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketAnalyticsConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketAnalyticsConfiguration'
+type S3_GetBucketAnalyticsConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketAnalyticsConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketAnalyticsConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketAnalyticsConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketAnalyticsConfiguration_Call {
+	return &S3_GetBucketAnalyticsConfiguration_Call{Call: _e.mock.On("GetBucketAnalyticsConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketAnalyticsConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketAnalyticsConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketAnalyticsConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketAnalyticsConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketAnalyticsConfiguration_Call) Return(_a0 *s3.GetBucketAnalyticsConfigurationOutput, _a1 error) *S3_GetBucketAnalyticsConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketAnalyticsConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketAnalyticsConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)) *S3_GetBucketAnalyticsConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketCors provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketCors(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketCors")
+	}
+
+	var r0 *s3.GetBucketCorsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketCorsInput, ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketCorsInput, ...func(*s3.Options)) *s3.GetBucketCorsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketCorsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketCorsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketCors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketCors'
+type S3_GetBucketCors_Call struct {
+	*mock.Call
+}
+
+// GetBucketCors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketCorsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketCors(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketCors_Call {
+	return &S3_GetBucketCors_Call{Call: _e.mock.On("GetBucketCors",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketCors_Call) Run(run func(ctx context.Context, params *s3.GetBucketCorsInput, optFns ...func(*s3.Options))) *S3_GetBucketCors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketCorsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketCors_Call) Return(_a0 *s3.GetBucketCorsOutput, _a1 error) *S3_GetBucketCors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketCors_Call) RunAndReturn(run func(context.Context, *s3.GetBucketCorsInput, ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)) *S3_GetBucketCors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketEncryption provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketEncryption(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketEncryption")
+	}
+
+	var r0 *s3.GetBucketEncryptionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketEncryptionInput, ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketEncryptionInput, ...func(*s3.Options)) *s3.GetBucketEncryptionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketEncryptionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketEncryptionInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketEncryption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketEncryption'
+type S3_GetBucketEncryption_Call struct {
+	*mock.Call
+}
+
+// GetBucketEncryption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketEncryptionInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketEncryption(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketEncryption_Call {
+	return &S3_GetBucketEncryption_Call{Call: _e.mock.On("GetBucketEncryption",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketEncryption_Call) Run(run func(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options))) *S3_GetBucketEncryption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketEncryptionInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketEncryption_Call) Return(_a0 *s3.GetBucketEncryptionOutput, _a1 error) *S3_GetBucketEncryption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketEncryption_Call) RunAndReturn(run func(context.Context, *s3.GetBucketEncryptionInput, ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)) *S3_GetBucketEncryption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketIntelligentTieringConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketIntelligentTieringConfiguration(ctx context.Context, params *s3.GetBucketIntelligentTieringConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketIntelligentTieringConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketIntelligentTieringConfiguration")
+	}
+
+	var r0 *s3.GetBucketIntelligentTieringConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketIntelligentTieringConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) *s3.GetBucketIntelligentTieringConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketIntelligentTieringConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketIntelligentTieringConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketIntelligentTieringConfiguration'
+type S3_GetBucketIntelligentTieringConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketIntelligentTieringConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketIntelligentTieringConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketIntelligentTieringConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketIntelligentTieringConfiguration_Call {
+	return &S3_GetBucketIntelligentTieringConfiguration_Call{Call: _e.mock.On("GetBucketIntelligentTieringConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketIntelligentTieringConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketIntelligentTieringConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketIntelligentTieringConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketIntelligentTieringConfiguration_Call) Return(_a0 *s3.GetBucketIntelligentTieringConfigurationOutput, _a1 error) *S3_GetBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketIntelligentTieringConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketIntelligentTieringConfigurationOutput, error)) *S3_GetBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketInventoryConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketInventoryConfiguration(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketInventoryConfiguration")
+	}
+
+	var r0 *s3.GetBucketInventoryConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketInventoryConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketInventoryConfigurationInput, ...func(*s3.Options)) *s3.GetBucketInventoryConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketInventoryConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketInventoryConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketInventoryConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketInventoryConfiguration'
+type S3_GetBucketInventoryConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketInventoryConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketInventoryConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketInventoryConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketInventoryConfiguration_Call {
+	return &S3_GetBucketInventoryConfiguration_Call{Call: _e.mock.On("GetBucketInventoryConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketInventoryConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketInventoryConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketInventoryConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketInventoryConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketInventoryConfiguration_Call) Return(_a0 *s3.GetBucketInventoryConfigurationOutput, _a1 error) *S3_GetBucketInventoryConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketInventoryConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketInventoryConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketInventoryConfigurationOutput, error)) *S3_GetBucketInventoryConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketLifecycleConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketLifecycleConfiguration(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketLifecycleConfiguration")
+	}
+
+	var r0 *s3.GetBucketLifecycleConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketLifecycleConfigurationInput, ...func(*s3.Options)) *s3.GetBucketLifecycleConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketLifecycleConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketLifecycleConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketLifecycleConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketLifecycleConfiguration'
+type S3_GetBucketLifecycleConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketLifecycleConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketLifecycleConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketLifecycleConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketLifecycleConfiguration_Call {
+	return &S3_GetBucketLifecycleConfiguration_Call{Call: _e.mock.On("GetBucketLifecycleConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketLifecycleConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketLifecycleConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketLifecycleConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketLifecycleConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketLifecycleConfiguration_Call) Return(_a0 *s3.GetBucketLifecycleConfigurationOutput, _a1 error) *S3_GetBucketLifecycleConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketLifecycleConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)) *S3_GetBucketLifecycleConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketLocation provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketLocation")
+	}
+
+	var r0 *s3.GetBucketLocationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketLocationInput, ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketLocationInput, ...func(*s3.Options)) *s3.GetBucketLocationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketLocationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketLocationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketLocation'
+type S3_GetBucketLocation_Call struct {
+	*mock.Call
+}
+
+// GetBucketLocation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketLocationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketLocation(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketLocation_Call {
+	return &S3_GetBucketLocation_Call{Call: _e.mock.On("GetBucketLocation",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketLocation_Call) Run(run func(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options))) *S3_GetBucketLocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketLocationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketLocation_Call) Return(_a0 *s3.GetBucketLocationOutput, _a1 error) *S3_GetBucketLocation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketLocation_Call) RunAndReturn(run func(context.Context, *s3.GetBucketLocationInput, ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)) *S3_GetBucketLocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketLogging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketLogging(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketLogging")
+	}
+
+	var r0 *s3.GetBucketLoggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketLoggingInput, ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketLoggingInput, ...func(*s3.Options)) *s3.GetBucketLoggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketLoggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketLoggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketLogging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketLogging'
+type S3_GetBucketLogging_Call struct {
+	*mock.Call
+}
+
+// GetBucketLogging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketLoggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketLogging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketLogging_Call {
+	return &S3_GetBucketLogging_Call{Call: _e.mock.On("GetBucketLogging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketLogging_Call) Run(run func(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options))) *S3_GetBucketLogging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketLoggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketLogging_Call) Return(_a0 *s3.GetBucketLoggingOutput, _a1 error) *S3_GetBucketLogging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketLogging_Call) RunAndReturn(run func(context.Context, *s3.GetBucketLoggingInput, ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)) *S3_GetBucketLogging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketMetadataConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketMetadataConfiguration(ctx context.Context, params *s3.GetBucketMetadataConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketMetadataConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketMetadataConfiguration")
+	}
+
+	var r0 *s3.GetBucketMetadataConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketMetadataConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketMetadataConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketMetadataConfigurationInput, ...func(*s3.Options)) *s3.GetBucketMetadataConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketMetadataConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketMetadataConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketMetadataConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketMetadataConfiguration'
+type S3_GetBucketMetadataConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketMetadataConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketMetadataConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketMetadataConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketMetadataConfiguration_Call {
+	return &S3_GetBucketMetadataConfiguration_Call{Call: _e.mock.On("GetBucketMetadataConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketMetadataConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketMetadataConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketMetadataConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketMetadataConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketMetadataConfiguration_Call) Return(_a0 *s3.GetBucketMetadataConfigurationOutput, _a1 error) *S3_GetBucketMetadataConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketMetadataConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketMetadataConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketMetadataConfigurationOutput, error)) *S3_GetBucketMetadataConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketMetadataTableConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketMetadataTableConfiguration(ctx context.Context, params *s3.GetBucketMetadataTableConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketMetadataTableConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketMetadataTableConfiguration")
+	}
+
+	var r0 *s3.GetBucketMetadataTableConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketMetadataTableConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketMetadataTableConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketMetadataTableConfigurationInput, ...func(*s3.Options)) *s3.GetBucketMetadataTableConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketMetadataTableConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketMetadataTableConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketMetadataTableConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketMetadataTableConfiguration'
+type S3_GetBucketMetadataTableConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketMetadataTableConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketMetadataTableConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketMetadataTableConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketMetadataTableConfiguration_Call {
+	return &S3_GetBucketMetadataTableConfiguration_Call{Call: _e.mock.On("GetBucketMetadataTableConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketMetadataTableConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketMetadataTableConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketMetadataTableConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketMetadataTableConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketMetadataTableConfiguration_Call) Return(_a0 *s3.GetBucketMetadataTableConfigurationOutput, _a1 error) *S3_GetBucketMetadataTableConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketMetadataTableConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketMetadataTableConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketMetadataTableConfigurationOutput, error)) *S3_GetBucketMetadataTableConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketMetricsConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketMetricsConfiguration(ctx context.Context, params *s3.GetBucketMetricsConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketMetricsConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketMetricsConfiguration")
+	}
+
+	var r0 *s3.GetBucketMetricsConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketMetricsConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketMetricsConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketMetricsConfigurationInput, ...func(*s3.Options)) *s3.GetBucketMetricsConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketMetricsConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketMetricsConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketMetricsConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketMetricsConfiguration'
+type S3_GetBucketMetricsConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketMetricsConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketMetricsConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketMetricsConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketMetricsConfiguration_Call {
+	return &S3_GetBucketMetricsConfiguration_Call{Call: _e.mock.On("GetBucketMetricsConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketMetricsConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketMetricsConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketMetricsConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketMetricsConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketMetricsConfiguration_Call) Return(_a0 *s3.GetBucketMetricsConfigurationOutput, _a1 error) *S3_GetBucketMetricsConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketMetricsConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketMetricsConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketMetricsConfigurationOutput, error)) *S3_GetBucketMetricsConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketNotificationConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketNotificationConfiguration(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketNotificationConfiguration")
+	}
+
+	var r0 *s3.GetBucketNotificationConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketNotificationConfigurationInput, ...func(*s3.Options)) *s3.GetBucketNotificationConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketNotificationConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketNotificationConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketNotificationConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketNotificationConfiguration'
+type S3_GetBucketNotificationConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetBucketNotificationConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketNotificationConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketNotificationConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketNotificationConfiguration_Call {
+	return &S3_GetBucketNotificationConfiguration_Call{Call: _e.mock.On("GetBucketNotificationConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketNotificationConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options))) *S3_GetBucketNotificationConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketNotificationConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketNotificationConfiguration_Call) Return(_a0 *s3.GetBucketNotificationConfigurationOutput, _a1 error) *S3_GetBucketNotificationConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketNotificationConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)) *S3_GetBucketNotificationConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketOwnershipControls provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketOwnershipControls(ctx context.Context, params *s3.GetBucketOwnershipControlsInput, optFns ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketOwnershipControls")
+	}
+
+	var r0 *s3.GetBucketOwnershipControlsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketOwnershipControlsInput, ...func(*s3.Options)) *s3.GetBucketOwnershipControlsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketOwnershipControlsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketOwnershipControlsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketOwnershipControls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketOwnershipControls'
+type S3_GetBucketOwnershipControls_Call struct {
+	*mock.Call
+}
+
+// GetBucketOwnershipControls is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketOwnershipControlsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketOwnershipControls(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketOwnershipControls_Call {
+	return &S3_GetBucketOwnershipControls_Call{Call: _e.mock.On("GetBucketOwnershipControls",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketOwnershipControls_Call) Run(run func(ctx context.Context, params *s3.GetBucketOwnershipControlsInput, optFns ...func(*s3.Options))) *S3_GetBucketOwnershipControls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketOwnershipControlsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketOwnershipControls_Call) Return(_a0 *s3.GetBucketOwnershipControlsOutput, _a1 error) *S3_GetBucketOwnershipControls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketOwnershipControls_Call) RunAndReturn(run func(context.Context, *s3.GetBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)) *S3_GetBucketOwnershipControls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketPolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketPolicy(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketPolicy")
+	}
+
+	var r0 *s3.GetBucketPolicyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketPolicyInput, ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketPolicyInput, ...func(*s3.Options)) *s3.GetBucketPolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketPolicyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketPolicyInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketPolicy'
+type S3_GetBucketPolicy_Call struct {
+	*mock.Call
+}
+
+// GetBucketPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketPolicyInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketPolicy(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketPolicy_Call {
+	return &S3_GetBucketPolicy_Call{Call: _e.mock.On("GetBucketPolicy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketPolicy_Call) Run(run func(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options))) *S3_GetBucketPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketPolicyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketPolicy_Call) Return(_a0 *s3.GetBucketPolicyOutput, _a1 error) *S3_GetBucketPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketPolicy_Call) RunAndReturn(run func(context.Context, *s3.GetBucketPolicyInput, ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)) *S3_GetBucketPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketPolicyStatus provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketPolicyStatus(ctx context.Context, params *s3.GetBucketPolicyStatusInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyStatusOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketPolicyStatus")
+	}
+
+	var r0 *s3.GetBucketPolicyStatusOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketPolicyStatusInput, ...func(*s3.Options)) (*s3.GetBucketPolicyStatusOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketPolicyStatusInput, ...func(*s3.Options)) *s3.GetBucketPolicyStatusOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketPolicyStatusOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketPolicyStatusInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketPolicyStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketPolicyStatus'
+type S3_GetBucketPolicyStatus_Call struct {
+	*mock.Call
+}
+
+// GetBucketPolicyStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketPolicyStatusInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketPolicyStatus(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketPolicyStatus_Call {
+	return &S3_GetBucketPolicyStatus_Call{Call: _e.mock.On("GetBucketPolicyStatus",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketPolicyStatus_Call) Run(run func(ctx context.Context, params *s3.GetBucketPolicyStatusInput, optFns ...func(*s3.Options))) *S3_GetBucketPolicyStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketPolicyStatusInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketPolicyStatus_Call) Return(_a0 *s3.GetBucketPolicyStatusOutput, _a1 error) *S3_GetBucketPolicyStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketPolicyStatus_Call) RunAndReturn(run func(context.Context, *s3.GetBucketPolicyStatusInput, ...func(*s3.Options)) (*s3.GetBucketPolicyStatusOutput, error)) *S3_GetBucketPolicyStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketReplication provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketReplication(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketReplication")
+	}
+
+	var r0 *s3.GetBucketReplicationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketReplicationInput, ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketReplicationInput, ...func(*s3.Options)) *s3.GetBucketReplicationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketReplicationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketReplicationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketReplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketReplication'
+type S3_GetBucketReplication_Call struct {
+	*mock.Call
+}
+
+// GetBucketReplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketReplicationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketReplication(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketReplication_Call {
+	return &S3_GetBucketReplication_Call{Call: _e.mock.On("GetBucketReplication",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketReplication_Call) Run(run func(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options))) *S3_GetBucketReplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketReplicationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketReplication_Call) Return(_a0 *s3.GetBucketReplicationOutput, _a1 error) *S3_GetBucketReplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketReplication_Call) RunAndReturn(run func(context.Context, *s3.GetBucketReplicationInput, ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error)) *S3_GetBucketReplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketRequestPayment provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketRequestPayment(ctx context.Context, params *s3.GetBucketRequestPaymentInput, optFns ...func(*s3.Options)) (*s3.GetBucketRequestPaymentOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketRequestPayment")
+	}
+
+	var r0 *s3.GetBucketRequestPaymentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketRequestPaymentInput, ...func(*s3.Options)) (*s3.GetBucketRequestPaymentOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketRequestPaymentInput, ...func(*s3.Options)) *s3.GetBucketRequestPaymentOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketRequestPaymentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketRequestPaymentInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketRequestPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketRequestPayment'
+type S3_GetBucketRequestPayment_Call struct {
+	*mock.Call
+}
+
+// GetBucketRequestPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketRequestPaymentInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketRequestPayment(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketRequestPayment_Call {
+	return &S3_GetBucketRequestPayment_Call{Call: _e.mock.On("GetBucketRequestPayment",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketRequestPayment_Call) Run(run func(ctx context.Context, params *s3.GetBucketRequestPaymentInput, optFns ...func(*s3.Options))) *S3_GetBucketRequestPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketRequestPaymentInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketRequestPayment_Call) Return(_a0 *s3.GetBucketRequestPaymentOutput, _a1 error) *S3_GetBucketRequestPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketRequestPayment_Call) RunAndReturn(run func(context.Context, *s3.GetBucketRequestPaymentInput, ...func(*s3.Options)) (*s3.GetBucketRequestPaymentOutput, error)) *S3_GetBucketRequestPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketTagging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketTagging(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketTagging")
+	}
+
+	var r0 *s3.GetBucketTaggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketTaggingInput, ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketTaggingInput, ...func(*s3.Options)) *s3.GetBucketTaggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketTaggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketTaggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketTagging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketTagging'
+type S3_GetBucketTagging_Call struct {
+	*mock.Call
+}
+
+// GetBucketTagging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketTaggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketTagging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketTagging_Call {
+	return &S3_GetBucketTagging_Call{Call: _e.mock.On("GetBucketTagging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketTagging_Call) Run(run func(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options))) *S3_GetBucketTagging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketTaggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketTagging_Call) Return(_a0 *s3.GetBucketTaggingOutput, _a1 error) *S3_GetBucketTagging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketTagging_Call) RunAndReturn(run func(context.Context, *s3.GetBucketTaggingInput, ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)) *S3_GetBucketTagging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketVersioning provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketVersioning(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketVersioning")
+	}
+
+	var r0 *s3.GetBucketVersioningOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketVersioningInput, ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketVersioningInput, ...func(*s3.Options)) *s3.GetBucketVersioningOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketVersioningOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketVersioningInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketVersioning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketVersioning'
+type S3_GetBucketVersioning_Call struct {
+	*mock.Call
+}
+
+// GetBucketVersioning is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketVersioningInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketVersioning(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketVersioning_Call {
+	return &S3_GetBucketVersioning_Call{Call: _e.mock.On("GetBucketVersioning",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketVersioning_Call) Run(run func(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options))) *S3_GetBucketVersioning_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketVersioningInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketVersioning_Call) Return(_a0 *s3.GetBucketVersioningOutput, _a1 error) *S3_GetBucketVersioning_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketVersioning_Call) RunAndReturn(run func(context.Context, *s3.GetBucketVersioningInput, ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error)) *S3_GetBucketVersioning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBucketWebsite provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetBucketWebsite(ctx context.Context, params *s3.GetBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBucketWebsite")
+	}
+
+	var r0 *s3.GetBucketWebsiteOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketWebsiteInput, ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetBucketWebsiteInput, ...func(*s3.Options)) *s3.GetBucketWebsiteOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetBucketWebsiteOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetBucketWebsiteInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetBucketWebsite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBucketWebsite'
+type S3_GetBucketWebsite_Call struct {
+	*mock.Call
+}
+
+// GetBucketWebsite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetBucketWebsiteInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetBucketWebsite(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetBucketWebsite_Call {
+	return &S3_GetBucketWebsite_Call{Call: _e.mock.On("GetBucketWebsite",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetBucketWebsite_Call) Run(run func(ctx context.Context, params *s3.GetBucketWebsiteInput, optFns ...func(*s3.Options))) *S3_GetBucketWebsite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetBucketWebsiteInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetBucketWebsite_Call) Return(_a0 *s3.GetBucketWebsiteOutput, _a1 error) *S3_GetBucketWebsite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetBucketWebsite_Call) RunAndReturn(run func(context.Context, *s3.GetBucketWebsiteInput, ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error)) *S3_GetBucketWebsite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObject provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObject")
+	}
+
+	var r0 *s3.GetObjectOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) (*s3.GetObjectOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) *s3.GetObjectOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObject'
+type S3_GetObject_Call struct {
+	*mock.Call
+}
+
+// GetObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObject(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObject_Call {
+	return &S3_GetObject_Call{Call: _e.mock.On("GetObject",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObject_Call) Run(run func(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options))) *S3_GetObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObject_Call) Return(_a0 *s3.GetObjectOutput, _a1 error) *S3_GetObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObject_Call) RunAndReturn(run func(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) (*s3.GetObjectOutput, error)) *S3_GetObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObjectAcl provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObjectAcl(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjectAcl")
+	}
+
+	var r0 *s3.GetObjectAclOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectAclInput, ...func(*s3.Options)) (*s3.GetObjectAclOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectAclInput, ...func(*s3.Options)) *s3.GetObjectAclOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectAclOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectAclInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObjectAcl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObjectAcl'
+type S3_GetObjectAcl_Call struct {
+	*mock.Call
+}
+
+// GetObjectAcl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectAclInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObjectAcl(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObjectAcl_Call {
+	return &S3_GetObjectAcl_Call{Call: _e.mock.On("GetObjectAcl",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObjectAcl_Call) Run(run func(ctx context.Context, params *s3.GetObjectAclInput, optFns ...func(*s3.Options))) *S3_GetObjectAcl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectAclInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObjectAcl_Call) Return(_a0 *s3.GetObjectAclOutput, _a1 error) *S3_GetObjectAcl_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObjectAcl_Call) RunAndReturn(run func(context.Context, *s3.GetObjectAclInput, ...func(*s3.Options)) (*s3.GetObjectAclOutput, error)) *S3_GetObjectAcl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObjectAttributes provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObjectAttributes(ctx context.Context, params *s3.GetObjectAttributesInput, optFns ...func(*s3.Options)) (*s3.GetObjectAttributesOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjectAttributes")
+	}
+
+	var r0 *s3.GetObjectAttributesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectAttributesInput, ...func(*s3.Options)) (*s3.GetObjectAttributesOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectAttributesInput, ...func(*s3.Options)) *s3.GetObjectAttributesOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectAttributesOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectAttributesInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObjectAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObjectAttributes'
+type S3_GetObjectAttributes_Call struct {
+	*mock.Call
+}
+
+// GetObjectAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectAttributesInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObjectAttributes(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObjectAttributes_Call {
+	return &S3_GetObjectAttributes_Call{Call: _e.mock.On("GetObjectAttributes",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObjectAttributes_Call) Run(run func(ctx context.Context, params *s3.GetObjectAttributesInput, optFns ...func(*s3.Options))) *S3_GetObjectAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectAttributesInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObjectAttributes_Call) Return(_a0 *s3.GetObjectAttributesOutput, _a1 error) *S3_GetObjectAttributes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObjectAttributes_Call) RunAndReturn(run func(context.Context, *s3.GetObjectAttributesInput, ...func(*s3.Options)) (*s3.GetObjectAttributesOutput, error)) *S3_GetObjectAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObjectLegalHold provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObjectLegalHold(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjectLegalHold")
+	}
+
+	var r0 *s3.GetObjectLegalHoldOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectLegalHoldInput, ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectLegalHoldInput, ...func(*s3.Options)) *s3.GetObjectLegalHoldOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectLegalHoldOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectLegalHoldInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObjectLegalHold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObjectLegalHold'
+type S3_GetObjectLegalHold_Call struct {
+	*mock.Call
+}
+
+// GetObjectLegalHold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectLegalHoldInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObjectLegalHold(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObjectLegalHold_Call {
+	return &S3_GetObjectLegalHold_Call{Call: _e.mock.On("GetObjectLegalHold",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObjectLegalHold_Call) Run(run func(ctx context.Context, params *s3.GetObjectLegalHoldInput, optFns ...func(*s3.Options))) *S3_GetObjectLegalHold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectLegalHoldInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObjectLegalHold_Call) Return(_a0 *s3.GetObjectLegalHoldOutput, _a1 error) *S3_GetObjectLegalHold_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObjectLegalHold_Call) RunAndReturn(run func(context.Context, *s3.GetObjectLegalHoldInput, ...func(*s3.Options)) (*s3.GetObjectLegalHoldOutput, error)) *S3_GetObjectLegalHold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObjectLockConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObjectLockConfiguration(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjectLockConfiguration")
+	}
+
+	var r0 *s3.GetObjectLockConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectLockConfigurationInput, ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectLockConfigurationInput, ...func(*s3.Options)) *s3.GetObjectLockConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectLockConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectLockConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObjectLockConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObjectLockConfiguration'
+type S3_GetObjectLockConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetObjectLockConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectLockConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObjectLockConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObjectLockConfiguration_Call {
+	return &S3_GetObjectLockConfiguration_Call{Call: _e.mock.On("GetObjectLockConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObjectLockConfiguration_Call) Run(run func(ctx context.Context, params *s3.GetObjectLockConfigurationInput, optFns ...func(*s3.Options))) *S3_GetObjectLockConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectLockConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObjectLockConfiguration_Call) Return(_a0 *s3.GetObjectLockConfigurationOutput, _a1 error) *S3_GetObjectLockConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObjectLockConfiguration_Call) RunAndReturn(run func(context.Context, *s3.GetObjectLockConfigurationInput, ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)) *S3_GetObjectLockConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObjectRetention provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObjectRetention(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjectRetention")
+	}
+
+	var r0 *s3.GetObjectRetentionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectRetentionInput, ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectRetentionInput, ...func(*s3.Options)) *s3.GetObjectRetentionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectRetentionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectRetentionInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObjectRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObjectRetention'
+type S3_GetObjectRetention_Call struct {
+	*mock.Call
+}
+
+// GetObjectRetention is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectRetentionInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObjectRetention(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObjectRetention_Call {
+	return &S3_GetObjectRetention_Call{Call: _e.mock.On("GetObjectRetention",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObjectRetention_Call) Run(run func(ctx context.Context, params *s3.GetObjectRetentionInput, optFns ...func(*s3.Options))) *S3_GetObjectRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectRetentionInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObjectRetention_Call) Return(_a0 *s3.GetObjectRetentionOutput, _a1 error) *S3_GetObjectRetention_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObjectRetention_Call) RunAndReturn(run func(context.Context, *s3.GetObjectRetentionInput, ...func(*s3.Options)) (*s3.GetObjectRetentionOutput, error)) *S3_GetObjectRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObjectTagging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObjectTagging(ctx context.Context, params *s3.GetObjectTaggingInput, optFns ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjectTagging")
+	}
+
+	var r0 *s3.GetObjectTaggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectTaggingInput, ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectTaggingInput, ...func(*s3.Options)) *s3.GetObjectTaggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectTaggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectTaggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObjectTagging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObjectTagging'
+type S3_GetObjectTagging_Call struct {
+	*mock.Call
+}
+
+// GetObjectTagging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectTaggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObjectTagging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObjectTagging_Call {
+	return &S3_GetObjectTagging_Call{Call: _e.mock.On("GetObjectTagging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObjectTagging_Call) Run(run func(ctx context.Context, params *s3.GetObjectTaggingInput, optFns ...func(*s3.Options))) *S3_GetObjectTagging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectTaggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObjectTagging_Call) Return(_a0 *s3.GetObjectTaggingOutput, _a1 error) *S3_GetObjectTagging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObjectTagging_Call) RunAndReturn(run func(context.Context, *s3.GetObjectTaggingInput, ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)) *S3_GetObjectTagging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetObjectTorrent provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetObjectTorrent(ctx context.Context, params *s3.GetObjectTorrentInput, optFns ...func(*s3.Options)) (*s3.GetObjectTorrentOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObjectTorrent")
+	}
+
+	var r0 *s3.GetObjectTorrentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectTorrentInput, ...func(*s3.Options)) (*s3.GetObjectTorrentOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetObjectTorrentInput, ...func(*s3.Options)) *s3.GetObjectTorrentOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetObjectTorrentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetObjectTorrentInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetObjectTorrent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObjectTorrent'
+type S3_GetObjectTorrent_Call struct {
+	*mock.Call
+}
+
+// GetObjectTorrent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetObjectTorrentInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetObjectTorrent(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetObjectTorrent_Call {
+	return &S3_GetObjectTorrent_Call{Call: _e.mock.On("GetObjectTorrent",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetObjectTorrent_Call) Run(run func(ctx context.Context, params *s3.GetObjectTorrentInput, optFns ...func(*s3.Options))) *S3_GetObjectTorrent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetObjectTorrentInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetObjectTorrent_Call) Return(_a0 *s3.GetObjectTorrentOutput, _a1 error) *S3_GetObjectTorrent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetObjectTorrent_Call) RunAndReturn(run func(context.Context, *s3.GetObjectTorrentInput, ...func(*s3.Options)) (*s3.GetObjectTorrentOutput, error)) *S3_GetObjectTorrent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublicAccessBlock provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) GetPublicAccessBlock(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicAccessBlock")
+	}
+
+	var r0 *s3.GetPublicAccessBlockOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetPublicAccessBlockInput, ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.GetPublicAccessBlockInput, ...func(*s3.Options)) *s3.GetPublicAccessBlockOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.GetPublicAccessBlockOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.GetPublicAccessBlockInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_GetPublicAccessBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicAccessBlock'
+type S3_GetPublicAccessBlock_Call struct {
+	*mock.Call
+}
+
+// GetPublicAccessBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.GetPublicAccessBlockInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) GetPublicAccessBlock(ctx interface{}, params interface{}, optFns ...interface{}) *S3_GetPublicAccessBlock_Call {
+	return &S3_GetPublicAccessBlock_Call{Call: _e.mock.On("GetPublicAccessBlock",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_GetPublicAccessBlock_Call) Run(run func(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options))) *S3_GetPublicAccessBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.GetPublicAccessBlockInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_GetPublicAccessBlock_Call) Return(_a0 *s3.GetPublicAccessBlockOutput, _a1 error) *S3_GetPublicAccessBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_GetPublicAccessBlock_Call) RunAndReturn(run func(context.Context, *s3.GetPublicAccessBlockInput, ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)) *S3_GetPublicAccessBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HeadBucket provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) HeadBucket(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeadBucket")
+	}
+
+	var r0 *s3.HeadBucketOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) (*s3.HeadBucketOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) *s3.HeadBucketOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.HeadBucketOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_HeadBucket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HeadBucket'
+type S3_HeadBucket_Call struct {
+	*mock.Call
+}
+
+// HeadBucket is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.HeadBucketInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) HeadBucket(ctx interface{}, params interface{}, optFns ...interface{}) *S3_HeadBucket_Call {
+	return &S3_HeadBucket_Call{Call: _e.mock.On("HeadBucket",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_HeadBucket_Call) Run(run func(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options))) *S3_HeadBucket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.HeadBucketInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_HeadBucket_Call) Return(_a0 *s3.HeadBucketOutput, _a1 error) *S3_HeadBucket_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_HeadBucket_Call) RunAndReturn(run func(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) (*s3.HeadBucketOutput, error)) *S3_HeadBucket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HeadObject provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeadObject")
+	}
+
+	var r0 *s3.HeadObjectOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.HeadObjectInput, ...func(*s3.Options)) (*s3.HeadObjectOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.HeadObjectInput, ...func(*s3.Options)) *s3.HeadObjectOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.HeadObjectOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.HeadObjectInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_HeadObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HeadObject'
+type S3_HeadObject_Call struct {
+	*mock.Call
+}
+
+// HeadObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.HeadObjectInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) HeadObject(ctx interface{}, params interface{}, optFns ...interface{}) *S3_HeadObject_Call {
+	return &S3_HeadObject_Call{Call: _e.mock.On("HeadObject",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_HeadObject_Call) Run(run func(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options))) *S3_HeadObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.HeadObjectInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_HeadObject_Call) Return(_a0 *s3.HeadObjectOutput, _a1 error) *S3_HeadObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_HeadObject_Call) RunAndReturn(run func(context.Context, *s3.HeadObjectInput, ...func(*s3.Options)) (*s3.HeadObjectOutput, error)) *S3_HeadObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBucketAnalyticsConfigurations provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListBucketAnalyticsConfigurations(ctx context.Context, params *s3.ListBucketAnalyticsConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBucketAnalyticsConfigurations")
+	}
+
+	var r0 *s3.ListBucketAnalyticsConfigurationsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...func(*s3.Options)) *s3.ListBucketAnalyticsConfigurationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListBucketAnalyticsConfigurationsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListBucketAnalyticsConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBucketAnalyticsConfigurations'
+type S3_ListBucketAnalyticsConfigurations_Call struct {
+	*mock.Call
+}
+
+// ListBucketAnalyticsConfigurations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListBucketAnalyticsConfigurationsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListBucketAnalyticsConfigurations(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListBucketAnalyticsConfigurations_Call {
+	return &S3_ListBucketAnalyticsConfigurations_Call{Call: _e.mock.On("ListBucketAnalyticsConfigurations",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListBucketAnalyticsConfigurations_Call) Run(run func(ctx context.Context, params *s3.ListBucketAnalyticsConfigurationsInput, optFns ...func(*s3.Options))) *S3_ListBucketAnalyticsConfigurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListBucketAnalyticsConfigurationsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListBucketAnalyticsConfigurations_Call) Return(_a0 *s3.ListBucketAnalyticsConfigurationsOutput, _a1 error) *S3_ListBucketAnalyticsConfigurations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListBucketAnalyticsConfigurations_Call) RunAndReturn(run func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)) *S3_ListBucketAnalyticsConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBucketIntelligentTieringConfigurations provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListBucketIntelligentTieringConfigurations(ctx context.Context, params *s3.ListBucketIntelligentTieringConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBucketIntelligentTieringConfigurations")
+	}
+
+	var r0 *s3.ListBucketIntelligentTieringConfigurationsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, ...func(*s3.Options)) *s3.ListBucketIntelligentTieringConfigurationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListBucketIntelligentTieringConfigurationsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListBucketIntelligentTieringConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBucketIntelligentTieringConfigurations'
+type S3_ListBucketIntelligentTieringConfigurations_Call struct {
+	*mock.Call
+}
+
+// ListBucketIntelligentTieringConfigurations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListBucketIntelligentTieringConfigurationsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListBucketIntelligentTieringConfigurations(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListBucketIntelligentTieringConfigurations_Call {
+	return &S3_ListBucketIntelligentTieringConfigurations_Call{Call: _e.mock.On("ListBucketIntelligentTieringConfigurations",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListBucketIntelligentTieringConfigurations_Call) Run(run func(ctx context.Context, params *s3.ListBucketIntelligentTieringConfigurationsInput, optFns ...func(*s3.Options))) *S3_ListBucketIntelligentTieringConfigurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListBucketIntelligentTieringConfigurationsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListBucketIntelligentTieringConfigurations_Call) Return(_a0 *s3.ListBucketIntelligentTieringConfigurationsOutput, _a1 error) *S3_ListBucketIntelligentTieringConfigurations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListBucketIntelligentTieringConfigurations_Call) RunAndReturn(run func(context.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)) *S3_ListBucketIntelligentTieringConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBucketInventoryConfigurations provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListBucketInventoryConfigurations(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBucketInventoryConfigurations")
+	}
+
+	var r0 *s3.ListBucketInventoryConfigurationsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketInventoryConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketInventoryConfigurationsInput, ...func(*s3.Options)) *s3.ListBucketInventoryConfigurationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListBucketInventoryConfigurationsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListBucketInventoryConfigurationsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListBucketInventoryConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBucketInventoryConfigurations'
+type S3_ListBucketInventoryConfigurations_Call struct {
+	*mock.Call
+}
+
+// ListBucketInventoryConfigurations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListBucketInventoryConfigurationsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListBucketInventoryConfigurations(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListBucketInventoryConfigurations_Call {
+	return &S3_ListBucketInventoryConfigurations_Call{Call: _e.mock.On("ListBucketInventoryConfigurations",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListBucketInventoryConfigurations_Call) Run(run func(ctx context.Context, params *s3.ListBucketInventoryConfigurationsInput, optFns ...func(*s3.Options))) *S3_ListBucketInventoryConfigurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListBucketInventoryConfigurationsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListBucketInventoryConfigurations_Call) Return(_a0 *s3.ListBucketInventoryConfigurationsOutput, _a1 error) *S3_ListBucketInventoryConfigurations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListBucketInventoryConfigurations_Call) RunAndReturn(run func(context.Context, *s3.ListBucketInventoryConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)) *S3_ListBucketInventoryConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBucketMetricsConfigurations provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListBucketMetricsConfigurations(ctx context.Context, params *s3.ListBucketMetricsConfigurationsInput, optFns ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBucketMetricsConfigurations")
+	}
+
+	var r0 *s3.ListBucketMetricsConfigurationsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketMetricsConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketMetricsConfigurationsInput, ...func(*s3.Options)) *s3.ListBucketMetricsConfigurationsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListBucketMetricsConfigurationsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListBucketMetricsConfigurationsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListBucketMetricsConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBucketMetricsConfigurations'
+type S3_ListBucketMetricsConfigurations_Call struct {
+	*mock.Call
+}
+
+// ListBucketMetricsConfigurations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListBucketMetricsConfigurationsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListBucketMetricsConfigurations(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListBucketMetricsConfigurations_Call {
+	return &S3_ListBucketMetricsConfigurations_Call{Call: _e.mock.On("ListBucketMetricsConfigurations",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListBucketMetricsConfigurations_Call) Run(run func(ctx context.Context, params *s3.ListBucketMetricsConfigurationsInput, optFns ...func(*s3.Options))) *S3_ListBucketMetricsConfigurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListBucketMetricsConfigurationsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListBucketMetricsConfigurations_Call) Return(_a0 *s3.ListBucketMetricsConfigurationsOutput, _a1 error) *S3_ListBucketMetricsConfigurations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListBucketMetricsConfigurations_Call) RunAndReturn(run func(context.Context, *s3.ListBucketMetricsConfigurationsInput, ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)) *S3_ListBucketMetricsConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBuckets provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBuckets")
+	}
+
+	var r0 *s3.ListBucketsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketsInput, ...func(*s3.Options)) (*s3.ListBucketsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListBucketsInput, ...func(*s3.Options)) *s3.ListBucketsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListBucketsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListBucketsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListBuckets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBuckets'
+type S3_ListBuckets_Call struct {
+	*mock.Call
+}
+
+// ListBuckets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListBucketsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListBuckets(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListBuckets_Call {
+	return &S3_ListBuckets_Call{Call: _e.mock.On("ListBuckets",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListBuckets_Call) Run(run func(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options))) *S3_ListBuckets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListBucketsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListBuckets_Call) Return(_a0 *s3.ListBucketsOutput, _a1 error) *S3_ListBuckets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListBuckets_Call) RunAndReturn(run func(context.Context, *s3.ListBucketsInput, ...func(*s3.Options)) (*s3.ListBucketsOutput, error)) *S3_ListBuckets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDirectoryBuckets provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListDirectoryBuckets(ctx context.Context, params *s3.ListDirectoryBucketsInput, optFns ...func(*s3.Options)) (*s3.ListDirectoryBucketsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDirectoryBuckets")
+	}
+
+	var r0 *s3.ListDirectoryBucketsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListDirectoryBucketsInput, ...func(*s3.Options)) (*s3.ListDirectoryBucketsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListDirectoryBucketsInput, ...func(*s3.Options)) *s3.ListDirectoryBucketsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListDirectoryBucketsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListDirectoryBucketsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListDirectoryBuckets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDirectoryBuckets'
+type S3_ListDirectoryBuckets_Call struct {
+	*mock.Call
+}
+
+// ListDirectoryBuckets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListDirectoryBucketsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListDirectoryBuckets(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListDirectoryBuckets_Call {
+	return &S3_ListDirectoryBuckets_Call{Call: _e.mock.On("ListDirectoryBuckets",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListDirectoryBuckets_Call) Run(run func(ctx context.Context, params *s3.ListDirectoryBucketsInput, optFns ...func(*s3.Options))) *S3_ListDirectoryBuckets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListDirectoryBucketsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListDirectoryBuckets_Call) Return(_a0 *s3.ListDirectoryBucketsOutput, _a1 error) *S3_ListDirectoryBuckets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListDirectoryBuckets_Call) RunAndReturn(run func(context.Context, *s3.ListDirectoryBucketsInput, ...func(*s3.Options)) (*s3.ListDirectoryBucketsOutput, error)) *S3_ListDirectoryBuckets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMultipartUploads provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListMultipartUploads(ctx context.Context, params *s3.ListMultipartUploadsInput, optFns ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMultipartUploads")
+	}
+
+	var r0 *s3.ListMultipartUploadsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListMultipartUploadsInput, ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListMultipartUploadsInput, ...func(*s3.Options)) *s3.ListMultipartUploadsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListMultipartUploadsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListMultipartUploadsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListMultipartUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMultipartUploads'
+type S3_ListMultipartUploads_Call struct {
+	*mock.Call
+}
+
+// ListMultipartUploads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListMultipartUploadsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListMultipartUploads(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListMultipartUploads_Call {
+	return &S3_ListMultipartUploads_Call{Call: _e.mock.On("ListMultipartUploads",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListMultipartUploads_Call) Run(run func(ctx context.Context, params *s3.ListMultipartUploadsInput, optFns ...func(*s3.Options))) *S3_ListMultipartUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListMultipartUploadsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListMultipartUploads_Call) Return(_a0 *s3.ListMultipartUploadsOutput, _a1 error) *S3_ListMultipartUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListMultipartUploads_Call) RunAndReturn(run func(context.Context, *s3.ListMultipartUploadsInput, ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error)) *S3_ListMultipartUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListObjectVersions provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListObjectVersions(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObjectVersions")
+	}
+
+	var r0 *s3.ListObjectVersionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListObjectVersionsInput, ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListObjectVersionsInput, ...func(*s3.Options)) *s3.ListObjectVersionsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListObjectVersionsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListObjectVersionsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListObjectVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObjectVersions'
+type S3_ListObjectVersions_Call struct {
+	*mock.Call
+}
+
+// ListObjectVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListObjectVersionsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListObjectVersions(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListObjectVersions_Call {
+	return &S3_ListObjectVersions_Call{Call: _e.mock.On("ListObjectVersions",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListObjectVersions_Call) Run(run func(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options))) *S3_ListObjectVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListObjectVersionsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListObjectVersions_Call) Return(_a0 *s3.ListObjectVersionsOutput, _a1 error) *S3_ListObjectVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListObjectVersions_Call) RunAndReturn(run func(context.Context, *s3.ListObjectVersionsInput, ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)) *S3_ListObjectVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListObjects provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListObjects(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObjects")
+	}
+
+	var r0 *s3.ListObjectsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListObjectsInput, ...func(*s3.Options)) (*s3.ListObjectsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListObjectsInput, ...func(*s3.Options)) *s3.ListObjectsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListObjectsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListObjectsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObjects'
+type S3_ListObjects_Call struct {
+	*mock.Call
+}
+
+// ListObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListObjectsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListObjects(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListObjects_Call {
+	return &S3_ListObjects_Call{Call: _e.mock.On("ListObjects",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListObjects_Call) Run(run func(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options))) *S3_ListObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListObjectsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListObjects_Call) Return(_a0 *s3.ListObjectsOutput, _a1 error) *S3_ListObjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListObjects_Call) RunAndReturn(run func(context.Context, *s3.ListObjectsInput, ...func(*s3.Options)) (*s3.ListObjectsOutput, error)) *S3_ListObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListObjectsV2 provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObjectsV2")
+	}
+
+	var r0 *s3.ListObjectsV2Output
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListObjectsV2Input, ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListObjectsV2Input, ...func(*s3.Options)) *s3.ListObjectsV2Output); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListObjectsV2Output)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListObjectsV2Input, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListObjectsV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObjectsV2'
+type S3_ListObjectsV2_Call struct {
+	*mock.Call
+}
+
+// ListObjectsV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListObjectsV2Input
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListObjectsV2(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListObjectsV2_Call {
+	return &S3_ListObjectsV2_Call{Call: _e.mock.On("ListObjectsV2",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListObjectsV2_Call) Run(run func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options))) *S3_ListObjectsV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListObjectsV2Input), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListObjectsV2_Call) Return(_a0 *s3.ListObjectsV2Output, _a1 error) *S3_ListObjectsV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListObjectsV2_Call) RunAndReturn(run func(context.Context, *s3.ListObjectsV2Input, ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)) *S3_ListObjectsV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParts provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) ListParts(ctx context.Context, params *s3.ListPartsInput, optFns ...func(*s3.Options)) (*s3.ListPartsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParts")
+	}
+
+	var r0 *s3.ListPartsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListPartsInput, ...func(*s3.Options)) (*s3.ListPartsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.ListPartsInput, ...func(*s3.Options)) *s3.ListPartsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.ListPartsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.ListPartsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
+type S3_ListParts_Call struct {
+	*mock.Call
+}
+
+// ListParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.ListPartsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) ListParts(ctx interface{}, params interface{}, optFns ...interface{}) *S3_ListParts_Call {
+	return &S3_ListParts_Call{Call: _e.mock.On("ListParts",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_ListParts_Call) Run(run func(ctx context.Context, params *s3.ListPartsInput, optFns ...func(*s3.Options))) *S3_ListParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.ListPartsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_ListParts_Call) Return(_a0 *s3.ListPartsOutput, _a1 error) *S3_ListParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_ListParts_Call) RunAndReturn(run func(context.Context, *s3.ListPartsInput, ...func(*s3.Options)) (*s3.ListPartsOutput, error)) *S3_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Options provides a mock function with no fields
+func (_m *S3) Options() s3.Options {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Options")
+	}
+
+	var r0 s3.Options
+	if rf, ok := ret.Get(0).(func() s3.Options); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(s3.Options)
+	}
+
+	return r0
+}
+
+// S3_Options_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Options'
+type S3_Options_Call struct {
+	*mock.Call
+}
+
+// Options is a helper method to define mock.On call
+func (_e *S3_Expecter) Options() *S3_Options_Call {
+	return &S3_Options_Call{Call: _e.mock.On("Options")}
+}
+
+func (_c *S3_Options_Call) Run(run func()) *S3_Options_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *S3_Options_Call) Return(_a0 s3.Options) *S3_Options_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *S3_Options_Call) RunAndReturn(run func() s3.Options) *S3_Options_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketAbac provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketAbac(ctx context.Context, params *s3.PutBucketAbacInput, optFns ...func(*s3.Options)) (*s3.PutBucketAbacOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketAbac")
+	}
+
+	var r0 *s3.PutBucketAbacOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAbacInput, ...func(*s3.Options)) (*s3.PutBucketAbacOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAbacInput, ...func(*s3.Options)) *s3.PutBucketAbacOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketAbacOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketAbacInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketAbac_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketAbac'
+type S3_PutBucketAbac_Call struct {
+	*mock.Call
+}
+
+// PutBucketAbac is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketAbacInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketAbac(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketAbac_Call {
+	return &S3_PutBucketAbac_Call{Call: _e.mock.On("PutBucketAbac",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketAbac_Call) Run(run func(ctx context.Context, params *s3.PutBucketAbacInput, optFns ...func(*s3.Options))) *S3_PutBucketAbac_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketAbacInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketAbac_Call) Return(_a0 *s3.PutBucketAbacOutput, _a1 error) *S3_PutBucketAbac_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketAbac_Call) RunAndReturn(run func(context.Context, *s3.PutBucketAbacInput, ...func(*s3.Options)) (*s3.PutBucketAbacOutput, error)) *S3_PutBucketAbac_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketAccelerateConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketAccelerateConfiguration(ctx context.Context, params *s3.PutBucketAccelerateConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketAccelerateConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketAccelerateConfiguration")
+	}
+
+	var r0 *s3.PutBucketAccelerateConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAccelerateConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketAccelerateConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAccelerateConfigurationInput, ...func(*s3.Options)) *s3.PutBucketAccelerateConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketAccelerateConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketAccelerateConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketAccelerateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketAccelerateConfiguration'
+type S3_PutBucketAccelerateConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutBucketAccelerateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketAccelerateConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketAccelerateConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketAccelerateConfiguration_Call {
+	return &S3_PutBucketAccelerateConfiguration_Call{Call: _e.mock.On("PutBucketAccelerateConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketAccelerateConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutBucketAccelerateConfigurationInput, optFns ...func(*s3.Options))) *S3_PutBucketAccelerateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketAccelerateConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketAccelerateConfiguration_Call) Return(_a0 *s3.PutBucketAccelerateConfigurationOutput, _a1 error) *S3_PutBucketAccelerateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketAccelerateConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutBucketAccelerateConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketAccelerateConfigurationOutput, error)) *S3_PutBucketAccelerateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketAcl provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketAcl(ctx context.Context, params *s3.PutBucketAclInput, optFns ...func(*s3.Options)) (*s3.PutBucketAclOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketAcl")
+	}
+
+	var r0 *s3.PutBucketAclOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAclInput, ...func(*s3.Options)) (*s3.PutBucketAclOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAclInput, ...func(*s3.Options)) *s3.PutBucketAclOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketAclOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketAclInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketAcl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketAcl'
+type S3_PutBucketAcl_Call struct {
+	*mock.Call
+}
+
+// PutBucketAcl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketAclInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketAcl(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketAcl_Call {
+	return &S3_PutBucketAcl_Call{Call: _e.mock.On("PutBucketAcl",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketAcl_Call) Run(run func(ctx context.Context, params *s3.PutBucketAclInput, optFns ...func(*s3.Options))) *S3_PutBucketAcl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketAclInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketAcl_Call) Return(_a0 *s3.PutBucketAclOutput, _a1 error) *S3_PutBucketAcl_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketAcl_Call) RunAndReturn(run func(context.Context, *s3.PutBucketAclInput, ...func(*s3.Options)) (*s3.PutBucketAclOutput, error)) *S3_PutBucketAcl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketAnalyticsConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketAnalyticsConfiguration(ctx context.Context, params *s3.PutBucketAnalyticsConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketAnalyticsConfiguration")
+	}
+
+	var r0 *s3.PutBucketAnalyticsConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAnalyticsConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketAnalyticsConfigurationInput, ...func(*s3.Options)) *s3.PutBucketAnalyticsConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketAnalyticsConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketAnalyticsConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketAnalyticsConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketAnalyticsConfiguration'
+type S3_PutBucketAnalyticsConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutBucketAnalyticsConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketAnalyticsConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketAnalyticsConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketAnalyticsConfiguration_Call {
+	return &S3_PutBucketAnalyticsConfiguration_Call{Call: _e.mock.On("PutBucketAnalyticsConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketAnalyticsConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutBucketAnalyticsConfigurationInput, optFns ...func(*s3.Options))) *S3_PutBucketAnalyticsConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketAnalyticsConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketAnalyticsConfiguration_Call) Return(_a0 *s3.PutBucketAnalyticsConfigurationOutput, _a1 error) *S3_PutBucketAnalyticsConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketAnalyticsConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutBucketAnalyticsConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)) *S3_PutBucketAnalyticsConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketCors provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketCors(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketCors")
+	}
+
+	var r0 *s3.PutBucketCorsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketCorsInput, ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketCorsInput, ...func(*s3.Options)) *s3.PutBucketCorsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketCorsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketCorsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketCors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketCors'
+type S3_PutBucketCors_Call struct {
+	*mock.Call
+}
+
+// PutBucketCors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketCorsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketCors(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketCors_Call {
+	return &S3_PutBucketCors_Call{Call: _e.mock.On("PutBucketCors",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketCors_Call) Run(run func(ctx context.Context, params *s3.PutBucketCorsInput, optFns ...func(*s3.Options))) *S3_PutBucketCors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketCorsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketCors_Call) Return(_a0 *s3.PutBucketCorsOutput, _a1 error) *S3_PutBucketCors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketCors_Call) RunAndReturn(run func(context.Context, *s3.PutBucketCorsInput, ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)) *S3_PutBucketCors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketEncryption provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketEncryption(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketEncryption")
+	}
+
+	var r0 *s3.PutBucketEncryptionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketEncryptionInput, ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketEncryptionInput, ...func(*s3.Options)) *s3.PutBucketEncryptionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketEncryptionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketEncryptionInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketEncryption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketEncryption'
+type S3_PutBucketEncryption_Call struct {
+	*mock.Call
+}
+
+// PutBucketEncryption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketEncryptionInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketEncryption(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketEncryption_Call {
+	return &S3_PutBucketEncryption_Call{Call: _e.mock.On("PutBucketEncryption",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketEncryption_Call) Run(run func(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options))) *S3_PutBucketEncryption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketEncryptionInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketEncryption_Call) Return(_a0 *s3.PutBucketEncryptionOutput, _a1 error) *S3_PutBucketEncryption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketEncryption_Call) RunAndReturn(run func(context.Context, *s3.PutBucketEncryptionInput, ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)) *S3_PutBucketEncryption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketIntelligentTieringConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketIntelligentTieringConfiguration(ctx context.Context, params *s3.PutBucketIntelligentTieringConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketIntelligentTieringConfiguration")
+	}
+
+	var r0 *s3.PutBucketIntelligentTieringConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) *s3.PutBucketIntelligentTieringConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketIntelligentTieringConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketIntelligentTieringConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketIntelligentTieringConfiguration'
+type S3_PutBucketIntelligentTieringConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutBucketIntelligentTieringConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketIntelligentTieringConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketIntelligentTieringConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketIntelligentTieringConfiguration_Call {
+	return &S3_PutBucketIntelligentTieringConfiguration_Call{Call: _e.mock.On("PutBucketIntelligentTieringConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketIntelligentTieringConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutBucketIntelligentTieringConfigurationInput, optFns ...func(*s3.Options))) *S3_PutBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketIntelligentTieringConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketIntelligentTieringConfiguration_Call) Return(_a0 *s3.PutBucketIntelligentTieringConfigurationOutput, _a1 error) *S3_PutBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketIntelligentTieringConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutBucketIntelligentTieringConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)) *S3_PutBucketIntelligentTieringConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketInventoryConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketInventoryConfiguration(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketInventoryConfiguration")
+	}
+
+	var r0 *s3.PutBucketInventoryConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketInventoryConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketInventoryConfigurationInput, ...func(*s3.Options)) *s3.PutBucketInventoryConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketInventoryConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketInventoryConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketInventoryConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketInventoryConfiguration'
+type S3_PutBucketInventoryConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutBucketInventoryConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketInventoryConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketInventoryConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketInventoryConfiguration_Call {
+	return &S3_PutBucketInventoryConfiguration_Call{Call: _e.mock.On("PutBucketInventoryConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketInventoryConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutBucketInventoryConfigurationInput, optFns ...func(*s3.Options))) *S3_PutBucketInventoryConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketInventoryConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketInventoryConfiguration_Call) Return(_a0 *s3.PutBucketInventoryConfigurationOutput, _a1 error) *S3_PutBucketInventoryConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketInventoryConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutBucketInventoryConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)) *S3_PutBucketInventoryConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketLifecycleConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketLifecycleConfiguration(ctx context.Context, params *s3.PutBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketLifecycleConfiguration")
+	}
+
+	var r0 *s3.PutBucketLifecycleConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...func(*s3.Options)) *s3.PutBucketLifecycleConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketLifecycleConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketLifecycleConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketLifecycleConfiguration'
+type S3_PutBucketLifecycleConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutBucketLifecycleConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketLifecycleConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketLifecycleConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketLifecycleConfiguration_Call {
+	return &S3_PutBucketLifecycleConfiguration_Call{Call: _e.mock.On("PutBucketLifecycleConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketLifecycleConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutBucketLifecycleConfigurationInput, optFns ...func(*s3.Options))) *S3_PutBucketLifecycleConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketLifecycleConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketLifecycleConfiguration_Call) Return(_a0 *s3.PutBucketLifecycleConfigurationOutput, _a1 error) *S3_PutBucketLifecycleConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketLifecycleConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)) *S3_PutBucketLifecycleConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketLogging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketLogging(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketLogging")
+	}
+
+	var r0 *s3.PutBucketLoggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketLoggingInput, ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketLoggingInput, ...func(*s3.Options)) *s3.PutBucketLoggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketLoggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketLoggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketLogging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketLogging'
+type S3_PutBucketLogging_Call struct {
+	*mock.Call
+}
+
+// PutBucketLogging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketLoggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketLogging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketLogging_Call {
+	return &S3_PutBucketLogging_Call{Call: _e.mock.On("PutBucketLogging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketLogging_Call) Run(run func(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options))) *S3_PutBucketLogging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketLoggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketLogging_Call) Return(_a0 *s3.PutBucketLoggingOutput, _a1 error) *S3_PutBucketLogging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketLogging_Call) RunAndReturn(run func(context.Context, *s3.PutBucketLoggingInput, ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)) *S3_PutBucketLogging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketMetricsConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketMetricsConfiguration(ctx context.Context, params *s3.PutBucketMetricsConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketMetricsConfiguration")
+	}
+
+	var r0 *s3.PutBucketMetricsConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketMetricsConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketMetricsConfigurationInput, ...func(*s3.Options)) *s3.PutBucketMetricsConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketMetricsConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketMetricsConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketMetricsConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketMetricsConfiguration'
+type S3_PutBucketMetricsConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutBucketMetricsConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketMetricsConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketMetricsConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketMetricsConfiguration_Call {
+	return &S3_PutBucketMetricsConfiguration_Call{Call: _e.mock.On("PutBucketMetricsConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketMetricsConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutBucketMetricsConfigurationInput, optFns ...func(*s3.Options))) *S3_PutBucketMetricsConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketMetricsConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketMetricsConfiguration_Call) Return(_a0 *s3.PutBucketMetricsConfigurationOutput, _a1 error) *S3_PutBucketMetricsConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketMetricsConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutBucketMetricsConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)) *S3_PutBucketMetricsConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketNotificationConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketNotificationConfiguration(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketNotificationConfiguration")
+	}
+
+	var r0 *s3.PutBucketNotificationConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...func(*s3.Options)) *s3.PutBucketNotificationConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketNotificationConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketNotificationConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketNotificationConfiguration'
+type S3_PutBucketNotificationConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutBucketNotificationConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketNotificationConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketNotificationConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketNotificationConfiguration_Call {
+	return &S3_PutBucketNotificationConfiguration_Call{Call: _e.mock.On("PutBucketNotificationConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketNotificationConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options))) *S3_PutBucketNotificationConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketNotificationConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketNotificationConfiguration_Call) Return(_a0 *s3.PutBucketNotificationConfigurationOutput, _a1 error) *S3_PutBucketNotificationConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketNotificationConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)) *S3_PutBucketNotificationConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketOwnershipControls provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketOwnershipControls(ctx context.Context, params *s3.PutBucketOwnershipControlsInput, optFns ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketOwnershipControls")
+	}
+
+	var r0 *s3.PutBucketOwnershipControlsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketOwnershipControlsInput, ...func(*s3.Options)) *s3.PutBucketOwnershipControlsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketOwnershipControlsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketOwnershipControlsInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketOwnershipControls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketOwnershipControls'
+type S3_PutBucketOwnershipControls_Call struct {
+	*mock.Call
+}
+
+// PutBucketOwnershipControls is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketOwnershipControlsInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketOwnershipControls(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketOwnershipControls_Call {
+	return &S3_PutBucketOwnershipControls_Call{Call: _e.mock.On("PutBucketOwnershipControls",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketOwnershipControls_Call) Run(run func(ctx context.Context, params *s3.PutBucketOwnershipControlsInput, optFns ...func(*s3.Options))) *S3_PutBucketOwnershipControls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketOwnershipControlsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketOwnershipControls_Call) Return(_a0 *s3.PutBucketOwnershipControlsOutput, _a1 error) *S3_PutBucketOwnershipControls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketOwnershipControls_Call) RunAndReturn(run func(context.Context, *s3.PutBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)) *S3_PutBucketOwnershipControls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketPolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketPolicy(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketPolicy")
+	}
+
+	var r0 *s3.PutBucketPolicyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketPolicyInput, ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketPolicyInput, ...func(*s3.Options)) *s3.PutBucketPolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketPolicyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketPolicyInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketPolicy'
+type S3_PutBucketPolicy_Call struct {
+	*mock.Call
+}
+
+// PutBucketPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketPolicyInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketPolicy(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketPolicy_Call {
+	return &S3_PutBucketPolicy_Call{Call: _e.mock.On("PutBucketPolicy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketPolicy_Call) Run(run func(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options))) *S3_PutBucketPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketPolicyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketPolicy_Call) Return(_a0 *s3.PutBucketPolicyOutput, _a1 error) *S3_PutBucketPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketPolicy_Call) RunAndReturn(run func(context.Context, *s3.PutBucketPolicyInput, ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)) *S3_PutBucketPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketReplication provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketReplication(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketReplication")
+	}
+
+	var r0 *s3.PutBucketReplicationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketReplicationInput, ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketReplicationInput, ...func(*s3.Options)) *s3.PutBucketReplicationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketReplicationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketReplicationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketReplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketReplication'
+type S3_PutBucketReplication_Call struct {
+	*mock.Call
+}
+
+// PutBucketReplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketReplicationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketReplication(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketReplication_Call {
+	return &S3_PutBucketReplication_Call{Call: _e.mock.On("PutBucketReplication",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketReplication_Call) Run(run func(ctx context.Context, params *s3.PutBucketReplicationInput, optFns ...func(*s3.Options))) *S3_PutBucketReplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketReplicationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketReplication_Call) Return(_a0 *s3.PutBucketReplicationOutput, _a1 error) *S3_PutBucketReplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketReplication_Call) RunAndReturn(run func(context.Context, *s3.PutBucketReplicationInput, ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error)) *S3_PutBucketReplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketRequestPayment provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketRequestPayment(ctx context.Context, params *s3.PutBucketRequestPaymentInput, optFns ...func(*s3.Options)) (*s3.PutBucketRequestPaymentOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketRequestPayment")
+	}
+
+	var r0 *s3.PutBucketRequestPaymentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketRequestPaymentInput, ...func(*s3.Options)) (*s3.PutBucketRequestPaymentOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketRequestPaymentInput, ...func(*s3.Options)) *s3.PutBucketRequestPaymentOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketRequestPaymentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketRequestPaymentInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketRequestPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketRequestPayment'
+type S3_PutBucketRequestPayment_Call struct {
+	*mock.Call
+}
+
+// PutBucketRequestPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketRequestPaymentInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketRequestPayment(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketRequestPayment_Call {
+	return &S3_PutBucketRequestPayment_Call{Call: _e.mock.On("PutBucketRequestPayment",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketRequestPayment_Call) Run(run func(ctx context.Context, params *s3.PutBucketRequestPaymentInput, optFns ...func(*s3.Options))) *S3_PutBucketRequestPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketRequestPaymentInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketRequestPayment_Call) Return(_a0 *s3.PutBucketRequestPaymentOutput, _a1 error) *S3_PutBucketRequestPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketRequestPayment_Call) RunAndReturn(run func(context.Context, *s3.PutBucketRequestPaymentInput, ...func(*s3.Options)) (*s3.PutBucketRequestPaymentOutput, error)) *S3_PutBucketRequestPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketTagging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketTagging(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketTagging")
+	}
+
+	var r0 *s3.PutBucketTaggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketTaggingInput, ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketTaggingInput, ...func(*s3.Options)) *s3.PutBucketTaggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketTaggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketTaggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketTagging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketTagging'
+type S3_PutBucketTagging_Call struct {
+	*mock.Call
+}
+
+// PutBucketTagging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketTaggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketTagging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketTagging_Call {
+	return &S3_PutBucketTagging_Call{Call: _e.mock.On("PutBucketTagging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketTagging_Call) Run(run func(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options))) *S3_PutBucketTagging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketTaggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketTagging_Call) Return(_a0 *s3.PutBucketTaggingOutput, _a1 error) *S3_PutBucketTagging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketTagging_Call) RunAndReturn(run func(context.Context, *s3.PutBucketTaggingInput, ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)) *S3_PutBucketTagging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketVersioning provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketVersioning(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketVersioning")
+	}
+
+	var r0 *s3.PutBucketVersioningOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketVersioningInput, ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketVersioningInput, ...func(*s3.Options)) *s3.PutBucketVersioningOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketVersioningOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketVersioningInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketVersioning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketVersioning'
+type S3_PutBucketVersioning_Call struct {
+	*mock.Call
+}
+
+// PutBucketVersioning is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketVersioningInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketVersioning(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketVersioning_Call {
+	return &S3_PutBucketVersioning_Call{Call: _e.mock.On("PutBucketVersioning",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketVersioning_Call) Run(run func(ctx context.Context, params *s3.PutBucketVersioningInput, optFns ...func(*s3.Options))) *S3_PutBucketVersioning_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketVersioningInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketVersioning_Call) Return(_a0 *s3.PutBucketVersioningOutput, _a1 error) *S3_PutBucketVersioning_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketVersioning_Call) RunAndReturn(run func(context.Context, *s3.PutBucketVersioningInput, ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error)) *S3_PutBucketVersioning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutBucketWebsite provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutBucketWebsite(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBucketWebsite")
+	}
+
+	var r0 *s3.PutBucketWebsiteOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketWebsiteInput, ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutBucketWebsiteInput, ...func(*s3.Options)) *s3.PutBucketWebsiteOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutBucketWebsiteOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutBucketWebsiteInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutBucketWebsite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutBucketWebsite'
+type S3_PutBucketWebsite_Call struct {
+	*mock.Call
+}
+
+// PutBucketWebsite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutBucketWebsiteInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutBucketWebsite(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutBucketWebsite_Call {
+	return &S3_PutBucketWebsite_Call{Call: _e.mock.On("PutBucketWebsite",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutBucketWebsite_Call) Run(run func(ctx context.Context, params *s3.PutBucketWebsiteInput, optFns ...func(*s3.Options))) *S3_PutBucketWebsite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutBucketWebsiteInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutBucketWebsite_Call) Return(_a0 *s3.PutBucketWebsiteOutput, _a1 error) *S3_PutBucketWebsite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutBucketWebsite_Call) RunAndReturn(run func(context.Context, *s3.PutBucketWebsiteInput, ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error)) *S3_PutBucketWebsite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutObject provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutObject")
+	}
+
+	var r0 *s3.PutObjectOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) (*s3.PutObjectOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) *s3.PutObjectOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutObjectOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutObject'
+type S3_PutObject_Call struct {
+	*mock.Call
+}
+
+// PutObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutObjectInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutObject(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutObject_Call {
+	return &S3_PutObject_Call{Call: _e.mock.On("PutObject",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutObject_Call) Run(run func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options))) *S3_PutObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutObjectInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutObject_Call) Return(_a0 *s3.PutObjectOutput, _a1 error) *S3_PutObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutObject_Call) RunAndReturn(run func(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) (*s3.PutObjectOutput, error)) *S3_PutObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutObjectAcl provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutObjectAcl(ctx context.Context, params *s3.PutObjectAclInput, optFns ...func(*s3.Options)) (*s3.PutObjectAclOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutObjectAcl")
+	}
+
+	var r0 *s3.PutObjectAclOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectAclInput, ...func(*s3.Options)) (*s3.PutObjectAclOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectAclInput, ...func(*s3.Options)) *s3.PutObjectAclOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutObjectAclOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutObjectAclInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutObjectAcl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutObjectAcl'
+type S3_PutObjectAcl_Call struct {
+	*mock.Call
+}
+
+// PutObjectAcl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutObjectAclInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutObjectAcl(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutObjectAcl_Call {
+	return &S3_PutObjectAcl_Call{Call: _e.mock.On("PutObjectAcl",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutObjectAcl_Call) Run(run func(ctx context.Context, params *s3.PutObjectAclInput, optFns ...func(*s3.Options))) *S3_PutObjectAcl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutObjectAclInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutObjectAcl_Call) Return(_a0 *s3.PutObjectAclOutput, _a1 error) *S3_PutObjectAcl_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutObjectAcl_Call) RunAndReturn(run func(context.Context, *s3.PutObjectAclInput, ...func(*s3.Options)) (*s3.PutObjectAclOutput, error)) *S3_PutObjectAcl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutObjectLegalHold provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutObjectLegalHold(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutObjectLegalHold")
+	}
+
+	var r0 *s3.PutObjectLegalHoldOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectLegalHoldInput, ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectLegalHoldInput, ...func(*s3.Options)) *s3.PutObjectLegalHoldOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutObjectLegalHoldOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutObjectLegalHoldInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutObjectLegalHold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutObjectLegalHold'
+type S3_PutObjectLegalHold_Call struct {
+	*mock.Call
+}
+
+// PutObjectLegalHold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutObjectLegalHoldInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutObjectLegalHold(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutObjectLegalHold_Call {
+	return &S3_PutObjectLegalHold_Call{Call: _e.mock.On("PutObjectLegalHold",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutObjectLegalHold_Call) Run(run func(ctx context.Context, params *s3.PutObjectLegalHoldInput, optFns ...func(*s3.Options))) *S3_PutObjectLegalHold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutObjectLegalHoldInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutObjectLegalHold_Call) Return(_a0 *s3.PutObjectLegalHoldOutput, _a1 error) *S3_PutObjectLegalHold_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutObjectLegalHold_Call) RunAndReturn(run func(context.Context, *s3.PutObjectLegalHoldInput, ...func(*s3.Options)) (*s3.PutObjectLegalHoldOutput, error)) *S3_PutObjectLegalHold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutObjectLockConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutObjectLockConfiguration(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutObjectLockConfiguration")
+	}
+
+	var r0 *s3.PutObjectLockConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectLockConfigurationInput, ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectLockConfigurationInput, ...func(*s3.Options)) *s3.PutObjectLockConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutObjectLockConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutObjectLockConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutObjectLockConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutObjectLockConfiguration'
+type S3_PutObjectLockConfiguration_Call struct {
+	*mock.Call
+}
+
+// PutObjectLockConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutObjectLockConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutObjectLockConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutObjectLockConfiguration_Call {
+	return &S3_PutObjectLockConfiguration_Call{Call: _e.mock.On("PutObjectLockConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutObjectLockConfiguration_Call) Run(run func(ctx context.Context, params *s3.PutObjectLockConfigurationInput, optFns ...func(*s3.Options))) *S3_PutObjectLockConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutObjectLockConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutObjectLockConfiguration_Call) Return(_a0 *s3.PutObjectLockConfigurationOutput, _a1 error) *S3_PutObjectLockConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutObjectLockConfiguration_Call) RunAndReturn(run func(context.Context, *s3.PutObjectLockConfigurationInput, ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)) *S3_PutObjectLockConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutObjectRetention provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutObjectRetention(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutObjectRetention")
+	}
+
+	var r0 *s3.PutObjectRetentionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectRetentionInput, ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectRetentionInput, ...func(*s3.Options)) *s3.PutObjectRetentionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutObjectRetentionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutObjectRetentionInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutObjectRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutObjectRetention'
+type S3_PutObjectRetention_Call struct {
+	*mock.Call
+}
+
+// PutObjectRetention is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutObjectRetentionInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutObjectRetention(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutObjectRetention_Call {
+	return &S3_PutObjectRetention_Call{Call: _e.mock.On("PutObjectRetention",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutObjectRetention_Call) Run(run func(ctx context.Context, params *s3.PutObjectRetentionInput, optFns ...func(*s3.Options))) *S3_PutObjectRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutObjectRetentionInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutObjectRetention_Call) Return(_a0 *s3.PutObjectRetentionOutput, _a1 error) *S3_PutObjectRetention_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutObjectRetention_Call) RunAndReturn(run func(context.Context, *s3.PutObjectRetentionInput, ...func(*s3.Options)) (*s3.PutObjectRetentionOutput, error)) *S3_PutObjectRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutObjectTagging provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutObjectTagging(ctx context.Context, params *s3.PutObjectTaggingInput, optFns ...func(*s3.Options)) (*s3.PutObjectTaggingOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutObjectTagging")
+	}
+
+	var r0 *s3.PutObjectTaggingOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectTaggingInput, ...func(*s3.Options)) (*s3.PutObjectTaggingOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutObjectTaggingInput, ...func(*s3.Options)) *s3.PutObjectTaggingOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutObjectTaggingOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutObjectTaggingInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutObjectTagging_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutObjectTagging'
+type S3_PutObjectTagging_Call struct {
+	*mock.Call
+}
+
+// PutObjectTagging is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutObjectTaggingInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutObjectTagging(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutObjectTagging_Call {
+	return &S3_PutObjectTagging_Call{Call: _e.mock.On("PutObjectTagging",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutObjectTagging_Call) Run(run func(ctx context.Context, params *s3.PutObjectTaggingInput, optFns ...func(*s3.Options))) *S3_PutObjectTagging_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutObjectTaggingInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutObjectTagging_Call) Return(_a0 *s3.PutObjectTaggingOutput, _a1 error) *S3_PutObjectTagging_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutObjectTagging_Call) RunAndReturn(run func(context.Context, *s3.PutObjectTaggingInput, ...func(*s3.Options)) (*s3.PutObjectTaggingOutput, error)) *S3_PutObjectTagging_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutPublicAccessBlock provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) PutPublicAccessBlock(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutPublicAccessBlock")
+	}
+
+	var r0 *s3.PutPublicAccessBlockOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutPublicAccessBlockInput, ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.PutPublicAccessBlockInput, ...func(*s3.Options)) *s3.PutPublicAccessBlockOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.PutPublicAccessBlockOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.PutPublicAccessBlockInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_PutPublicAccessBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutPublicAccessBlock'
+type S3_PutPublicAccessBlock_Call struct {
+	*mock.Call
+}
+
+// PutPublicAccessBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.PutPublicAccessBlockInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) PutPublicAccessBlock(ctx interface{}, params interface{}, optFns ...interface{}) *S3_PutPublicAccessBlock_Call {
+	return &S3_PutPublicAccessBlock_Call{Call: _e.mock.On("PutPublicAccessBlock",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_PutPublicAccessBlock_Call) Run(run func(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options))) *S3_PutPublicAccessBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.PutPublicAccessBlockInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_PutPublicAccessBlock_Call) Return(_a0 *s3.PutPublicAccessBlockOutput, _a1 error) *S3_PutPublicAccessBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_PutPublicAccessBlock_Call) RunAndReturn(run func(context.Context, *s3.PutPublicAccessBlockInput, ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)) *S3_PutPublicAccessBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameObject provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) RenameObject(ctx context.Context, params *s3.RenameObjectInput, optFns ...func(*s3.Options)) (*s3.RenameObjectOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameObject")
+	}
+
+	var r0 *s3.RenameObjectOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.RenameObjectInput, ...func(*s3.Options)) (*s3.RenameObjectOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.RenameObjectInput, ...func(*s3.Options)) *s3.RenameObjectOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.RenameObjectOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.RenameObjectInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_RenameObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameObject'
+type S3_RenameObject_Call struct {
+	*mock.Call
+}
+
+// RenameObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.RenameObjectInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) RenameObject(ctx interface{}, params interface{}, optFns ...interface{}) *S3_RenameObject_Call {
+	return &S3_RenameObject_Call{Call: _e.mock.On("RenameObject",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_RenameObject_Call) Run(run func(ctx context.Context, params *s3.RenameObjectInput, optFns ...func(*s3.Options))) *S3_RenameObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.RenameObjectInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_RenameObject_Call) Return(_a0 *s3.RenameObjectOutput, _a1 error) *S3_RenameObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_RenameObject_Call) RunAndReturn(run func(context.Context, *s3.RenameObjectInput, ...func(*s3.Options)) (*s3.RenameObjectOutput, error)) *S3_RenameObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreObject provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) RestoreObject(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options)) (*s3.RestoreObjectOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreObject")
+	}
+
+	var r0 *s3.RestoreObjectOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.RestoreObjectInput, ...func(*s3.Options)) (*s3.RestoreObjectOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.RestoreObjectInput, ...func(*s3.Options)) *s3.RestoreObjectOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.RestoreObjectOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.RestoreObjectInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_RestoreObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreObject'
+type S3_RestoreObject_Call struct {
+	*mock.Call
+}
+
+// RestoreObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.RestoreObjectInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) RestoreObject(ctx interface{}, params interface{}, optFns ...interface{}) *S3_RestoreObject_Call {
+	return &S3_RestoreObject_Call{Call: _e.mock.On("RestoreObject",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_RestoreObject_Call) Run(run func(ctx context.Context, params *s3.RestoreObjectInput, optFns ...func(*s3.Options))) *S3_RestoreObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.RestoreObjectInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_RestoreObject_Call) Return(_a0 *s3.RestoreObjectOutput, _a1 error) *S3_RestoreObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_RestoreObject_Call) RunAndReturn(run func(context.Context, *s3.RestoreObjectInput, ...func(*s3.Options)) (*s3.RestoreObjectOutput, error)) *S3_RestoreObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectObjectContent provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) SelectObjectContent(ctx context.Context, params *s3.SelectObjectContentInput, optFns ...func(*s3.Options)) (*s3.SelectObjectContentOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectObjectContent")
+	}
+
+	var r0 *s3.SelectObjectContentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.SelectObjectContentInput, ...func(*s3.Options)) (*s3.SelectObjectContentOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.SelectObjectContentInput, ...func(*s3.Options)) *s3.SelectObjectContentOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.SelectObjectContentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.SelectObjectContentInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_SelectObjectContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectObjectContent'
+type S3_SelectObjectContent_Call struct {
+	*mock.Call
+}
+
+// SelectObjectContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.SelectObjectContentInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) SelectObjectContent(ctx interface{}, params interface{}, optFns ...interface{}) *S3_SelectObjectContent_Call {
+	return &S3_SelectObjectContent_Call{Call: _e.mock.On("SelectObjectContent",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_SelectObjectContent_Call) Run(run func(ctx context.Context, params *s3.SelectObjectContentInput, optFns ...func(*s3.Options))) *S3_SelectObjectContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.SelectObjectContentInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_SelectObjectContent_Call) Return(_a0 *s3.SelectObjectContentOutput, _a1 error) *S3_SelectObjectContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_SelectObjectContent_Call) RunAndReturn(run func(context.Context, *s3.SelectObjectContentInput, ...func(*s3.Options)) (*s3.SelectObjectContentOutput, error)) *S3_SelectObjectContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBucketMetadataInventoryTableConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) UpdateBucketMetadataInventoryTableConfiguration(ctx context.Context, params *s3.UpdateBucketMetadataInventoryTableConfigurationInput, optFns ...func(*s3.Options)) (*s3.UpdateBucketMetadataInventoryTableConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBucketMetadataInventoryTableConfiguration")
+	}
+
+	var r0 *s3.UpdateBucketMetadataInventoryTableConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UpdateBucketMetadataInventoryTableConfigurationInput, ...func(*s3.Options)) (*s3.UpdateBucketMetadataInventoryTableConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UpdateBucketMetadataInventoryTableConfigurationInput, ...func(*s3.Options)) *s3.UpdateBucketMetadataInventoryTableConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.UpdateBucketMetadataInventoryTableConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.UpdateBucketMetadataInventoryTableConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_UpdateBucketMetadataInventoryTableConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBucketMetadataInventoryTableConfiguration'
+type S3_UpdateBucketMetadataInventoryTableConfiguration_Call struct {
+	*mock.Call
+}
+
+// UpdateBucketMetadataInventoryTableConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.UpdateBucketMetadataInventoryTableConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) UpdateBucketMetadataInventoryTableConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_UpdateBucketMetadataInventoryTableConfiguration_Call {
+	return &S3_UpdateBucketMetadataInventoryTableConfiguration_Call{Call: _e.mock.On("UpdateBucketMetadataInventoryTableConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_UpdateBucketMetadataInventoryTableConfiguration_Call) Run(run func(ctx context.Context, params *s3.UpdateBucketMetadataInventoryTableConfigurationInput, optFns ...func(*s3.Options))) *S3_UpdateBucketMetadataInventoryTableConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.UpdateBucketMetadataInventoryTableConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_UpdateBucketMetadataInventoryTableConfiguration_Call) Return(_a0 *s3.UpdateBucketMetadataInventoryTableConfigurationOutput, _a1 error) *S3_UpdateBucketMetadataInventoryTableConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_UpdateBucketMetadataInventoryTableConfiguration_Call) RunAndReturn(run func(context.Context, *s3.UpdateBucketMetadataInventoryTableConfigurationInput, ...func(*s3.Options)) (*s3.UpdateBucketMetadataInventoryTableConfigurationOutput, error)) *S3_UpdateBucketMetadataInventoryTableConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBucketMetadataJournalTableConfiguration provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) UpdateBucketMetadataJournalTableConfiguration(ctx context.Context, params *s3.UpdateBucketMetadataJournalTableConfigurationInput, optFns ...func(*s3.Options)) (*s3.UpdateBucketMetadataJournalTableConfigurationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBucketMetadataJournalTableConfiguration")
+	}
+
+	var r0 *s3.UpdateBucketMetadataJournalTableConfigurationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UpdateBucketMetadataJournalTableConfigurationInput, ...func(*s3.Options)) (*s3.UpdateBucketMetadataJournalTableConfigurationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UpdateBucketMetadataJournalTableConfigurationInput, ...func(*s3.Options)) *s3.UpdateBucketMetadataJournalTableConfigurationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.UpdateBucketMetadataJournalTableConfigurationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.UpdateBucketMetadataJournalTableConfigurationInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_UpdateBucketMetadataJournalTableConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBucketMetadataJournalTableConfiguration'
+type S3_UpdateBucketMetadataJournalTableConfiguration_Call struct {
+	*mock.Call
+}
+
+// UpdateBucketMetadataJournalTableConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.UpdateBucketMetadataJournalTableConfigurationInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) UpdateBucketMetadataJournalTableConfiguration(ctx interface{}, params interface{}, optFns ...interface{}) *S3_UpdateBucketMetadataJournalTableConfiguration_Call {
+	return &S3_UpdateBucketMetadataJournalTableConfiguration_Call{Call: _e.mock.On("UpdateBucketMetadataJournalTableConfiguration",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_UpdateBucketMetadataJournalTableConfiguration_Call) Run(run func(ctx context.Context, params *s3.UpdateBucketMetadataJournalTableConfigurationInput, optFns ...func(*s3.Options))) *S3_UpdateBucketMetadataJournalTableConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.UpdateBucketMetadataJournalTableConfigurationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_UpdateBucketMetadataJournalTableConfiguration_Call) Return(_a0 *s3.UpdateBucketMetadataJournalTableConfigurationOutput, _a1 error) *S3_UpdateBucketMetadataJournalTableConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_UpdateBucketMetadataJournalTableConfiguration_Call) RunAndReturn(run func(context.Context, *s3.UpdateBucketMetadataJournalTableConfigurationInput, ...func(*s3.Options)) (*s3.UpdateBucketMetadataJournalTableConfigurationOutput, error)) *S3_UpdateBucketMetadataJournalTableConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateObjectEncryption provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) UpdateObjectEncryption(ctx context.Context, params *s3.UpdateObjectEncryptionInput, optFns ...func(*s3.Options)) (*s3.UpdateObjectEncryptionOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateObjectEncryption")
+	}
+
+	var r0 *s3.UpdateObjectEncryptionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UpdateObjectEncryptionInput, ...func(*s3.Options)) (*s3.UpdateObjectEncryptionOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UpdateObjectEncryptionInput, ...func(*s3.Options)) *s3.UpdateObjectEncryptionOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.UpdateObjectEncryptionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.UpdateObjectEncryptionInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_UpdateObjectEncryption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateObjectEncryption'
+type S3_UpdateObjectEncryption_Call struct {
+	*mock.Call
+}
+
+// UpdateObjectEncryption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.UpdateObjectEncryptionInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) UpdateObjectEncryption(ctx interface{}, params interface{}, optFns ...interface{}) *S3_UpdateObjectEncryption_Call {
+	return &S3_UpdateObjectEncryption_Call{Call: _e.mock.On("UpdateObjectEncryption",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_UpdateObjectEncryption_Call) Run(run func(ctx context.Context, params *s3.UpdateObjectEncryptionInput, optFns ...func(*s3.Options))) *S3_UpdateObjectEncryption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.UpdateObjectEncryptionInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_UpdateObjectEncryption_Call) Return(_a0 *s3.UpdateObjectEncryptionOutput, _a1 error) *S3_UpdateObjectEncryption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_UpdateObjectEncryption_Call) RunAndReturn(run func(context.Context, *s3.UpdateObjectEncryptionInput, ...func(*s3.Options)) (*s3.UpdateObjectEncryptionOutput, error)) *S3_UpdateObjectEncryption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadPart provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadPart")
+	}
+
+	var r0 *s3.UploadPartOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) (*s3.UploadPartOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) *s3.UploadPartOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.UploadPartOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_UploadPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadPart'
+type S3_UploadPart_Call struct {
+	*mock.Call
+}
+
+// UploadPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.UploadPartInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) UploadPart(ctx interface{}, params interface{}, optFns ...interface{}) *S3_UploadPart_Call {
+	return &S3_UploadPart_Call{Call: _e.mock.On("UploadPart",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_UploadPart_Call) Run(run func(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options))) *S3_UploadPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.UploadPartInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_UploadPart_Call) Return(_a0 *s3.UploadPartOutput, _a1 error) *S3_UploadPart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_UploadPart_Call) RunAndReturn(run func(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) (*s3.UploadPartOutput, error)) *S3_UploadPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadPartCopy provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) UploadPartCopy(ctx context.Context, params *s3.UploadPartCopyInput, optFns ...func(*s3.Options)) (*s3.UploadPartCopyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadPartCopy")
+	}
+
+	var r0 *s3.UploadPartCopyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UploadPartCopyInput, ...func(*s3.Options)) (*s3.UploadPartCopyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.UploadPartCopyInput, ...func(*s3.Options)) *s3.UploadPartCopyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.UploadPartCopyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.UploadPartCopyInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_UploadPartCopy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadPartCopy'
+type S3_UploadPartCopy_Call struct {
+	*mock.Call
+}
+
+// UploadPartCopy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.UploadPartCopyInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) UploadPartCopy(ctx interface{}, params interface{}, optFns ...interface{}) *S3_UploadPartCopy_Call {
+	return &S3_UploadPartCopy_Call{Call: _e.mock.On("UploadPartCopy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_UploadPartCopy_Call) Run(run func(ctx context.Context, params *s3.UploadPartCopyInput, optFns ...func(*s3.Options))) *S3_UploadPartCopy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.UploadPartCopyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_UploadPartCopy_Call) Return(_a0 *s3.UploadPartCopyOutput, _a1 error) *S3_UploadPartCopy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_UploadPartCopy_Call) RunAndReturn(run func(context.Context, *s3.UploadPartCopyInput, ...func(*s3.Options)) (*s3.UploadPartCopyOutput, error)) *S3_UploadPartCopy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteGetObjectResponse provides a mock function with given fields: ctx, params, optFns
+func (_m *S3) WriteGetObjectResponse(ctx context.Context, params *s3.WriteGetObjectResponseInput, optFns ...func(*s3.Options)) (*s3.WriteGetObjectResponseOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteGetObjectResponse")
+	}
+
+	var r0 *s3.WriteGetObjectResponseOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.WriteGetObjectResponseInput, ...func(*s3.Options)) (*s3.WriteGetObjectResponseOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.WriteGetObjectResponseInput, ...func(*s3.Options)) *s3.WriteGetObjectResponseOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.WriteGetObjectResponseOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.WriteGetObjectResponseInput, ...func(*s3.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// S3_WriteGetObjectResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteGetObjectResponse'
+type S3_WriteGetObjectResponse_Call struct {
+	*mock.Call
+}
+
+// WriteGetObjectResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *s3.WriteGetObjectResponseInput
+//   - optFns ...func(*s3.Options)
+func (_e *S3_Expecter) WriteGetObjectResponse(ctx interface{}, params interface{}, optFns ...interface{}) *S3_WriteGetObjectResponse_Call {
+	return &S3_WriteGetObjectResponse_Call{Call: _e.mock.On("WriteGetObjectResponse",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *S3_WriteGetObjectResponse_Call) Run(run func(ctx context.Context, params *s3.WriteGetObjectResponseInput, optFns ...func(*s3.Options))) *S3_WriteGetObjectResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*s3.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*s3.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*s3.WriteGetObjectResponseInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *S3_WriteGetObjectResponse_Call) Return(_a0 *s3.WriteGetObjectResponseOutput, _a1 error) *S3_WriteGetObjectResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *S3_WriteGetObjectResponse_Call) RunAndReturn(run func(context.Context, *s3.WriteGetObjectResponseInput, ...func(*s3.Options)) (*s3.WriteGetObjectResponseOutput, error)) *S3_WriteGetObjectResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewS3 creates a new instance of S3. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewS3(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *S3 {
+	mock := &S3{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
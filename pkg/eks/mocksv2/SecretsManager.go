@@ -0,0 +1,1784 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocksv2
+
+import (
+	context "context"
+
+	secretsmanager "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SecretsManager is an autogenerated mock type for the SecretsManager type
+type SecretsManager struct {
+	mock.Mock
+}
+
+type SecretsManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretsManager) EXPECT() *SecretsManager_Expecter {
+	return &SecretsManager_Expecter{mock: &_m.Mock}
+}
+
+// BatchGetSecretValue provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) BatchGetSecretValue(ctx context.Context, params *secretsmanager.BatchGetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.BatchGetSecretValueOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGetSecretValue")
+	}
+
+	var r0 *secretsmanager.BatchGetSecretValueOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.BatchGetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.BatchGetSecretValueOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.BatchGetSecretValueInput, ...func(*secretsmanager.Options)) *secretsmanager.BatchGetSecretValueOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.BatchGetSecretValueOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.BatchGetSecretValueInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_BatchGetSecretValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchGetSecretValue'
+type SecretsManager_BatchGetSecretValue_Call struct {
+	*mock.Call
+}
+
+// BatchGetSecretValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.BatchGetSecretValueInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) BatchGetSecretValue(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_BatchGetSecretValue_Call {
+	return &SecretsManager_BatchGetSecretValue_Call{Call: _e.mock.On("BatchGetSecretValue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_BatchGetSecretValue_Call) Run(run func(ctx context.Context, params *secretsmanager.BatchGetSecretValueInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_BatchGetSecretValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.BatchGetSecretValueInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_BatchGetSecretValue_Call) Return(_a0 *secretsmanager.BatchGetSecretValueOutput, _a1 error) *SecretsManager_BatchGetSecretValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_BatchGetSecretValue_Call) RunAndReturn(run func(context.Context, *secretsmanager.BatchGetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.BatchGetSecretValueOutput, error)) *SecretsManager_BatchGetSecretValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelRotateSecret provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) CancelRotateSecret(ctx context.Context, params *secretsmanager.CancelRotateSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.CancelRotateSecretOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRotateSecret")
+	}
+
+	var r0 *secretsmanager.CancelRotateSecretOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.CancelRotateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.CancelRotateSecretOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.CancelRotateSecretInput, ...func(*secretsmanager.Options)) *secretsmanager.CancelRotateSecretOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.CancelRotateSecretOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.CancelRotateSecretInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_CancelRotateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRotateSecret'
+type SecretsManager_CancelRotateSecret_Call struct {
+	*mock.Call
+}
+
+// CancelRotateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.CancelRotateSecretInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) CancelRotateSecret(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_CancelRotateSecret_Call {
+	return &SecretsManager_CancelRotateSecret_Call{Call: _e.mock.On("CancelRotateSecret",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_CancelRotateSecret_Call) Run(run func(ctx context.Context, params *secretsmanager.CancelRotateSecretInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_CancelRotateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.CancelRotateSecretInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_CancelRotateSecret_Call) Return(_a0 *secretsmanager.CancelRotateSecretOutput, _a1 error) *SecretsManager_CancelRotateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_CancelRotateSecret_Call) RunAndReturn(run func(context.Context, *secretsmanager.CancelRotateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.CancelRotateSecretOutput, error)) *SecretsManager_CancelRotateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSecret provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) CreateSecret(ctx context.Context, params *secretsmanager.CreateSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.CreateSecretOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSecret")
+	}
+
+	var r0 *secretsmanager.CreateSecretOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.CreateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.CreateSecretOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.CreateSecretInput, ...func(*secretsmanager.Options)) *secretsmanager.CreateSecretOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.CreateSecretOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.CreateSecretInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_CreateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSecret'
+type SecretsManager_CreateSecret_Call struct {
+	*mock.Call
+}
+
+// CreateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.CreateSecretInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) CreateSecret(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_CreateSecret_Call {
+	return &SecretsManager_CreateSecret_Call{Call: _e.mock.On("CreateSecret",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_CreateSecret_Call) Run(run func(ctx context.Context, params *secretsmanager.CreateSecretInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_CreateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.CreateSecretInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_CreateSecret_Call) Return(_a0 *secretsmanager.CreateSecretOutput, _a1 error) *SecretsManager_CreateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_CreateSecret_Call) RunAndReturn(run func(context.Context, *secretsmanager.CreateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.CreateSecretOutput, error)) *SecretsManager_CreateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteResourcePolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) DeleteResourcePolicy(ctx context.Context, params *secretsmanager.DeleteResourcePolicyInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DeleteResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteResourcePolicy")
+	}
+
+	var r0 *secretsmanager.DeleteResourcePolicyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.DeleteResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.DeleteResourcePolicyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.DeleteResourcePolicyInput, ...func(*secretsmanager.Options)) *secretsmanager.DeleteResourcePolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.DeleteResourcePolicyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.DeleteResourcePolicyInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_DeleteResourcePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteResourcePolicy'
+type SecretsManager_DeleteResourcePolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteResourcePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.DeleteResourcePolicyInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) DeleteResourcePolicy(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_DeleteResourcePolicy_Call {
+	return &SecretsManager_DeleteResourcePolicy_Call{Call: _e.mock.On("DeleteResourcePolicy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_DeleteResourcePolicy_Call) Run(run func(ctx context.Context, params *secretsmanager.DeleteResourcePolicyInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_DeleteResourcePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.DeleteResourcePolicyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_DeleteResourcePolicy_Call) Return(_a0 *secretsmanager.DeleteResourcePolicyOutput, _a1 error) *SecretsManager_DeleteResourcePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_DeleteResourcePolicy_Call) RunAndReturn(run func(context.Context, *secretsmanager.DeleteResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.DeleteResourcePolicyOutput, error)) *SecretsManager_DeleteResourcePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecret provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) DeleteSecret(ctx context.Context, params *secretsmanager.DeleteSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecret")
+	}
+
+	var r0 *secretsmanager.DeleteSecretOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.DeleteSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.DeleteSecretInput, ...func(*secretsmanager.Options)) *secretsmanager.DeleteSecretOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.DeleteSecretOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.DeleteSecretInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_DeleteSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecret'
+type SecretsManager_DeleteSecret_Call struct {
+	*mock.Call
+}
+
+// DeleteSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.DeleteSecretInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) DeleteSecret(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_DeleteSecret_Call {
+	return &SecretsManager_DeleteSecret_Call{Call: _e.mock.On("DeleteSecret",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_DeleteSecret_Call) Run(run func(ctx context.Context, params *secretsmanager.DeleteSecretInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_DeleteSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.DeleteSecretInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_DeleteSecret_Call) Return(_a0 *secretsmanager.DeleteSecretOutput, _a1 error) *SecretsManager_DeleteSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_DeleteSecret_Call) RunAndReturn(run func(context.Context, *secretsmanager.DeleteSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error)) *SecretsManager_DeleteSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeSecret provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) DescribeSecret(ctx context.Context, params *secretsmanager.DescribeSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeSecret")
+	}
+
+	var r0 *secretsmanager.DescribeSecretOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.DescribeSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.DescribeSecretInput, ...func(*secretsmanager.Options)) *secretsmanager.DescribeSecretOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.DescribeSecretOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.DescribeSecretInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_DescribeSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeSecret'
+type SecretsManager_DescribeSecret_Call struct {
+	*mock.Call
+}
+
+// DescribeSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.DescribeSecretInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) DescribeSecret(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_DescribeSecret_Call {
+	return &SecretsManager_DescribeSecret_Call{Call: _e.mock.On("DescribeSecret",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_DescribeSecret_Call) Run(run func(ctx context.Context, params *secretsmanager.DescribeSecretInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_DescribeSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.DescribeSecretInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_DescribeSecret_Call) Return(_a0 *secretsmanager.DescribeSecretOutput, _a1 error) *SecretsManager_DescribeSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_DescribeSecret_Call) RunAndReturn(run func(context.Context, *secretsmanager.DescribeSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error)) *SecretsManager_DescribeSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRandomPassword provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) GetRandomPassword(ctx context.Context, params *secretsmanager.GetRandomPasswordInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetRandomPasswordOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRandomPassword")
+	}
+
+	var r0 *secretsmanager.GetRandomPasswordOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.GetRandomPasswordInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetRandomPasswordOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.GetRandomPasswordInput, ...func(*secretsmanager.Options)) *secretsmanager.GetRandomPasswordOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.GetRandomPasswordOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.GetRandomPasswordInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_GetRandomPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRandomPassword'
+type SecretsManager_GetRandomPassword_Call struct {
+	*mock.Call
+}
+
+// GetRandomPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.GetRandomPasswordInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) GetRandomPassword(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_GetRandomPassword_Call {
+	return &SecretsManager_GetRandomPassword_Call{Call: _e.mock.On("GetRandomPassword",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_GetRandomPassword_Call) Run(run func(ctx context.Context, params *secretsmanager.GetRandomPasswordInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_GetRandomPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.GetRandomPasswordInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_GetRandomPassword_Call) Return(_a0 *secretsmanager.GetRandomPasswordOutput, _a1 error) *SecretsManager_GetRandomPassword_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_GetRandomPassword_Call) RunAndReturn(run func(context.Context, *secretsmanager.GetRandomPasswordInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetRandomPasswordOutput, error)) *SecretsManager_GetRandomPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourcePolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) GetResourcePolicy(ctx context.Context, params *secretsmanager.GetResourcePolicyInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourcePolicy")
+	}
+
+	var r0 *secretsmanager.GetResourcePolicyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.GetResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetResourcePolicyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.GetResourcePolicyInput, ...func(*secretsmanager.Options)) *secretsmanager.GetResourcePolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.GetResourcePolicyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.GetResourcePolicyInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_GetResourcePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourcePolicy'
+type SecretsManager_GetResourcePolicy_Call struct {
+	*mock.Call
+}
+
+// GetResourcePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.GetResourcePolicyInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) GetResourcePolicy(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_GetResourcePolicy_Call {
+	return &SecretsManager_GetResourcePolicy_Call{Call: _e.mock.On("GetResourcePolicy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_GetResourcePolicy_Call) Run(run func(ctx context.Context, params *secretsmanager.GetResourcePolicyInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_GetResourcePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.GetResourcePolicyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_GetResourcePolicy_Call) Return(_a0 *secretsmanager.GetResourcePolicyOutput, _a1 error) *SecretsManager_GetResourcePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_GetResourcePolicy_Call) RunAndReturn(run func(context.Context, *secretsmanager.GetResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetResourcePolicyOutput, error)) *SecretsManager_GetResourcePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecretValue provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecretValue")
+	}
+
+	var r0 *secretsmanager.GetSecretValueOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) *secretsmanager.GetSecretValueOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.GetSecretValueOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_GetSecretValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretValue'
+type SecretsManager_GetSecretValue_Call struct {
+	*mock.Call
+}
+
+// GetSecretValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.GetSecretValueInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) GetSecretValue(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_GetSecretValue_Call {
+	return &SecretsManager_GetSecretValue_Call{Call: _e.mock.On("GetSecretValue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_GetSecretValue_Call) Run(run func(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_GetSecretValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.GetSecretValueInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_GetSecretValue_Call) Return(_a0 *secretsmanager.GetSecretValueOutput, _a1 error) *SecretsManager_GetSecretValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_GetSecretValue_Call) RunAndReturn(run func(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)) *SecretsManager_GetSecretValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecretVersionIds provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) ListSecretVersionIds(ctx context.Context, params *secretsmanager.ListSecretVersionIdsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretVersionIds")
+	}
+
+	var r0 *secretsmanager.ListSecretVersionIdsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ListSecretVersionIdsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ListSecretVersionIdsInput, ...func(*secretsmanager.Options)) *secretsmanager.ListSecretVersionIdsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.ListSecretVersionIdsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.ListSecretVersionIdsInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_ListSecretVersionIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretVersionIds'
+type SecretsManager_ListSecretVersionIds_Call struct {
+	*mock.Call
+}
+
+// ListSecretVersionIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.ListSecretVersionIdsInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) ListSecretVersionIds(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_ListSecretVersionIds_Call {
+	return &SecretsManager_ListSecretVersionIds_Call{Call: _e.mock.On("ListSecretVersionIds",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_ListSecretVersionIds_Call) Run(run func(ctx context.Context, params *secretsmanager.ListSecretVersionIdsInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_ListSecretVersionIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.ListSecretVersionIdsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_ListSecretVersionIds_Call) Return(_a0 *secretsmanager.ListSecretVersionIdsOutput, _a1 error) *SecretsManager_ListSecretVersionIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_ListSecretVersionIds_Call) RunAndReturn(run func(context.Context, *secretsmanager.ListSecretVersionIdsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error)) *SecretsManager_ListSecretVersionIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecrets provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecrets")
+	}
+
+	var r0 *secretsmanager.ListSecretsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ListSecretsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ListSecretsInput, ...func(*secretsmanager.Options)) *secretsmanager.ListSecretsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.ListSecretsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.ListSecretsInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_ListSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecrets'
+type SecretsManager_ListSecrets_Call struct {
+	*mock.Call
+}
+
+// ListSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.ListSecretsInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) ListSecrets(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_ListSecrets_Call {
+	return &SecretsManager_ListSecrets_Call{Call: _e.mock.On("ListSecrets",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_ListSecrets_Call) Run(run func(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_ListSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.ListSecretsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_ListSecrets_Call) Return(_a0 *secretsmanager.ListSecretsOutput, _a1 error) *SecretsManager_ListSecrets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_ListSecrets_Call) RunAndReturn(run func(context.Context, *secretsmanager.ListSecretsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)) *SecretsManager_ListSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Options provides a mock function with no fields
+func (_m *SecretsManager) Options() secretsmanager.Options {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Options")
+	}
+
+	var r0 secretsmanager.Options
+	if rf, ok := ret.Get(0).(func() secretsmanager.Options); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(secretsmanager.Options)
+	}
+
+	return r0
+}
+
+// SecretsManager_Options_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Options'
+type SecretsManager_Options_Call struct {
+	*mock.Call
+}
+
+// Options is a helper method to define mock.On call
+func (_e *SecretsManager_Expecter) Options() *SecretsManager_Options_Call {
+	return &SecretsManager_Options_Call{Call: _e.mock.On("Options")}
+}
+
+func (_c *SecretsManager_Options_Call) Run(run func()) *SecretsManager_Options_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SecretsManager_Options_Call) Return(_a0 secretsmanager.Options) *SecretsManager_Options_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretsManager_Options_Call) RunAndReturn(run func() secretsmanager.Options) *SecretsManager_Options_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutResourcePolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) PutResourcePolicy(ctx context.Context, params *secretsmanager.PutResourcePolicyInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutResourcePolicy")
+	}
+
+	var r0 *secretsmanager.PutResourcePolicyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.PutResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.PutResourcePolicyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.PutResourcePolicyInput, ...func(*secretsmanager.Options)) *secretsmanager.PutResourcePolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.PutResourcePolicyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.PutResourcePolicyInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_PutResourcePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutResourcePolicy'
+type SecretsManager_PutResourcePolicy_Call struct {
+	*mock.Call
+}
+
+// PutResourcePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.PutResourcePolicyInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) PutResourcePolicy(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_PutResourcePolicy_Call {
+	return &SecretsManager_PutResourcePolicy_Call{Call: _e.mock.On("PutResourcePolicy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_PutResourcePolicy_Call) Run(run func(ctx context.Context, params *secretsmanager.PutResourcePolicyInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_PutResourcePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.PutResourcePolicyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_PutResourcePolicy_Call) Return(_a0 *secretsmanager.PutResourcePolicyOutput, _a1 error) *SecretsManager_PutResourcePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_PutResourcePolicy_Call) RunAndReturn(run func(context.Context, *secretsmanager.PutResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.PutResourcePolicyOutput, error)) *SecretsManager_PutResourcePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutSecretValue provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) PutSecretValue(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutSecretValue")
+	}
+
+	var r0 *secretsmanager.PutSecretValueOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.PutSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.PutSecretValueInput, ...func(*secretsmanager.Options)) *secretsmanager.PutSecretValueOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.PutSecretValueOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.PutSecretValueInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_PutSecretValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutSecretValue'
+type SecretsManager_PutSecretValue_Call struct {
+	*mock.Call
+}
+
+// PutSecretValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.PutSecretValueInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) PutSecretValue(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_PutSecretValue_Call {
+	return &SecretsManager_PutSecretValue_Call{Call: _e.mock.On("PutSecretValue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_PutSecretValue_Call) Run(run func(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_PutSecretValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.PutSecretValueInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_PutSecretValue_Call) Return(_a0 *secretsmanager.PutSecretValueOutput, _a1 error) *SecretsManager_PutSecretValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_PutSecretValue_Call) RunAndReturn(run func(context.Context, *secretsmanager.PutSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)) *SecretsManager_PutSecretValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRegionsFromReplication provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) RemoveRegionsFromReplication(ctx context.Context, params *secretsmanager.RemoveRegionsFromReplicationInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.RemoveRegionsFromReplicationOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRegionsFromReplication")
+	}
+
+	var r0 *secretsmanager.RemoveRegionsFromReplicationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.RemoveRegionsFromReplicationInput, ...func(*secretsmanager.Options)) (*secretsmanager.RemoveRegionsFromReplicationOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.RemoveRegionsFromReplicationInput, ...func(*secretsmanager.Options)) *secretsmanager.RemoveRegionsFromReplicationOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.RemoveRegionsFromReplicationOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.RemoveRegionsFromReplicationInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_RemoveRegionsFromReplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRegionsFromReplication'
+type SecretsManager_RemoveRegionsFromReplication_Call struct {
+	*mock.Call
+}
+
+// RemoveRegionsFromReplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.RemoveRegionsFromReplicationInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) RemoveRegionsFromReplication(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_RemoveRegionsFromReplication_Call {
+	return &SecretsManager_RemoveRegionsFromReplication_Call{Call: _e.mock.On("RemoveRegionsFromReplication",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_RemoveRegionsFromReplication_Call) Run(run func(ctx context.Context, params *secretsmanager.RemoveRegionsFromReplicationInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_RemoveRegionsFromReplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.RemoveRegionsFromReplicationInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_RemoveRegionsFromReplication_Call) Return(_a0 *secretsmanager.RemoveRegionsFromReplicationOutput, _a1 error) *SecretsManager_RemoveRegionsFromReplication_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_RemoveRegionsFromReplication_Call) RunAndReturn(run func(context.Context, *secretsmanager.RemoveRegionsFromReplicationInput, ...func(*secretsmanager.Options)) (*secretsmanager.RemoveRegionsFromReplicationOutput, error)) *SecretsManager_RemoveRegionsFromReplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplicateSecretToRegions provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) ReplicateSecretToRegions(ctx context.Context, params *secretsmanager.ReplicateSecretToRegionsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ReplicateSecretToRegionsOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplicateSecretToRegions")
+	}
+
+	var r0 *secretsmanager.ReplicateSecretToRegionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ReplicateSecretToRegionsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ReplicateSecretToRegionsOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ReplicateSecretToRegionsInput, ...func(*secretsmanager.Options)) *secretsmanager.ReplicateSecretToRegionsOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.ReplicateSecretToRegionsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.ReplicateSecretToRegionsInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_ReplicateSecretToRegions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicateSecretToRegions'
+type SecretsManager_ReplicateSecretToRegions_Call struct {
+	*mock.Call
+}
+
+// ReplicateSecretToRegions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.ReplicateSecretToRegionsInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) ReplicateSecretToRegions(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_ReplicateSecretToRegions_Call {
+	return &SecretsManager_ReplicateSecretToRegions_Call{Call: _e.mock.On("ReplicateSecretToRegions",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_ReplicateSecretToRegions_Call) Run(run func(ctx context.Context, params *secretsmanager.ReplicateSecretToRegionsInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_ReplicateSecretToRegions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.ReplicateSecretToRegionsInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_ReplicateSecretToRegions_Call) Return(_a0 *secretsmanager.ReplicateSecretToRegionsOutput, _a1 error) *SecretsManager_ReplicateSecretToRegions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_ReplicateSecretToRegions_Call) RunAndReturn(run func(context.Context, *secretsmanager.ReplicateSecretToRegionsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ReplicateSecretToRegionsOutput, error)) *SecretsManager_ReplicateSecretToRegions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSecret provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) RestoreSecret(ctx context.Context, params *secretsmanager.RestoreSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.RestoreSecretOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSecret")
+	}
+
+	var r0 *secretsmanager.RestoreSecretOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.RestoreSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.RestoreSecretOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.RestoreSecretInput, ...func(*secretsmanager.Options)) *secretsmanager.RestoreSecretOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.RestoreSecretOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.RestoreSecretInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_RestoreSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSecret'
+type SecretsManager_RestoreSecret_Call struct {
+	*mock.Call
+}
+
+// RestoreSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.RestoreSecretInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) RestoreSecret(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_RestoreSecret_Call {
+	return &SecretsManager_RestoreSecret_Call{Call: _e.mock.On("RestoreSecret",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_RestoreSecret_Call) Run(run func(ctx context.Context, params *secretsmanager.RestoreSecretInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_RestoreSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.RestoreSecretInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_RestoreSecret_Call) Return(_a0 *secretsmanager.RestoreSecretOutput, _a1 error) *SecretsManager_RestoreSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_RestoreSecret_Call) RunAndReturn(run func(context.Context, *secretsmanager.RestoreSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.RestoreSecretOutput, error)) *SecretsManager_RestoreSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSecret provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) RotateSecret(ctx context.Context, params *secretsmanager.RotateSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.RotateSecretOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecret")
+	}
+
+	var r0 *secretsmanager.RotateSecretOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.RotateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.RotateSecretOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.RotateSecretInput, ...func(*secretsmanager.Options)) *secretsmanager.RotateSecretOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.RotateSecretOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.RotateSecretInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_RotateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecret'
+type SecretsManager_RotateSecret_Call struct {
+	*mock.Call
+}
+
+// RotateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.RotateSecretInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) RotateSecret(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_RotateSecret_Call {
+	return &SecretsManager_RotateSecret_Call{Call: _e.mock.On("RotateSecret",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_RotateSecret_Call) Run(run func(ctx context.Context, params *secretsmanager.RotateSecretInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_RotateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.RotateSecretInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_RotateSecret_Call) Return(_a0 *secretsmanager.RotateSecretOutput, _a1 error) *SecretsManager_RotateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_RotateSecret_Call) RunAndReturn(run func(context.Context, *secretsmanager.RotateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.RotateSecretOutput, error)) *SecretsManager_RotateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopReplicationToReplica provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) StopReplicationToReplica(ctx context.Context, params *secretsmanager.StopReplicationToReplicaInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.StopReplicationToReplicaOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopReplicationToReplica")
+	}
+
+	var r0 *secretsmanager.StopReplicationToReplicaOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.StopReplicationToReplicaInput, ...func(*secretsmanager.Options)) (*secretsmanager.StopReplicationToReplicaOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.StopReplicationToReplicaInput, ...func(*secretsmanager.Options)) *secretsmanager.StopReplicationToReplicaOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.StopReplicationToReplicaOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.StopReplicationToReplicaInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_StopReplicationToReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopReplicationToReplica'
+type SecretsManager_StopReplicationToReplica_Call struct {
+	*mock.Call
+}
+
+// StopReplicationToReplica is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.StopReplicationToReplicaInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) StopReplicationToReplica(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_StopReplicationToReplica_Call {
+	return &SecretsManager_StopReplicationToReplica_Call{Call: _e.mock.On("StopReplicationToReplica",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_StopReplicationToReplica_Call) Run(run func(ctx context.Context, params *secretsmanager.StopReplicationToReplicaInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_StopReplicationToReplica_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.StopReplicationToReplicaInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_StopReplicationToReplica_Call) Return(_a0 *secretsmanager.StopReplicationToReplicaOutput, _a1 error) *SecretsManager_StopReplicationToReplica_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_StopReplicationToReplica_Call) RunAndReturn(run func(context.Context, *secretsmanager.StopReplicationToReplicaInput, ...func(*secretsmanager.Options)) (*secretsmanager.StopReplicationToReplicaOutput, error)) *SecretsManager_StopReplicationToReplica_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagResource provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) TagResource(ctx context.Context, params *secretsmanager.TagResourceInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.TagResourceOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagResource")
+	}
+
+	var r0 *secretsmanager.TagResourceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.TagResourceInput, ...func(*secretsmanager.Options)) (*secretsmanager.TagResourceOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.TagResourceInput, ...func(*secretsmanager.Options)) *secretsmanager.TagResourceOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.TagResourceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.TagResourceInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_TagResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagResource'
+type SecretsManager_TagResource_Call struct {
+	*mock.Call
+}
+
+// TagResource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.TagResourceInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) TagResource(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_TagResource_Call {
+	return &SecretsManager_TagResource_Call{Call: _e.mock.On("TagResource",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_TagResource_Call) Run(run func(ctx context.Context, params *secretsmanager.TagResourceInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_TagResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.TagResourceInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_TagResource_Call) Return(_a0 *secretsmanager.TagResourceOutput, _a1 error) *SecretsManager_TagResource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_TagResource_Call) RunAndReturn(run func(context.Context, *secretsmanager.TagResourceInput, ...func(*secretsmanager.Options)) (*secretsmanager.TagResourceOutput, error)) *SecretsManager_TagResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UntagResource provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) UntagResource(ctx context.Context, params *secretsmanager.UntagResourceInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.UntagResourceOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntagResource")
+	}
+
+	var r0 *secretsmanager.UntagResourceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.UntagResourceInput, ...func(*secretsmanager.Options)) (*secretsmanager.UntagResourceOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.UntagResourceInput, ...func(*secretsmanager.Options)) *secretsmanager.UntagResourceOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.UntagResourceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.UntagResourceInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_UntagResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagResource'
+type SecretsManager_UntagResource_Call struct {
+	*mock.Call
+}
+
+// UntagResource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.UntagResourceInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) UntagResource(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_UntagResource_Call {
+	return &SecretsManager_UntagResource_Call{Call: _e.mock.On("UntagResource",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_UntagResource_Call) Run(run func(ctx context.Context, params *secretsmanager.UntagResourceInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_UntagResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.UntagResourceInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_UntagResource_Call) Return(_a0 *secretsmanager.UntagResourceOutput, _a1 error) *SecretsManager_UntagResource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_UntagResource_Call) RunAndReturn(run func(context.Context, *secretsmanager.UntagResourceInput, ...func(*secretsmanager.Options)) (*secretsmanager.UntagResourceOutput, error)) *SecretsManager_UntagResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSecret provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) UpdateSecret(ctx context.Context, params *secretsmanager.UpdateSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.UpdateSecretOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSecret")
+	}
+
+	var r0 *secretsmanager.UpdateSecretOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.UpdateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.UpdateSecretOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.UpdateSecretInput, ...func(*secretsmanager.Options)) *secretsmanager.UpdateSecretOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.UpdateSecretOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.UpdateSecretInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_UpdateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSecret'
+type SecretsManager_UpdateSecret_Call struct {
+	*mock.Call
+}
+
+// UpdateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.UpdateSecretInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) UpdateSecret(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_UpdateSecret_Call {
+	return &SecretsManager_UpdateSecret_Call{Call: _e.mock.On("UpdateSecret",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_UpdateSecret_Call) Run(run func(ctx context.Context, params *secretsmanager.UpdateSecretInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_UpdateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.UpdateSecretInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_UpdateSecret_Call) Return(_a0 *secretsmanager.UpdateSecretOutput, _a1 error) *SecretsManager_UpdateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_UpdateSecret_Call) RunAndReturn(run func(context.Context, *secretsmanager.UpdateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.UpdateSecretOutput, error)) *SecretsManager_UpdateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSecretVersionStage provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) UpdateSecretVersionStage(ctx context.Context, params *secretsmanager.UpdateSecretVersionStageInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.UpdateSecretVersionStageOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSecretVersionStage")
+	}
+
+	var r0 *secretsmanager.UpdateSecretVersionStageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.UpdateSecretVersionStageInput, ...func(*secretsmanager.Options)) (*secretsmanager.UpdateSecretVersionStageOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.UpdateSecretVersionStageInput, ...func(*secretsmanager.Options)) *secretsmanager.UpdateSecretVersionStageOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.UpdateSecretVersionStageOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.UpdateSecretVersionStageInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_UpdateSecretVersionStage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSecretVersionStage'
+type SecretsManager_UpdateSecretVersionStage_Call struct {
+	*mock.Call
+}
+
+// UpdateSecretVersionStage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.UpdateSecretVersionStageInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) UpdateSecretVersionStage(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_UpdateSecretVersionStage_Call {
+	return &SecretsManager_UpdateSecretVersionStage_Call{Call: _e.mock.On("UpdateSecretVersionStage",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_UpdateSecretVersionStage_Call) Run(run func(ctx context.Context, params *secretsmanager.UpdateSecretVersionStageInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_UpdateSecretVersionStage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.UpdateSecretVersionStageInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_UpdateSecretVersionStage_Call) Return(_a0 *secretsmanager.UpdateSecretVersionStageOutput, _a1 error) *SecretsManager_UpdateSecretVersionStage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_UpdateSecretVersionStage_Call) RunAndReturn(run func(context.Context, *secretsmanager.UpdateSecretVersionStageInput, ...func(*secretsmanager.Options)) (*secretsmanager.UpdateSecretVersionStageOutput, error)) *SecretsManager_UpdateSecretVersionStage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateResourcePolicy provides a mock function with given fields: ctx, params, optFns
+func (_m *SecretsManager) ValidateResourcePolicy(ctx context.Context, params *secretsmanager.ValidateResourcePolicyInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ValidateResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateResourcePolicy")
+	}
+
+	var r0 *secretsmanager.ValidateResourcePolicyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ValidateResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.ValidateResourcePolicyOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretsmanager.ValidateResourcePolicyInput, ...func(*secretsmanager.Options)) *secretsmanager.ValidateResourcePolicyOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretsmanager.ValidateResourcePolicyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretsmanager.ValidateResourcePolicyInput, ...func(*secretsmanager.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretsManager_ValidateResourcePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateResourcePolicy'
+type SecretsManager_ValidateResourcePolicy_Call struct {
+	*mock.Call
+}
+
+// ValidateResourcePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *secretsmanager.ValidateResourcePolicyInput
+//   - optFns ...func(*secretsmanager.Options)
+func (_e *SecretsManager_Expecter) ValidateResourcePolicy(ctx interface{}, params interface{}, optFns ...interface{}) *SecretsManager_ValidateResourcePolicy_Call {
+	return &SecretsManager_ValidateResourcePolicy_Call{Call: _e.mock.On("ValidateResourcePolicy",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *SecretsManager_ValidateResourcePolicy_Call) Run(run func(ctx context.Context, params *secretsmanager.ValidateResourcePolicyInput, optFns ...func(*secretsmanager.Options))) *SecretsManager_ValidateResourcePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*secretsmanager.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*secretsmanager.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*secretsmanager.ValidateResourcePolicyInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SecretsManager_ValidateResourcePolicy_Call) Return(_a0 *secretsmanager.ValidateResourcePolicyOutput, _a1 error) *SecretsManager_ValidateResourcePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretsManager_ValidateResourcePolicy_Call) RunAndReturn(run func(context.Context, *secretsmanager.ValidateResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.ValidateResourcePolicyOutput, error)) *SecretsManager_ValidateResourcePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretsManager creates a new instance of SecretsManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretsManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretsManager {
+	mock := &SecretsManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
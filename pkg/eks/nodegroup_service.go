@@ -41,6 +41,10 @@ type NodeGroupService struct {
 	provider         api.ClusterProvider
 	instanceSelector InstanceSelector
 	outpostsService  *outposts.Service
+
+	// SkipKeyImport makes Normalize use the names SSH public keys would be imported into EC2 as, without
+	// importing them, for when the templates of nodegroups are exported instead of creating anything
+	SkipKeyImport bool
 }
 
 // NewNodeGroupService creates a new NodeGroupService.
@@ -107,7 +111,13 @@ func (n *NodeGroupService) Normalize(ctx context.Context, nodePools []api.NodePo
 		// fingerprint, so if unique keys are provided, each will get
 		// loaded and used as intended and there is no need to have
 		// nodegroup name in the key name
-		publicKeyName, err := ssh.LoadKey(ctx, ng.SSH, clusterConfig.Metadata.Name, ng.Name, n.provider.EC2())
+		var publicKeyName string
+		var err error
+		if n.SkipKeyImport {
+			publicKeyName, err = ssh.KeyName(ng.SSH, clusterConfig.Metadata.Name, ng.Name)
+		} else {
+			publicKeyName, err = ssh.LoadKey(ctx, ng.SSH, clusterConfig.Metadata.Name, ng.Name, n.provider.EC2())
+		}
 		if err != nil {
 			return err
 		}
@@ -261,7 +271,8 @@ func (n *NodeGroupService) expandInstanceSelector(ins *api.InstanceSelector, azs
 }
 
 // DoAllNodegroupStackTasks iterates over nodegroup tasks and returns any errors.
-func DoAllNodegroupStackTasks(taskTree *tasks.TaskTree, region, name string) error {
+func DoAllNodegroupStackTasks(ctx context.Context, taskTree *tasks.TaskTree, region, name string) error {
+	taskTree.Context = ctx
 	logger.Info(taskTree.Describe())
 	errs := taskTree.DoAllSync()
 	if len(errs) > 0 {
@@ -267,6 +267,27 @@ var _ = Describe("NodeGroupService", func() {
 			expectedInstanceTypes: []string{"", ""},
 		}),
 	)
+
+	It("does not import SSH public keys into EC2 when key imports are skipped", func() {
+		provider := mockprovider.NewMockProvider()
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "test"
+		ng := api.NewManagedNodeGroup()
+		ng.Name = "mng"
+		ng.InstanceType = "m5.large"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2023
+		ng.SSH = &api.NodeGroupSSH{
+			Allow:     api.Enabled(),
+			PublicKey: aws.String("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJdipwj1E3EMFZzKBYid6O9YK7D2a2zOD/1+8HbLIa3T"),
+		}
+		clusterConfig.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+
+		nodeGroupService := eks.NewNodeGroupService(provider, nil, nil)
+		nodeGroupService.SkipKeyImport = true
+		Expect(nodeGroupService.Normalize(context.Background(), nodes.ToNodePools(clusterConfig), clusterConfig)).To(Succeed())
+		Expect(aws.ToString(ng.SSH.PublicKeyName)).To(HavePrefix("eksctl-test-nodegroup-mng-"))
+		Expect(provider.MockEC2().Calls).To(BeEmpty())
+	})
 })
 
 func mockOutpostInstanceTypes(provider *mockprovider.MockProvider) {
@@ -0,0 +1,297 @@
+package eks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha6"
+)
+
+const (
+	// OrgDefaultsEnvName is the environment variable that points at the org defaults file.
+	OrgDefaultsEnvName = "EKSCTL_DEFAULTS"
+
+	// enforcedTag marks values in an org defaults file that configs must not override.
+	enforcedTag = "!enforced"
+)
+
+// orgDefaultsNodeGroupFields are the ClusterConfig fields whose single item in an org defaults file holds
+// the defaults for each of their nodegroups.
+var orgDefaultsNodeGroupFields = []string{"nodeGroups", "managedNodeGroups"}
+
+// OrgDefaults holds organisation-wide defaults that are merged into ClusterConfigs before eksctl's own defaults.
+// They are read from a ClusterConfig document without a cluster name, in which nodeGroups and managedNodeGroups
+// may each hold a single unnamed item with the defaults for every nodegroup of that kind.
+// Values tagged with `!enforced` cannot be overridden by configs; a tagged object enforces all its fields.
+type OrgDefaults struct {
+	file string
+	// scopes holds the defaults of the cluster under "" and those of nodegroups under their field name
+	scopes map[string]*defaultValues
+}
+
+type defaultValues struct {
+	values   map[string]interface{}
+	enforced [][]string
+}
+
+// LoadOrgDefaults reads org defaults from file.
+func LoadOrgDefaults(file string) (*OrgDefaults, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading org defaults file %q: %w", file, err)
+	}
+	return ParseOrgDefaults(file, data)
+}
+
+// ParseOrgDefaults parses org defaults read from file.
+func ParseOrgDefaults(file string, data []byte) (*OrgDefaults, error) {
+	d := &OrgDefaults{
+		file:   file,
+		scopes: map[string]*defaultValues{"": {}},
+	}
+	for _, field := range orgDefaultsNodeGroupFields {
+		d.scopes[field] = &defaultValues{}
+	}
+
+	var document yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("parsing org defaults file %q: %w", file, err)
+	}
+	if len(document.Content) == 0 {
+		return d, nil
+	}
+	root := document.Content[0]
+	if root.Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("org defaults file %q must contain a ClusterConfig", file)
+	}
+	d.collectEnforced(root, nil, false)
+
+	// the enforced tags have been removed, so the document can be parsed like any other config
+	stripped, err := yamlv3.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("parsing org defaults file %q: %w", file, err)
+	}
+	source := &ConfigSource{}
+	source.add(file, data)
+	var clusterConfig api.ClusterConfig
+	if err := yaml.UnmarshalStrict(stripped, &clusterConfig); err != nil {
+		return nil, fmt.Errorf("loading org defaults file %q: %w", file, source.Annotate(err))
+	}
+	if clusterConfig.Metadata != nil && clusterConfig.Metadata.Name != "" {
+		return nil, fmt.Errorf("loading org defaults file %q: %w", file, source.Annotate(&api.FieldError{Path: "metadata.name", Err: errors.New("metadata.name cannot be set in org defaults")}))
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(stripped, &values); err != nil {
+		return nil, fmt.Errorf("loading org defaults file %q: %w", file, err)
+	}
+	delete(values, "apiVersion")
+	delete(values, "kind")
+	for _, field := range orgDefaultsNodeGroupFields {
+		items, _ := values[field].([]interface{})
+		delete(values, field)
+		if len(items) == 0 {
+			continue
+		}
+		if len(items) > 1 {
+			return nil, fmt.Errorf("loading org defaults file %q: %w", file, source.Annotate(&api.FieldError{
+				Path: field + "[1]",
+				Err:  fmt.Errorf("%s[1]: %s can only hold a single item with the defaults for all nodegroups", field, field),
+			}))
+		}
+		item, _ := items[0].(map[string]interface{})
+		if _, ok := item["name"]; ok {
+			return nil, fmt.Errorf("loading org defaults file %q: %w", file, source.Annotate(&api.FieldError{
+				Path: field + "[0].name",
+				Err:  fmt.Errorf("%s[0].name cannot be set in org defaults", field),
+			}))
+		}
+		d.scopes[field].values = item
+	}
+	d.scopes[""].values = values
+	return d, nil
+}
+
+// collectEnforced records the paths of all values below an `!enforced` tag, and removes the tags.
+// Lists are enforced as a whole, except for nodegroup lists whose item holds the nodegroup defaults.
+func (d *OrgDefaults) collectEnforced(node *yamlv3.Node, path []string, enforced bool) {
+	if node.Tag == enforcedTag {
+		node.Tag = ""
+		enforced = true
+	}
+	switch {
+	case node.Kind == yamlv3.MappingNode && len(node.Content) > 0:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			d.collectEnforced(node.Content[i+1], append(slices.Clone(path), node.Content[i].Value), enforced)
+		}
+	case node.Kind == yamlv3.SequenceNode && len(path) == 1 && slices.Contains(orgDefaultsNodeGroupFields, path[0]):
+		for _, item := range node.Content {
+			d.collectEnforced(item, path, enforced)
+		}
+	case enforced:
+		scope, fieldPath := "", path
+		if len(path) > 1 && slices.Contains(orgDefaultsNodeGroupFields, path[0]) {
+			scope, fieldPath = path[0], path[1:]
+		}
+		d.scopes[scope].enforced = append(d.scopes[scope].enforced, fieldPath)
+	}
+}
+
+// apply merges the defaults into the values of a ClusterConfig, with the values of the config taking
+// precedence; it returns an error for each enforced default that the config overrides.
+func (d *OrgDefaults) apply(config map[string]interface{}) (map[string]interface{}, error) {
+	merged, errs := d.scopes[""].apply(config, "")
+	for _, field := range orgDefaultsNodeGroupFields {
+		items, _ := merged[field].([]interface{})
+		for i, item := range items {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			scope := field
+			// v1alpha6 lists all nodegroups under nodeGroups, which are managed unless `managed: false` is set
+			if merged["apiVersion"] == v1alpha6.SchemeGroupVersion.String() && object["managed"] != false {
+				scope = "managedNodeGroups"
+			}
+			var itemErrs []error
+			items[i], itemErrs = d.scopes[scope].apply(object, fmt.Sprintf("%s[%d].", field, i))
+			errs = append(errs, itemErrs...)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("config violates enforced org defaults from %q: %w", d.file, errors.Join(errs...))
+	}
+	return merged, nil
+}
+
+func (v *defaultValues) apply(values map[string]interface{}, prefix string) (map[string]interface{}, []error) {
+	var errs []error
+	for _, path := range v.enforced {
+		want, _ := lookupValue(v.values, path)
+		if got, ok := lookupValue(values, path); ok && got != nil && !reflect.DeepEqual(got, want) {
+			fieldPath := prefix + strings.Join(path, ".")
+			errs = append(errs, &api.FieldError{
+				Path: fieldPath,
+				Err:  fmt.Errorf("%s is set to %s, but the org default %s is enforced", fieldPath, jsonValue(got), jsonValue(want)),
+			})
+		}
+	}
+	return mergeDefaults(v.values, values), errs
+}
+
+// ApplyTo merges the defaults into a ClusterConfig that was not loaded from a config file, e.g. one built
+// from command line flags. As such a config cannot tell unset fields from fields set to their zero value,
+// fields with zero values are treated as unset. flagFields maps the paths of the fields bound to flags, e.g.
+// "managedNodeGroups[0].privateNetworking", to whether the flag was set: fields of flags that were set keep
+// their value even if it is a zero value, and fields of flags that were not set are treated as unset.
+func (d *OrgDefaults) ApplyTo(clusterConfig *api.ClusterConfig, flagFields map[string]bool) error {
+	if d == nil {
+		return nil
+	}
+	data, err := json.Marshal(clusterConfig)
+	if err != nil {
+		return err
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	merged, err := d.apply(withoutUnsetValues(values, "", flagFields))
+	if err != nil {
+		return err
+	}
+	if data, err = json.Marshal(merged); err != nil {
+		return err
+	}
+	// unmarshalling into the existing config keeps the pointers to it and its nodegroups valid
+	return json.Unmarshal(data, clusterConfig)
+}
+
+// mergeDefaults merges values onto a copy of defaults: objects are merged recursively, null values are
+// ignored and all other values, including lists, replace the default.
+func mergeDefaults(defaults, values map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(values))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+		if object, ok := value.(map[string]interface{}); ok {
+			if defaultObject, ok := merged[key].(map[string]interface{}); ok {
+				merged[key] = mergeDefaults(defaultObject, object)
+				continue
+			}
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// withoutUnsetValues removes the values of fields of flags that were not set, as well as false, zero, empty and
+// null values that were not set by flags, from objects, including those in lists.
+func withoutUnsetValues(values map[string]interface{}, prefix string, flagFields map[string]bool) map[string]interface{} {
+	for key, value := range values {
+		path := prefix + key
+		if set, ok := flagFields[path]; ok {
+			if !set {
+				delete(values, key)
+			}
+			continue
+		}
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if len(withoutUnsetValues(value, path+".", flagFields)) > 0 {
+				continue
+			}
+		case []interface{}:
+			for i, item := range value {
+				if object, ok := item.(map[string]interface{}); ok {
+					withoutUnsetValues(object, fmt.Sprintf("%s[%d].", path, i), flagFields)
+				}
+			}
+			if len(value) > 0 {
+				continue
+			}
+		case nil:
+		default:
+			if !reflect.ValueOf(value).IsZero() {
+				continue
+			}
+		}
+		delete(values, key)
+	}
+	return values
+}
+
+func lookupValue(values map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = values
+	for _, key := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func jsonValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,117 @@
+package eks_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+const boundaryARN = "arn:aws:iam::111122223333:policy/boundary"
+
+var _ = Describe("OrgDefaults", func() {
+	var defaults *eks.OrgDefaults
+
+	BeforeEach(func() {
+		Expect(api.Register()).To(Succeed())
+		var err error
+		defaults, err = eks.LoadOrgDefaults("testdata/org-defaults.yaml")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("merges the defaults into a config file, with the config taking precedence", func() {
+		cfg, err := eks.LoadConfigWithOptions("testdata/org-defaults-cluster.yaml", nil, eks.LoadConfigOptions{Defaults: defaults})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cfg.Metadata.Name).To(Equal("cluster-1"))
+		Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"cost-center": "1234", "team": "payments"}))
+		Expect(cfg.IAM.ServiceRolePermissionsBoundary).To(Equal(aws.String(boundaryARN)))
+		Expect(cfg.CloudWatch.ClusterLogging.EnableTypes).To(Equal([]string{"audit", "authenticator"}))
+
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(2))
+		for _, ng := range cfg.ManagedNodeGroups {
+			Expect(ng.VolumeEncrypted).To(Equal(aws.Bool(true)))
+			Expect(ng.DisableIMDSv1).To(Equal(aws.Bool(true)))
+			Expect(ng.IAM.InstanceRolePermissionsBoundary).To(Equal(boundaryARN))
+		}
+		Expect(cfg.ManagedNodeGroups[0].PrivateNetworking).To(BeFalse())
+		Expect(cfg.ManagedNodeGroups[1].PrivateNetworking).To(BeTrue())
+		Expect(cfg.ManagedNodeGroups[1].InstanceType).To(Equal("m5.large"))
+	})
+
+	It("reports config files that override enforced defaults", func() {
+		_, err := eks.LoadConfigWithOptions("testdata/org-defaults-violation.yaml", nil, eks.LoadConfigOptions{Defaults: defaults})
+		Expect(err).To(MatchError(ContainSubstring(`config violates enforced org defaults from "testdata/org-defaults.yaml": ` +
+			`managedNodeGroups[1].disableIMDSv1 is set to false, but the org default true is enforced`)))
+
+		var configErr *eks.ConfigError
+		Expect(errors.As(err, &configErr)).To(BeTrue())
+		Expect(configErr.File).To(Equal("testdata/org-defaults-violation.yaml"))
+		Expect(configErr.Line).To(Equal(11))
+	})
+
+	It("merges the managed nodegroup defaults into the managed nodegroups of v1alpha6 configs", func() {
+		cfg, err := eks.LoadConfigWithOptions("testdata/v1alpha6.yaml", nil, eks.LoadConfigOptions{Defaults: defaults})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		Expect(cfg.ManagedNodeGroups[0].VolumeEncrypted).To(Equal(aws.Bool(true)))
+		Expect(cfg.ManagedNodeGroups[0].PrivateNetworking).To(BeTrue())
+		Expect(cfg.NodeGroups).To(HaveLen(1))
+		Expect(cfg.NodeGroups[0].VolumeEncrypted).To(BeNil())
+		Expect(*cfg.NodeGroups[0].IAM.WithAddonPolicies.AutoScaler).To(BeTrue())
+	})
+
+	It("treats zero values as unset in configs that were not loaded from a file", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		ng := api.NewManagedNodeGroup()
+		ng.Name = "mng-1"
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, ng)
+
+		Expect(defaults.ApplyTo(cfg, nil)).To(Succeed())
+		Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"cost-center": "1234", "team": "platform"}))
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		Expect(cfg.ManagedNodeGroups[0]).To(BeIdenticalTo(ng))
+		Expect(ng.Name).To(Equal("mng-1"))
+		Expect(ng.PrivateNetworking).To(BeTrue())
+		Expect(ng.DisableIMDSv1).To(Equal(aws.Bool(true)))
+
+		ng.IAM.InstanceRolePermissionsBoundary = "arn:aws:iam::111122223333:policy/other"
+		Expect(defaults.ApplyTo(cfg, nil)).To(MatchError(ContainSubstring(`managedNodeGroups[0].iam.instanceRolePermissionsBoundary is set to "arn:aws:iam::111122223333:policy/other", but the org default "` + boundaryARN + `" is enforced`)))
+	})
+
+	It("keeps the values of fields set by flags, and treats fields of flags that were not set as unset", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		ng := api.NewManagedNodeGroup()
+		ng.Name = "mng-1"
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, ng)
+
+		Expect(*ng.VolumeSize).To(Equal(api.DefaultNodeVolumeSize))
+		Expect(defaults.ApplyTo(cfg, map[string]bool{
+			"managedNodeGroups[0].privateNetworking": true,
+			"managedNodeGroups[0].volumeSize":        false,
+		})).To(Succeed())
+		Expect(ng.PrivateNetworking).To(BeFalse())
+		Expect(ng.VolumeSize).To(Equal(aws.Int(100)))
+
+		ng.DisableIMDSv1 = aws.Bool(false)
+		Expect(defaults.ApplyTo(cfg, map[string]bool{"managedNodeGroups[0].disableIMDSv1": true})).To(MatchError(ContainSubstring(
+			"managedNodeGroups[0].disableIMDSv1 is set to false, but the org default true is enforced")))
+	})
+
+	DescribeTable("rejects invalid org defaults", func(data, expectedErr string) {
+		_, err := eks.ParseOrgDefaults("defaults.yaml", []byte(data))
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("unknown fields", "nodeGroups:\n  - unknownField: true\n", `unknown field "unknownField"`),
+		Entry("a cluster name", "metadata:\n  name: cluster-1\n", "metadata.name cannot be set in org defaults"),
+		Entry("named nodegroups", "managedNodeGroups:\n  - name: mng-1\n", "managedNodeGroups[0].name cannot be set in org defaults"),
+		Entry("several nodegroups", "nodeGroups:\n  - {}\n  - {}\n", "nodeGroups can only hold a single item with the defaults for all nodegroups"),
+	)
+})
@@ -0,0 +1,122 @@
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// ReferenceResolver resolves references to SSM parameters and Secrets Manager secrets in the ClusterConfig
+// fields that may hold sensitive values.
+type ReferenceResolver struct {
+	SSM            awsapi.SSM
+	SecretsManager awsapi.SecretsManager
+}
+
+// NewReferenceResolver creates a ReferenceResolver that uses the clients of provider.
+func NewReferenceResolver(provider api.ClusterProvider) *ReferenceResolver {
+	return &ReferenceResolver{
+		SSM:            provider.SSM(),
+		SecretsManager: provider.SecretsManager(),
+	}
+}
+
+// ResolveReferences resolves the references in clusterConfig, if it holds any, using the SSM and Secrets Manager
+// clients of provider, so that they are read with the profile and region of the command.
+func ResolveReferences(ctx context.Context, provider api.ClusterProvider, clusterConfig *api.ClusterConfig) error {
+	if clusterConfig == nil || !clusterConfig.HasReferences() {
+		return nil
+	}
+	return NewReferenceResolver(provider).Resolve(ctx, clusterConfig)
+}
+
+// Resolve replaces references with the values they refer to, and records the resolved fields in
+// clusterConfig.ResolvedReferences.
+func (r *ReferenceResolver) Resolve(ctx context.Context, clusterConfig *api.ClusterConfig) error {
+	resolved := map[string]string{}
+	secrets := map[string]string{}
+	return clusterConfig.ForEachReferenceField(func(field string, value *string) error {
+		reference := *value
+		if !api.IsReference(reference) {
+			return nil
+		}
+		resolvedValue, ok := resolved[reference]
+		if !ok {
+			var err error
+			if resolvedValue, err = r.resolve(ctx, reference, secrets); err != nil {
+				return fmt.Errorf("resolving %s reference %q: %w", field, reference, err)
+			}
+			resolved[reference] = resolvedValue
+		}
+		*value = resolvedValue
+		clusterConfig.ResolvedReferences = append(clusterConfig.ResolvedReferences, api.ResolvedReference{
+			Field:     field,
+			Reference: reference,
+		})
+		return nil
+	})
+}
+
+// resolve returns the value of a reference; secrets holds the secret strings that were already fetched.
+func (r *ReferenceResolver) resolve(ctx context.Context, reference string, secrets map[string]string) (string, error) {
+	if name, ok := strings.CutPrefix(reference, api.SSMReferencePrefix); ok {
+		output, err := r.SSM.GetParameter(ctx, &ssm.GetParameterInput{
+			Name:           aws.String(name),
+			WithDecryption: aws.Bool(true),
+		})
+		if err != nil {
+			return "", fmt.Errorf("getting SSM parameter %q: %w", name, err)
+		}
+		if output.Parameter == nil || output.Parameter.Value == nil {
+			return "", fmt.Errorf("SSM parameter %q has no value", name)
+		}
+		return *output.Parameter.Value, nil
+	}
+
+	name, key, hasKey := strings.Cut(strings.TrimPrefix(reference, api.SecretsManagerReferencePrefix), "#")
+	if name == "" {
+		return "", fmt.Errorf("secret name must be set, e.g. %sname#key", api.SecretsManagerReferencePrefix)
+	}
+	secret, ok := secrets[name]
+	if !ok {
+		output, err := r.SecretsManager.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(name),
+		})
+		if err != nil {
+			return "", fmt.Errorf("getting secret %q: %w", name, err)
+		}
+		if output.SecretString == nil {
+			return "", fmt.Errorf("secret %q does not hold a string", name)
+		}
+		secret = *output.SecretString
+		secrets[name] = secret
+	}
+	if !hasKey {
+		return secret, nil
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", fmt.Errorf("secret %q must hold a JSON object to look up key %q", name, key)
+	}
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("secret %q has no key %q", name, key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
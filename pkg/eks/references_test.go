@@ -0,0 +1,141 @@
+package eks_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ReferenceResolver", func() {
+	const (
+		keyARN    = "arn:aws:kms:us-west-2:111122223333:key/secrets"
+		publicKey = "ssh-ed25519 AAAA"
+	)
+
+	var (
+		provider *mockprovider.MockProvider
+		cfg      *api.ClusterConfig
+	)
+
+	mockGetParameter := func(name, value string) {
+		provider.MockSSM().On("GetParameter", mock.Anything, &ssm.GetParameterInput{
+			Name:           aws.String(name),
+			WithDecryption: aws.Bool(true),
+		}).Return(&ssm.GetParameterOutput{
+			Parameter: &ssmtypes.Parameter{Value: aws.String(value)},
+		}, nil)
+	}
+	mockGetSecretValue := func(name, value string) {
+		provider.MockSecretsManager().On("GetSecretValue", mock.Anything, &secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(name),
+		}).Return(&secretsmanager.GetSecretValueOutput{
+			SecretString: aws.String(value),
+		}, nil).Once()
+	}
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: "ssm:/eksctl/key-arn"}
+		cfg.IdentityProviders = []api.IdentityProvider{
+			api.FromIdentityProvider(&api.OIDCIdentityProvider{
+				Name:      "idp",
+				IssuerURL: "https://example.com",
+				ClientID:  "secretsmanager:oidc#clientID",
+			}),
+		}
+		cfg.Addons = []*api.Addon{
+			{Name: "vpc-cni", ConfigurationValues: `{"env":{"WARM_IP_TARGET":"1"}}`},
+			{Name: "coredns", ConfigurationValues: "secretsmanager:coredns-values"},
+		}
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng"
+		ng.SSH = &api.NodeGroupSSH{Allow: api.Enabled(), PublicKey: aws.String("secretsmanager:oidc#sshKey")}
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng"
+		mng.VolumeKmsKeyID = aws.String("ssm:/eksctl/key-arn")
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+	})
+
+	It("resolves references to SSM parameters and Secrets Manager secrets, and redacts the resolved values", func() {
+		mockGetParameter("/eksctl/key-arn", keyARN)
+		mockGetSecretValue("oidc", `{"clientID": "client-1", "sshKey": "`+publicKey+`"}`)
+		mockGetSecretValue("coredns-values", `{"replicaCount":3}`)
+
+		Expect(cfg.HasReferences()).To(BeTrue())
+		Expect(eks.ResolveReferences(context.Background(), provider, cfg)).To(Succeed())
+		Expect(cfg.HasReferences()).To(BeFalse())
+
+		Expect(cfg.SecretsEncryption.KeyARN).To(Equal(keyARN))
+		Expect(cfg.IdentityProviders[0].Inner.(*api.OIDCIdentityProvider).ClientID).To(Equal("client-1"))
+		Expect(cfg.Addons[0].ConfigurationValues).To(Equal(`{"env":{"WARM_IP_TARGET":"1"}}`))
+		Expect(cfg.Addons[1].ConfigurationValues).To(Equal(`{"replicaCount":3}`))
+		Expect(*cfg.NodeGroups[0].SSH.PublicKey).To(Equal(publicKey))
+		Expect(*cfg.ManagedNodeGroups[0].VolumeKmsKeyID).To(Equal(keyARN))
+		// each parameter and secret is only fetched once
+		provider.MockSSM().AssertNumberOfCalls(GinkgoT(), "GetParameter", 1)
+		provider.MockSecretsManager().AssertNumberOfCalls(GinkgoT(), "GetSecretValue", 2)
+
+		redacted := cfg.Redacted()
+		Expect(redacted.SecretsEncryption.KeyARN).To(Equal("ssm:/eksctl/key-arn"))
+		Expect(redacted.IdentityProviders[0].Inner.(*api.OIDCIdentityProvider).ClientID).To(Equal("secretsmanager:oidc#clientID"))
+		Expect(redacted.Addons[0].ConfigurationValues).To(Equal(`{"env":{"WARM_IP_TARGET":"1"}}`))
+		Expect(redacted.Addons[1].ConfigurationValues).To(Equal("secretsmanager:coredns-values"))
+		Expect(*redacted.NodeGroups[0].SSH.PublicKey).To(Equal("secretsmanager:oidc#sshKey"))
+		Expect(*redacted.ManagedNodeGroups[0].VolumeKmsKeyID).To(Equal("ssm:/eksctl/key-arn"))
+		Expect(cfg.SecretsEncryption.KeyARN).To(Equal(keyARN), "the config itself should not be redacted")
+
+		// redaction follows nodegroups that are filtered out of a copy
+		filtered := cfg.DeepCopy()
+		filtered.NodeGroups = nil
+		Expect(*filtered.Redacted().ManagedNodeGroups[0].VolumeKmsKeyID).To(Equal("ssm:/eksctl/key-arn"))
+	})
+
+	It("leaves configs without references untouched", func() {
+		cfg.SecretsEncryption.KeyARN = keyARN
+		cfg.IdentityProviders = nil
+		cfg.Addons = nil
+		cfg.NodeGroups = nil
+		cfg.ManagedNodeGroups = nil
+
+		Expect(cfg.HasReferences()).To(BeFalse())
+		Expect(eks.NewReferenceResolver(provider).Resolve(context.Background(), cfg)).To(Succeed())
+		Expect(cfg.ResolvedReferences).To(BeEmpty())
+		Expect(cfg.Redacted()).To(BeIdenticalTo(cfg))
+		Expect(eks.ResolveReferences(context.Background(), provider, cfg)).To(Succeed())
+		Expect(provider.MockSSM().Calls).To(BeEmpty())
+		Expect(provider.MockSecretsManager().Calls).To(BeEmpty())
+	})
+
+	DescribeTable("invalid references", func(secret string, secretErr error, expectedErr string) {
+		cfg.IdentityProviders = nil
+		cfg.Addons = nil
+		cfg.NodeGroups = nil
+		cfg.ManagedNodeGroups = nil
+		cfg.SecretsEncryption.KeyARN = "secretsmanager:oidc#clientID"
+		if secretErr != nil {
+			provider.MockSecretsManager().On("GetSecretValue", mock.Anything, mock.Anything).Return(nil, secretErr)
+		} else {
+			mockGetSecretValue("oidc", secret)
+		}
+
+		err := eks.NewReferenceResolver(provider).Resolve(context.Background(), cfg)
+		Expect(err).To(MatchError(ContainSubstring(`resolving secretsEncryption.keyARN reference "secretsmanager:oidc#clientID": ` + expectedErr)))
+	},
+		Entry("missing secret", "", errors.New("ResourceNotFoundException"), `getting secret "oidc": ResourceNotFoundException`),
+		Entry("secret is not a JSON object", "client-1", nil, `secret "oidc" must hold a JSON object to look up key "clientID"`),
+		Entry("missing key", `{"id": "client-1"}`, nil, `secret "oidc" has no key "clientID"`),
+	)
+})
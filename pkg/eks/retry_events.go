@@ -0,0 +1,62 @@
+package eks
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+const (
+	retryEventsStateID = "eksctl/RetryEventsState"
+	retryEventsID      = "eksctl/RetryEvents"
+)
+
+type retryEventsStateKey struct{}
+
+// retryEventsState tracks the attempts of an AWS API call across the retry loop of the SDK
+type retryEventsState struct {
+	attempts int
+	lastErr  error
+}
+
+// addRetryEventsMiddleware emits a progress event for every retry of an AWS API call. The state of the call is
+// added before the retry loop of the SDK, and each attempt is observed within it.
+func addRetryEventsMiddleware(stack *middleware.Stack) error {
+	if _, ok := stack.Finalize.Get("Retry"); !ok {
+		return nil
+	}
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc(retryEventsStateID, func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return next.HandleInitialize(context.WithValue(ctx, retryEventsStateKey{}, &retryEventsState{}), in)
+	}), middleware.Before); err != nil {
+		return err
+	}
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(retryEventsID, func(
+		ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler,
+	) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		state, ok := ctx.Value(retryEventsStateKey{}).(*retryEventsState)
+		if !ok {
+			return next.HandleFinalize(ctx, in)
+		}
+		state.attempts++
+		if state.attempts > 1 {
+			event := tasks.Event{
+				Type:      tasks.EventAWSRetry,
+				Service:   awsmiddleware.GetServiceID(ctx),
+				Operation: awsmiddleware.GetOperationName(ctx),
+				Attempt:   state.attempts,
+			}
+			if state.lastErr != nil {
+				event.Error = state.lastErr.Error()
+			}
+			tasks.EmitEvent(ctx, event)
+		}
+		out, metadata, err := next.HandleFinalize(ctx, in)
+		state.lastErr = err
+		return out, metadata, err
+	}), "Retry", middleware.After)
+}
@@ -0,0 +1,97 @@
+package eks_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/smithy-go/middleware"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+type recordingEventSink struct {
+	mu     sync.Mutex
+	events []tasks.Event
+}
+
+func (s *recordingEventSink) Emit(event tasks.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+// throttlingHTTPClient fails the first failures requests with a 503 status code.
+type throttlingHTTPClient struct {
+	failures int
+	requests int
+}
+
+func (c *throttlingHTTPClient) Do(*http.Request) (*http.Response, error) {
+	c.requests++
+	if c.requests <= c.failures {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(
+		`<DescribeStacksResponse><DescribeStacksResult><Stacks></Stacks></DescribeStacksResult></DescribeStacksResponse>`,
+	))}, nil
+}
+
+var _ = Describe("AWS API retry events", func() {
+	var (
+		sink *recordingEventSink
+		ctx  context.Context
+	)
+
+	newClient := func(httpClient *throttlingHTTPClient) *cloudformation.Client {
+		return cloudformation.New(cloudformation.Options{
+			Region:      "us-west-2",
+			Credentials: aws.AnonymousCredentials{},
+			HTTPClient:  httpClient,
+			Retryer: retry.NewStandard(func(o *retry.StandardOptions) {
+				o.RateLimiter = ratelimit.None
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+			}),
+			APIOptions: []func(*middleware.Stack) error{eks.AddRetryEventsMiddleware},
+		})
+	}
+
+	BeforeEach(func() {
+		sink = &recordingEventSink{}
+		ctx = tasks.WithRunOptions(context.Background(), tasks.RunOptions{EventSink: sink})
+	})
+
+	It("emits an event for every retry of a call", func() {
+		httpClient := &throttlingHTTPClient{failures: 2}
+		_, err := newClient(httpClient).DescribeStacks(ctx, &cloudformation.DescribeStacksInput{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(httpClient.requests).To(Equal(3))
+
+		Expect(sink.events).To(HaveLen(2))
+		for i, e := range sink.events {
+			Expect(e.Type).To(Equal(tasks.EventAWSRetry))
+			Expect(e.Service).To(Equal("CloudFormation"))
+			Expect(e.Operation).To(Equal("DescribeStacks"))
+			Expect(e.Attempt).To(Equal(i + 2))
+			Expect(e.Error).To(ContainSubstring("503"))
+		}
+	})
+
+	It("does not emit events for calls that are not retried", func() {
+		_, err := newClient(&throttlingHTTPClient{}).DescribeStacks(ctx, &cloudformation.DescribeStacksInput{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink.events).To(BeEmpty())
+	})
+})
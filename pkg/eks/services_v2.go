@@ -14,6 +14,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/outposts"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/kris-nova/logger"
@@ -40,6 +42,8 @@ type ServicesV2 struct {
 	ec2                    *ec2.Client
 	eks                    *eks.Client
 	outposts               *outposts.Client
+	secretsManager         *secretsmanager.Client
+	s3                     *s3.Client
 }
 
 // STS implements the AWS STS service.
@@ -203,6 +207,34 @@ func (s *ServicesV2) Outposts() awsapi.Outposts {
 	return s.outposts
 }
 
+// SecretsManager returns the AWS Secrets Manager service.
+func (s *ServicesV2) SecretsManager() awsapi.SecretsManager {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.secretsManager == nil {
+		s.secretsManager = secretsmanager.NewFromConfig(s.config)
+	}
+	return s.secretsManager
+}
+
+// S3 returns the AWS S3 service.
+func (s *ServicesV2) S3() awsapi.S3 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s3 == nil {
+		s.s3 = s3.NewFromConfig(s.config, func(o *s3.Options) {
+			o.BaseEndpoint = getBaseEndpoint(s3.ServiceID, []string{
+				"AWS_S3_ENDPOINT",
+				"AWS_ENDPOINT_URL_S3",
+				"AWS_ENDPOINT_URL",
+			})
+			// S3-compatible stand-ins such as MinIO or LocalStack do not serve virtual-hosted-style requests
+			o.UsePathStyle = o.BaseEndpoint != nil
+		})
+	}
+	return s.s3
+}
+
 func (s *ServicesV2) AWSConfig() aws.Config {
 	return s.config
 }
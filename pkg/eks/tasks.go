@@ -90,7 +90,7 @@ func (v *VPCControllerTask) Do(errCh chan error) error {
 		return err
 	}
 
-	stackCollection := manager.NewStackCollection(v.ClusterProvider.AWSProvider, v.ClusterConfig)
+	stackCollection := manager.NewStackCollectionWithOptions(v.ClusterProvider.AWSProvider, v.ClusterConfig, v.ClusterProvider.StackCollectionOptions...)
 
 	clientSet, err := v.ClusterProvider.NewStdClientSet(v.ClusterConfig)
 	if err != nil {
@@ -177,16 +177,20 @@ func newEFADevicePluginTask(
 	return &t
 }
 
-// CreateExtraClusterConfigTasks returns all tasks for updating cluster configuration
-func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg *api.ClusterConfig, preNodeGroupAddons *tasks.TaskTree, updateVPCCNITask *tasks.GenericTask) *tasks.TaskTree {
-	newTasks := &tasks.TaskTree{
+// CreateExtraClusterConfigTasks returns all tasks for updating cluster configuration, to be run once the control
+// plane is created, along with the tasks among them that nodegroups must wait for
+func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg *api.ClusterConfig, preNodeGroupAddons *tasks.TaskTree, updateVPCCNITask *tasks.GenericTask) (*tasks.Graph, []tasks.Task) {
+	newTasks := &tasks.Graph{}
+	// the tasks that nodegroups wait for are run in sequence ahead of the others, as they refresh the cluster status
+	prerequisites := &tasks.TaskTree{
 		Parallel:  false,
 		IsSubTask: true,
 	}
-	if preNodeGroupAddons != nil {
-		newTasks.Append(preNodeGroupAddons)
+	if preNodeGroupAddons != nil && preNodeGroupAddons.Len() > 0 {
+		preNodeGroupAddons.IsSubTask = true
+		prerequisites.Append(preNodeGroupAddons)
 	}
-	newTasks.Append(&tasks.GenericTask{
+	prerequisites.Append(&tasks.GenericTask{
 		Description: "wait for control plane to become ready",
 		Doer: func() error {
 			clientSet, err := c.NewRawClient(cfg)
@@ -202,22 +206,72 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 			}
 			return c.RefreshClusterStatus(ctx, cfg)
 		},
+		Resumer: func() error {
+			return c.RefreshClusterStatus(ctx, cfg)
+		},
 	})
 	if cfg.IsAutoModeEnabled() && cfg.VPC != nil && cfg.VPC.ID != "" {
 		logger.Info("subnets supplied in subnets.private and subnets.public will be used for nodes launched by Auto Mode; please create a new NodeClass " +
 			"resource if you do not want to use cluster subnets")
 	}
 
+	if len(cfg.IAMIdentityMappings) > 0 {
+		prerequisites.Append(&tasks.GenericTask{
+			Description: "create IAM identity mappings",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return fmt.Errorf("error creating Clientset: %w", err)
+				}
+
+				rawClient, err := c.NewRawClient(cfg)
+				if err != nil {
+					return fmt.Errorf("error creating rawClient: %w", err)
+				}
+				m, err := iamidentitymapping.New(cfg, clientSet, rawClient, cfg.Metadata.Region)
+				if err != nil {
+					return fmt.Errorf("error initialising iamidentitymapping: %w", err)
+				}
+
+				for _, mapping := range cfg.IAMIdentityMappings {
+					if err := m.Create(ctx, mapping); err != nil {
+						return err
+					}
+				}
+				return c.RefreshClusterStatus(ctx, cfg)
+			},
+		})
+	}
+
+	if cfg.HasWindowsNodeGroup() {
+		prerequisites.Append(&WindowsIPAMTask{
+			Info: "enable Windows IP address management",
+			ClientsetFunc: func() (kubernetes.Interface, error) {
+				return c.NewStdClientSet(cfg)
+			},
+		})
+	}
+
+	newTasks.Add(prerequisites)
+	nodeGroupPrerequisites := []tasks.Task{prerequisites}
+
 	if api.IsEnabled(cfg.IAM.WithOIDC) {
-		c.appendCreateTasksForIAMServiceAccounts(ctx, cfg, newTasks)
+		serviceAccountTasks := &tasks.TaskTree{
+			Parallel:  false,
+			IsSubTask: true,
+		}
+		c.appendCreateTasksForIAMServiceAccounts(ctx, cfg, serviceAccountTasks)
+		newTasks.Add(serviceAccountTasks, prerequisites)
+		// nodes must not start with the VPC CNI using the node role while it is being switched to IRSA
 		if updateVPCCNITask != nil {
-			newTasks.Append(updateVPCCNITask)
+			newTasks.Add(updateVPCCNITask, serviceAccountTasks)
+			nodeGroupPrerequisites = append(nodeGroupPrerequisites, updateVPCCNITask)
 		}
 	}
 
 	if cfg.HasClusterCloudWatchLogging() {
 		if logRetentionDays := cfg.CloudWatch.ClusterLogging.LogRetentionInDays; logRetentionDays != 0 {
-			newTasks.Append(&clusterConfigTask{
+			newTasks.Add(&clusterConfigTask{
 				info: "update CloudWatch log retention",
 				spec: cfg,
 				call: func(clusterConfig *api.ClusterConfig) error {
@@ -238,57 +292,20 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 
 	if cfg.IsFargateEnabled() {
 		manager := fargate.NewFromProvider(cfg.Metadata.Name, c.AWSProvider, c.NewStackManager(cfg))
-		newTasks.Append(&fargateProfilesTask{
+		newTasks.Add(&fargateProfilesTask{
 			info:            "create fargate profiles",
 			spec:            cfg,
 			clusterProvider: c,
 			manager:         &manager,
 			ctx:             ctx,
-		})
+		}, prerequisites)
 	}
 
 	if len(cfg.IdentityProviders) > 0 {
-		newTasks.Append(identityproviders.NewAssociateProvidersTask(ctx, *cfg.Metadata, cfg.IdentityProviders, c.AWSProvider.EKS()))
-	}
-
-	if len(cfg.IAMIdentityMappings) > 0 {
-		newTasks.Append(&tasks.GenericTask{
-			Description: "create IAM identity mappings",
-			Doer: func() error {
-				clientSet, err := c.NewStdClientSet(cfg)
-				if err != nil {
-					return fmt.Errorf("error creating Clientset: %w", err)
-				}
-
-				rawClient, err := c.NewRawClient(cfg)
-				if err != nil {
-					return fmt.Errorf("error creating rawClient: %w", err)
-				}
-				m, err := iamidentitymapping.New(cfg, clientSet, rawClient, cfg.Metadata.Region)
-				if err != nil {
-					return fmt.Errorf("error initialising iamidentitymapping: %w", err)
-				}
-
-				for _, mapping := range cfg.IAMIdentityMappings {
-					if err := m.Create(ctx, mapping); err != nil {
-						return err
-					}
-				}
-				return c.RefreshClusterStatus(ctx, cfg)
-			},
-		})
-	}
-
-	if cfg.HasWindowsNodeGroup() {
-		newTasks.Append(&WindowsIPAMTask{
-			Info: "enable Windows IP address management",
-			ClientsetFunc: func() (kubernetes.Interface, error) {
-				return c.NewStdClientSet(cfg)
-			},
-		})
+		newTasks.Add(identityproviders.NewAssociateProvidersTask(ctx, *cfg.Metadata, cfg.IdentityProviders, c.AWSProvider.EKS()))
 	}
 
-	return newTasks
+	return newTasks, nodeGroupPrerequisites
 }
 
 // LogEnabledFeatures logs enabled features
@@ -426,6 +443,7 @@ func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(ctx context.Con
 	// given a clientSet getter and OpenIDConnectManager reference we can build out
 	// the list of tasks for each of the service accounts that need to be created
 	newTasks := c.NewStackManager(cfg).NewTasksToCreateIAMServiceAccounts(
+		ctx,
 		cfg.IAM.ServiceAccounts,
 		oidcPlaceholder,
 		clientSet,
@@ -1,10 +1,13 @@
 package eks
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
 var _ = Describe("ClusterTasksForNodeGroups", func() {
@@ -181,3 +184,53 @@ var _ = Describe("ClusterTasksForNodeGroups", func() {
 		})
 	}
 })
+
+var _ = Describe("CreateExtraClusterConfigTasks", func() {
+	It("only makes nodegroups wait for the tasks that must run before nodes join", func() {
+		cfg := v1alpha5.NewClusterConfig()
+		cfg.Metadata.Name = "dev"
+		cfg.IAM.WithOIDC = v1alpha5.Enabled()
+		cfg.CloudWatch.ClusterLogging = &v1alpha5.ClusterCloudWatchLogging{
+			EnableTypes:        []string{"api"},
+			LogRetentionInDays: 7,
+		}
+		cfg.IAMIdentityMappings = []*v1alpha5.IAMIdentityMapping{{ARN: "arn:aws:iam::123456789012:role/admin"}}
+		clusterProvider := &ClusterProvider{
+			AWSProvider: mockprovider.NewMockProvider(),
+		}
+
+		taskGraph, nodeGroupPrerequisites := clusterProvider.CreateExtraClusterConfigTasks(context.Background(), cfg, nil, nil)
+		Expect(nodeGroupPrerequisites).To(HaveLen(1))
+		prerequisites := nodeGroupPrerequisites[0].(*tasks.TaskTree)
+		Expect(prerequisites.Describe()).To(ContainSubstring("wait for control plane to become ready"))
+		Expect(prerequisites.Describe()).To(ContainSubstring("create IAM identity mappings"))
+
+		graphTasks := taskGraph.Tasks()
+		Expect(graphTasks).To(HaveLen(3))
+		Expect(graphTasks[0]).To(Equal(prerequisites))
+		Expect(graphTasks[1].Describe()).To(ContainSubstring("associate IAM OIDC provider"))
+		Expect(taskGraph.DependenciesOf(graphTasks[1])).To(Equal([]tasks.Task{prerequisites}))
+		Expect(graphTasks[2].Describe()).To(Equal("update CloudWatch log retention"))
+		Expect(taskGraph.DependenciesOf(graphTasks[2])).To(BeEmpty())
+	})
+
+	It("makes nodegroups wait for the VPC CNI to use IRSA", func() {
+		cfg := v1alpha5.NewClusterConfig()
+		cfg.Metadata.Name = "dev"
+		cfg.IAM.WithOIDC = v1alpha5.Enabled()
+		clusterProvider := &ClusterProvider{
+			AWSProvider: mockprovider.NewMockProvider(),
+		}
+		updateVPCCNI := &tasks.GenericTask{Description: "update VPC CNI to use IRSA if required"}
+
+		taskGraph, nodeGroupPrerequisites := clusterProvider.CreateExtraClusterConfigTasks(context.Background(), cfg, nil, updateVPCCNI)
+		Expect(nodeGroupPrerequisites).To(HaveLen(2))
+		Expect(nodeGroupPrerequisites[1]).To(Equal(updateVPCCNI))
+
+		graphTasks := taskGraph.Tasks()
+		Expect(graphTasks).To(HaveLen(3))
+		Expect(graphTasks[1].Describe()).To(ContainSubstring("associate IAM OIDC provider"))
+		Expect(graphTasks[2]).To(Equal(updateVPCCNI))
+		Expect(taskGraph.DependenciesOf(updateVPCCNI)).To(Equal([]tasks.Task{graphTasks[1]}))
+	})
+})
@@ -0,0 +1,165 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/cel-go/cel"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// RulesFile holds declarative rules.
+type RulesFile struct {
+	Rules []CELRuleSpec `json:"rules"`
+}
+
+// CELRuleSpec describes a rule whose CEL expression is evaluated against the config, which is available
+// as `config` in the JSON form of a ClusterConfig file. The expression must evaluate to true for configs
+// that comply with the rule.
+type CELRuleSpec struct {
+	ID string `json:"id"`
+	// Severity defaults to error.
+	Severity Severity `json:"severity,omitempty"`
+	// ForEach is a top-level list field, e.g. `managedNodeGroups`; when set, the expression is evaluated for
+	// each of its items, which is available as `item`.
+	ForEach    string `json:"forEach,omitempty"`
+	Expression string `json:"expression"`
+	// Path is the path of the field that is reported, relative to the item for ForEach rules.
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+	Fix     string `json:"fix,omitempty"`
+}
+
+type celRule struct {
+	spec    CELRuleSpec
+	program cel.Program
+}
+
+// LoadRules reads the rules in a rules file.
+func LoadRules(file string) ([]Rule, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading rules file %q: %w", file, err)
+	}
+	var rulesFile RulesFile
+	if err := yaml.UnmarshalStrict(data, &rulesFile); err != nil {
+		return nil, fmt.Errorf("loading rules file %q: %w", file, err)
+	}
+	var rules []Rule
+	for i, spec := range rulesFile.Rules {
+		rule, err := NewCELRule(spec)
+		if err != nil {
+			return nil, fmt.Errorf("loading rules file %q: rules[%d]: %w", file, i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// NewCELRule compiles a CEL rule.
+func NewCELRule(spec CELRuleSpec) (Rule, error) {
+	if spec.ID == "" {
+		return nil, errors.New("id must be set")
+	}
+	if spec.Expression == "" {
+		return nil, fmt.Errorf("rule %q: expression must be set", spec.ID)
+	}
+	if spec.Message == "" {
+		return nil, fmt.Errorf("rule %q: message must be set", spec.ID)
+	}
+	if spec.Severity == "" {
+		spec.Severity = SeverityError
+	}
+	if err := spec.Severity.validate(); err != nil {
+		return nil, fmt.Errorf("rule %q: %w", spec.ID, err)
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable("config", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("item", cel.DynType),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(spec.Expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("rule %q: compiling expression: %w", spec.ID, issues.Err())
+	}
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("rule %q: expression must evaluate to a bool, not %s", spec.ID, outputType)
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("rule %q: %w", spec.ID, err)
+	}
+	return &celRule{spec: spec, program: program}, nil
+}
+
+func (r *celRule) ID() string {
+	return r.spec.ID
+}
+
+func (r *celRule) Check(clusterConfig *api.ClusterConfig) ([]Finding, error) {
+	data, err := json.Marshal(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	if r.spec.ForEach == "" {
+		finding, err := r.evaluate(config, nil, r.spec.Path)
+		if err != nil || finding == nil {
+			return nil, err
+		}
+		return []Finding{*finding}, nil
+	}
+
+	var findings []Finding
+	items, _ := config[r.spec.ForEach].([]interface{})
+	for i, item := range items {
+		path := fmt.Sprintf("%s[%d]", r.spec.ForEach, i)
+		if r.spec.Path != "" {
+			path += "." + r.spec.Path
+		}
+		finding, err := r.evaluate(config, item, path)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", r.spec.ForEach, i, err)
+		}
+		if finding != nil {
+			findings = append(findings, *finding)
+		}
+	}
+	return findings, nil
+}
+
+// evaluate returns a finding if the expression does not evaluate to true.
+func (r *celRule) evaluate(config map[string]interface{}, item interface{}, path string) (*Finding, error) {
+	result, _, err := r.program.Eval(map[string]interface{}{
+		"config": config,
+		"item":   item,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("evaluating expression: %w", err)
+	}
+	complies, ok := result.Value().(bool)
+	if !ok {
+		return nil, fmt.Errorf("expression must evaluate to a bool, got %v", result.Value())
+	}
+	if complies {
+		return nil, nil
+	}
+	return &Finding{
+		RuleID:   r.spec.ID,
+		Severity: r.spec.Severity,
+		Path:     path,
+		Message:  r.spec.Message,
+		Fix:      r.spec.Fix,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package lint_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/lint"
+)
+
+var _ = Describe("CEL rules", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		for _, instanceType := range []string{"m7i.large", "t3.large"} {
+			mng := api.NewManagedNodeGroup()
+			mng.Name = instanceType
+			mng.InstanceType = instanceType
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+		}
+	})
+
+	It("reports the findings of rules in a rules file", func() {
+		rules, err := lint.LoadRules("testdata/rules.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		findings, err := lint.Lint(cfg, rules)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(Equal([]lint.Finding{
+			{
+				RuleID:   "team-tag",
+				Severity: lint.SeverityWarning,
+				Path:     "metadata.tags",
+				Message:  "clusters must be tagged with their team",
+				Fix:      "add a team tag",
+			},
+			{
+				RuleID:   "instance-type",
+				Severity: lint.SeverityError,
+				Path:     "managedNodeGroups[1].instanceType",
+				Message:  "managed nodegroups must use m7 instances",
+				Fix:      "set instanceType to an m7 instance type",
+			},
+		}))
+
+		cfg.Metadata.Tags = map[string]string{"team": "platform"}
+		cfg.ManagedNodeGroups = cfg.ManagedNodeGroups[:1]
+		findings, err = lint.Lint(cfg, rules)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	DescribeTable("invalid rules", func(spec lint.CELRuleSpec, expectedErr string) {
+		_, err := lint.NewCELRule(spec)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("missing ID", lint.CELRuleSpec{Expression: "true", Message: "m"}, "id must be set"),
+		Entry("missing expression", lint.CELRuleSpec{ID: "r", Message: "m"}, `rule "r": expression must be set`),
+		Entry("missing message", lint.CELRuleSpec{ID: "r", Expression: "true"}, `rule "r": message must be set`),
+		Entry("invalid severity", lint.CELRuleSpec{ID: "r", Expression: "true", Message: "m", Severity: "fatal"}, `rule "r": invalid severity "fatal"`),
+		Entry("invalid expression", lint.CELRuleSpec{ID: "r", Expression: "config.metadata.", Message: "m"}, `rule "r": compiling expression`),
+		Entry("non-bool expression", lint.CELRuleSpec{ID: "r", Expression: "1 + 1", Message: "m"}, `rule "r": expression must evaluate to a bool, not int`),
+	)
+
+	It("fails for expressions that do not evaluate to a bool", func() {
+		rule, err := lint.NewCELRule(lint.CELRuleSpec{ID: "r", Expression: "config.metadata.name", Message: "m"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = lint.Lint(cfg, []lint.Rule{rule})
+		Expect(err).To(MatchError(ContainSubstring(`checking rule "r": expression must evaluate to a bool`)))
+	})
+
+	It("fails for unknown fields in the rules file", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "rules.yaml")
+		Expect(os.WriteFile(file, []byte("rules:\n- id: r\n  expr: 'true'\n"), 0o600)).To(Succeed())
+		_, err := lint.LoadRules(file)
+		Expect(err).To(MatchError(ContainSubstring("loading rules file")))
+	})
+})
@@ -0,0 +1,70 @@
+// Package lint checks ClusterConfigs against best-practice and org-policy rules.
+package lint
+
+import (
+	"fmt"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Severity is the severity of a Finding.
+type Severity string
+
+// Values for Severity.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+func (s Severity) validate() error {
+	switch s {
+	case SeverityError, SeverityWarning, SeverityInfo:
+		return nil
+	default:
+		return fmt.Errorf("invalid severity %q, must be one of %q, %q or %q", s, SeverityError, SeverityWarning, SeverityInfo)
+	}
+}
+
+// A Finding is a violation of a rule by a ClusterConfig.
+type Finding struct {
+	RuleID   string   `json:"ruleID"`
+	Severity Severity `json:"severity"`
+	// Path is the path of the offending field, e.g. `managedNodeGroups[0].volumeEncrypted`.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+	// Fix suggests how to resolve the finding.
+	Fix string `json:"fix,omitempty"`
+}
+
+// A Rule checks a ClusterConfig.
+type Rule interface {
+	// ID returns the ID of the rule, which is reported with its findings.
+	ID() string
+	// Check returns the findings of the rule for clusterConfig.
+	Check(clusterConfig *api.ClusterConfig) ([]Finding, error)
+}
+
+// Lint checks clusterConfig against rules, and returns their findings in order.
+func Lint(clusterConfig *api.ClusterConfig, rules []Rule) ([]Finding, error) {
+	var findings []Finding
+	for _, rule := range rules {
+		ruleFindings, err := rule.Check(clusterConfig)
+		if err != nil {
+			return nil, fmt.Errorf("checking rule %q: %w", rule.ID(), err)
+		}
+		findings = append(findings, ruleFindings...)
+	}
+	return findings, nil
+}
+
+// CountErrors returns the number of error-level findings.
+func CountErrors(findings []Finding) int {
+	count := 0
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,209 @@
+package lint
+
+import (
+	"fmt"
+	"slices"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+// IDs of the built-in rules.
+const (
+	RuleVolumeEncryption            = "volume-encryption"
+	RulePublicEndpointCIDRs         = "public-endpoint-cidrs"
+	RuleIMDSv1                      = "imdsv1-enabled"
+	RuleDeprecatedAMIFamily         = "deprecated-ami-family"
+	RuleDeprecatedKubernetesVersion = "deprecated-kubernetes-version"
+)
+
+// deprecatedAMIFamilies maps deprecated AMI families to their replacements.
+var deprecatedAMIFamilies = map[string]string{
+	api.NodeImageFamilyAmazonLinux2:                   api.NodeImageFamilyAmazonLinux2023,
+	api.NodeImageFamilyUbuntu2004:                     api.NodeImageFamilyUbuntu2404,
+	api.NodeImageFamilyUbuntuPro2004:                  api.NodeImageFamilyUbuntuPro2404,
+	api.NodeImageFamilyWindowsServer2004CoreContainer: api.NodeImageFamilyWindowsServer2022CoreContainer,
+	api.NodeImageFamilyWindowsServer20H2CoreContainer: api.NodeImageFamilyWindowsServer2022CoreContainer,
+}
+
+// goRule is a rule written in Go against the v1alpha5 types.
+type goRule struct {
+	id string
+	// severity is used for findings that do not set their own severity
+	severity Severity
+	check    func(clusterConfig *api.ClusterConfig, report func(Finding))
+}
+
+func (r *goRule) ID() string {
+	return r.id
+}
+
+func (r *goRule) Check(clusterConfig *api.ClusterConfig) ([]Finding, error) {
+	var findings []Finding
+	r.check(clusterConfig, func(finding Finding) {
+		finding.RuleID = r.id
+		if finding.Severity == "" {
+			finding.Severity = r.severity
+		}
+		findings = append(findings, finding)
+	})
+	return findings, nil
+}
+
+// BuiltInRules returns the built-in rules; the Kubernetes version is only checked if versionsManager is set.
+func BuiltInRules(versionsManager eks.ClusterVersionsManagerInterface) []Rule {
+	rules := []Rule{
+		&goRule{
+			id:       RuleVolumeEncryption,
+			severity: SeverityError,
+			check:    checkVolumeEncryption,
+		},
+		&goRule{
+			id:       RulePublicEndpointCIDRs,
+			severity: SeverityError,
+			check:    checkPublicEndpointCIDRs,
+		},
+		&goRule{
+			id:       RuleIMDSv1,
+			severity: SeverityWarning,
+			check:    checkIMDSv1,
+		},
+		&goRule{
+			id:       RuleDeprecatedAMIFamily,
+			severity: SeverityWarning,
+			check:    checkDeprecatedAMIFamilies,
+		},
+	}
+	if versionsManager != nil {
+		rules = append(rules, &goRule{
+			id:       RuleDeprecatedKubernetesVersion,
+			severity: SeverityError,
+			check: func(clusterConfig *api.ClusterConfig, report func(Finding)) {
+				checkKubernetesVersion(clusterConfig, versionsManager, report)
+			},
+		})
+	}
+	return rules
+}
+
+func checkVolumeEncryption(clusterConfig *api.ClusterConfig, report func(Finding)) {
+	forEachNodeGroup(clusterConfig, func(path string, np api.NodePool) {
+		if mng, ok := np.(*api.ManagedNodeGroup); ok && mng.LaunchTemplate != nil {
+			// volumes are configured in the user-supplied launch template
+			return
+		}
+		ng := np.BaseNodeGroup()
+		if !api.IsEnabled(ng.VolumeEncrypted) {
+			report(Finding{
+				Path:    path + ".volumeEncrypted",
+				Message: fmt.Sprintf("nodegroup %q does not encrypt its volumes", ng.Name),
+				Fix:     "set volumeEncrypted: true",
+			})
+		}
+	})
+}
+
+func checkPublicEndpointCIDRs(clusterConfig *api.ClusterConfig, report func(Finding)) {
+	if clusterConfig.IsControlPlaneOnOutposts() {
+		return
+	}
+	vpc := clusterConfig.VPC
+	if vpc == nil {
+		vpc = &api.ClusterVPC{}
+	}
+	endpoints := vpc.ClusterEndpoints
+	if endpoints == nil {
+		endpoints = api.ClusterEndpointAccessDefaults()
+	}
+	if endpoints.PublicAccess != nil && !*endpoints.PublicAccess {
+		return
+	}
+	if len(vpc.PublicAccessCIDRs) == 0 {
+		report(Finding{
+			Path:    "vpc.publicAccessCIDRs",
+			Message: "the cluster endpoint is publicly accessible from any address",
+			Fix:     "restrict vpc.publicAccessCIDRs, or set vpc.clusterEndpoints.publicAccess: false and privateAccess: true",
+		})
+		return
+	}
+	for i, cidr := range vpc.PublicAccessCIDRs {
+		if cidr == "0.0.0.0/0" || cidr == "::/0" {
+			report(Finding{
+				Path:    fmt.Sprintf("vpc.publicAccessCIDRs[%d]", i),
+				Message: fmt.Sprintf("the cluster endpoint is publicly accessible from %s", cidr),
+				Fix:     "restrict vpc.publicAccessCIDRs to the CIDRs that need access",
+			})
+		}
+	}
+}
+
+func checkIMDSv1(clusterConfig *api.ClusterConfig, report func(Finding)) {
+	forEachNodeGroup(clusterConfig, func(path string, np api.NodePool) {
+		ng := np.BaseNodeGroup()
+		if api.IsDisabled(ng.DisableIMDSv1) {
+			report(Finding{
+				Path:    path + ".disableIMDSv1",
+				Message: fmt.Sprintf("nodegroup %q allows IMDSv1 requests to the instance metadata service", ng.Name),
+				Fix:     "remove disableIMDSv1 or set it to true",
+			})
+		}
+	})
+}
+
+func checkDeprecatedAMIFamilies(clusterConfig *api.ClusterConfig, report func(Finding)) {
+	forEachNodeGroup(clusterConfig, func(path string, np api.NodePool) {
+		ng := np.BaseNodeGroup()
+		replacement, deprecated := deprecatedAMIFamilies[ng.AMIFamily]
+		if !deprecated {
+			return
+		}
+		finding := Finding{
+			Path:    path + ".amiFamily",
+			Message: fmt.Sprintf("nodegroup %q uses the deprecated AMI family %s", ng.Name, ng.AMIFamily),
+			Fix:     fmt.Sprintf("use amiFamily: %s", replacement),
+		}
+		if !slices.Contains(api.SupportedAMIFamilies(), ng.AMIFamily) {
+			finding.Severity = SeverityError
+			finding.Message = fmt.Sprintf("nodegroup %q uses the AMI family %s, which is no longer supported", ng.Name, ng.AMIFamily)
+		} else if ng.AMIFamily == api.NodeImageFamilyAmazonLinux2 && clusterConfig.Metadata.Version != "" {
+			if eol, err := utils.IsMinVersion(api.AmazonLinux2EOLVersion, clusterConfig.Metadata.Version); err == nil && eol {
+				finding.Severity = SeverityError
+				finding.Message = fmt.Sprintf("nodegroup %q uses the AMI family %s, which is not available for Kubernetes %s", ng.Name, ng.AMIFamily, clusterConfig.Metadata.Version)
+			}
+		}
+		report(finding)
+	})
+}
+
+func checkKubernetesVersion(clusterConfig *api.ClusterConfig, versionsManager eks.ClusterVersionsManagerInterface, report func(Finding)) {
+	version := clusterConfig.Metadata.Version
+	if version == "" || version == "auto" || version == "latest" {
+		return
+	}
+	fix := fmt.Sprintf("set metadata.version to a supported version, e.g. %q", versionsManager.DefaultVersion())
+	switch {
+	case versionsManager.IsDeprecatedVersion(version):
+		report(Finding{
+			Path:    "metadata.version",
+			Message: fmt.Sprintf("Kubernetes version %s is deprecated", version),
+			Fix:     fix,
+		})
+	case !versionsManager.IsSupportedVersion(version):
+		report(Finding{
+			Path:    "metadata.version",
+			Message: fmt.Sprintf("Kubernetes version %s is not supported", version),
+			Fix:     fix,
+		})
+	}
+}
+
+// forEachNodeGroup calls fn for each nodegroup and managed nodegroup, with its path in the config.
+func forEachNodeGroup(clusterConfig *api.ClusterConfig, fn func(path string, np api.NodePool)) {
+	for i, ng := range clusterConfig.NodeGroups {
+		fn(fmt.Sprintf("nodeGroups[%d]", i), ng)
+	}
+	for i, ng := range clusterConfig.ManagedNodeGroups {
+		fn(fmt.Sprintf("managedNodeGroups[%d]", i), ng)
+	}
+}
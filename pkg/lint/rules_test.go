@@ -0,0 +1,201 @@
+package lint_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks/fakes"
+	"github.com/weaveworks/eksctl/pkg/lint"
+)
+
+var _ = Describe("built-in rules", func() {
+	newClusterConfig := func() *api.ClusterConfig {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		cfg.Metadata.Version = "1.32"
+		cfg.VPC.PublicAccessCIDRs = []string{"192.0.2.0/24"}
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng"
+		ng.VolumeEncrypted = api.Enabled()
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng"
+		mng.VolumeEncrypted = api.Enabled()
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+		return cfg
+	}
+
+	type ruleEntry struct {
+		updateClusterConfig func(*api.ClusterConfig)
+		deprecatedVersion   bool
+		unsupportedVersion  bool
+
+		expectedFindings []lint.Finding
+	}
+
+	DescribeTable("linting a ClusterConfig", func(e ruleEntry) {
+		cfg := newClusterConfig()
+		if e.updateClusterConfig != nil {
+			e.updateClusterConfig(cfg)
+		}
+		versionsManager := &fakes.FakeClusterVersionsManagerInterface{}
+		versionsManager.DefaultVersionReturns("1.32")
+		versionsManager.IsDeprecatedVersionReturns(e.deprecatedVersion)
+		versionsManager.IsSupportedVersionReturns(!e.unsupportedVersion)
+
+		findings, err := lint.Lint(cfg, lint.BuiltInRules(versionsManager))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(Equal(e.expectedFindings))
+	},
+		Entry("compliant config", ruleEntry{}),
+
+		Entry("unencrypted volumes", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.NodeGroups[0].VolumeEncrypted = nil
+				c.ManagedNodeGroups[0].VolumeEncrypted = api.Disabled()
+			},
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RuleVolumeEncryption,
+					Severity: lint.SeverityError,
+					Path:     "nodeGroups[0].volumeEncrypted",
+					Message:  `nodegroup "ng" does not encrypt its volumes`,
+					Fix:      "set volumeEncrypted: true",
+				},
+				{
+					RuleID:   lint.RuleVolumeEncryption,
+					Severity: lint.SeverityError,
+					Path:     "managedNodeGroups[0].volumeEncrypted",
+					Message:  `nodegroup "mng" does not encrypt its volumes`,
+					Fix:      "set volumeEncrypted: true",
+				},
+			},
+		}),
+
+		Entry("managed nodegroup with a launch template", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.ManagedNodeGroups[0].VolumeEncrypted = nil
+				c.ManagedNodeGroups[0].LaunchTemplate = &api.LaunchTemplate{ID: "lt-1234"}
+			},
+		}),
+
+		Entry("public endpoint without publicAccessCIDRs", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.PublicAccessCIDRs = nil
+			},
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RulePublicEndpointCIDRs,
+					Severity: lint.SeverityError,
+					Path:     "vpc.publicAccessCIDRs",
+					Message:  "the cluster endpoint is publicly accessible from any address",
+					Fix:      "restrict vpc.publicAccessCIDRs, or set vpc.clusterEndpoints.publicAccess: false and privateAccess: true",
+				},
+			},
+		}),
+
+		Entry("public endpoint open to all addresses", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.PublicAccessCIDRs = []string{"192.0.2.0/24", "0.0.0.0/0"}
+			},
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RulePublicEndpointCIDRs,
+					Severity: lint.SeverityError,
+					Path:     "vpc.publicAccessCIDRs[1]",
+					Message:  "the cluster endpoint is publicly accessible from 0.0.0.0/0",
+					Fix:      "restrict vpc.publicAccessCIDRs to the CIDRs that need access",
+				},
+			},
+		}),
+
+		Entry("private endpoint without publicAccessCIDRs", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.PublicAccessCIDRs = nil
+				c.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+					PublicAccess:  api.Disabled(),
+					PrivateAccess: api.Enabled(),
+				}
+			},
+		}),
+
+		Entry("IMDSv1 enabled", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.ManagedNodeGroups[0].DisableIMDSv1 = api.Disabled()
+			},
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RuleIMDSv1,
+					Severity: lint.SeverityWarning,
+					Path:     "managedNodeGroups[0].disableIMDSv1",
+					Message:  `nodegroup "mng" allows IMDSv1 requests to the instance metadata service`,
+					Fix:      "remove disableIMDSv1 or set it to true",
+				},
+			},
+		}),
+
+		Entry("deprecated AMI family", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.Metadata.Version = "1.32"
+				c.NodeGroups[0].AMIFamily = api.NodeImageFamilyUbuntu2004
+			},
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RuleDeprecatedAMIFamily,
+					Severity: lint.SeverityWarning,
+					Path:     "nodeGroups[0].amiFamily",
+					Message:  "nodegroup \"ng\" uses the deprecated AMI family Ubuntu2004",
+					Fix:      "use amiFamily: Ubuntu2404",
+				},
+			},
+		}),
+
+		Entry("AmazonLinux2 past its end of support", ruleEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.Metadata.Version = api.AmazonLinux2EOLVersion
+				c.ManagedNodeGroups[0].AMIFamily = api.NodeImageFamilyAmazonLinux2
+			},
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RuleDeprecatedAMIFamily,
+					Severity: lint.SeverityError,
+					Path:     "managedNodeGroups[0].amiFamily",
+					Message:  "nodegroup \"mng\" uses the AMI family AmazonLinux2, which is not available for Kubernetes " + api.AmazonLinux2EOLVersion,
+					Fix:      "use amiFamily: AmazonLinux2023",
+				},
+			},
+		}),
+
+		Entry("deprecated Kubernetes version", ruleEntry{
+			deprecatedVersion: true,
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RuleDeprecatedKubernetesVersion,
+					Severity: lint.SeverityError,
+					Path:     "metadata.version",
+					Message:  "Kubernetes version 1.32 is deprecated",
+					Fix:      `set metadata.version to a supported version, e.g. "1.32"`,
+				},
+			},
+		}),
+
+		Entry("unsupported Kubernetes version", ruleEntry{
+			unsupportedVersion: true,
+			expectedFindings: []lint.Finding{
+				{
+					RuleID:   lint.RuleDeprecatedKubernetesVersion,
+					Severity: lint.SeverityError,
+					Path:     "metadata.version",
+					Message:  "Kubernetes version 1.32 is not supported",
+					Fix:      `set metadata.version to a supported version, e.g. "1.32"`,
+				},
+			},
+		}),
+	)
+
+	It("skips the Kubernetes version rule without a versions manager", func() {
+		for _, rule := range lint.BuiltInRules(nil) {
+			Expect(rule.ID()).NotTo(Equal(lint.RuleDeprecatedKubernetesVersion))
+		}
+	})
+})
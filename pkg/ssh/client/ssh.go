@@ -24,24 +24,13 @@ import (
 // LoadKeyFromFile loads and imports a public SSH key from a file provided a path to that file.
 // returns the name of the key
 func LoadKeyFromFile(ctx context.Context, filePath, clusterName, ngName string, ec2API awsapi.EC2) (string, error) {
-	if !file.Exists(filePath) {
-		return "", fmt.Errorf("SSH public key file %q not found", filePath)
-	}
-
-	expandedPath := file.ExpandPath(filePath)
-	fileContent, err := readFileContents(expandedPath)
+	key, fingerprint, err := readKeyFile(filePath)
 	if err != nil {
-		return "", fmt.Errorf("reading SSH public key file %q: %w", filePath, err)
-	}
-
-	fingerprint, err := fingerprint(fileContent)
-	if err != nil {
-		return "", fmt.Errorf("parsing SSH public key %q: %w", filePath, err)
+		return "", err
 	}
 
-	key := string(fileContent)
 	keyName := getKeyName(clusterName, ngName, fingerprint)
-	logger.Info("using SSH public key %q as %q ", expandedPath, keyName)
+	logger.Info("using SSH public key %q as %q ", file.ExpandPath(filePath), keyName)
 
 	// Import SSH key in EC2
 	if err := importKey(ctx, keyName, fingerprint, &key, ec2API); err != nil {
@@ -50,6 +39,32 @@ func LoadKeyFromFile(ctx context.Context, filePath, clusterName, ngName string,
 	return keyName, nil
 }
 
+// KeyNameFromFile returns the name the public SSH key in a file is imported into EC2 as, without importing it
+func KeyNameFromFile(filePath, clusterName, ngName string) (string, error) {
+	_, fingerprint, err := readKeyFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	return getKeyName(clusterName, ngName, fingerprint), nil
+}
+
+func readKeyFile(filePath string) (key, keyFingerprint string, err error) {
+	if !file.Exists(filePath) {
+		return "", "", fmt.Errorf("SSH public key file %q not found", filePath)
+	}
+
+	fileContent, err := readFileContents(file.ExpandPath(filePath))
+	if err != nil {
+		return "", "", fmt.Errorf("reading SSH public key file %q: %w", filePath, err)
+	}
+
+	keyFingerprint, err = fingerprint(fileContent)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing SSH public key %q: %w", filePath, err)
+	}
+	return string(fileContent), keyFingerprint, nil
+}
+
 func fingerprint(key []byte) (string, error) {
 	pk, _, _, _, err := ssh.ParseAuthorizedKey(key)
 	if err != nil {
@@ -85,6 +100,15 @@ func LoadKeyByContent(ctx context.Context, key *string, clusterName, ngName stri
 	return keyName, nil
 }
 
+// KeyNameByContent returns the name an SSH public key is imported into EC2 as, without importing it
+func KeyNameByContent(key *string, clusterName, ngName string) (string, error) {
+	fingerprint, err := fingerprint([]byte(*key))
+	if err != nil {
+		return "", fmt.Errorf("parsing SSH public key \"%q\": %w", *key, err)
+	}
+	return getKeyName(clusterName, ngName, fingerprint), nil
+}
+
 // DeleteKeys will delete the public SSH key, if it exists
 func DeleteKeys(ctx context.Context, ec2API awsapi.EC2, clusterName string) {
 	existing, err := ec2API.DescribeKeyPairs(ctx, &ec2.DescribeKeyPairsInput{})
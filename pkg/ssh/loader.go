@@ -57,3 +57,22 @@ func LoadKey(ctx context.Context, sshConfig *api.NodeGroupSSH, clusterName, node
 	}
 
 }
+
+// KeyName returns the name of the SSH public key specified in NodeGroupSSH, as LoadKey does, but without
+// importing the key into EC2 or checking that it exists there
+func KeyName(sshConfig *api.NodeGroupSSH, clusterName, nodeGroupName string) (string, error) {
+	if sshConfig.Allow == nil || !*sshConfig.Allow {
+		return "", nil
+	}
+
+	switch {
+	case sshConfig.PublicKey != nil:
+		return client.KeyNameByContent(sshConfig.PublicKey, clusterName, nodeGroupName)
+	case sshConfig.PublicKeyName != nil && *sshConfig.PublicKeyName != "":
+		return *sshConfig.PublicKeyName, nil
+	case file.Exists(*sshConfig.PublicKeyPath):
+		return client.KeyNameFromFile(*sshConfig.PublicKeyPath, clusterName, nodeGroupName)
+	default:
+		return *sshConfig.PublicKeyPath, nil
+	}
+}
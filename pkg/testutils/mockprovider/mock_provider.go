@@ -52,6 +52,9 @@ type MockProvider struct {
 	iam          *mocksv2.IAM
 	ec2          *mocksv2.EC2
 	outposts     *mocksv2.Outposts
+
+	secretsManager *mocksv2.SecretsManager
+	s3             *mocksv2.S3
 }
 
 // NewMockProvider returns a new MockProvider
@@ -72,6 +75,8 @@ func NewMockProvider() *MockProvider {
 		iam:                 &mocksv2.IAM{},
 		ec2:                 &mocksv2.EC2{},
 		outposts:            &mocksv2.Outposts{},
+		secretsManager:      &mocksv2.SecretsManager{},
+		s3:                  &mocksv2.S3{},
 		credentialsProvider: &mocksv2.CredentialsProvider{},
 	}
 }
@@ -129,6 +134,11 @@ func (m MockProvider) CloudFormationDisableRollback() bool {
 	return false
 }
 
+// CloudFormationTemplateBucket returns, if any, the S3 bucket used to stage large templates
+func (m MockProvider) CloudFormationTemplateBucket() string {
+	return ""
+}
+
 // ASG returns a representation of the ASG API
 func (m MockProvider) ASG() awsapi.ASG { return m.asg }
 
@@ -191,6 +201,22 @@ func (m MockProvider) MockOutposts() *mocksv2.Outposts {
 	return m.outposts
 }
 
+// SecretsManager returns a representation of the Secrets Manager API
+func (m MockProvider) SecretsManager() awsapi.SecretsManager { return m.secretsManager }
+
+// MockSecretsManager returns a mocked Secrets Manager API
+func (m MockProvider) MockSecretsManager() *mocksv2.SecretsManager {
+	return m.secretsManager
+}
+
+// S3 returns a representation of the S3 API
+func (m MockProvider) S3() awsapi.S3 { return m.s3 }
+
+// MockS3 returns a mocked S3 API
+func (m MockProvider) MockS3() *mocksv2.S3 {
+	return m.s3
+}
+
 // Profile returns current profile setting
 func (m MockProvider) Profile() api.Profile { return ProviderConfig.Profile }
 
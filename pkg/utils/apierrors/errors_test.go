@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 	"github.com/weaveworks/eksctl/pkg/utils/apierrors"
 
@@ -58,4 +60,23 @@ var _ = Describe("APIErrors", func() {
 			shouldBeRetried: false,
 		}),
 	)
+
+	DescribeTable("IsRetryableAWSError", func(err error, retryable bool) {
+		Expect(apierrors.IsRetryableAWSError(err)).To(Equal(retryable))
+	},
+		Entry("non API error", fmt.Errorf("Non API Error"), false),
+		Entry("throttling", fmt.Errorf("creating addon: %w", &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}), true),
+		Entry("EC2 request limit", &smithy.GenericAPIError{Code: "RequestLimitExceeded"}, true),
+		Entry("ServiceUnavailableException", &ekstypes.ServiceUnavailableException{}, true),
+		Entry("insufficient capacity", &smithy.GenericAPIError{Code: "InsufficientInstanceCapacity"}, true),
+		Entry("role that has not propagated", &ekstypes.InvalidParameterException{
+			Message: aws.String("Role arn:aws:iam::123456789012:role/addon cannot be assumed by the addon"),
+		}, true),
+		Entry("instance profile that has not propagated", &smithy.GenericAPIError{
+			Code:    "InvalidParameterValue",
+			Message: "Value (eksctl-test-nodegroup-ng-1-NodeInstanceProfile) for parameter iamInstanceProfile.name is invalid. Invalid IAM Instance Profile name",
+		}, true),
+		Entry("invalid parameter", &ekstypes.InvalidParameterException{Message: aws.String("the addon version is not supported")}, false),
+		Entry("access denied", &ekstypes.AccessDeniedException{}, false),
+	)
 })
@@ -0,0 +1,72 @@
+package apierrors
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+var transientErrorCodes = []string{
+	"InternalFailure", "InternalError", "InternalServerError", "InternalServerException", "ServerException",
+	"ServiceUnavailable", "ServiceUnavailableException", "RequestTimeout", "RequestTimeoutException",
+}
+
+var insufficientCapacityErrorCodes = []string{
+	"InsufficientInstanceCapacity", "InsufficientCapacity", "InsufficientHostCapacity", "InsufficientReservedInstanceCapacity",
+}
+
+// iamEventualConsistencyErrorCodes are the codes of the errors returned when using an IAM role or instance profile
+// that was just created, before it has propagated; they are only retryable with one of iamEventualConsistencyMessages
+var (
+	iamEventualConsistencyErrorCodes = []string{
+		"InvalidParameterException", "InvalidParameterValueException", "InvalidParameterValue", "InvalidRequestException",
+		"MalformedPolicyDocument", "NoSuchEntity", "ValidationError",
+	}
+	iamEventualConsistencyMessages = []string{
+		"cannot be assumed", "unable to assume", "could not assume", "instance profile", "invalid principal", "role",
+	}
+)
+
+// IsRetryableAWSError reports whether err is an AWS API error that is likely to be transient: throttling, server
+// errors, insufficient EC2 capacity, or the use of an IAM role that has not propagated yet.
+func IsRetryableAWSError(err error) bool {
+	return IsThrottlingError(err) || IsTransientServerError(err) || IsInsufficientCapacityError(err) || IsIAMEventualConsistencyError(err)
+}
+
+// IsThrottlingError reports whether err is an AWS API error caused by the request being throttled.
+func IsThrottlingError(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	_, ok := retry.DefaultThrottleErrorCodes[apiErr.ErrorCode()]
+	return ok
+}
+
+// IsTransientServerError reports whether err is an AWS API error caused by a server error or a request timeout.
+func IsTransientServerError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && slices.Contains(transientErrorCodes, apiErr.ErrorCode())
+}
+
+// IsInsufficientCapacityError reports whether err is an AWS API error caused by EC2 not having enough capacity.
+func IsInsufficientCapacityError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && slices.Contains(insufficientCapacityErrorCodes, apiErr.ErrorCode())
+}
+
+// IsIAMEventualConsistencyError reports whether err is an AWS API error caused by an IAM role or instance profile
+// that was just created and has not propagated yet.
+func IsIAMEventualConsistencyError(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) || !slices.Contains(iamEventualConsistencyErrorCodes, apiErr.ErrorCode()) {
+		return false
+	}
+	message := strings.ToLower(apiErr.ErrorMessage())
+	return slices.ContainsFunc(iamEventualConsistencyMessages, func(m string) bool {
+		return strings.Contains(message, m)
+	})
+}
@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// EventType is the type of a progress event.
+type EventType string
+
+// Types of progress events.
+const (
+	EventTaskStarted  EventType = "taskStarted"
+	EventTaskFinished EventType = "taskFinished"
+	EventTaskFailed   EventType = "taskFailed"
+	EventTaskRetrying EventType = "taskRetrying"
+	EventStackStatus  EventType = "stackStatus"
+	EventAWSRetry     EventType = "awsRetry"
+)
+
+// ProgressFormatJSONLines is the progress format that writes an event per line as JSON.
+const ProgressFormatJSONLines = "jsonl"
+
+// An Event reports the progress of a command. Only the fields relevant to its type are set.
+type Event struct {
+	Time time.Time `json:"time"`
+	Type EventType `json:"type"`
+
+	// Task is the description of the task of task events
+	Task     string    `json:"task,omitempty"`
+	Metadata *Metadata `json:"metadata,omitempty"`
+	// DurationSeconds is the time the task took to finish or fail
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+	Error           string  `json:"error,omitempty"`
+
+	// StackName, Status and PreviousStatus are set for stack status transitions
+	StackName      string `json:"stackName,omitempty"`
+	Status         string `json:"status,omitempty"`
+	PreviousStatus string `json:"previousStatus,omitempty"`
+
+	// Service, Operation and Attempt are set for AWS API retries, and Attempt for task retries; Attempt is the
+	// number of the attempt about to be made, and Error the error of the previous attempt
+	Service   string `json:"service,omitempty"`
+	Operation string `json:"operation,omitempty"`
+	Attempt   int    `json:"attempt,omitempty"`
+}
+
+// An EventSink receives the progress events of a command.
+// Events are emitted concurrently, so implementations must be safe for concurrent use.
+type EventSink interface {
+	Emit(event Event)
+}
+
+// JSONLinesEventSink writes events to a writer as JSON, one per line.
+type JSONLinesEventSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONLinesEventSink returns a sink that writes events to w.
+func NewJSONLinesEventSink(w io.Writer) *JSONLinesEventSink {
+	return &JSONLinesEventSink{encoder: json.NewEncoder(w)}
+}
+
+// Emit writes event as a line of JSON; write errors are ignored, as they must not fail the command.
+func (s *JSONLinesEventSink) Emit(event Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.encoder.Encode(event)
+}
+
+// NewEventSink returns the sink for the given progress format, writing to w.
+func NewEventSink(format string, w io.Writer) (EventSink, error) {
+	switch format {
+	case ProgressFormatJSONLines:
+		return NewJSONLinesEventSink(w), nil
+	default:
+		return nil, fmt.Errorf("unknown progress format %q (valid option: %s)", format, ProgressFormatJSONLines)
+	}
+}
+
+// EmitEvent emits event to the sink of the RunOptions carried by ctx, if any, setting its time if unset.
+func EmitEvent(ctx context.Context, event Event) {
+	sink := RunOptionsFrom(ctx).EventSink
+	if sink == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	sink.Emit(event)
+}
+
+func emitTaskEvent(ctx context.Context, eventType EventType, task Task, desc string, duration time.Duration, err error) {
+	EmitEvent(ctx, newTaskEvent(eventType, task, desc, duration, err))
+}
+
+func newTaskEvent(eventType EventType, task Task, desc string, duration time.Duration, err error) Event {
+	event := Event{
+		Type: eventType,
+		Task: compact(desc),
+	}
+	if m, ok := task.(MetadataProvider); ok {
+		metadata := m.TaskMetadata()
+		metadata.ChangeSet = nil
+		if metadata != (Metadata{}) {
+			event.Metadata = &metadata
+		}
+	}
+	if eventType != EventTaskStarted {
+		event.DurationSeconds = duration.Seconds()
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	return event
+}
@@ -0,0 +1,98 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Progress events", func() {
+	var (
+		out *bytes.Buffer
+		ctx context.Context
+	)
+
+	events := func() []Event {
+		var events []Event
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			var e Event
+			Expect(json.Unmarshal([]byte(line), &e)).To(Succeed())
+			events = append(events, e)
+		}
+		return events
+	}
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		sink, err := NewEventSink(ProgressFormatJSONLines, out)
+		Expect(err).NotTo(HaveOccurred())
+		ctx = WithRunOptions(context.Background(), RunOptions{EventSink: sink})
+	})
+
+	It("emits an event when each task starts, finishes and fails", func() {
+		tree := &TaskTree{Context: ctx, Tasks: []Task{
+			&GenericTask{
+				Description: "create nodegroup ng-1",
+				Metadata:    Metadata{Action: "create", ResourceType: "nodeGroup", ResourceName: "ng-1"},
+				Doer: func() error {
+					time.Sleep(10 * time.Millisecond)
+					return nil
+				},
+			},
+			&GenericTask{
+				Description: "create addon vpc-cni",
+				Doer: func() error {
+					return errors.New("addon failed")
+				},
+			},
+		}}
+		Expect(tree.DoAllSync()).To(HaveLen(1))
+
+		emitted := events()
+		Expect(emitted).To(HaveLen(4))
+		Expect(emitted[0].Type).To(Equal(EventTaskStarted))
+		Expect(emitted[0].Task).To(Equal("create nodegroup ng-1"))
+		Expect(emitted[0].Metadata).To(Equal(&Metadata{Action: "create", ResourceType: "nodeGroup", ResourceName: "ng-1"}))
+		Expect(emitted[0].Time).NotTo(BeZero())
+		Expect(emitted[1].Type).To(Equal(EventTaskFinished))
+		Expect(emitted[1].DurationSeconds).To(BeNumerically(">=", 0.01))
+		Expect(emitted[2].Type).To(Equal(EventTaskStarted))
+		Expect(emitted[2].Metadata).To(BeNil())
+		Expect(emitted[3].Type).To(Equal(EventTaskFailed))
+		Expect(emitted[3].Task).To(Equal("create addon vpc-cni"))
+		Expect(emitted[3].Error).To(Equal("addon failed"))
+	})
+
+	It("emits the events of tasks run in parallel with a limit", func() {
+		tree := &TaskTree{Parallel: true, Limit: 1, Context: ctx}
+		for range 3 {
+			tree.Append(&GenericTask{Description: "task", Doer: func() error { return nil }})
+		}
+		Expect(tree.DoAllSync()).To(BeEmpty())
+
+		var finished int
+		for _, e := range events() {
+			if e.Type == EventTaskFinished {
+				finished++
+			}
+		}
+		Expect(finished).To(Equal(3))
+	})
+
+	It("does not emit events for task trees in plan mode", func() {
+		tree := &TaskTree{PlanMode: true, Context: ctx, Tasks: []Task{&GenericTask{Description: "task", Doer: func() error { return nil }}}}
+		Expect(tree.DoAllSync()).To(BeEmpty())
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("rejects unknown formats", func() {
+		_, err := NewEventSink("xml", out)
+		Expect(err).To(MatchError(`unknown progress format "xml" (valid option: jsonl)`))
+	})
+})
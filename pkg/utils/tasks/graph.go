@@ -0,0 +1,344 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kris-nova/logger"
+)
+
+// Graph runs a set of tasks in the order of the dependencies between them; a task is started as soon as all the
+// tasks it depends on have completed, instead of waiting for unrelated tasks as in a TaskTree.
+// Tasks that depend on a failed task are not run, and are reported as skipped, while tasks that do not depend on it
+// carry on.
+type Graph struct {
+	PlanMode bool
+	// Limit is the maximum number of tasks to run at the same time, or 0 for no limit; a TaskTree added as a
+	// single task counts as one
+	Limit int
+	// Context is the context the tasks run in, which carries their RunOptions; context.Background() if nil.
+	// A graph that is a task of a task tree or another graph runs in the context of that one instead.
+	Context context.Context
+
+	nodes []*graphNode
+	index map[Task]*graphNode
+	err   error
+}
+
+type graphNode struct {
+	id         string
+	task       Task
+	dependsOn  []*graphNode
+	dependents []*graphNode
+	// afterAny is set for tasks that run once any of the tasks they depend on has completed
+	afterAny bool
+	// slots, if set, limits the number of tasks that share it running at the same time
+	slots chan struct{}
+}
+
+// ErrDependencyFailed is the error reported for tasks of a graph that were not run because tasks they depend on failed.
+var ErrDependencyFailed = errors.New("tasks it depends on failed")
+
+// Add adds task to the graph, to be run once all the tasks in dependsOn have completed; the tasks it depends on
+// must have been added beforehand, which also keeps the graph free of cycles.
+// A task that cannot be added makes the graph fail when it is run.
+func (g *Graph) Add(task Task, dependsOn ...Task) {
+	g.add(task, false, dependsOn)
+}
+
+// AddAfterAny adds task to the graph, to be run as soon as any of the tasks in dependsOnAny has completed, e.g. for
+// addons that need nodes from a single nodegroup; it is not run if all of them fail.
+func (g *Graph) AddAfterAny(task Task, dependsOnAny ...Task) {
+	g.add(task, len(dependsOnAny) > 1, dependsOnAny)
+}
+
+func (g *Graph) add(task Task, afterAny bool, dependsOn []Task) {
+	if g.index == nil {
+		g.index = map[Task]*graphNode{}
+	}
+	if _, ok := g.index[task]; ok {
+		g.fail(fmt.Errorf("task %q is already part of the graph", compact(task.Describe())))
+		return
+	}
+	node := &graphNode{id: strconv.Itoa(len(g.nodes) + 1), task: task, afterAny: afterAny}
+	for _, d := range dependsOn {
+		dep, ok := g.index[d]
+		if !ok {
+			g.fail(fmt.Errorf("task %q depends on task %q, which is not part of the graph", compact(task.Describe()), compact(d.Describe())))
+			return
+		}
+		node.dependsOn = append(node.dependsOn, dep)
+		dep.dependents = append(dep.dependents, node)
+	}
+	g.nodes = append(g.nodes, node)
+	g.index[task] = node
+}
+
+// Include adds all tasks of sub to the graph, keeping the dependencies between them; the tasks of sub that do not
+// depend on any other task depend on the tasks in dependsOn instead.
+func (g *Graph) Include(sub *Graph, dependsOn ...Task) {
+	if sub == nil {
+		return
+	}
+	if sub.err != nil {
+		g.fail(sub.err)
+	}
+	for _, node := range sub.nodes {
+		deps := dependsOn
+		if len(node.dependsOn) > 0 {
+			deps = nil
+			for _, d := range node.dependsOn {
+				deps = append(deps, d.task)
+			}
+		}
+		g.add(node.task, node.afterAny && len(deps) > 1, deps)
+		if added, ok := g.index[node.task]; ok {
+			added.slots = node.slots
+		}
+	}
+}
+
+// LimitTasks limits the given tasks of the graph to at most limit running at the same time, on top of Limit, e.g. to
+// create a bounded number of nodegroups in parallel; 0 means no limit.
+func (g *Graph) LimitTasks(limit int, tasks ...Task) {
+	if limit <= 0 {
+		return
+	}
+	slots := make(chan struct{}, limit)
+	for _, task := range tasks {
+		if node, ok := g.index[task]; ok {
+			node.slots = slots
+		}
+	}
+}
+
+// Has reports whether task is part of the graph
+func (g *Graph) Has(task Task) bool {
+	if g == nil {
+		return false
+	}
+	_, ok := g.index[task]
+	return ok
+}
+
+func (g *Graph) fail(err error) {
+	if g.err == nil {
+		g.err = err
+	}
+}
+
+// Len returns the number of tasks in the graph
+func (g *Graph) Len() int {
+	if g == nil {
+		return 0
+	}
+	return len(g.nodes)
+}
+
+// Tasks returns the tasks of the graph in the order they were added
+func (g *Graph) Tasks() []Task {
+	if g == nil {
+		return nil
+	}
+	tasks := make([]Task, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		tasks = append(tasks, node.task)
+	}
+	return tasks
+}
+
+// DependenciesOf returns the tasks that task depends on
+func (g *Graph) DependenciesOf(task Task) []Task {
+	node, ok := g.index[task]
+	if !ok {
+		return nil
+	}
+	var deps []Task
+	for _, d := range node.dependsOn {
+		deps = append(deps, d.task)
+	}
+	return deps
+}
+
+// Describe lists all tasks of the graph, numbered in the order they were added, along with the numbers of the
+// tasks each of them runs after.
+func (g *Graph) Describe() string {
+	if g == nil {
+		return "no tasks"
+	}
+	return g.describe(RunOptionsFrom(g.Context).RetryOverrides)
+}
+
+func (g *Graph) describe(overrides RetryOverrides) string {
+	if g.Len() == 0 {
+		return "no tasks"
+	}
+	var b strings.Builder
+	if g.PlanMode {
+		b.WriteString("(plan) ")
+	}
+	noun := "tasks"
+	if g.Len() == 1 {
+		noun = "task"
+	}
+	fmt.Fprintf(&b, "\n%d %s", g.Len(), noun)
+	if g.Limit > 0 {
+		fmt.Fprintf(&b, ", at most %d at a time", g.Limit)
+	}
+	b.WriteString(": { \n")
+	for _, node := range g.nodes {
+		// descriptions of sub-tasks start on a new line and are already indented for this depth
+		desc := strings.TrimSpace(strings.TrimSuffix(describeWithRetryPolicy(node.task, overrides), "\n"))
+		fmt.Fprintf(&b, "%s%s: %s", strings.Repeat(" ", 4), node.id, desc)
+		if len(node.dependsOn) > 0 {
+			var ids []string
+			for _, d := range node.dependsOn {
+				ids = append(ids, d.id)
+			}
+			if node.afterAny {
+				fmt.Fprintf(&b, " (after any of %s)", strings.Join(ids, ", "))
+			} else {
+				fmt.Fprintf(&b, " (after %s)", strings.Join(ids, ", "))
+			}
+		}
+		b.WriteString(",\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Do runs the graph in the background; it closes errs once all tasks that can be run have completed
+func (g *Graph) Do(errs chan error) error {
+	return g.DoWithContext(contextOrBackground(g.Context), errs)
+}
+
+// DoWithContext is like Do, but runs the tasks in ctx rather than in the Context of the graph.
+func (g *Graph) DoWithContext(ctx context.Context, errs chan error) error {
+	recordGraph(ctx, g)
+	if g.err != nil {
+		close(errs)
+		return g.err
+	}
+	if g.Len() == 0 || g.PlanMode {
+		logger.Debug("no actual tasks")
+		close(errs)
+		return nil
+	}
+	RunOptionsFrom(ctx).Journal.register(g.Tasks())
+
+	allErrs := make(chan error)
+	go g.run(ctx, allErrs)
+	go func() {
+		defer close(errs)
+		for err := range allErrs {
+			errs <- err
+		}
+	}()
+	return nil
+}
+
+// DoAllSync runs the graph in the foreground and returns the errors of all tasks
+func (g *Graph) DoAllSync() []error {
+	ctx := contextOrBackground(g.Context)
+	recordGraph(ctx, g)
+	if g.err != nil {
+		return []error{g.err}
+	}
+	if g.Len() == 0 || g.PlanMode {
+		logger.Debug("no actual tasks")
+		return nil
+	}
+	RunOptionsFrom(ctx).Journal.register(g.Tasks())
+
+	errs := make(chan error)
+	go g.run(ctx, errs)
+
+	allErrs := []error{}
+	for err := range errs {
+		allErrs = append(allErrs, err)
+	}
+	return allErrs
+}
+
+func (g *Graph) run(ctx context.Context, errs chan error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		slots   chan struct{}
+		pending = make(map[*graphNode]int, len(g.nodes))
+		started = make(map[*graphNode]bool, len(g.nodes))
+	)
+	if g.Limit > 0 {
+		slots = make(chan struct{}, g.Limit)
+	}
+
+	var start func(node *graphNode)
+	start = func(node *graphNode) {
+		started[node] = true
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// the slot of the task's own limit is taken first, so as not to hold a slot of the graph while waiting for it
+			if node.slots != nil {
+				node.slots <- struct{}{}
+			}
+			if slots != nil {
+				slots <- struct{}{}
+			}
+			ok := doSingleTask(ctx, errs, node.task)
+			if slots != nil {
+				<-slots
+			}
+			if node.slots != nil {
+				<-node.slots
+			}
+			if !ok {
+				logger.Debug("failed task: %s (will not run the tasks that depend on it)", node.task.Describe())
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, d := range node.dependents {
+				// a task that runs after any of its dependencies waits for a single one, and is started only once
+				if pending[d]--; pending[d] == 0 && !started[d] {
+					start(d)
+				}
+			}
+		}()
+	}
+
+	mu.Lock()
+	for _, node := range g.nodes {
+		pending[node] = len(node.dependsOn)
+		if node.afterAny {
+			pending[node] = 1
+		}
+	}
+	for _, node := range g.nodes {
+		if pending[node] == 0 {
+			start(node)
+		}
+	}
+	mu.Unlock()
+
+	logger.Debug("waiting for %d tasks to complete", len(g.nodes))
+	wg.Wait()
+	for _, node := range g.nodes {
+		if started[node] {
+			continue
+		}
+		desc := compact(node.task.Describe())
+		if Interrupted(ctx) {
+			logger.Debug("not starting task as the run was interrupted: %s", desc)
+			errs <- fmt.Errorf("task %q was not started: %w", desc, ErrInterrupted)
+			continue
+		}
+		logger.Warning("skipped task %q as %s", desc, ErrDependencyFailed)
+		errs <- fmt.Errorf("task %q was skipped: %w", desc, ErrDependencyFailed)
+	}
+	close(errs)
+}
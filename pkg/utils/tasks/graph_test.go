@@ -0,0 +1,299 @@
+package tasks
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Graph", func() {
+	var (
+		mu  sync.Mutex
+		ran []string
+	)
+
+	newTask := func(description string, do func() error) *GenericTask {
+		return &GenericTask{
+			Description: description,
+			Doer: func() error {
+				if do != nil {
+					if err := do(); err != nil {
+						return err
+					}
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				ran = append(ran, description)
+				return nil
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ran = nil
+	})
+
+	It("describes the tasks along with the tasks they run after", func() {
+		cluster := newTask("create cluster", nil)
+		nodeGroups := &TaskTree{Parallel: true, IsSubTask: true}
+		nodeGroups.Append(newTask("create nodegroup ng-1", nil), newTask("create nodegroup ng-2", nil))
+		serviceAccount := newTask("create serviceaccount", nil)
+
+		g := &Graph{Limit: 4}
+		g.Add(cluster)
+		g.Add(nodeGroups, cluster)
+		g.Add(serviceAccount, cluster)
+		g.Add(newTask("create addon", nil), nodeGroups, serviceAccount)
+
+		Expect(g.Describe()).To(Equal(`
+4 tasks, at most 4 at a time: { 
+    1: create cluster,
+    2: 2 parallel sub-tasks: { 
+        create nodegroup ng-1,
+        create nodegroup ng-2,
+    } (after 1),
+    3: create serviceaccount (after 1),
+    4: create addon (after 2, 3),
+}
+`))
+		Expect(g.DependenciesOf(serviceAccount)).To(Equal([]Task{cluster}))
+		Expect((&Graph{}).Describe()).To(Equal("no tasks"))
+	})
+
+	It("starts tasks as soon as the tasks they depend on have completed", func() {
+		serviceAccountCreated := make(chan struct{})
+		cluster := newTask("create cluster", nil)
+		serviceAccount := newTask("create serviceaccount", func() error {
+			select {
+			case <-serviceAccountCreated:
+				return nil
+			case <-time.After(5 * time.Second):
+				return errors.New("nodegroup was not created while the serviceaccount was being created")
+			}
+		})
+		nodeGroup := newTask("create nodegroup", func() error {
+			close(serviceAccountCreated)
+			return nil
+		})
+
+		g := &Graph{}
+		g.Add(cluster)
+		g.Add(serviceAccount, cluster)
+		g.Add(nodeGroup, cluster)
+		Expect(g.DoAllSync()).To(BeEmpty())
+		Expect(ran).To(Equal([]string{"create cluster", "create nodegroup", "create serviceaccount"}))
+	})
+
+	It("does not run the tasks that depend on a failed task", func() {
+		cluster := newTask("create cluster", nil)
+		serviceAccount := newTask("create serviceaccount", func() error {
+			return errors.New("serviceaccount failed")
+		})
+		nodeGroup := newTask("create nodegroup", nil)
+
+		g := &Graph{}
+		g.Add(cluster)
+		g.Add(serviceAccount, cluster)
+		g.Add(nodeGroup, cluster)
+		g.Add(newTask("update vpc-cni", nil), serviceAccount)
+		g.Add(newTask("create addon", nil), nodeGroup)
+
+		errs := g.DoAllSync()
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0]).To(MatchError("serviceaccount failed"))
+		Expect(errs[1]).To(MatchError(ErrDependencyFailed))
+		Expect(errs[1]).To(MatchError(ContainSubstring(`task "update vpc-cni" was skipped`)))
+		Expect(ran).To(ConsistOf("create cluster", "create nodegroup", "create addon"))
+	})
+
+	It("runs a task after any of the tasks it depends on", func() {
+		nodeGroupCreated := make(chan struct{})
+		cluster := newTask("create cluster", nil)
+		slowNodeGroup := newTask("create nodegroup ng-1", func() error {
+			select {
+			case <-nodeGroupCreated:
+				return nil
+			case <-time.After(5 * time.Second):
+				return errors.New("addon was not created while nodegroup ng-1 was being created")
+			}
+		})
+		failedNodeGroup := newTask("create nodegroup ng-2", func() error {
+			return errors.New("nodegroup ng-2 failed")
+		})
+		nodeGroup := newTask("create nodegroup ng-3", nil)
+		addon := newTask("create addon", func() error {
+			close(nodeGroupCreated)
+			return nil
+		})
+
+		g := &Graph{}
+		g.Add(cluster)
+		g.Add(slowNodeGroup, cluster)
+		g.Add(failedNodeGroup, cluster)
+		g.Add(nodeGroup, cluster)
+		g.AddAfterAny(addon, slowNodeGroup, failedNodeGroup, nodeGroup)
+		Expect(g.Describe()).To(ContainSubstring("5: create addon (after any of 2, 3, 4),"))
+		Expect(g.Plan().Tasks[4].AfterAny).To(BeTrue())
+
+		errs := g.DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError("nodegroup ng-2 failed"))
+		Expect(ran).To(ConsistOf("create cluster", "create nodegroup ng-1", "create nodegroup ng-3", "create addon"))
+	})
+
+	It("skips a task that runs after any of the tasks it depends on when all of them fail", func() {
+		fail := func() error { return errors.New("nodegroup failed") }
+		nodeGroups := []Task{newTask("create nodegroup ng-1", fail), newTask("create nodegroup ng-2", fail)}
+
+		g := &Graph{}
+		g.Add(nodeGroups[0])
+		g.Add(nodeGroups[1])
+		g.AddAfterAny(newTask("create addon", nil), nodeGroups...)
+
+		errs := g.DoAllSync()
+		Expect(errs).To(HaveLen(3))
+		Expect(errs[2]).To(MatchError(ErrDependencyFailed))
+		Expect(ran).To(BeEmpty())
+	})
+
+	It("runs at most the limit of a set of tasks at a time", func() {
+		var running, maxRunning int32
+		g := &Graph{}
+		var nodeGroups []Task
+		for range 4 {
+			nodeGroup := newTask("create nodegroup", func() error {
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			})
+			g.Add(nodeGroup)
+			nodeGroups = append(nodeGroups, nodeGroup)
+		}
+		g.Add(newTask("create serviceaccount", nil))
+		g.LimitTasks(1, nodeGroups...)
+
+		Expect(g.DoAllSync()).To(BeEmpty())
+		Expect(ran).To(HaveLen(5))
+		Expect(maxRunning).To(BeEquivalentTo(1))
+	})
+
+	It("runs at most Limit tasks at a time", func() {
+		var running, maxRunning int32
+		g := &Graph{Limit: 2}
+		for range 6 {
+			g.Add(newTask("task", func() error {
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			}))
+		}
+		Expect(g.DoAllSync()).To(BeEmpty())
+		Expect(ran).To(HaveLen(6))
+		Expect(maxRunning).To(BeEquivalentTo(2))
+	})
+
+	It("runs in the background with Do", func() {
+		cluster := newTask("create cluster", nil)
+		g := &Graph{}
+		g.Add(cluster)
+		g.Add(newTask("create nodegroup", nil), cluster)
+
+		errs := make(chan error)
+		Expect(g.Do(errs)).To(Succeed())
+		Eventually(errs).Should(BeClosed())
+		Expect(ran).To(Equal([]string{"create cluster", "create nodegroup"}))
+	})
+
+	It("does not run tasks in plan mode", func() {
+		g := &Graph{PlanMode: true}
+		g.Add(newTask("create cluster", nil))
+		Expect(g.DoAllSync()).To(BeEmpty())
+		Expect(g.Describe()).To(HavePrefix("(plan) \n1 task: { \n"))
+		Expect(ran).To(BeEmpty())
+	})
+
+	It("fails when a task depends on a task that is not part of the graph", func() {
+		g := &Graph{}
+		g.Add(newTask("create nodegroup", nil), newTask("create cluster", nil))
+		errs := g.DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(`task "create nodegroup" depends on task "create cluster", which is not part of the graph`))
+		Expect(ran).To(BeEmpty())
+	})
+
+	It("fails when a task is added twice", func() {
+		cluster := newTask("create cluster", nil)
+		g := &Graph{}
+		g.Add(cluster)
+		g.Add(cluster)
+		Expect(g.Do(make(chan error))).To(MatchError(`task "create cluster" is already part of the graph`))
+	})
+
+	It("includes the tasks of another graph after the given tasks", func() {
+		cluster := newTask("create cluster", nil)
+		oidc := newTask("associate IAM OIDC provider", nil)
+		serviceAccount := newTask("create serviceaccount", nil)
+		logRetention := newTask("update CloudWatch log retention", nil)
+
+		sub := &Graph{}
+		sub.Add(oidc)
+		sub.Add(serviceAccount, oidc)
+		sub.Add(logRetention)
+
+		g := &Graph{}
+		g.Add(cluster)
+		g.Include(sub, cluster)
+		g.Include(nil, cluster)
+
+		Expect(g.Tasks()).To(Equal([]Task{cluster, oidc, serviceAccount, logRetention}))
+		Expect(g.DependenciesOf(oidc)).To(Equal([]Task{cluster}))
+		Expect(g.DependenciesOf(serviceAccount)).To(Equal([]Task{oidc}))
+		Expect(g.DependenciesOf(logRetention)).To(Equal([]Task{cluster}))
+	})
+
+	It("returns the structured representation of the graph", func() {
+		cluster := &GenericTask{
+			Description: "create cluster control plane",
+			Metadata:    Metadata{Action: "create", ResourceType: "cluster", ResourceName: "dev"},
+		}
+		nodeGroups := &TaskTree{Parallel: true, IsSubTask: true}
+		nodeGroups.Append(&GenericTask{
+			Description: "create nodegroup ng-1",
+			Metadata:    Metadata{Action: "create", ResourceType: "nodeGroup", ResourceName: "ng-1"},
+		})
+
+		g := &Graph{Limit: 2}
+		g.Add(cluster)
+		g.Add(nodeGroups, cluster)
+
+		plan := g.Plan()
+		Expect(plan.Kind).To(Equal("taskGraph"))
+		Expect(plan.Limit).To(Equal(2))
+		Expect(plan.Tasks).To(HaveLen(2))
+		Expect(plan.Tasks[0].ID).To(Equal("1"))
+		Expect(plan.Tasks[0].Metadata.ResourceType).To(Equal("cluster"))
+		Expect(plan.Tasks[1].ID).To(Equal("2"))
+		Expect(plan.Tasks[1].Kind).To(Equal("taskTree"))
+		Expect(plan.Tasks[1].DependsOn).To(Equal([]string{"1"}))
+
+		Expect(g.Select(func(m Metadata) bool { return m.ResourceType == "nodeGroup" }).Tasks).To(Equal(nodeGroups.Tasks))
+	})
+})
@@ -0,0 +1,34 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+)
+
+// ErrInterrupted is the error reported for tasks that were not started because the run was interrupted.
+var ErrInterrupted = errors.New("run was interrupted")
+
+// Interrupted reports whether the context that tasks run in has been cancelled, e.g. when eksctl receives SIGINT or
+// SIGTERM. Once it is cancelled, no more tasks are started; running tasks stop when the operations they wait for,
+// which must use the same context, are cancelled.
+func Interrupted(ctx context.Context) bool {
+	return ctx.Err() != nil
+}
+
+// notStarted reports task as not started if the run has been interrupted; task trees and graphs are always
+// started, and report the tasks they contain instead.
+func notStarted(ctx context.Context, allErrs chan error, task Task, desc string) bool {
+	if !Interrupted(ctx) {
+		return false
+	}
+	switch task.(type) {
+	case *TaskTree, *Graph:
+		return false
+	}
+	logger.Debug("not starting task as the run was interrupted: %s", desc)
+	allErrs <- fmt.Errorf("task %q was not started: %w", compact(desc), ErrInterrupted)
+	return true
+}
@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Interrupted runs", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		ran    []string
+	)
+
+	newTask := func(description string, interrupt bool) *GenericTask {
+		return &GenericTask{
+			Description: description,
+			Metadata:    Metadata{Action: "create", ResourceType: "nodeGroup", ResourceName: description, StackName: "eksctl-test-" + description},
+			Doer: func() error {
+				ran = append(ran, description)
+				if interrupt {
+					cancel()
+				}
+				return nil
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ran = nil
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+	})
+
+	It("does not start the remaining tasks of a task tree", func() {
+		journal := NewJournal(GinkgoT().TempDir(), "eksctl create nodegroup", "test")
+
+		nodeGroups := &TaskTree{Parallel: true, IsSubTask: true}
+		nodeGroups.Append(newTask("ng-2", false))
+		tree := &TaskTree{Context: WithRunOptions(ctx, RunOptions{Journal: journal})}
+		tree.Append(newTask("ng-1", true), nodeGroups)
+
+		errs := tree.DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(ErrInterrupted))
+		Expect(errs[0]).To(MatchError(`task "ng-2" was not started: run was interrupted`))
+		Expect(ran).To(Equal([]string{"ng-1"}))
+		Expect(Interrupted(ctx)).To(BeTrue())
+
+		started := journal.Started()
+		Expect(started).To(HaveLen(1))
+		Expect(started[0].StackName).To(Equal("eksctl-test-ng-1"))
+		Expect(started[0].Status).To(Equal(TaskStatusCompleted))
+	})
+
+	It("does not start the tasks of a graph that depend on running tasks", func() {
+		cluster := newTask("cluster", true)
+		g := &Graph{Context: ctx}
+		g.Add(cluster)
+		g.Add(newTask("ng-1", false), cluster)
+
+		errs := g.DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(ErrInterrupted))
+		Expect(ran).To(Equal([]string{"cluster"}))
+	})
+})
@@ -0,0 +1,376 @@
+package tasks
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+)
+
+// StateDirEnvName is the environment variable that overrides the local state directory of eksctl.
+const StateDirEnvName = "EKSCTL_STATE_DIR"
+
+// runIDPattern matches the IDs of runs, which are made of the time the run started and a random suffix.
+var runIDPattern = regexp.MustCompile(`^\d{8}T\d{6}Z-[0-9a-f]{6}$`)
+
+// TaskStatus is the status of a task in a journal.
+type TaskStatus string
+
+// Statuses of a task in a journal.
+const (
+	TaskStatusPending   TaskStatus = "pending"
+	TaskStatusRunning   TaskStatus = "running"
+	TaskStatusCompleted TaskStatus = "completed"
+	TaskStatusFailed    TaskStatus = "failed"
+)
+
+// A Resumable task restores the state it would have produced, e.g. the outputs of the stack it creates, when it
+// is skipped because it was completed in the run being resumed.
+type Resumable interface {
+	Resume() error
+}
+
+// JournalEntry is the record of a task in a journal.
+type JournalEntry struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Metadata
+	Status     TaskStatus `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// A Journal records the status of the tasks of a run of a command in a file in the state directory, so that
+// an interrupted run can be resumed, skipping the tasks it completed. Tasks are identified by an ID derived from
+// their metadata, or their description, which is stable across runs of the same command with the same config.
+type Journal struct {
+	RunID     string          `json:"runId"`
+	Command   string          `json:"command"`
+	Cluster   string          `json:"cluster,omitempty"`
+	StartedAt time.Time       `json:"startedAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+	Tasks     []*JournalEntry `json:"tasks"`
+
+	mu       sync.Mutex
+	path     string
+	ids      map[Task]string
+	idCounts map[string]int
+	resumed  map[string]bool
+	started  []string
+	saved    bool
+}
+
+// NewJournal creates the journal of a new run of command; it is only written to dir once a task is registered.
+func NewJournal(dir, command, cluster string) *Journal {
+	runID := fmt.Sprintf("%s-%s", time.Now().UTC().Format("20060102T150405Z"), randomSuffix())
+	now := time.Now().UTC()
+	return &Journal{
+		RunID:     runID,
+		Command:   command,
+		Cluster:   cluster,
+		StartedAt: now,
+		UpdatedAt: now,
+		path:      journalPath(dir, runID),
+	}
+}
+
+// LoadJournal loads the journal of the run runID from dir. Tasks that were completed in the run are skipped
+// when the journal is set in the RunOptions of the tasks.
+func LoadJournal(dir, runID string) (*Journal, error) {
+	if err := ValidateRunID(runID); err != nil {
+		return nil, err
+	}
+	path := journalPath(dir, runID)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no run %q found in %q", runID, dir)
+		}
+		return nil, fmt.Errorf("reading journal of run %q: %w", runID, err)
+	}
+	j := &Journal{}
+	if err := json.Unmarshal(data, j); err != nil {
+		return nil, fmt.Errorf("parsing journal of run %q: %w", runID, err)
+	}
+	j.path = path
+	j.saved = true
+	j.resumed = map[string]bool{}
+	for _, e := range j.Tasks {
+		if e.Status == TaskStatusCompleted {
+			j.resumed[e.ID] = true
+		}
+	}
+	return j, nil
+}
+
+// ValidateRunID returns an error if runID is not the ID of a run, e.g. `20261017T092145Z-3f9a1c`.
+func ValidateRunID(runID string) error {
+	if !runIDPattern.MatchString(runID) {
+		return fmt.Errorf("invalid run ID %q; run IDs look like 20261017T092145Z-3f9a1c, see 'eksctl utils show-run'", runID)
+	}
+	return nil
+}
+
+// ListJournals returns the journals in dir, most recent first.
+func ListJournals(dir string) ([]*Journal, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var journals []*Journal
+	for _, f := range files {
+		j, err := LoadJournal(dir, strings.TrimSuffix(filepath.Base(f), ".json"))
+		if err != nil {
+			logger.Warning("skipping journal %q: %v", f, err)
+			continue
+		}
+		journals = append(journals, j)
+	}
+	slices.SortFunc(journals, func(a, b *Journal) int {
+		return b.StartedAt.Compare(a.StartedAt)
+	})
+	return journals, nil
+}
+
+// JournalDir returns the directory journals are written to, in $EKSCTL_STATE_DIR or ~/.eksctl/state.
+func JournalDir() (string, error) {
+	stateDir := os.Getenv(StateDirEnvName)
+	if stateDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("finding the state directory: %w", err)
+		}
+		stateDir = filepath.Join(home, ".eksctl", "state")
+	}
+	return filepath.Join(stateDir, "runs"), nil
+}
+
+// Counts returns the number of tasks in each status.
+func (j *Journal) Counts() map[TaskStatus]int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	counts := map[TaskStatus]int{}
+	for _, e := range j.Tasks {
+		counts[e.Status]++
+	}
+	return counts
+}
+
+// Started returns copies of the entries of the tasks started by this process, in the order they were started;
+// tasks completed in the run being resumed are not included.
+func (j *Journal) Started() []JournalEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var entries []JournalEntry
+	for _, id := range j.started {
+		if e := j.entry(id); e != nil {
+			entries = append(entries, *e)
+		}
+	}
+	return entries
+}
+
+// Remove deletes the journal of a run that completed, as it no longer needs to be resumed.
+func (j *Journal) Remove() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if err := os.Remove(j.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing journal of run %q: %w", j.RunID, err)
+	}
+	return nil
+}
+
+// register assigns IDs to the given tasks and their sub-tasks that do not have one yet, in the order they appear,
+// and records them as pending.
+func (j *Journal) register(tasks []Task) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.ids == nil {
+		j.ids = map[Task]string{}
+		j.idCounts = map[string]int{}
+	}
+	var changed bool
+	var walk func(tasks []Task)
+	walk = func(tasks []Task) {
+		for _, task := range tasks {
+			switch t := task.(type) {
+			case *TaskTree:
+				walk(t.Tasks)
+				continue
+			case *Graph:
+				walk(t.Tasks())
+				continue
+			}
+			if !isJournaled(task) {
+				continue
+			}
+			if _, ok := j.ids[task]; ok {
+				continue
+			}
+			id := j.uniqueID(taskID(task))
+			j.ids[task] = id
+			if j.entry(id) == nil {
+				entry := &JournalEntry{ID: id, Description: compact(task.Describe()), Status: TaskStatusPending}
+				if m, ok := task.(MetadataProvider); ok {
+					entry.Metadata = m.TaskMetadata()
+				}
+				j.Tasks = append(j.Tasks, entry)
+				changed = true
+			}
+		}
+	}
+	walk(tasks)
+	if changed {
+		j.save()
+	}
+}
+
+// skip reports whether task was completed in the resumed run
+func (j *Journal) skip(task Task) bool {
+	if j == nil {
+		return false
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	id, ok := j.ids[task]
+	return ok && j.resumed[id]
+}
+
+func (j *Journal) start(task Task) {
+	j.update(task, func(e *JournalEntry, now time.Time) {
+		j.started = append(j.started, e.ID)
+		e.Status = TaskStatusRunning
+		e.Error = ""
+		e.StartedAt = &now
+		e.FinishedAt = nil
+	})
+}
+
+func (j *Journal) finish(task Task, err error) {
+	j.update(task, func(e *JournalEntry, now time.Time) {
+		e.Status = TaskStatusCompleted
+		if err != nil {
+			e.Status = TaskStatusFailed
+			e.Error = err.Error()
+		}
+		e.FinishedAt = &now
+	})
+}
+
+func (j *Journal) update(task Task, fn func(e *JournalEntry, now time.Time)) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	id, ok := j.ids[task]
+	if !ok {
+		return
+	}
+	if e := j.entry(id); e != nil {
+		fn(e, time.Now().UTC())
+		j.save()
+	}
+}
+
+func (j *Journal) entry(id string) *JournalEntry {
+	for _, e := range j.Tasks {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+func (j *Journal) uniqueID(id string) string {
+	j.idCounts[id]++
+	if n := j.idCounts[id]; n > 1 {
+		return fmt.Sprintf("%s#%d", id, n)
+	}
+	return id
+}
+
+// save writes the journal atomically; failures are logged, as they must not fail the tasks
+func (j *Journal) save() {
+	j.UpdatedAt = time.Now().UTC()
+	if !j.saved {
+		logger.Info("recording tasks in run %q; to resume the run if it is interrupted, rerun the command with --resume=%s", j.RunID, j.RunID)
+		j.saved = true
+	}
+	if err := writeJournal(j.path, j); err != nil {
+		logger.Warning("failed to write journal of run %q: %v", j.RunID, err)
+	}
+}
+
+func writeJournal(path string, j *Journal) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// taskID derives the ID of a task from its metadata, or a hash of its description for tasks without metadata
+func taskID(task Task) string {
+	if m, ok := task.(MetadataProvider); ok {
+		metadata := m.TaskMetadata()
+		var parts []string
+		for _, p := range []string{metadata.Action, metadata.ResourceType, metadata.ResourceName, metadata.StackName} {
+			if p != "" && !slices.Contains(parts, p) {
+				parts = append(parts, p)
+			}
+		}
+		if len(parts) > 1 {
+			return strings.Join(parts, "/")
+		}
+	}
+	sum := sha256.Sum256([]byte(compact(task.Describe())))
+	return "task/" + hex.EncodeToString(sum[:])[:12]
+}
+
+// isJournaled reports whether task can be tracked by the journal, which requires it to be a pointer
+func isJournaled(task Task) bool {
+	return task != nil && reflect.TypeOf(task).Kind() == reflect.Pointer
+}
+
+func journalPath(dir, runID string) string {
+	return filepath.Join(dir, runID+".json")
+}
+
+func randomSuffix() string {
+	b := make([]byte, 3)
+	if _, err := rand.Read(b); err != nil {
+		return "000000"
+	}
+	return hex.EncodeToString(b)
+}
+
+// IsResumingRun reports whether the journal of the RunOptions carried by ctx resumes a previous run.
+func IsResumingRun(ctx context.Context) bool {
+	j := RunOptionsFrom(ctx).Journal
+	return j != nil && j.resumed != nil
+}
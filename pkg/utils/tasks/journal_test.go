@@ -0,0 +1,156 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		dir  string
+		runs []string
+	)
+
+	withJournal := func(journal *Journal) context.Context {
+		return WithRunOptions(context.Background(), RunOptions{Journal: journal})
+	}
+
+	newTree := func(journal *Journal, failNodeGroup bool) *TaskTree {
+		return &TaskTree{Context: withJournal(journal), Tasks: []Task{
+			&GenericTask{
+				Description: "create cluster control plane",
+				Metadata:    Metadata{Action: "create", ResourceType: "cluster", StackName: "eksctl-test-cluster"},
+				Doer: func() error {
+					runs = append(runs, "cluster")
+					return nil
+				},
+				Resumer: func() error {
+					runs = append(runs, "resume cluster")
+					return nil
+				},
+			},
+			&TaskTree{Parallel: true, IsSubTask: true, Tasks: []Task{
+				&GenericTask{
+					Description: "create nodegroup ng-1",
+					Metadata:    Metadata{Action: "create", ResourceType: "nodeGroup", ResourceName: "ng-1", StackName: "eksctl-test-nodegroup-ng-1"},
+					Doer: func() error {
+						runs = append(runs, "ng-1")
+						if failNodeGroup {
+							return errors.New("stack failed")
+						}
+						return nil
+					},
+				},
+			}},
+			&GenericTask{
+				Description: "wait for control plane",
+				Doer: func() error {
+					runs = append(runs, "wait")
+					return nil
+				},
+			},
+		}}
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		runs = nil
+	})
+
+	It("records the status of every task of the run", func() {
+		journal := NewJournal(dir, "eksctl create cluster", "test")
+		Expect(newTree(journal, true).DoAllSync()).To(HaveLen(1))
+
+		loaded, err := LoadJournal(dir, journal.RunID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.Command).To(Equal("eksctl create cluster"))
+		Expect(loaded.Cluster).To(Equal("test"))
+		Expect(loaded.Tasks).To(HaveLen(3))
+		Expect(loaded.Tasks[0].ID).To(Equal("create/cluster/eksctl-test-cluster"))
+		Expect(loaded.Tasks[0].Status).To(Equal(TaskStatusCompleted))
+		Expect(loaded.Tasks[1].ID).To(Equal("create/nodeGroup/ng-1/eksctl-test-nodegroup-ng-1"))
+		Expect(loaded.Tasks[1].Status).To(Equal(TaskStatusFailed))
+		Expect(loaded.Tasks[1].Error).To(Equal("stack failed"))
+		Expect(loaded.Tasks[2].ID).To(HavePrefix("task/"))
+		Expect(loaded.Tasks[2].Status).To(Equal(TaskStatusPending))
+		Expect(loaded.Counts()).To(Equal(map[TaskStatus]int{
+			TaskStatusCompleted: 1,
+			TaskStatusFailed:    1,
+			TaskStatusPending:   1,
+		}))
+	})
+
+	It("skips the tasks completed in a resumed run and restores their state", func() {
+		journal := NewJournal(dir, "eksctl create cluster", "test")
+		Expect(newTree(journal, true).DoAllSync()).To(HaveLen(1))
+		Expect(runs).To(Equal([]string{"cluster", "ng-1"}))
+
+		runs = nil
+		resumed, err := LoadJournal(dir, journal.RunID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newTree(resumed, false).DoAllSync()).To(BeEmpty())
+		Expect(runs).To(Equal([]string{"resume cluster", "ng-1", "wait"}))
+
+		loaded, err := LoadJournal(dir, journal.RunID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.Counts()).To(Equal(map[TaskStatus]int{TaskStatusCompleted: 3}))
+	})
+
+	It("rejects run IDs that are not the ID of a run", func() {
+		for _, runID := range []string{"../x", "20261017T092145Z-3f9a1c/../../x", "", "run"} {
+			_, err := LoadJournal(dir, runID)
+			Expect(err).To(MatchError(ContainSubstring("invalid run ID %q", runID)))
+		}
+		Expect(ValidateRunID(NewJournal(dir, "eksctl create cluster", "test").RunID)).To(Succeed())
+	})
+
+	It("gives tasks with the same metadata distinct IDs", func() {
+		journal := NewJournal(dir, "eksctl create cluster", "test")
+		tree := &TaskTree{Context: withJournal(journal), Tasks: []Task{
+			&GenericTask{Description: "a", Metadata: Metadata{Action: "create", ResourceType: "addon"}, Doer: func() error { return nil }},
+			&GenericTask{Description: "b", Metadata: Metadata{Action: "create", ResourceType: "addon"}, Doer: func() error { return nil }},
+		}}
+		Expect(tree.DoAllSync()).To(BeEmpty())
+		Expect(journal.Tasks[0].ID).To(Equal("create/addon"))
+		Expect(journal.Tasks[1].ID).To(Equal("create/addon#2"))
+	})
+
+	It("does not record task trees in plan mode", func() {
+		journal := NewJournal(dir, "eksctl create cluster", "test")
+		tree := newTree(journal, false)
+		tree.PlanMode = true
+		Expect(tree.DoAllSync()).To(BeEmpty())
+
+		journals, err := ListJournals(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(journals).To(BeEmpty())
+	})
+
+	It("lists the runs most recent first", func() {
+		first := NewJournal(dir, "eksctl create cluster", "first")
+		Expect(newTree(first, false).DoAllSync()).To(BeEmpty())
+		second := NewJournal(dir, "eksctl create cluster", "second")
+		second.StartedAt = first.StartedAt.Add(1)
+		Expect(newTree(second, false).DoAllSync()).To(BeEmpty())
+
+		journals, err := ListJournals(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(journals).To(HaveLen(2))
+		Expect(journals[0].Cluster).To(Equal("second"))
+		Expect(journals[1].Cluster).To(Equal("first"))
+	})
+
+	It("removes the journal of a completed run", func() {
+		journal := NewJournal(dir, "eksctl create cluster", "test")
+		Expect(newTree(journal, false).DoAllSync()).To(BeEmpty())
+
+		Expect(journal.Remove()).To(Succeed())
+		journals, err := ListJournals(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(journals).To(BeEmpty())
+		Expect(journal.Remove()).To(Succeed())
+	})
+})
@@ -0,0 +1,248 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Metadata describes the resource a task acts upon.
+type Metadata struct {
+	Action       string     `json:"action,omitempty"`
+	ResourceType string     `json:"resourceType,omitempty"`
+	ResourceName string     `json:"resourceName,omitempty"`
+	StackName    string     `json:"stackName,omitempty"`
+	ChangeSet    *ChangeSet `json:"changeSet,omitempty"`
+}
+
+// ChangeSet is a summary of a CloudFormation change set.
+type ChangeSet struct {
+	Name      string           `json:"name"`
+	StackName string           `json:"stackName"`
+	Changes   []ResourceChange `json:"changes"`
+}
+
+// ResourceChange is a single resource change in a CloudFormation change set.
+type ResourceChange struct {
+	Action             string `json:"action"`
+	LogicalResourceID  string `json:"logicalResourceId"`
+	PhysicalResourceID string `json:"physicalResourceId,omitempty"`
+	ResourceType       string `json:"resourceType"`
+	Replacement        string `json:"replacement,omitempty"`
+}
+
+// MetadataProvider is implemented by tasks that can describe the resource they act upon.
+type MetadataProvider interface {
+	TaskMetadata() Metadata
+}
+
+// Select returns a sequential task tree of all tasks in t whose metadata matches keep,
+// in the order they appear in t; nested task trees are flattened.
+func (t *TaskTree) Select(keep func(Metadata) bool) *TaskTree {
+	selected := &TaskTree{Parallel: false}
+	if t == nil {
+		return selected
+	}
+	for _, task := range t.Tasks {
+		switch sub := task.(type) {
+		case *TaskTree:
+			selected.Append(sub.Select(keep).Tasks...)
+			continue
+		case *Graph:
+			selected.Append(sub.Select(keep).Tasks...)
+			continue
+		}
+		if m, ok := task.(MetadataProvider); ok && keep(m.TaskMetadata()) {
+			selected.Append(task)
+		}
+	}
+	return selected
+}
+
+// Select returns a sequential task tree of all tasks in g whose metadata matches keep,
+// in the order they were added to g; nested task trees are flattened.
+func (g *Graph) Select(keep func(Metadata) bool) *TaskTree {
+	return (&TaskTree{Tasks: g.Tasks()}).Select(keep)
+}
+
+// Plan is the machine-readable representation of a task, a task tree or a task graph.
+type Plan struct {
+	Kind        string `json:"kind"`
+	Description string `json:"description"`
+	Metadata
+	Parallel bool   `json:"parallel,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	Tasks    []Plan `json:"tasks,omitempty"`
+
+	// Retries and Timeout are set for tasks with a retry policy
+	Retries int    `json:"retries,omitempty"`
+	Timeout string `json:"timeout,omitempty"`
+
+	// ID and DependsOn are set for the tasks of a task graph; DependsOn lists the IDs of the tasks it runs after,
+	// or of which it runs after any one if AfterAny is set
+	ID        string   `json:"id,omitempty"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+	AfterAny  bool     `json:"afterAny,omitempty"`
+}
+
+// Plan returns the machine-readable representation of the task tree.
+func (t *TaskTree) Plan() Plan {
+	if t == nil {
+		return Plan{Kind: "taskTree"}
+	}
+	return t.plan(RunOptionsFrom(t.Context).RetryOverrides)
+}
+
+func (t *TaskTree) plan(overrides RetryOverrides) Plan {
+	plan := Plan{Kind: "taskTree"}
+	if t == nil {
+		return plan
+	}
+	plan.Description = compact(t.describe(overrides))
+	plan.Parallel = t.Parallel
+	for _, task := range t.Tasks {
+		plan.Tasks = append(plan.Tasks, planForTask(task, overrides))
+	}
+	return plan
+}
+
+// Plan returns the machine-readable representation of the task graph.
+func (g *Graph) Plan() Plan {
+	if g == nil {
+		return Plan{Kind: "taskGraph"}
+	}
+	return g.plan(RunOptionsFrom(g.Context).RetryOverrides)
+}
+
+func (g *Graph) plan(overrides RetryOverrides) Plan {
+	plan := Plan{Kind: "taskGraph"}
+	if g == nil {
+		return plan
+	}
+	plan.Description = compact(g.describe(overrides))
+	plan.Limit = g.Limit
+	for _, node := range g.nodes {
+		p := planForTask(node.task, overrides)
+		p.ID = node.id
+		for _, d := range node.dependsOn {
+			p.DependsOn = append(p.DependsOn, d.id)
+		}
+		p.AfterAny = node.afterAny
+		plan.Tasks = append(plan.Tasks, p)
+	}
+	return plan
+}
+
+func planForTask(task Task, overrides RetryOverrides) Plan {
+	switch t := task.(type) {
+	case *TaskTree:
+		return t.plan(overrides)
+	case *Graph:
+		return t.plan(overrides)
+	}
+	plan := Plan{
+		Kind:        kindOf(task),
+		Description: compact(task.Describe()),
+	}
+	if m, ok := task.(MetadataProvider); ok {
+		plan.Metadata = m.TaskMetadata()
+	}
+	if policy := retryPolicyOf(task, overrides); policy != nil {
+		plan.Retries = policy.Retries
+		if policy.Timeout > 0 {
+			plan.Timeout = policy.Timeout.String()
+		}
+	}
+	return plan
+}
+
+// compact joins multi-line descriptions into a single line.
+func compact(description string) string {
+	return strings.Join(strings.Fields(description), " ")
+}
+
+func kindOf(task Task) string {
+	t := reflect.TypeOf(task)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	name := t.Name()
+	if name == "" {
+		return "task"
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// A PlanRecorder collects the task trees, actions and change sets of a command
+// so that they can be written out as JSON.
+type PlanRecorder struct {
+	mu    sync.Mutex
+	plans []Plan
+}
+
+// Plans returns all recorded plans in the order they were recorded.
+func (r *PlanRecorder) Plans() []Plan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Plan(nil), r.plans...)
+}
+
+// WriteJSON writes all recorded plans as a JSON document.
+func (r *PlanRecorder) WriteJSON(w io.Writer) error {
+	plans := r.Plans()
+	if plans == nil {
+		plans = []Plan{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Plans []Plan `json:"plans"`
+	}{Plans: plans})
+}
+
+func (r *PlanRecorder) record(plan Plan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.plans = append(r.plans, plan)
+}
+
+// RecordAction records an action that is not performed by a task, e.g. a direct API call, with the plan recorder
+// of the RunOptions carried by ctx, if any.
+func RecordAction(ctx context.Context, description string, metadata Metadata) {
+	if r := RunOptionsFrom(ctx).PlanRecorder; r != nil {
+		r.record(Plan{Kind: "action", Description: description, Metadata: metadata})
+	}
+}
+
+// RecordChangeSet records a CloudFormation change set that is about to be executed.
+func RecordChangeSet(ctx context.Context, description string, changeSet ChangeSet) {
+	RecordAction(ctx, description, Metadata{
+		Action:       "update",
+		ResourceType: "stack",
+		StackName:    changeSet.StackName,
+		ChangeSet:    &changeSet,
+	})
+}
+
+func recordTaskTree(ctx context.Context, t *TaskTree) {
+	if t.Len() == 0 || t.IsSubTask {
+		return
+	}
+	options := RunOptionsFrom(ctx)
+	if r := options.PlanRecorder; r != nil {
+		r.record(t.plan(options.RetryOverrides))
+	}
+}
+
+func recordGraph(ctx context.Context, g *Graph) {
+	if g.Len() == 0 {
+		return
+	}
+	options := RunOptionsFrom(ctx)
+	if r := options.PlanRecorder; r != nil {
+		r.record(g.plan(options.RetryOverrides))
+	}
+}
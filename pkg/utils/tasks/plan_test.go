@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	newTaskTree := func() *TaskTree {
+		subTasks := &TaskTree{Parallel: true, IsSubTask: true}
+		subTasks.Append(&GenericTask{
+			Description: "create addon vpc-cni",
+			Metadata: Metadata{
+				Action:       "create",
+				ResourceType: "addon",
+				ResourceName: "vpc-cni",
+				StackName:    "eksctl-cluster-addon-vpc-cni",
+			},
+			Doer: func() error { return nil },
+		})
+		taskTree := &TaskTree{PlanMode: true}
+		taskTree.Append(&TaskWithoutParams{Info: "create cluster control plane"}, subTasks)
+		return taskTree
+	}
+
+	It("returns the structured representation of a task tree", func() {
+		plan := newTaskTree().Plan()
+		Expect(plan.Kind).To(Equal("taskTree"))
+		Expect(plan.Description).To(HavePrefix("(plan) 2 sequential tasks"))
+		Expect(plan.Tasks).To(HaveLen(2))
+		Expect(plan.Tasks[0]).To(Equal(Plan{Kind: "taskWithoutParams", Description: "create cluster control plane"}))
+		Expect(plan.Tasks[1].Parallel).To(BeTrue())
+		Expect(plan.Tasks[1].Tasks).To(Equal([]Plan{
+			{
+				Kind:        "genericTask",
+				Description: "create addon vpc-cni",
+				Metadata: Metadata{
+					Action:       "create",
+					ResourceType: "addon",
+					ResourceName: "vpc-cni",
+					StackName:    "eksctl-cluster-addon-vpc-cni",
+				},
+			},
+		}))
+	})
+
+	It("returns empty plans for nil task trees and graphs", func() {
+		var (
+			taskTree *TaskTree
+			graph    *Graph
+		)
+		Expect(taskTree.Plan()).To(Equal(Plan{Kind: "taskTree"}))
+		Expect(graph.Plan()).To(Equal(Plan{Kind: "taskGraph"}))
+	})
+
+	It("records top-level task trees, actions and change sets when a recorder is set", func() {
+		recorder := &PlanRecorder{}
+		ctx := WithRunOptions(context.Background(), RunOptions{PlanRecorder: recorder})
+
+		tree := newTaskTree()
+		tree.Context = ctx
+		Expect(tree.DoAllSync()).To(BeEmpty())
+		RecordAction(ctx, "update Kubernetes version", Metadata{Action: "update", ResourceType: "cluster", ResourceName: "cluster"})
+		RecordChangeSet(ctx, "updating nodegroup stack", ChangeSet{
+			Name:      "update-nodegroup",
+			StackName: "eksctl-cluster-nodegroup-ng",
+			Changes: []ResourceChange{
+				{Action: "Modify", LogicalResourceID: "NodeGroupLaunchTemplate", ResourceType: "AWS::EC2::LaunchTemplate", Replacement: "False"},
+			},
+		})
+
+		var out bytes.Buffer
+		Expect(recorder.WriteJSON(&out)).To(Succeed())
+		var document struct {
+			Plans []map[string]interface{} `json:"plans"`
+		}
+		Expect(json.Unmarshal(out.Bytes(), &document)).To(Succeed())
+		Expect(document.Plans).To(HaveLen(3))
+		Expect(document.Plans[0]).To(HaveKeyWithValue("kind", "taskTree"))
+		Expect(document.Plans[1]).To(HaveKeyWithValue("resourceType", "cluster"))
+		Expect(document.Plans[2]).To(HaveKeyWithValue("stackName", "eksctl-cluster-nodegroup-ng"))
+		Expect(document.Plans[2]).To(HaveKey("changeSet"))
+	})
+
+	It("does not record anything without a recorder", func() {
+		recorder := &PlanRecorder{}
+		Expect(newTaskTree().DoAllSync()).To(BeEmpty())
+		RecordAction(context.Background(), "update Kubernetes version", Metadata{})
+		Expect(recorder.Plans()).To(BeEmpty())
+	})
+
+	It("selects tasks by their metadata into a sequential task tree", func() {
+		selected := newTaskTree().Select(func(m Metadata) bool {
+			return m.Action == "create" && m.StackName != ""
+		})
+		Expect(selected.Parallel).To(BeFalse())
+		Expect(selected.Tasks).To(HaveLen(1))
+		Expect(selected.Tasks[0].Describe()).To(Equal("create addon vpc-cni"))
+	})
+})
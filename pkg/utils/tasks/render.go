@@ -0,0 +1,169 @@
+package tasks
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Formats that plans can be written in.
+const (
+	PlanFormatJSON    = "json"
+	PlanFormatDOT     = "dot"
+	PlanFormatMermaid = "mermaid"
+)
+
+// PlanFormats are all formats that plans can be written in.
+var PlanFormats = []string{PlanFormatJSON, PlanFormatDOT, PlanFormatMermaid}
+
+// Write writes all recorded plans in the given format.
+func (r *PlanRecorder) Write(w io.Writer, format string) error {
+	switch format {
+	case PlanFormatJSON:
+		return r.WriteJSON(w)
+	case PlanFormatDOT:
+		return WriteDOT(w, r.Plans())
+	case PlanFormatMermaid:
+		return WriteMermaid(w, r.Plans())
+	default:
+		return fmt.Errorf("unknown plan format %q (valid options: %s)", format, strings.Join(PlanFormats, ", "))
+	}
+}
+
+// WriteDOT writes plans as a Graphviz DOT digraph, with a node per task or action and an edge from each of them
+// to the tasks that run after it; dashed edges lead to tasks that run after any one of the tasks they come from.
+// Plans are assumed to run one after the other, in the given order.
+func WriteDOT(w io.Writer, plans []Plan) error {
+	g := newPlanGraph(plans)
+	var b strings.Builder
+	b.WriteString("digraph plan {\n")
+	b.WriteString("    node [shape=box];\n")
+	for i, label := range g.labels {
+		fmt.Fprintf(&b, "    t%d [label=\"%s\"];\n", i+1, strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(label))
+	}
+	for _, e := range g.edges {
+		if g.anyOf[e] {
+			fmt.Fprintf(&b, "    t%d -> t%d [style=dashed];\n", e[0]+1, e[1]+1)
+		} else {
+			fmt.Fprintf(&b, "    t%d -> t%d;\n", e[0]+1, e[1]+1)
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes plans as a Mermaid flowchart, with a node per task or action and an edge from each of them
+// to the tasks that run after it; dotted edges lead to tasks that run after any one of the tasks they come from.
+// Plans are assumed to run one after the other, in the given order.
+func WriteMermaid(w io.Writer, plans []Plan) error {
+	g := newPlanGraph(plans)
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	for i, label := range g.labels {
+		fmt.Fprintf(&b, "    t%d[\"%s\"]\n", i+1, strings.ReplaceAll(label, `"`, "#quot;"))
+	}
+	for _, e := range g.edges {
+		if g.anyOf[e] {
+			fmt.Fprintf(&b, "    t%d -.-> t%d\n", e[0]+1, e[1]+1)
+		} else {
+			fmt.Fprintf(&b, "    t%d --> t%d\n", e[0]+1, e[1]+1)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// planGraph is the dependency graph between the individual tasks of plans, with task trees and graphs flattened
+type planGraph struct {
+	labels []string
+	edges  [][2]int
+	linked map[[2]int]bool
+	// anyOf holds the edges to tasks that run after any one of the tasks they depend on
+	anyOf map[[2]int]bool
+}
+
+func newPlanGraph(plans []Plan) *planGraph {
+	g := &planGraph{linked: map[[2]int]bool{}, anyOf: map[[2]int]bool{}}
+	g.add(Plan{Kind: "taskTree", Tasks: plans})
+	return g
+}
+
+// add adds the tasks of plan, returning the tasks that plan starts and ends with
+func (g *planGraph) add(plan Plan) (first, last []int) {
+	switch plan.Kind {
+	case "taskTree":
+		if plan.Parallel {
+			for _, p := range plan.Tasks {
+				f, l := g.add(p)
+				first = append(first, f...)
+				last = append(last, l...)
+			}
+			return first, last
+		}
+		for _, p := range plan.Tasks {
+			f, l := g.add(p)
+			if len(f) == 0 {
+				continue
+			}
+			if first == nil {
+				first = f
+			} else {
+				g.link(last, f)
+			}
+			last = l
+		}
+		return first, last
+	case "taskGraph":
+		ends := map[string][]int{}
+		hasDependents := map[string]bool{}
+		for _, p := range plan.Tasks {
+			for _, d := range p.DependsOn {
+				hasDependents[d] = true
+			}
+		}
+		for _, p := range plan.Tasks {
+			f, l := g.add(p)
+			var after []int
+			for _, d := range p.DependsOn {
+				after = append(after, ends[d]...)
+			}
+			if len(f) == 0 {
+				// an empty task passes the tasks it depends on to the tasks that depend on it
+				ends[p.ID] = after
+				continue
+			}
+			ends[p.ID] = l
+			if len(after) == 0 {
+				first = append(first, f...)
+			}
+			g.link(after, f)
+			if p.AfterAny {
+				for _, a := range after {
+					for _, t := range f {
+						g.anyOf[[2]int{a, t}] = true
+					}
+				}
+			}
+			if !hasDependents[p.ID] {
+				last = append(last, l...)
+			}
+		}
+		return first, last
+	default:
+		g.labels = append(g.labels, plan.Description)
+		i := len(g.labels) - 1
+		return []int{i}, []int{i}
+	}
+}
+
+func (g *planGraph) link(from, to []int) {
+	for _, f := range from {
+		for _, t := range to {
+			if e := [2]int{f, t}; !g.linked[e] {
+				g.linked[e] = true
+				g.edges = append(g.edges, e)
+			}
+		}
+	}
+}
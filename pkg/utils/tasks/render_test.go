@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan rendering", func() {
+	newPlans := func() []Plan {
+		cluster := &TaskWithoutParams{Info: `create cluster control plane "dev"`}
+		nodeGroups := &TaskTree{Parallel: true, IsSubTask: true}
+		nodeGroups.Append(&TaskWithoutParams{Info: "create nodegroup ng-1"}, &TaskWithoutParams{Info: "create nodegroup ng-2"})
+		serviceAccount := &TaskTree{IsSubTask: true}
+		serviceAccount.Append(&TaskWithoutParams{Info: "create IAM role"}, &TaskWithoutParams{Info: "create serviceaccount"})
+
+		g := &Graph{}
+		g.Add(cluster)
+		g.Add(nodeGroups, cluster)
+		g.Add(serviceAccount, cluster)
+		return []Plan{g.Plan(), {Kind: "action", Description: "wait for nodes"}}
+	}
+
+	It("writes plans as a Graphviz DOT digraph", func() {
+		var out bytes.Buffer
+		Expect(WriteDOT(&out, newPlans())).To(Succeed())
+		Expect(out.String()).To(Equal(`digraph plan {
+    node [shape=box];
+    t1 [label="create cluster control plane \"dev\""];
+    t2 [label="create nodegroup ng-1"];
+    t3 [label="create nodegroup ng-2"];
+    t4 [label="create IAM role"];
+    t5 [label="create serviceaccount"];
+    t6 [label="wait for nodes"];
+    t1 -> t2;
+    t1 -> t3;
+    t4 -> t5;
+    t1 -> t4;
+    t2 -> t6;
+    t3 -> t6;
+    t5 -> t6;
+}
+`))
+	})
+
+	It("writes plans as a Mermaid flowchart", func() {
+		var out bytes.Buffer
+		Expect(WriteMermaid(&out, newPlans())).To(Succeed())
+		Expect(out.String()).To(Equal(`flowchart TD
+    t1["create cluster control plane #quot;dev#quot;"]
+    t2["create nodegroup ng-1"]
+    t3["create nodegroup ng-2"]
+    t4["create IAM role"]
+    t5["create serviceaccount"]
+    t6["wait for nodes"]
+    t1 --> t2
+    t1 --> t3
+    t4 --> t5
+    t1 --> t4
+    t2 --> t6
+    t3 --> t6
+    t5 --> t6
+`))
+	})
+
+	It("draws the edges to tasks that run after any of the tasks they depend on as dashed lines", func() {
+		nodeGroups := []Task{&TaskWithoutParams{Info: "create nodegroup ng-1"}, &TaskWithoutParams{Info: "create nodegroup ng-2"}}
+		g := &Graph{}
+		g.Add(nodeGroups[0])
+		g.Add(nodeGroups[1])
+		g.AddAfterAny(&TaskWithoutParams{Info: "create addons"}, nodeGroups...)
+
+		var dot, mermaid bytes.Buffer
+		Expect(WriteDOT(&dot, []Plan{g.Plan()})).To(Succeed())
+		Expect(dot.String()).To(HaveSuffix("    t1 -> t3 [style=dashed];\n    t2 -> t3 [style=dashed];\n}\n"))
+		Expect(WriteMermaid(&mermaid, []Plan{g.Plan()})).To(Succeed())
+		Expect(mermaid.String()).To(HaveSuffix("    t1 -.-> t3\n    t2 -.-> t3\n"))
+	})
+
+	It("writes recorded plans in the given format", func() {
+		recorder := &PlanRecorder{}
+		recorder.record(Plan{Kind: "action", Description: "update cluster"})
+
+		var out bytes.Buffer
+		Expect(recorder.Write(&out, PlanFormatMermaid)).To(Succeed())
+		Expect(out.String()).To(Equal("flowchart TD\n    t1[\"update cluster\"]\n"))
+		Expect(recorder.Write(&out, "svg")).To(MatchError(`unknown plan format "svg" (valid options: json, dot, mermaid)`))
+	})
+})
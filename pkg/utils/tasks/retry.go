@@ -0,0 +1,218 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/utils/apierrors"
+	"github.com/weaveworks/eksctl/pkg/utils/retry"
+)
+
+// DefaultRetryDelay is the delay before the first retry of a task, doubled for each subsequent retry.
+const DefaultRetryDelay = 10 * time.Second
+
+// ErrTimedOut is the error of tasks that did not complete within the timeout of their retry policy.
+var ErrTimedOut = errors.New("task timed out")
+
+// A RetryPolicy retries a task that failed with a retryable error, and bounds the time each attempt may take.
+// Tasks that create stacks can be retried, as a stack created by an earlier attempt is waited for, or deleted and
+// created again if it failed, instead of failing to be created again.
+type RetryPolicy struct {
+	// Retries is the maximum number of times the task is retried
+	Retries int
+	// Delay is the delay before the first retry, doubled for each subsequent retry; DefaultRetryDelay if 0
+	Delay time.Duration
+	// Retryable reports whether the task can be retried after failing with err; all errors are retryable if nil
+	Retryable func(err error) bool
+	// Timeout is the maximum duration of each attempt, or 0 for no timeout. The context of an attempt is cancelled
+	// when it times out, so timeouts only apply to tasks that run under the context of the attempt (ContextTask).
+	// Attempts that time out are not retried.
+	Timeout time.Duration
+}
+
+// RetryAWSErrors returns a policy that retries a task up to retries times when it fails with an AWS API error that
+// is likely to be transient, as classified by apierrors.IsRetryableAWSError.
+func RetryAWSErrors(retries int) *RetryPolicy {
+	return &RetryPolicy{Retries: retries, Retryable: apierrors.IsRetryableAWSError}
+}
+
+// String describes the policy, e.g. "retries: 3, timeout: 10m0s".
+func (p *RetryPolicy) String() string {
+	var parts []string
+	if p.Retries > 0 {
+		parts = append(parts, fmt.Sprintf("retries: %d", p.Retries))
+	}
+	if p.Timeout > 0 {
+		parts = append(parts, fmt.Sprintf("timeout: %s", p.Timeout))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (p *RetryPolicy) backoff() retry.Policy {
+	delay := p.Delay
+	if delay == 0 {
+		delay = DefaultRetryDelay
+	}
+	return &retry.ExponentialBackoff{MaxRetries: p.Retries, TimeUnit: delay}
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if errors.Is(err, ErrTimedOut) || errors.Is(err, ErrInterrupted) {
+		return false
+	}
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+// A RetryPolicyProvider is a task with a retry policy.
+type RetryPolicyProvider interface {
+	TaskRetryPolicy() *RetryPolicy
+}
+
+// A ContextTask is a task that runs under the context of each attempt, which is derived from the context of the
+// task tree or graph it belongs to and is cancelled when the attempt times out.
+type ContextTask interface {
+	Task
+	DoWithContext(ctx context.Context, errs chan error) error
+}
+
+type attemptKey struct{}
+
+// IsRetry reports whether ctx is the context of a retry of a task, rather than of its first attempt.
+func IsRetry(ctx context.Context) bool {
+	attempt, _ := ctx.Value(attemptKey{}).(int)
+	return attempt > 1
+}
+
+// cancellable reports whether task stops when the context of its attempt is cancelled
+func cancellable(task Task) bool {
+	if t, ok := task.(*GenericTask); ok {
+		return t.ContextDoer != nil
+	}
+	_, ok := task.(ContextTask)
+	return ok
+}
+
+// RetryOverrides override the retry policies of all tasks.
+type RetryOverrides struct {
+	// Retries, if set, is the number of retries of all tasks; tasks without a retry policy are retried when they
+	// fail with an AWS API error that is likely to be transient
+	Retries *int
+	// Timeout, if non-zero, is the timeout of each attempt of all tasks
+	Timeout time.Duration
+}
+
+// retryPolicyOf returns the retry policy of task with the overrides applied, or nil if it has none; task trees and
+// graphs have no policy of their own, the tasks they contain do.
+func retryPolicyOf(task Task, overrides RetryOverrides) *RetryPolicy {
+	switch task.(type) {
+	case *TaskTree, *Graph:
+		return nil
+	}
+	var policy RetryPolicy
+	if p, ok := task.(RetryPolicyProvider); ok && p.TaskRetryPolicy() != nil {
+		policy = *p.TaskRetryPolicy()
+	}
+	if overrides.Retries != nil {
+		policy.Retries = *overrides.Retries
+		if policy.Retryable == nil {
+			policy.Retryable = apierrors.IsRetryableAWSError
+		}
+	}
+	if overrides.Timeout > 0 {
+		policy.Timeout = overrides.Timeout
+	}
+	if !cancellable(task) {
+		policy.Timeout = 0
+	}
+	if policy.Retries <= 0 && policy.Timeout <= 0 {
+		return nil
+	}
+	return &policy
+}
+
+// describeWithRetryPolicy describes task along with its retry policy, if any; the tasks of task trees and graphs
+// are described with the same overrides
+func describeWithRetryPolicy(task Task, overrides RetryOverrides) string {
+	switch t := task.(type) {
+	case *TaskTree:
+		return t.describe(overrides)
+	case *Graph:
+		return t.describe(overrides)
+	}
+	desc := task.Describe()
+	if policy := retryPolicyOf(task, overrides); policy != nil {
+		return fmt.Sprintf("%s [%s]", strings.TrimSuffix(desc, "\n"), policy)
+	}
+	return desc
+}
+
+// doWithRetries runs task in ctx, retrying it according to its retry policy
+func doWithRetries(ctx context.Context, task Task, desc string) error {
+	policy := retryPolicyOf(task, RunOptionsFrom(ctx).RetryOverrides)
+	if policy == nil {
+		return doAttempt(ctx, task, 1, 0)
+	}
+	backoff := policy.backoff()
+	for attempt := 1; ; attempt++ {
+		err := doAttempt(ctx, task, attempt, policy.Timeout)
+		if errors.Is(err, ErrTimedOut) {
+			err = fmt.Errorf("task %q did not complete within %s: %w", compact(desc), policy.Timeout, err)
+		}
+		if err == nil || !policy.retryable(err) || backoff.Done() || Interrupted(ctx) {
+			return err
+		}
+		delay := backoff.Duration()
+		logger.Warning("task %q failed, retrying in %s (retry %d of %d): %v", compact(desc), delay, attempt, policy.Retries, err)
+		event := newTaskEvent(EventTaskRetrying, task, desc, 0, err)
+		event.Attempt = attempt + 1
+		EmitEvent(ctx, event)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// doAttempt runs an attempt of task, and waits for it to exit. Tasks that run under the context of the attempt
+// fail with ErrTimedOut if they do not complete within timeout, at which point the context is cancelled.
+func doAttempt(ctx context.Context, task Task, attempt int, timeout time.Duration) error {
+	errs := make(chan error)
+	switch t := task.(type) {
+	case *TaskTree, *Graph:
+		// the tasks they contain run in ctx, with attempts of their own
+		if err := t.(ContextTask).DoWithContext(ctx, errs); err != nil {
+			return err
+		}
+		return <-errs
+	}
+	ct, ok := task.(ContextTask)
+	if !ok {
+		if err := task.Do(errs); err != nil {
+			return err
+		}
+		return <-errs
+	}
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	ctx = context.WithValue(ctx, attemptKey{}, attempt)
+	err := ct.DoWithContext(ctx, errs)
+	if err == nil {
+		err = <-errs
+	}
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrTimedOut
+	}
+	return err
+}
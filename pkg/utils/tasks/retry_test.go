@@ -0,0 +1,157 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task retries", func() {
+	var (
+		attempts int
+		retries  []bool
+		errFail  = errors.New("failed")
+	)
+
+	// newTask returns a task that fails with err until it has been attempted failures times
+	newTask := func(failures int, err error, policy *RetryPolicy) *GenericTask {
+		return &GenericTask{
+			Description: "create addon vpc-cni",
+			Metadata:    Metadata{Action: "create", ResourceType: "addon", ResourceName: "vpc-cni"},
+			ContextDoer: func(ctx context.Context) error {
+				attempts++
+				retries = append(retries, IsRetry(ctx))
+				if attempts <= failures {
+					return err
+				}
+				return nil
+			},
+			RetryPolicy: policy,
+		}
+	}
+
+	BeforeEach(func() {
+		attempts = 0
+		retries = nil
+	})
+
+	It("retries a task that fails with a retryable error", func() {
+		out := &bytes.Buffer{}
+		tree := &TaskTree{Context: WithRunOptions(context.Background(), RunOptions{EventSink: NewJSONLinesEventSink(out)})}
+		tree.Append(newTask(2, errFail, &RetryPolicy{Retries: 2, Delay: time.Millisecond}))
+		Expect(tree.DoAllSync()).To(BeEmpty())
+		Expect(attempts).To(Equal(3))
+		Expect(retries).To(Equal([]bool{false, true, true}))
+
+		var retried []Event
+		for _, line := range bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n")) {
+			var e Event
+			Expect(json.Unmarshal(line, &e)).To(Succeed())
+			if e.Type == EventTaskRetrying {
+				retried = append(retried, e)
+			}
+		}
+		Expect(retried).To(HaveLen(2))
+		Expect(retried[0].Attempt).To(Equal(2))
+		Expect(retried[0].Error).To(Equal("failed"))
+		Expect(retried[1].Attempt).To(Equal(3))
+	})
+
+	It("fails once the retries are exhausted", func() {
+		tree := &TaskTree{}
+		tree.Append(newTask(3, errFail, &RetryPolicy{Retries: 2, Delay: time.Millisecond}))
+		errs := tree.DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(errFail))
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("does not retry errors that are not retryable", func() {
+		tree := &TaskTree{}
+		tree.Append(newTask(1, errFail, &RetryPolicy{
+			Retries:   2,
+			Delay:     time.Millisecond,
+			Retryable: func(err error) bool { return false },
+		}))
+		Expect(tree.DoAllSync()).To(HaveLen(1))
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("cancels tasks that do not complete within the timeout, without retrying them", func() {
+		var stopped bool
+		task := newTask(0, nil, &RetryPolicy{Retries: 2, Delay: time.Millisecond, Timeout: 10 * time.Millisecond})
+		task.ContextDoer = func(ctx context.Context) error {
+			attempts++
+			<-ctx.Done()
+			stopped = true
+			return ctx.Err()
+		}
+		g := &Graph{}
+		g.Add(task)
+		errs := g.DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(ErrTimedOut))
+		Expect(errs[0]).To(MatchError(`task "create addon vpc-cni" did not complete within 10ms: task timed out`))
+		Expect(attempts).To(Equal(1))
+		Expect(stopped).To(BeTrue())
+	})
+
+	It("does not apply timeouts to tasks that do not run under the context of the attempt", func() {
+		task := &GenericTask{
+			Description: "create addon vpc-cni",
+			Doer:        func() error { return nil },
+			RetryPolicy: &RetryPolicy{Retries: 1, Timeout: time.Minute},
+		}
+		Expect(retryPolicyOf(task, RetryOverrides{}).Timeout).To(BeZero())
+	})
+
+	It("retries tasks without a policy on retryable AWS errors when retries are overridden", func() {
+		retries := 1
+		policy := retryPolicyOf(newTask(0, nil, nil), RetryOverrides{Retries: &retries})
+		Expect(policy).NotTo(BeNil())
+		Expect(policy.Retries).To(Equal(1))
+		Expect(policy.retryable(&smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"})).To(BeTrue())
+		Expect(policy.retryable(errFail)).To(BeFalse())
+	})
+
+	It("overrides the retries and timeout of task policies", func() {
+		retries := 0
+		policy := retryPolicyOf(newTask(0, nil, &RetryPolicy{Retries: 3}), RetryOverrides{Retries: &retries, Timeout: time.Minute})
+		Expect(policy.Retries).To(BeZero())
+		Expect(policy.Timeout).To(Equal(time.Minute))
+
+		Expect(retryPolicyOf(newTask(0, nil, &RetryPolicy{Retries: 0}), RetryOverrides{})).To(BeNil())
+		Expect(retryPolicyOf(&TaskTree{}, RetryOverrides{})).To(BeNil())
+	})
+
+	It("describes the retry policies of tasks in task trees, graphs and plans", func() {
+		task := newTask(0, nil, &RetryPolicy{Retries: 3, Timeout: 10 * time.Minute})
+		tree := &TaskTree{}
+		tree.Append(task)
+		Expect(tree.Describe()).To(Equal("1 task: { create addon vpc-cni [retries: 3, timeout: 10m0s] }"))
+		Expect(task.Describe()).To(Equal("create addon vpc-cni"))
+
+		g := &Graph{}
+		g.Add(task)
+		Expect(g.Describe()).To(ContainSubstring("1: create addon vpc-cni [retries: 3, timeout: 10m0s],"))
+
+		plan := tree.Plan()
+		Expect(plan.Tasks[0].Description).To(Equal("create addon vpc-cni"))
+		Expect(plan.Tasks[0].Retries).To(Equal(3))
+		Expect(plan.Tasks[0].Timeout).To(Equal("10m0s"))
+	})
+
+	It("describes the tasks of nested task trees with the retry overrides of the top-level tree", func() {
+		retries := 2
+		tree := &TaskTree{Context: WithRunOptions(context.Background(), RunOptions{RetryOverrides: RetryOverrides{Retries: &retries}})}
+		tree.Append(&TaskTree{IsSubTask: true, Tasks: []Task{newTask(0, nil, nil)}})
+		Expect(tree.Describe()).To(Equal("1 task: { create addon vpc-cni [retries: 2] }"))
+		Expect(tree.Plan().Tasks[0].Tasks[0].Retries).To(Equal(2))
+	})
+})
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"context"
+)
+
+// RunOptions are the options that task trees and graphs run their tasks with. They are carried by the context the
+// tasks run in, so that the operations the tasks perform under that context, such as waiting on stacks, emit their
+// progress events and record their change sets along with the tasks.
+type RunOptions struct {
+	// Journal, if set, records the status of each task, and skips the tasks completed in the run it resumes
+	Journal *Journal
+	// EventSink, if set, receives progress events
+	EventSink EventSink
+	// PlanRecorder, if set, records top-level task trees and graphs, actions and change sets
+	PlanRecorder *PlanRecorder
+	// RetryOverrides override the retry policies of all tasks
+	RetryOverrides RetryOverrides
+	// PreviewChangeSets makes stack updates in plan mode create a CloudFormation change set to list the changes to
+	// their resources, and delete it afterwards; otherwise, plan mode makes no calls that change anything
+	PreviewChangeSets bool
+}
+
+type runOptionsKey struct{}
+
+// WithRunOptions returns a copy of ctx that carries options.
+func WithRunOptions(ctx context.Context, options RunOptions) context.Context {
+	return context.WithValue(ctx, runOptionsKey{}, options)
+}
+
+// RunOptionsFrom returns the options carried by ctx, or the zero value if it carries none.
+func RunOptionsFrom(ctx context.Context) RunOptions {
+	if ctx == nil {
+		return RunOptions{}
+	}
+	options, _ := ctx.Value(runOptionsKey{}).(RunOptions)
+	return options
+}
+
+// contextOrBackground returns ctx, or context.Background() if it is nil
+func contextOrBackground(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
@@ -1,9 +1,11 @@
 package tasks
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/kris-nova/logger"
 	"golang.org/x/sync/errgroup"
@@ -20,14 +22,48 @@ type Task interface {
 
 type GenericTask struct {
 	Description string
+	Metadata    Metadata
 	Doer        func() error
+	// ContextDoer, if set, is called instead of Doer with the context of each attempt of the task
+	ContextDoer func(ctx context.Context) error
+	// Resumer restores the state produced by the task when it is skipped in a resumed run
+	Resumer func() error
+	// RetryPolicy, if set, retries the task when it fails with a retryable error
+	RetryPolicy *RetryPolicy
 }
 
 func (t *GenericTask) Describe() string {
 	return t.Description
 }
+
+// Resume calls Resumer, if set.
+func (t *GenericTask) Resume() error {
+	if t.Resumer == nil {
+		return nil
+	}
+	return t.Resumer()
+}
+
+// TaskMetadata returns the metadata of the resource the task acts upon.
+func (t *GenericTask) TaskMetadata() Metadata {
+	return t.Metadata
+}
+
+// TaskRetryPolicy returns the retry policy of the task, if any.
+func (t *GenericTask) TaskRetryPolicy() *RetryPolicy {
+	return t.RetryPolicy
+}
+
 func (t *GenericTask) Do(errCh chan error) error {
+	return t.DoWithContext(context.Background(), errCh)
+}
+
+// DoWithContext calls ContextDoer with ctx if set, and Doer otherwise.
+func (t *GenericTask) DoWithContext(ctx context.Context, errCh chan error) error {
 	close(errCh)
+	if t.ContextDoer != nil {
+		return t.ContextDoer(ctx)
+	}
 	return t.Doer()
 }
 
@@ -52,6 +88,9 @@ type TaskTree struct {
 	PlanMode  bool
 	IsSubTask bool
 	Limit     int
+	// Context is the context the tasks run in, which carries their RunOptions; context.Background() if nil.
+	// A task tree that is a task of another tree or graph runs in the context of that one instead.
+	Context context.Context
 }
 
 // Append new tasks to the set
@@ -72,12 +111,19 @@ func (t *TaskTree) Len() int {
 // is recursively called from the rest of the task Describes and eventually
 // returns a collection of all the tasks' `Info` value.
 func (t *TaskTree) Describe() string {
+	if t == nil {
+		return "no tasks"
+	}
+	return t.describe(RunOptionsFrom(t.Context).RetryOverrides)
+}
+
+func (t *TaskTree) describe(overrides RetryOverrides) string {
 	if t.Len() == 0 {
 		return "no tasks"
 	}
 	var descriptions []string
 	for _, task := range t.Tasks {
-		descriptions = append(descriptions, strings.TrimSuffix(task.Describe(), "\n"))
+		descriptions = append(descriptions, strings.TrimSuffix(describeWithRetryPolicy(task, overrides), "\n"))
 	}
 	noun := "task"
 	if t.IsSubTask {
@@ -140,23 +186,21 @@ func (t *TaskTree) Describe() string {
 // or eventually write to the errs channel; it will close the channel once all tasks
 // are completed
 func (t *TaskTree) Do(allErrs chan error) error {
+	return t.DoWithContext(contextOrBackground(t.Context), allErrs)
+}
+
+// DoWithContext is like Do, but runs the tasks in ctx rather than in the Context of the task tree.
+func (t *TaskTree) DoWithContext(ctx context.Context, allErrs chan error) error {
+	recordTaskTree(ctx, t)
 	if t.Len() == 0 || t.PlanMode {
 		logger.Debug("no actual tasks")
 		close(allErrs)
 		return nil
 	}
+	RunOptionsFrom(ctx).Journal.register(t.Tasks)
 
 	errs := make(chan error)
-
-	if t.Parallel {
-		if t.Limit > 0 {
-			go runInErrorGroup(t.Tasks, t.Limit, errs)
-		} else {
-			go doParallelTasks(errs, t.Tasks)
-		}
-	} else {
-		go doSequentialTasks(errs, t.Tasks)
-	}
+	go t.run(ctx, errs)
 
 	go func() {
 		defer close(allErrs)
@@ -171,53 +215,83 @@ func (t *TaskTree) Do(allErrs chan error) error {
 // DoAllSync will run through the set in the foregrounds and return all the errors
 // in a slice
 func (t *TaskTree) DoAllSync() []error {
+	ctx := contextOrBackground(t.Context)
+	recordTaskTree(ctx, t)
 	if t.Len() == 0 || t.PlanMode {
 		logger.Debug("no actual tasks")
 		return nil
 	}
+	RunOptionsFrom(ctx).Journal.register(t.Tasks)
 
 	errs := make(chan error)
+	go t.run(ctx, errs)
 
+	allErrs := []error{}
+	for err := range errs {
+		allErrs = append(allErrs, err)
+	}
+	return allErrs
+}
+
+func (t *TaskTree) run(ctx context.Context, errs chan error) {
 	if t.Parallel {
 		if t.Limit > 0 {
-			go runInErrorGroup(t.Tasks, t.Limit, errs)
+			runInErrorGroup(ctx, t.Tasks, t.Limit, errs)
 		} else {
-			go doParallelTasks(errs, t.Tasks)
+			doParallelTasks(ctx, errs, t.Tasks)
 		}
 	} else {
-		go doSequentialTasks(errs, t.Tasks)
-	}
-
-	allErrs := []error{}
-	for err := range errs {
-		allErrs = append(allErrs, err)
+		doSequentialTasks(ctx, errs, t.Tasks)
 	}
-	return allErrs
 }
 
-func doSingleTask(allErrs chan error, task Task) bool {
+func doSingleTask(ctx context.Context, allErrs chan error, task Task) bool {
 	desc := task.Describe()
-	logger.Debug("started task: %s", desc)
-	errs := make(chan error)
-	if err := task.Do(errs); err != nil {
-		allErrs <- err
+	journal := RunOptionsFrom(ctx).Journal
+	if journal.skip(task) {
+		return resumeTask(allErrs, task, desc)
+	}
+	if notStarted(ctx, allErrs, task, desc) {
 		return false
 	}
-	if err := <-errs; err != nil {
+	logger.Debug("started task: %s", desc)
+	journal.start(task)
+	start := time.Now()
+	emitTaskEvent(ctx, EventTaskStarted, task, desc, 0, nil)
+	fail := func(err error) bool {
+		journal.finish(task, err)
+		emitTaskEvent(ctx, EventTaskFailed, task, desc, time.Since(start), err)
 		allErrs <- err
 		return false
 	}
+	if err := doWithRetries(ctx, task, desc); err != nil {
+		return fail(err)
+	}
+	journal.finish(task, nil)
+	emitTaskEvent(ctx, EventTaskFinished, task, desc, time.Since(start), nil)
 	logger.Debug("completed task: %s", desc)
 	return true
 }
 
-func doParallelTasks(allErrs chan error, tasks []Task) {
+// resumeTask skips a task that was completed in the resumed run, restoring its state if it is Resumable
+func resumeTask(allErrs chan error, task Task, desc string) bool {
+	logger.Info("skipping task completed in resumed run: %s", compact(desc))
+	if r, ok := task.(Resumable); ok {
+		if err := r.Resume(); err != nil {
+			allErrs <- fmt.Errorf("restoring the state of completed task %q: %w", compact(desc), err)
+			return false
+		}
+	}
+	return true
+}
+
+func doParallelTasks(ctx context.Context, allErrs chan error, tasks []Task) {
 	wg := &sync.WaitGroup{}
 	wg.Add(len(tasks))
 	for t := range tasks {
 		go func(t int) {
 			defer wg.Done()
-			if ok := doSingleTask(allErrs, tasks[t]); !ok {
+			if ok := doSingleTask(ctx, allErrs, tasks[t]); !ok {
 				logger.Debug("failed task: %s (will continue until other parallel tasks are completed)", tasks[t].Describe())
 			}
 		}(t)
@@ -227,13 +301,13 @@ func doParallelTasks(allErrs chan error, tasks []Task) {
 	close(allErrs)
 }
 
-func runInErrorGroup(tasks []Task, limit int, errs chan error) {
+func runInErrorGroup(ctx context.Context, tasks []Task, limit int, errs chan error) {
 	var eg errgroup.Group
 	eg.SetLimit(limit)
 	for _, t := range tasks {
 		t := t
 		eg.Go(func() error {
-			if ok := doSingleTask(errs, t); !ok {
+			if ok := doSingleTask(ctx, errs, t); !ok {
 				logger.Debug("failed task: %s (will continue until other parallel tasks are completed)", t.Describe())
 			}
 			return nil
@@ -245,9 +319,9 @@ func runInErrorGroup(tasks []Task, limit int, errs chan error) {
 	close(errs)
 }
 
-func doSequentialTasks(allErrs chan error, tasks []Task) {
+func doSequentialTasks(ctx context.Context, allErrs chan error, tasks []Task) {
 	for t := range tasks {
-		if ok := doSingleTask(allErrs, tasks[t]); !ok {
+		if ok := doSingleTask(ctx, allErrs, tasks[t]); !ok {
 			logger.Debug("failed task: %s (will not run other sequential tasks)", tasks[t].Describe())
 			break
 		}